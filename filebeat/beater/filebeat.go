@@ -310,7 +310,14 @@ func (fb *Filebeat) Run(b *beat.Beat) error {
 	finishedLogger := newFinishedLogger(wgEvents)
 
 	// Setup registrar to persist state
-	registrar, err := registrar.New(config.RegistryFile, config.RegistryFilePermissions, config.RegistryFlush, finishedLogger)
+	if config.RegistryStore == "log" {
+		cfgwarn.Beta("The log registry store is beta.")
+	}
+	storeSettings := registrar.StoreSettings{
+		Type:           config.RegistryStore,
+		CheckpointSize: config.RegistryCheckpointSize,
+	}
+	registrar, err := registrar.New(config.RegistryFile, config.RegistryFilePermissions, config.RegistryFlush, storeSettings, finishedLogger)
 	if err != nil {
 		logp.Err("Could not init registrar: %v", err)
 		return err
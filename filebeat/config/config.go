@@ -44,6 +44,8 @@ type Config struct {
 	RegistryFile            string               `config:"registry_file"`
 	RegistryFilePermissions os.FileMode          `config:"registry_file_permissions"`
 	RegistryFlush           time.Duration        `config:"registry_flush"`
+	RegistryStore           string               `config:"registry_store"`
+	RegistryCheckpointSize  int                  `config:"registry_checkpoint_size" validate:"min=1"`
 	ConfigDir               string               `config:"config_dir"`
 	ShutdownTimeout         time.Duration        `config:"shutdown_timeout"`
 	Modules                 []*common.Config     `config:"modules"`
@@ -58,6 +60,8 @@ var (
 	DefaultConfig = Config{
 		RegistryFile:            "registry",
 		RegistryFilePermissions: 0600,
+		RegistryStore:           "file",
+		RegistryCheckpointSize:  10000,
 		ShutdownTimeout:         0,
 		OverwritePipelines:      false,
 	}
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzsXVtz47ixft9fgfJLZk5pNJ7Lzsk6ValMfNlVMreMPclJzU5JEAlRWJMAFwBta1P576fQAHgFKVKix7uJ/GTx0v0BbDQaje7GN0/QNdmcoCXB6huEFFUxOUF/Nr9CIgNBU0U5O0F//AYhhE45U5gyiQKeJJzBe2hFSRxKhG8wjfEyJogyhOMYkRvCFFKblMjpN8g+dvINEHqCGE6IYTzV/8JVL0/9d7Um8ALiK6TWBBAiSVhIWQQXYh6hhEiJIyKnaFZ6Cl6jMiclidIA9f2AsxWNMoE1O7SiMZno6/omVugGx5l+E2WShECTKv2TcVUmBq+gNZfKcrLPX3FgVcEx0ffg0kL/XOR0OLS4Hde02WmO4/aOy7FhiQRRmWAkRMsNsOIp0WxYhORGKpIgztDtmgbrAnip70TGGGWRB42iCfmFsx5o3JP3ieaGCEk52w7GPujECsQZPn5EmIZCQqTWVBpRnlZF9+hPuilS4SQ9skS1rJ+gECvXD4L8nFFBwhOkROYurrhIsKo8R+5wkuqh9zqLMqnQ81dqjZ4fP3s1Qc+en7z49uTbF9MXL573612AhG6NIBM7DPUAESTgIkS3WBbtqzVK4Uh2c3ktllQJLDbwrOmtAGtVAPKeEmE+FGYh/FACM4kDVXwP0081xkY7VPqRL38igRtr5sfc3Lkmm1suwm6gua7KJBHFmNIKyjCrISBCcFEBEAmepd1MzvVLTgMGhqOWXxyGVD+LY0TZiuuRHWAJ+gv4yKkTBqsVHUGHxiqz/LrDpMidKl1sgVVAs3SmDQYBD5vUY86iIdQ1kSZpTatBuvrNelE3YmKnqCDmWVjMUaf6J0oFv6Eh0c1UOMQK+6ett/YuWgmeGEr5q1J/q0IF4TCcwwNzR1I/GRApuWidxfSjU3hr6sjWBzYJtozed6XprYpwij5wKakWXJiTJMKCaIITFAVkgrhAIY2owjEPCGbTVmyUSYVZQOZ0y9CZ2QfR7MxB0pMISnCwpqw+dH0cts9MOY/yvN6Pi31gXpKzvJ/V82lCQpol3dzfGhIgYsOYWzOHxlRt5qUpL0eQyScES/XkWbBFkZYIIZgRaTHbUWngUFlMcx0iB7ox/6o5FHvnyV1/0bOvaCzfcx7FxIy0du6CRFun2o/wzLb22YEe8uAaxo8d6Wfut4e4uYekwkqr3zgmgZ6zYZibe3rMyjUXam5mgBO0wrHUHw2zYM2F4/ckH+XfVJWya3IOC3nnhzY9bucEIqY03E8nfmL054wUBBENfVo9Z5f4po9BHMtyAeScdWoBaENimdFYIc66oJSUwY5ITnOemlYXrxgvSSwb3Cq2BOq2J7ZgmUFPGD650GphLkT2B/PLQ2SmjYGSoOpZrqF6CtnU17dKpuU9TC73/yY/2GVF82uMJOlGQXiEHItgTRUJVCZGaEOFHHpEptEU3f3+1fzVywnCIpmgNA0mKKGpfNyEwuU0jbHSJv1+SN5fIkfIYggIU1xOULbMmMom6JaykN+2gKiueHbHYOl4eaxwQuPN3iwMGdtIQcI1VhMUkiXFbIJWgpClDLtaS9MGhMqlDu5vqFRaoc0+PMFhKIiURDYZJDjYr5GOzRqL8BYLUjCboExmOI436O3r0zIGp0eusyURjCgiC23y1/I1D9vifm4GV23agigq65LuabF4aasCqoBGg9RQysMRpodSD6Q8NLrNyyrbVzXVOGl6XtUqUxyM16iCYpOZXoGN2oOaYksX9p1c+zEy1FCC0yYnzBhX4P8ajV2JpJ/nmAZLiW9QsV262I5gsnn5GrpWw8Q8KlTLGx6BfxEeJmyb1zd2j8eUVZ265QZJnolc+H1t8HrFOpxawBJs+sIZqBGAASoIDqfoSq8oAIxrtzTL+aXkcaYISrFaI8XhYuFR1X8XXBQrpsXTGyyexjx6ajyQ05hHi9rih69WklQtrpLfpGic06h9WmdoAjpBUi60cQhNlAoLJRGuex+r/qGGb4hGjAsyx0t+Q07Q8Y4db6XCrQEAkO5v8zGc3910Z1UElCA46SUCPXpJS6mhaLyaGgJlUUnCYx7JiXND/k6qkGfqd4gL+J8I8bsqvFRwmZJAcTEt+RCG9g5laWb2N+rCaVyuVT9rWUSpNHsDRhzNRoMGRFeUFAPdLQ4WmsWitkdgmEsCjlX3gS5oTMCHbSZ182XQo7PzDx/PT19fnZ+dIEkIWsDL0PTF42rPFHf+szul2motUPPcdd7dyJn15Bp+EZEKpTQlMDZSLCQxiqdwxFfGih1RcoKoQlJxQYr5DXZABI0owzFaFLsLC/RIkFQQSZhy+136ZuHi15QrCvGx6ZHSZgn0ccPnHhNJ1DThYRb3+LZ5T5oXeu+UOD79tqtyLva13mzkRsY8mq5wAD618RS0JYjInRK4cDAZdxnlgqqNH4q7OxoUR9DJtuHT1RuS3BD9xhysrbE0MmwrZgk2uhj2VByj7o9y7zAco2lD4+slzDQVPBLjzUz1fWlLvo15vvoYQRJoWGIK5GvbWSAT7quMxtcRdMy9okfEDQ0qy5IB+3aX5m3r6asQ1pIUk5tOAWq3ISKtPOF1b1cFgmj9UiFd2qJtoVt5t2p76perO9jFFGhfmObTCV/lJPMRLY2uo7JD0+s1vtOYhhpPUiyoLDmDiqlE0yqJjNam/nlK84CYhyVXa9hvoqGefgIc52Q5izdl2nLNszjUFhhEQLgFB05xsCbPi0XH0Wtz5ci/2rB30Vs3K1X9FNYM8S08Ck5oyB6uY+h2hc3VNo8FDnTPNVZpZT4dvFB5OWW3jZ1J43D8cHX14czyAct2Wnq9DgtVzJiEKzKveMPahkkPnIA1plpkZx+QdVZNvZwzScS8tmrek7NWNrBzD/pVS5QZBUssaYBwptZGHo39Z6NuvOASota8zr0LWb4a/P78ajhorVK1Vag/o+Xd0mkiHre7Kpw/fXzjZ7tWKp03/cUj8Ae+DQ8yqkioTDmTZF6LPkBtEQhDODvitaiEMv8lDzdzbUdPlxtFZF8ELmLH91IPdCxLlkToGRMI5MYbETdEFLA1uLZuWxEh8t3HKt79Ppcj7WeMI+MUanKtxaH0YHlanhsz9gQWS6EZ48BHr/Epi6bovZ5Y7IIHUdNZ+rEGSfPaeYylooEkWARrlMZZRJkN1CsFJXIBF9rVBOiw9gbXFfzQFtvmfiqaa5ZkY7W2aClmoaeZ/qmj3AEh0XZX43a3nPXoBuQzlNcbqY0Jy7QOtbwZ8xNvdkXHWB0ACGjXYwALeewARdn9gdK0dwGVYhWs7+/rAfldcHnMgj6w8kn4dC24l8IOYtcHL69r+D5od8BSD3btQjT/6sNgGLqvPR4GodtRAEf/pA5SRHiLib7PHPM94bMPEGyqbRXdVxFWayJIqE1mEuqVqNlesIsE5xKsU/TNR4Z4r6mnQW+XqUivoykjTH3Fj5fzbBemgGdMic2cSu6zYEcCdmq4oNnle48piyr+ELP+acURET5POW2YNAO6SI9eqrLQ2BUxVvCjHZOJB7zn72aY1ILB6kgCqjb3jEOzqKFoOAzKceVoF3/BhXUTOP+M8RMYuoP8A2XXWZ+O6LGGyN2BQNsNpUbQeRlFAC6FcWEU/okihBocF429A9tvbZ6CetA92mPBY0ZPFJGwu0NS6ndO7LYUtq49NDvzc1OjclNrcEK2MavsLFX57fyt7eZTKniYBaX8r0o/O99jFlIVll2PcKHF82g8juCP06s1yOOA5/NR1t8V6RijIZ7I+kivcUcdbkkT1lHt4j10zBvKsjvDH7zu6J1eTMdxnuwnCAp5kCWE6XGl7Qy0JAHOZPVrqzXZmIc3DCc0gEnkBosNWm4s+SJNsL+fM+AinNfSTHqKTxfTktkYh3OcNYbKFvoXRiFTVvffg3EYh5b57Mz4M53jF1YlsLWHFG8QBRpA1Q+VkduxoTJym0OdlnptduZiLAC/D6zAAUGrDGJpHWVetFJfskYlFXZPQW1QsMYsIhI9iul1c7pekoAnejQKztXj9g8mhzrntn4vSSQsO8b/YuNi1R+swDpFM1X7UEhRgrDPNtctqH2w5aZMzNsESX7OCGt4i/aZSsoD05G3rtMW52QQ7DAjGxdAAKa8sf+xlDygYB/cUrUub2v62Dan6z4Gylljt9ZL+z6JU0WSvbzXQACyu1lXB+nHhrPRb7lIIBbSACsi7dYp3OJZHoemuMJxHVdzHQCxRfYpKtEvRPAnsBT+A8I2voiv0DFKCGbSJneb8EAhFRBtkbvj4a0zNLGIYMZ0KtFmOQc4jlv3S4bzEkRmsSqFiDge6JHMzK4iF2iFaZwJ0qJOH9ZHsTCGz1RbHtquXzRIdvjOD76Kr7X6rSCCmJs2MF/FKVCGYxgePDnl/mnz5Ni1EimPmNKSqXK9ZeVUeaaI3PCtjOpsUP8FUmtIhtYWu69+upYajIfEJ7YVYkd16dNvHdXeyPczjm7mf//L+qe/1x9ol7ci/WLT2BZsAoGnqsG3LmDShZcqEsLcoiamPEPKhb6HaDpf0VgR0Q5evzUcOXDfumAD+C4j267Q1lghHgSZgCA1zDjbJDyTcxMeMw8JoySc1OJB5npyg8u1p8zPSGC9ap1oNcpMsLD3mntN4STVk9TcBlhMkMjYHJcI2d/mhfbOq/If3o3m823vx3/AelyVQkLqHx49at4xMoPRx/PLK/T6w8y9/LgsJfl7JgAtIPSmmLeLx/SCjpH48QQ0WzyHpKJHxlMTaONN/6ZSZtYp51i1911BZ+d+sy7CrSJY8ibWYtibndYO+Nl3z6fPXv1++mz68rkfcs3CKsJFKQtoiuuu2ibQ/En0SC9r9OuPzZAxA6A2LNqxzvOBNbxza7Vd2rCW9aN5xSDVckTuSJB1dmYQZ1IRcZJwRhUXTxNMG83ZDjUTdCtOkH7CQphs0aePs1ZQT+d3KQ6un0oSZIKqzdN5qbv7Oz0LGwlkq7eCdLI4oBdPY4LFZSB4HH80bw/vQ8t2vuThZitW/VBhklnlSVeIMG2CdyDVL/qxVfzwRehKKkjDgtrTDsjXQs104FFsC0G0DXZD5nbVXmSgzCUJ/MFpq5jjHZwekC4Cuuwvf39rcspQluqlvCQBZ6GcICwRNuQpi5CR/i7vi/H8yzksxudS8RTwjwr9eyyWOCKuYILRGcDW+gA0W9M4244Wb5XiaUpZNM9Bj430SmNQnF/rpb1BZYF2AiuV26pC2Gl35PtT2AeBPREceTLbTcTh/UhzwJOUs1F39qpr+JyBp10xv61ugFRV0aW57/ybMBDO68sa91cooc/Pj5/9/snxqyfPv7t6dnxy/Ork2cvJdy9efPk8e3fxHn35TFlI7qaGxNSCmP6cEbH5gj5bM/8L+pwQJWiwJFg9eTV9MT1+oulOj19Nn7/68vn4C8jN55fTbxP5ZQI/5gmNYyo/v4TfenytqZKfn3338sW3+tImJfLzl4mpewP/AAQwDD//7dP5x3/Or344fze/OL86/SGnIddYhPLzM/08pOh+/tePR4D2x6OTf/14lGAVrOc4js3PJedS/Xh08mx6/O9///vL5Gir3DTFo7Q71dx8rn6jN9wmZ1TVfvE1dJ9X77WJVolrVFswNXhGNsK8jav3E6+IqsrMdiz+hWQVzrvOJeSWBeQ2ANCQIQjghTYIHSI9DBYI5bzm9a6CmuWZTvBwG6TjYYz1OOtgCgqdqlynW+MKYMCYaYPx4vg4kT4otQCCHIcezF1A9P02ZgP7WquLDlaXCisKSnEIv5Z2lVRSO8sPMcESiu6Vr7cwryu2gY0HlTeHb9fVBzG/7f7AA5TmgO6C3NJ5pXSCD965fsy2pexIH+GDlSaebeMCBgVlyDxtDJwWBC+fDxyVbrbrwgC2n35oTKZmetzKVn97SkKji9oAPO8CYP2tNKAswiVH6wwutHhYzc1u12pOEe3vUw3JMtsroqQty802BOgPil6r5XVv+7hb4Om/S1tdQKJbqGLrDEwoEWVg2riirUFttTzbEcD5an4eQa8dTdAR44oGRP9XVgITdHSLBaMsOkKegOyjQFBI4Tx66PC3IruD7rFg3ypkmvxBxv7LZQy8HVmzaNx4YmY5HCTtv0zS3EROZXkWn132Dy+dzS7zEkqt9bQoba+r1yOQtMEDffXsdg1hh3x2464aNZ/9qsi73ZbTfkgbr7CFNc/cpOjeD3/gYJOAYZcAs5Z86JQLv5txt8hDBwB2Lrt83r/qMgf3UP3hqkik2DZaHixF/aFLCshsOZcKq6x3NYG+zGW2NIQ7uN9S9uL5+Pz/Yarvoq383W4gbKg0okvHGJSwu1PzNbR8Caq8sTr7ThdU2d1rzEJE82MLOvSEnbjGx1LeRLfTWKXAUF6gk0DcWe2kFx/Uh6wGEnB+TUfuoVrJSsMCSRP8lAesdE8vtaOVRkIG5czXBIdW2Xdj+I1WKgHYrpd/VdCNNmlHfih1Mnapk+xQ6uRQ6uRQ6uRQ6uRQ6uRQ6qQO5lDq5FeePnRIkDmUOinj8JQ6Ga3CSZvPeHiJk4d2ggH3kd2TlvlW7+TDusst95HbbplvbftDujEOGwUVtg/tkBUES87m6VpgObILx0LQ9JGh37pXkt2HKxL20dI0dvsBKeexZ2Y4WF/538H6Olhf/2HWlzspEK+uy9GDf9W/WyIP4F5RjMt7hp8lh/YPHdyzFJUB6w4h6G351Y/v6dnBV+1n9cT2MNYmL18hvSLm9h+vP76r5671iy5xp1P4eD5Mbbp7yOo5zQOTXEdTiWyBJ93/bVUDcSNzd9fG/wXfYENwEASobTXWdIrQFZTKoqxD3nrMX55uQeOonVovmcpeXf2Etkor6vpoPWEh9NbQRikWRUkgja4dziqL6+N1HCxQ2yeLY9c99a/plDVdYlbW1uZCi7o2N7tjvXOK6DersEfNffyr6TN//iOqJcGMaJafZkKYDT047WdlgbSuFOs12AzrxpGmqO2I8uIUT6mwLNdpcZdahMrd7harEl00umBZoG9KQKvdsE/BSte8Id6icefUll1fPTB8jLqMiT3XibkpUSvIOzG1YAIuzDoaKgO+4dHLn8zjbZGQ91fFlQs7xdzmJUtqlWq6ss9H+nCz0loWL3lmz2HNGDPlZaHEbQFQ9+4WeDGP5tCO/qN9C8ZrsrG1U+OMmOwZUHSldXhHDepmgvTgAdckcRhZh5H11UdW+6gaju4jvkVhlqT5nqY7fLXJJI88AF/UyK69cta1YdDFu5FRu5/E2GoyBe8TNGNppuQEXUAtMDlB7zOlr2iZOuUhCdqqWXB+PafMl0S6u+v3HBKvofQHFDGxqTfOKdgnMNThYpg1Ih7uDRYw60JlP2eKBW4JnB0u0ZcQVlYUNy1BMkXLM9H043kBzb2T1H7z15M/VpFVIJnY9+WmhNkzoXX9Y03jhLOIh8uSZWyv9E/LeatfOPvz9tScghcakp5TNV9L3O67zLtnq7UNgQ/FlgyxbcLZOCvCN3nnfrRZ5fK2HG6/o2oLoouMQVUhHKMAKxJxQX8xwrgN3On7t29fvzsbCJE1RnQPw4fcqa1wKKMKszCmUhE2CJSPbB8jw/pgOt1XJS3mxuZG/hyXRubbzeXf3vQfl5oVvFIdmb1PZXDs0a65dC0AUMeIHT84ogpkeIzE1/SUGxOvXt9l92n3NQR2m5Z/O/3f6fNJpQ6+tShpOIV6+eY5u3kv84L95TcbHEwJ8spBzu5IFdqy0b5lO8DmsHY0tHup8dD7ASMuIrfIsuYwSJQ9weM9GmqYmbMPIFXant6d1/ZpTwsZzgzSPmwZUbfO6WDtvkLb2RGNDf0+YQONeqYjADH5oFohTCUJvGK4a7FCqLMCZnWBRtvwk70KFsY8uL4XvDiBsyS0UVvFfIupKh0aogFo7bMkRSADnK7SoGqsZCr3aq/gtxIyiEZSvdUkG00dCaIywQqzvWPwABqtFCkjYx5AVUMkA8z6AWqbBfcBkzF6V5ojFb4mrNBxi8vzq+Luogtcs0pVv2i5vHhVi/IYs+eLQtlodpYLueVu7T0WUXZXsvfe6d/D7D14ZUd7z7FH+9h7HgDoq1dQKIDsUEchj8Sa6wWCVwSwEHigwL1m5i1Ti1hzKE00RMIhRFTrLM3UHQ5mj1kJeJJwppUhZUGchWSClkTSkMjS4X0NjgX5SYWV+VYmtVKimF4TtPi/Jxdc3GIRklD/t5iiS0IQjqU5eWWR98nCF552j+HEp41Q4tIBMWm2jGnQmLCriOErLkznT9FshRgvXmzwK3oJC+LC7azV7LF1LQ5Bb7BqWg4+IE2OAKzVXvvVFlA4xPFW2D5kSPVDxxD/RrOvH6wIxyF5euzk6U+H5OlD8vQhefqQPH1Inj4kT9fBHNJ3Duk7h/Sd32b6jrvccBzdSxZ14Tcavk84cvjcuQEAe/+PyDSaGkgT5Oq4thxF2zwSedcV4Id8H48wRVeUCPTow+ysha8a0VtqdyUd27Zcm/zkwdFYnxZO2m3sx99QNCLn6FqXMJfOue2cwu9lft6Ch6h1x5K7lAtVePYXls6iO62t4Ib2D2c3ByvvN0TB77nyt8ke3GzOVpFE9R2o4zvUyvOd3X9bY1XUEiydKdriEPAdCrgHqAsuEGWBIAlhSi8HscITlGBxDQGu2oAxIa553UMcho2NJmRqACb8hoTlQ/I5g9YewTtHE3Rknzma6BeOJMOpXHPVUmh6zaWaF6Nr3C9R0lVOn8OOcqXso5Vyu/Kn0kXYNue8d9rqi+NNTqg9ezxj9A72S0dSRZ+qm2NWukCGyhu7SFIW2HjllAfrKfok7SZqwJM0U25jaPGn0l5awOMsaSsziWPCQiy8jcl2/jo21lIQawPngWPGSCxOrKMJgd1bY3Hb8W4/Wb5TlnKpIkGq4VEfzMXBMVLFeztunFXQoN1DG6tA7ju6sb5z19YN7u9XEyRFE/ILZ51n+raz+sVqr5zt14nEKptTfv3R9E2WTkIOk+YBrp0hUS5IvkE2d0tihZfNkh+lM8s2JgZ4MEsv5X7BXxevr16/GTv0K/RFcXcFsZRPJ5seD4Jz5sKz+QrhoSELBd/L8zfnp1fof9DFx/dv4RvKPwzC8TdbHB4rMAEeKibOamtBwsqhDx/17xYdDfe6sy4dOfTgubwGbK4teyrL8ZZoV6Vwy9mZm00NKrMP1hZeNHYalaZY5e9Kf0/RacVsXCRYKiIWE7SQMb4h+p9gTeNwgR7pmfnj2cXT1+8v0K1e57IIwb3HE59tutCGBGUkXvSPNB0ro63RLEgy1I25IWLJJbTLnNSyALt4YU9nacF6L4OxQXXE4NRLF30KkRJCr8LIjTsW34jADcUII0bULRfXpQV7X6siSIbEF/QKwkoSzML87O/uCWM62iEBP0BXsajtiEyHC/KzAtGdCTWq9ii0RsdkdU1GPNtIc70mm+qSzHVA+WD2lqWyGLMOAgSkiihL4KTjW6rWLaACHMcakp3RzEZEaUq7hAv91x2GwI7rjZw72idSzwcBdYXqZWo95nrjDWXZHVAtEom+emIGlgi8jTkqjae7yE/LeQc9g9vBV7QD11TwSOBkd/tgZ8aj6psPhcJxwMBXJl2Fo+2Axp8pe6Vn7ZdEAe6cIn+gcAiaUCGJFPdsdpT5SlkPQ9h5d9OORGmOwAv0bHR5+YNuN2X21Pd+W4tdaeY9lsS6Y2qM62bV0esgIKkyfsYLTOPczThjNzim4dG09IyHR0IwkwgjmUEk8CqLDbtpQcE+Yz+MDWewkU4u6Tbf6fWwsLvSOb46vaKJWCmSpAqtsUQreLjez53RlQO6tBbJaQMm652bYin1pHkEPWqiYq/J5qgNVWOD3Qmh50YvqEWl4FqqTbW/9Ayc4Ob+aG6xCZ6mJGxGHo+MT/dsYcbaT6zNX54SZg48ShISUqxIvHGo2kB7av92xnYMAQwVgPfqUkkjhlUmmgLfC0f+eu7itcBM5PU12bQx9sVxdOm6HoAGR3Ms7JDWo2jaEvRu/sYO6/AHdrSHdgwI7ti+Pd9rg35QiEe/sIH7Q0ZVQ85Q76iKe4Nl2Hb21vaQmNHQbQ+M6RUa0yc4ZkB/bQuQ8dtIWcjv0UgyplGe/On21jXXhVstDjScalEs5i93BIMl8u79FWz4ZSEnohlF2UsdV2ILNLUASzMraLL5SrfbJlGNI457cr+6+mdpHqpwpG3r/dI8ebujHRTYYoMhFSRQXGz2AOENHc+/k+B8R/NXYRERZVcGvOR8qAOUt1QFa88udamkR+KbUfp1Vc0xBq47DWHLgNO4cehfIN7rmLOMdxx2XoXfq6OK3KkloSwycROtQtNYOvc28LrYz85abafRGcJH7OC49gWR96Cr30MrHoelSA1GbqGBrSbpmnjq0vZgFpIVzmJlCHSw84o49MCDyLjj/NWFvGyr6F4CIPcgc60ACieRh33JC3pf9TUM6ZKH9IGdkhbPV3dL9uF7T47JXqwbojeGB7IP56/og7Q7DkpgsqLXpS2HK3NlWKyTfWl7rbaCH9pnk8HLDz1IQQAHZZ+SAN4PPlJie6uBdUgBP6SAH1LAfegOKeDokAJ+SAFnhxTwQwp4b1iHFPBDCvghBfyQAn5IAT+kgDdA/YpTwKt9AQuyOcjNiMudUn1Mw0F62a8EZ4qwsH1lvpsTqDxqHA8Y5v41Fw6uNYi25e4WDH7HgMjParHk7aaZWwJTcKiYUoHf/H8AAAD//4U5XCQ="
+	return "eJzsfWuT27iV9vf+FSh9if2WzPFt/GZ6t1Lb27YzSnyLuyfZLcclQSQkYZoEOADYbc1W/vvWwYUEKZAiJbadnSjjSrV4Oc+D28HBwTng2SN0Q7bnaEmwOkNIUZWSc/Sf5ldCZCxorihn5+gPZwghdMmZwpRJFPMs40y/h1aUpIlE+BbTFC9TgihDOE0RuSVMIbXNiYzOkH3s/EwLeoQYzogBjuBPfTWICf+uN0S/gPgKqQ3RDJEkLKFsrS+kfI0yIiVeExmhmfeUfo3KUpQkCgjC/ZizFV0XAkMR0YqmZArvwU2s0C1OC4KoRIUkiZZJFfxkXPnC9Ctow6WySPb5a66hajymcE8/v4CHF6UcrkvczivarTSHuL/iSm5YIkFUIRhJ0HKrefCcQPHZGsmtVCRDnKG7DY03FXGv7kTBGGXrABtFM/IrZz3YuCfvk80tEZJytp+MfdB1K3jZNP6aMKgYkiC1odJ05ajedSf/AUWRCmf5xAqFvn6OEqxcPQjyS0EFSc6REoW7uOIiw6r2HPmCsxyG3kWxLqRCT1+oDXr6+MmLKXry9PzZ9+ffP4uePXu6v0AlJXRnOjKxwxAGiCAxFwm6w7IqX6NQCq9lN8qFWFIlsNjqZ01txRhUge7vORGmoTBL9A8lMJM4VlV7IK0TGsBGO9gn4P454sufSezGmvkxN3duyPaOi6SbaKmrCklENaZAQRmwBgMiBBf2bQOzFrzIu0FewUtWHmCAdgSdhJOEwrM4RZStOIzsGEsCHU3jaI2IUKUVnUDHxiqz8rrjpMiXSv200qqoWTnRDkDMk13pKWfrIdJByK5okOU9HGqzXtLhxchNUXHKi6Saoy7hJ8oFv6UJgWIqnGCFw9PWW3sXrQTPUFx7VSKcJJUKwkky1w/MnUgAiYmUXLTOYvBopN+KnNjmwCbxntH7zpve6gwj9IFLSaHj6jlJIiwIIvHTKVrHZIq4QAldU4VTHhPMolZulEmFWUzmdM/QmdkH0eylowSTCMpwvKGM9EDYPzOVGP683g/FPjD3+llZz+pplJGEFlk3+lsjQg+qYeDWzKEpVdu5N+WVDAr5iGCpHj2JuylceIIQCEK0mu2o1CYFmBPlNNfGKBdc60aaNKnYO4++dDPxu559Bbj8kfN1SsxIa0cXZL13qv2on9lXPjvQEx7fEFGN9Jfud0C4uYekwgps0jQlsSKJGebmHoxZueFCzc0McI5WOJXQbTCLN1w4vEflKPcGuV/kklZ4fvBf8V+zcwIREU2O04k/MfpLQSqBiCZRF1yG10dqYb9faHHOOrUEwJBYFjRViLMuKp4yOJCJncuJ0P2vCyvFS5LKHbSaLbHHntjDZaZrwuCUnRYGa9VlfzS/AkJmYAx4HZWLgOqp+iaI3dszLfawfnl8m/xolxW7rTFST4dyBTs5FvGGKhKrQoxQhpo49IBE6wh9+f2L+YvnU4RFNkV5Hk9RRnP5cJcKl1GeYgUm/XFM3l8hJ8hyiAlTXE5RsSyYKqbojrKE37WQqK94Dudg5QQxVjij6fZoCCPGFlKQZIPVFCVkSTGbopUgZCmTrtLSfIcCzfuhv6FSgUKbfXiEk0QQKYncBchwvIMwqJAOZoNFcocFqcDAAVDgNN2itxeXPgenR26KJRGMKCIrbfJn/1oAtrpfmsF1m7YSWtmye6fF6qW9Cqh6dLAaynkywvTg1UDOEy36LAhV0GRUJJC3AwRwMsfxeIWqJO6CwQps1BpkPCEtVdh3cu0HZKShDOe7SJgxrrT/azQ4T2QYc0yDxcMtxbZUagU7gskWxDVyrYZJ+bpSLW/4Wvs99cOE7fP6pu7xlLK6U9cvkOSFKDt/qAxBr1hLocCppSG1Te+WD4aB9mQJgsHbCl46TcaVW2rDCi8lTwtFUI7VBimuL1YeVfjvNRfVimnx3S0W36V8/Z3xh0YpXy8aix++Wkmizlr8JlXhnEbtUzojU7MTJOcCjENdRKmwUBLhpvex7h/a8Q3RNeOCzPGS35Jz9HiHW7+Kt73CrQE0Iahvs8ByfndTnTV2UgmCs15doEctQS81Eo1XEyiAk63q4Slfy6lzQ/5OqoQX6nfgGIG/iRC/q9PLBZc5iRUXkedDGFo7lOWF2d9odk7jcq37Wf0uSqX2ldruqJ06CAjRFSWuhlC5OFgAxKKxR2DAJdGOVddAr2lKtA/brHV114rQg5evPnx8dXlx/erlOZKEoIV+WRd98bBeM9Wd33al1EsNHWpeus67CzmznlyDtyZSoZzmRI+NHAtJjOKpHPG1sWJHlJwiqpBUXJQ2E9LPcEHXlOEULardhQV6IEguiCRMuf0uuFm5+GEU1hTiQ1Mj3maJ7niNYkP3kERFGU+KtEfbljVpXui9U+JwvGm1D4p9rTeM3MqUr6MVjrVPbTwFbQUi8kUJXDmYoOZzQbmgahum4u6ORsUJdH3bFLmrNiS5JfDGXFtbY2nka/BZFBlmurfpTV8H1N0o907DATVo2CUMuOfXYryZqbkvbcW3gdNkvJ5AEw9Ui6+Dmj7hWmU0XCfQgQe7HhG3NK4tS0I13YJyZd62nr6aYOhJKbkl6XCpb/h6DcpTv94Qa8oQCwJzUttWbovc2rt12xP2d+s72NUUaF+IyumEr0qR5YgGMVTCxNiu6WGN7zSmkcazHAsqPWdQNZWALK/LwPZ2eJ6C2UTHPCy52uj9JprA9BNjV/cIcZZufdlyw4s0AQtMR0CUPg1G1Crld9Wq4x1Rr82FQKW+holKGjb2Qb2XPPvwevZfCATZfWw59Ryp1tNhoawZ07I+qfiEfRUtLQ1j3W702r5veEy12AThlYLtbm0WgBtPr14RSUmmm9K8U0ozxfGfzHhC0siV/46qDS8UwighK8r0ZrJuBw1WilloRvN/p8kfFnoncEGYIiIXVJL5v+eE/WHuPaGtp+qBR9a8qtxd+lk51RVu+zKwRgLfWYsMS7QhX5BUogy9qNeyX9OeHdk2XDsq3FW6v0fnqnxhG3EOHWKhC+6uGJNQulWbz4d80UsrEVnP2/HcrCBdYSC7VIsOqYNEm8/WU889GLgxYqVN0ZPHupl1B+uCN8v0ai/4UAJGDqIJelBy+eEhNAlfSiJuTe9OeAadCZ7SzB7amqqJM1UDaro+pwX5FzmonnlG05TKI8tgZDUbD4xoI57EnCUyQu84LC9UvwouNaMHtzOt9CAHcrzOr418C5IE8F275rfP5239vK/DHNp39uH2uWtkKy+AmhCpKNNtPTK0J7kDvyr1i7GgXwwu9YjQg0qtY6ygQ8zh/44cC7bQIGlPiUeF9Qvcgp0LrnjM07mxRVaUiCNBZx9KoYgV2TKosHmsiJonJFV4HvOCHVtSAwTKZrkFP7jVOjCTIUkh7AYGey7ILeUFWHlt1YHjm3siZkQfTo2yNYzAOQVbYxXaChnGjLKEfHFsSqGam6N6R4Q2Dwi9JYkOSNhhRb4NKfDbVIQcmUNM0KsiyyDykq8ahnAhnWNIm2Iaomy9D5oMLBB8ULt02RuIqB3Q2jm2U10HTmMrKsBpplk5jkBMT2ySkFDTpViOSyLFgzmU6u54s7EUVakfj8QUpfSGIBXnYEUVSR4gc5viYy3Hv765eIcqVeozCADGPMsKBp4kmhxfAZdOGoQYAiLaYLnZQ8HMTBHNj51ee9gzJdbti2PRXvRGG2EatVAtetniHB3h4CG9vbjcXzAdoBcxouZ6ypsrrnC6Q+HQ2UrLhHQQ5SIeDLepyTGw22luKauN6D5UrRIfmaybGo6lC/bSKEPBN7zaG9LCjTEaBgCOMCB8tJZRAY+MMyZ8sNrAsK4wnON4Q55WrrDJhbkyCTvD7F301u3Q1GN27JacP3W7MlVIgywMB+gyJLptAxzHocWOj9MvsNPuAcpye8/x+PH6+sNLiwPOz5JSiJZPTZCMKzKvdc6uht3DE/5dphTG7OxD1awhZEg3mXuu7xGQYayCWN22kEOWmL2/JZY0RriApDXwzcI4LzPQguQyojY8GcCsjIz446vr4aRhewF2SKEZIThtw1t4FSIdQGoo8k8f34RhN0rl810/3Aj4usRWchhbEJlzJsm8kYnTquiGIDvhjQwdH3/Jk+0cZqFIz599GbjstdBLPdg1Z21r8YGjkIiKNpBrq7YVEYKIIN/jmsuJDgPjNWEqiNrIyeoBWao9mP0L9kgHDiQwsoXBcQ529B42WezmP6KmsuCxHZHmtVewrqGxJBDUjPK0WIPTVSetegm6XOgL7WoCEObtBW4q+KEltsX9qSquVuWjlbYqKfjld4sZnjr8CkgI7EHu3O7uZz2qIbhpvNlKGuPUgkatpDL8c5mh2GusDiCkZbtNBMes6o8dpCi7P1KUHUYqxyre3F/rafGH8AqYBX1olZPw5UbwjBxO3O92ffhyeQDbA7g0E7+7GM2/+jAYxu5rj4dB7A7sgKM3qaO0Jpzmo88xfyR89qG2ub7GakMEBApgsJ65W1zbRYKdf3YkhuYjI7zX1LMj75CpCPbhKSNMfcXGKzGjDloFU2I7p5KHLNiRiF3CtobYotnV+4Ap6/NJuVn/BMTYDkX4POeUqcOYgHYA3UJVkejGRSlW+kc7J5Mbe8/tZkAaiZFNJjE4bu+XB0A0WJw1afhnLLQP9Q5APzqo8hMYuYP8A34YWZ+K2FMJtdA4LdsNpZ0DGHwWsXYpjEuj8k+Uo1mj7MbR2noLU6snGBy74AFiKV+vSdJdIVWI5N55sweijSJBs5dhNDUqmtrogLw2sFqU9UhtbWTCJlJSxG4LsFnPzvdYJFR5J2hMLvSFFs+j8Thqfxys1kA21s+Xo6y/K9IBh0d8SzGbI72BHhrfDtCkOCHUhjhQx7yhrPhiSgHwJgoIp6nF11F5CY8LiPMjCQI7Ay1JjIsyntYS2ZCteXjLcAaOO5agW9jPXW6t+OrILL8PNcvpl9VsAftHYfQooes+XaAVBE+TOa5nTvaQD2lVKV9T1oxlhcbkaWLBZy/B61FlwutViQ5zR4rvCNUytNQwVUbuxqbKyF1JNfJqbfbS5Rtp/iGyAmIUVoXOK3eSeVVKuGSNSirsRqzaoniD2ZpI9AA2gXeELknMMxiNgnP1MFwL0GCSyBErAdpLEqmXHeO32LhcocEqrhGaqUZDIUUJwjtCdTkUbzTYcusLCxZBgquZxWTEqcQfmE683UMMc8BxrIbD6CLjWJvyyB4RJ3lMIQ5eRyD7If4h2N3pugdqdahQGeUZlH2fwqki2VHeay0AAkSx7YTtOMNh4C2XFccS2Ngh0qYR6FsQGG5LqTenm7zqXOA/nWdnn6IS/UoEf7TEkiT/hrCN7OYr9BhlBDMJuWl2MJlYHdq6fscutXRA6YxMLNZ6xnQq0TgvUIzTNAzlp8r1xhJEFmlZWR4GeiALs6sI57dhmhaCPPxn9FEstC5IIBFZh60vzhoSu3znJ1+F8VXc/+q3xkgnBLq7TTJfxSng0zGAJ09OH0+OXSsRf8R4S6ba9ZaVU+2ZKnLDHzqupLVHz2pF9DRLsEjNkehEam1xtlNjDUUVFNk2wJ1oOBgk1G1rwibN3gdvTc5a9jMmt/O//mnz818nZ337myOT4i0Re4nop+qJ6C552KVaK5LAmJRq6kVlcoFoPl/RVBHRTh7eGs5cWwt7F2wTP/PJrtDAf8PjuBA6PRUzzrYZL+TchMfME8IoSaaNeJD5CtNUX248ZX6uBYZV6xRSF5k5TSB4zb0GmX7gSJ/bAIspnN83x54g+9u80F55lrR9bXg1mubbX49/gznV6kHNeKfh0YPdO6bPYPTx1dU1uvgwcy8/9HtJ+R4EDXuh5xqtegwWdIykD6das6VzGBHoATyjfyP9m0pZWKecg2qvu0rOwfVmXYSdVdfwJjbOc9ittHbCT354Gj158fvoSfT8aZgyzYNsc0FZTHOc7iVaPokewLIGCvvQuDzNAGgMi3au83JgDa/cxjnHffSjecUwhX5EvpC46KzMOC2kIuI844wqLr6DxLrhVAtB9/LUvZ+wRG+boJ8+zlpJfTf/AoGt30kSF+AD/27uVTcZTM72rb0EnYJ0fXFALV6mBIurWPA0/WjenhxKcw7RSnu5wkOu0e2LU7DTCYOYnA6m8OJkvx/ekUrgrJAdC+pIO8AJX8fjyfTlCgI22C2Z21V7dRrLXJK4ufAzwKuU46YJ2ApemX4g2aj3P/31LRwdKxQqcljK2zRPHYeNIRPDbKub3t/lfTGefxspPpeK55r/qNT/iMUSDh61h4eCd8vCIg0Lp8nkOp3flSPMFR7LKVvPS9JjM70GDorzG1jaA1xJtJOYd/T8/hG4v7ouwUOoj3wAwdFZE85EHN5Pb455lnM26s5efQ1fAgTKlfK7+gZITfLkytx3/k09EGrCJ2chJfTp6eMnv3/0+MWjpz9cP3l8/vjF+ZPn0x+ePfv8afbu9Xv0+ZPOhovMiimSBiT6pSBi+xl9smb+Z/QpI0rQGJLRHr2InkWPH4Hc6PGL6OmLz58ef9b95tPz6PtMfp7qHzbB+9Nz/VvhdL6hSn568sPzZ9/DJfi8yKfPUxjIyvyhKWjD8NNffnr18b/n1z++ejd//er68sdShoSzOeWnJ/C8zvv49D9/n2i2f5+c/8/fJxlEeM1xmpqfS86l+vvk/En0+B//+Mfn6WRvv9ntHq6BUr7e3XyutxEceKafmZyFpwSo88lZn67loa6J6MZc2wjzNtRgE6+IijfDuIQXknU67zqXkHsWkPsI6IIMYaBfaKPQ0aWH0dKdsp5e1yQ1K0/90Q+3UXo8DBjGWQeoVuhUlTrdGleahh4zbTSePX6cycnZHsPF4wGDuYsI3G8DG1ZkrS46oK7gqFDtAxqC11IuTyW1Q35ICXwhBCeJf70FvKnYBhZeq7y5brt2Qnqq6G7gAUpzQHXpc9bmtWNEQ/RewWO2LL4jvY3sAAbexNNBoDJ0GgeFtDB4/jTAoL2VqtmuiwM8hOChMUG1ctkPC32DkgSZx1sIPO0iYP2tFIJXsOdonekLLR5Wc7PbtVpKDE/RAam7c7aTlZBlsd474wdFdme52YJo+bXIlrAFUTFyp9zVbjbr1l3dSw/+XdmTNmGfEavKwITpxtK0cUV7g9pcHNx45ELfv5noWptM0YRxRWMCf/lKYIomd1jAl0UmKBCQPYkFBedEOgkXwpaw8V5QaRwZ/lYiYsrusZOBl+jUx/7F+5j2dhT5PXYzi3Dqaf9iPc1N5NT7cMVkNrvqH146m12Vx4m3ni1PS4Nst+P2CCTdwQh1TYdldqjOmvV15FgBCgfksxt31aj57NdV3m21yxMF0U9p47W0cbB7t3OTons/+BrBJgHrXQLMWvKhG0dkHBt56AiA2E6f9z/1MQf3cPrDdZVIsW+0fLMUdZc+HwrjOaZT9D1SQBbLOThWCjk2uCyWRnAH+h1lz56Oj/83890ttBffDh2z9ZONScENSpC8cyhpkIukKhirc2QfBLE2UJglyH3ts1NP2IlrfC7+JrqdxmqHbbt5HhEdd1b/8ESQ6rc8DSTm/IaOXEP2YxOukgyEPY5QlAEr3dNL4zPjIzEDqWhDcGKVfTeH/6MnlWjarpb/qagbbdLO/HTUydhHnRSno05OR52cjjo5HXVyOurkdNTJ6aiT01Enp6NOfltHnYx2wkmbz3j4ESff2gmm0Ud2T1rwvd7Jb+sut+gjl92C7y37t3RjnDYKahsF39ohKwiWnM3zjcAyjH9wBVgKIB8Z+WEKvxSkuA9XJOhEnOep2w/IOU8DM8PJ+jpZXyfr67dqfdmogxu8uvGjB/8Mv1siD/S96jAuf1C4MjhxYR0RZNkcWyMdRWXIug9y1qyNJqKPWiZP1e52IrnKLV+tzr9y8FEQK3SQXjmpT/528fHdZDiL1CVAhDFt1MlIHupQOEsItYwiOuvfrfdAX5aBSa6iKeww6gOeoP5biKRYypEK/yd8i43AQRTgQ9jNIR7u3T04IHQN4hBlHf0t3OP3Vcu+9unFbqeWdOk762l/b+1stJ60EHprOixsMyg3p2p27XRWRZreCxcYRyAcqXBrOmVNl5j52tpcaFHX5mZ3rHcpMdwLg+SbnekbK+xRcx//rOujJf/Rh4WQBjIe7iWcTKE39PSXr1eWSBg7I8o1mfufgebLn8nOYWbm4rxOznYo8IkoLP1zWtyllk7lbnd3K/fUWVtfCFZHs5k9aeW1iugbizE5ptPtHFhphQ7yFoW1VKtW6LtCqu/6gjIIAXUZE0d0yJop4dSjxZ+as2BiLswiXZ8M+Iavn/9sHpdf/RRXLuwUc1ceWdI4qSZMyWRXj9RwM28ti5dw0BzUmSgYxB5bKI8g1O4eeilfz3U5+o/2PRxv4BxVfXZqWhCk80C0ovPW4RWVsyYfm7h61mQyYMDtijiNrNPI+uojq31UDWf3Ed+hpMhy15YWOg2AOHizExha7B/Ran7WtQHowlbbfETs623ewD5HM5YXSk7Ra30WmJyi94WCKxBYdMkTErf0Zp1BSlkoifRw1+8rnXgNThhYppepN84p2Ccw1PFimPGvRkuDdbGyzZljgTM5Uo++0sHz1eGmHiVzaHkhdv14QULz4CR13Pz16A91ZjVK2n3rPg5qqFT11usPaxpnnK15svQsY3ulf1rOW3jh5X/uT82psMJzamul+Oarh1Z2lebc6gCPnMQDW61tDMIzfGeGWCcwQlf2nWoCDU3epR9tdtZHxTlCYUfVHkavC6ZPIsMpgiO71lzQX01n3Efu8v3btxfvXg6kyHZG9B6C0Frki9pLhzKqMEtSKhVhg0iFxO4hdV2ZPd3uK0+LubG5lb+k3sh8u736y5v+4xKg9Cv1kdn7qwwOPjx2WordXGkGCHSN2PGDI+pEhsdIlO7u2t1ezV6+WrEIN32JpU285vkuh0+7Fzom3ZT8++j/R0+ntXPwrUVJk0ifl2+es5v3sjyw339zB0HXHIr9FYc9nw3RJFzI0DqjHJqTv9kc1o6Cdi81wqChgXu44dC1HzDiInJPXwaEQV05EDzeo6CmW8C75gjKWJDy60A6CTEKgkECx3AweMudxuvWOR3QrhXavh1B8+EUqtCdEYnoh3TeVyRJHOyGh5yjVx7vD5I9NmDDT486sDDl8c298MUZRDGCXmpwvsNUeR8NAQKgfZakCmSIQMKOVGMlU3lUeQW/kzqDaCTVW0+yAelIEFUIVpntHYMHnp+DUqSMJPfHSMaY9SPUNgseQ6Zg9EslGCl8Q1il4xZXr66ru4sucrunVPXCl+XhVWGxo03DNtPOHpQNHyBxndyiW3uPrSn74tl77+D3MHtPv3Kgvefgw3NVT3svQCA0LTlMc87BWbOOfeChk6StWkPkgHMUykisOSwQao84elgIPLDDXTDzlh57GsGbaIjUHyGCmC0EoO7jYPYzKzHPMnCdcERZnBYJmaIlkTQh0vt43w5iJX5agzJDzKRWSgSfkEKL/3r0mos7LBKSwF+LCF0RgnAqzZdXFmWdLELhaTs11+DStqrqUW2XO6HE3gdi8mKZ0ti76WmPkotuxYWp/AjNVojx6sUdPCvInrliw+2s1RywdS0PQW+xIr2I7CJqYsH6/Kc+QOEUx1uL4/2WIdXfOob4/2j29Tc7hOOUPD128vRPp+TpU/L0KXn6lDx9Sp4+JU+fkqdPydOn5OnfVvL0WZPGvWRRV36j4fuEI4fPvTIEQCh6QKJ1ZChNkTvH9WHf7y23Thd7GHwo9/HgI10rSgR68GH2sgVXjegttbuSDjYM6H15cDToy8pJuw/e7vuNtIoDlaDbt5RrXcJcOue2cwq/l+X3FgJCrTuWfIEc8Mqzv7ByFlUMpd+XXaEqtPCQCpaiOSicMPNh5bNm5QwaotrvuQqXyX642XxbRZLaBNPk5PMK6K4jxmlzvrP7b7BJWJ4l6H1TNOr/UcAjSMFuPmWxIPD5bFgOYoWnKMPiBg7vJ2DA6Cqszj3ESbKz0YRgdQ+GzS1J/I/kc6Zn94l+B46hts9MpvDCRDKcyw1XLQdNww7vvBpd4xUaWqKSW+pzwKsf+2h7uV35U+kibOt84b934KFK020paHdqdMWCzSy9XzqSKvqpvjlme5fuQ/7GLpKUxTZeOefxJkI/SbuJCjFehXIbQ4v/8PbSYp4WWYszMcYpYQkWwcIUB7eOjbUUxNrAZeAYUPe/WEfhrEcsrXfQjncu6ztlOZdqLUg9POqDuTg4Rqp678CNsxqbsKZrrR1//6xOpLQ8mspspOhGH7mrGhAK0/CplH2rdrfXkC1f7R0kRTPyK2fkMKhfrfYqYb9OJJZvTgUBA77J0lczwUm2+wFXH7E1SH5HrMNLsMLL3SM/KsxsmywPggxK7rKSK8zXF9cXb8YO/UpCUdxdQSwVn2ePo8eD6Lx04dl8hXBXbIFvZ+3iXr168+ryGv0/9Prj+7ew1Bfy3wbx+Is9HB4rbQKEOThTM1Qvh5mwNgjKCi61tSBJ7aMPH+F3i47W99DbLivViQtrvSDNpu4aSYUasqW29O41Ae9niXbthVvOXrrZ1LAyZ0GFW17wsdOoQGId3x39HaHLmtm4yDB8m3oxRQuZ4lsCf8QbmiYL9ADMlo8vX3938f41uoN1Llsjfe/hdAeVC7QAQ4Iyki6i3srmyHJWuqZZLJ1kCIW5JWLJpS6X+VLLQtvFC/t1lsVXHIw7UkcMTr1y0ac6UkLAKozcus/imy5wSzHCiBF1x8WNt2CPeg6UOEvGbb2YZxn42+znKZMoCOsmjGi0jwT8qKuKrds+kel46YP7Y9GdCTWq9qi0RsdkdUO247YDpDfVlmSuAvwPs4e5YDHmOQigurBYFzBJSnRH1aaFVIzTlCTljGY2Irwp7Upf6L/uMAIOXG+U6OGB21Lmpr0fohAakWXNF2pzjMJo4r+hrPiiQ46qRKIhDtfSgq/d7dXk5asQJgyGfsUK+Di1GQVxW7530APWvXkIai74WmDX6ANAnX1wMPCo+uZDpXAcMZ1UIN0JR/sJ2ZsjzpS90rM6Fmo9ILQ7p8ofqByCJlRIIsUruCCuLI8Z6R6BPQjZkSjNJ/BimI2urn6EclNmv/peL3t4IHanme9lYbRvA7hpVk0u4pjkyvgZX2Oalm7GGbvFKU0mkfdMACMjmEFYrSx0JPCqSE05o0qCfcY2jA1nsJFOLum23OkNQNhd6ZJfU15VRPBnZblCG/Bv6cJELTUajK4cUKWNSE4bMNms3BxLCZMmfDEQTUxU7A3ZTtpY7Wywu05I88OoVicFN1Jt6vUFZkGGE9LGKxE8z0kyv29+0JKVGWubGMxfnhMG2/SIZhlJKFYk3TpWbaQDZ/926NZhhEH2cVUq6ZphVQhyGI/ydaftHTHdx8BYawMOxXF06boehAZHcyzskIZRFLUEvd9PWEc4sKNN/w4K7ug2lHtWZWjLqyPEo1/YwP0xo2rbRao7quLeaBnYztraHxIzGrv9gTG9QmP6BMcMqK99ATJnIXqySPhZkNQoRpK2UGSZ/On21gF14VaL0VmfgdsWxdJwBGtL5N37a73hVyScCHk2uEZ3YgtAWoylmRWAfLnS7bZJlNoehn59/d/ePFRDpG3r/Qo2v0sOg43tYYMJFSRWXGyPIBGw+r12EpyrwzgqLNZE2cxi7jkfmgTlHVXxJrBL7RjaZw+j4YBcNWjXHVCo0M5CoMAbJ8nXH3MW+MBhF1T4vSqqyp1aEvDj6BiIqAWm2Fk69zbwuuBnL9sA16MD6kbsQNyEgsh7yIX30IqniRepwcidLmAbltyQND0ELCErXKTKCOiAOwuh6hr4Jn3cIX/1Tu7bKtAomkjUAnNEn2slMHvZAe+A5VYeuYWxEwLqvGJGtOch/cZOScvHzt9REPk+3JJ9cO/JMdkLmibDYfd6IPsg25tfwwdpdxyUwGRFb7wth2tzpf+eA8i1L9W9/n6HdiWs8MJDq6VIVruVzRjECw0ih2sS9s+alXrMqOaiRuWYIwFqd7tXYIMT24PIpxTwUwr4KQX8lAJ+SgE/pYCfUsBPKeBfOwW8q5X9ZeIpBfyUAn5KAT+lgJ9SwE8p4PeUAl6vC70gm+ujA8566sZBCwqLIIPwKwEnjbMkVAnHOIH8UeMwwG5KgiyWOL4hLJm3nXi2h8NZcINBlN9qseLtppmtD9BKKy7usEhIcva/AwBagcun"
 }
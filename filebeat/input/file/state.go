@@ -40,6 +40,7 @@ type State struct {
 	Type        string            `json:"type"`
 	Meta        map[string]string `json:"meta"`
 	FileStateOS file.StateOS
+	Fingerprint string `json:"fingerprint,omitempty"` // content based file identity, empty for inode based identity
 }
 
 // NewState creates a new file state
@@ -57,18 +58,20 @@ func NewState(fileInfo os.FileInfo, path string, t string, meta map[string]strin
 }
 
 // ID returns a unique id for the state as a string
+// If the state has a fingerprint, the fingerprint is used instead of the OS
+// file state to identify the file.
 func (s *State) ID() string {
 	// Generate id on first request. This is needed as id is not set when converting back from json
 	if s.Id == "" {
 		if s.Meta == nil {
-			s.Id = s.FileStateOS.String()
+			s.Id = s.fileID()
 		} else {
 			hashValue, _ := hashstructure.Hash(s.Meta, nil)
 			var hashBuf [17]byte
 			hash := strconv.AppendUint(hashBuf[:0], hashValue, 16)
 			hash = append(hash, '-')
 
-			fileID := s.FileStateOS.String()
+			fileID := s.fileID()
 
 			var b strings.Builder
 			b.Grow(len(hash) + len(fileID))
@@ -82,6 +85,13 @@ func (s *State) ID() string {
 	return s.Id
 }
 
+func (s *State) fileID() string {
+	if s.Fingerprint != "" {
+		return "fingerprint-" + s.Fingerprint
+	}
+	return s.FileStateOS.String()
+}
+
 // IsEqual compares the state to an other state supporing stringer based on the unique string
 func (s *State) IsEqual(c *State) bool {
 	return s.ID() == c.ID()
@@ -91,6 +101,7 @@ func (s *State) IsEqual(c *State) bool {
 func (s *State) IsEmpty() bool {
 	return s.FileStateOS == file.StateOS{} &&
 		s.Source == "" &&
+		s.Fingerprint == "" &&
 		s.Meta == nil &&
 		s.Timestamp.IsZero()
 }
@@ -86,3 +86,22 @@ func TestCleanup(t *testing.T) {
 		})
 	}
 }
+
+func TestFingerprintID(t *testing.T) {
+	state := State{
+		Source:      "test.log",
+		Fingerprint: "abc",
+	}
+	assert.Equal(t, "fingerprint-abc", state.ID())
+	assert.False(t, state.IsEmpty())
+
+	states := NewStates()
+	states.Update(state)
+
+	renamed := State{Source: "test.log.1", Fingerprint: "abc"}
+	assert.Equal(t, "test.log", states.FindPrevious(renamed).Source)
+
+	other := State{Source: "test.log", Fingerprint: "def"}
+	previous := states.FindPrevious(other)
+	assert.True(t, previous.IsEmpty())
+}
@@ -53,6 +53,10 @@ var (
 		ScanSort:       "",
 		ScanOrder:      "asc",
 		RecursiveGlob:  true,
+		FileIdentity:   FileIdentityInode,
+		Fingerprint: fingerprintConfig{
+			Length: 1024,
+		},
 
 		// Harvester
 		BufferSize: 16 * humanize.KiByte,
@@ -90,6 +94,10 @@ type config struct {
 	TailFiles      bool            `config:"tail_files"`
 	RecursiveGlob  bool            `config:"recursive_glob.enabled"`
 
+	// File identity
+	FileIdentity string            `config:"file_identity"`
+	Fingerprint  fingerprintConfig `config:"fingerprint"`
+
 	// Harvester
 	BufferSize int    `config:"harvester_buffer_size"`
 	Encoding   string `config:"encoding"`
@@ -122,6 +130,10 @@ type LogConfig struct {
 	CloseTimeout  time.Duration `config:"close_timeout" validate:"min=0"`
 }
 
+type fingerprintConfig struct {
+	Length int64 `config:"length" validate:"min=1"`
+}
+
 // Contains available scan options
 const (
 	ScanOrderAsc     = "asc"
@@ -144,6 +156,18 @@ var ValidScanSort = map[string]struct{}{
 	ScanSortFilename: {},
 }
 
+// Contains available file identity options
+const (
+	FileIdentityInode       = "inode"
+	FileIdentityFingerprint = "fingerprint"
+)
+
+// ValidFileIdentity of valid file identities
+var ValidFileIdentity = map[string]struct{}{
+	FileIdentityInode:       {},
+	FileIdentityFingerprint: {},
+}
+
 func (c *config) Validate() error {
 	// DEPRECATED 6.0.0: warning is already outputted on propsector level
 	if c.InputType != "" {
@@ -163,6 +187,16 @@ func (c *config) Validate() error {
 		return fmt.Errorf("clean_inactive must be > ignore_older + scan_frequency to make sure only files which are not monitored anymore are removed")
 	}
 
+	if c.FileIdentity != "" {
+		if _, ok := ValidFileIdentity[c.FileIdentity]; !ok {
+			return fmt.Errorf("Invalid file identity: %v", c.FileIdentity)
+		}
+	}
+
+	if c.FileIdentity == FileIdentityFingerprint {
+		cfgwarn.Beta("file_identity fingerprint is used.")
+	}
+
 	// Harvester
 	if c.JSON != nil && len(c.JSON.MessageKey) == 0 &&
 		c.Multiline != nil {
@@ -70,3 +70,27 @@ func TestCleanOlderIgnoreOlder(t *testing.T) {
 	err := config.Validate()
 	assert.NoError(t, err)
 }
+
+func TestFileIdentity(t *testing.T) {
+	for identity, valid := range map[string]bool{
+		"":            true,
+		"inode":       true,
+		"fingerprint": true,
+		"path":        false,
+	} {
+		config := config{
+			Paths:        []string{"hello"},
+			FileIdentity: identity,
+			ForwarderConfig: harvester.ForwarderConfig{
+				Type: "log",
+			},
+		}
+
+		err := config.Validate()
+		if valid {
+			assert.NoError(t, err, identity)
+		} else {
+			assert.Error(t, err, identity)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package log
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	file_helper "github.com/elastic/beats/libbeat/common/file"
+)
+
+var errFileTooSmall = errors.New("file is smaller than the fingerprint length")
+
+// fingerprint returns the hex encoded SHA-256 hash of the first length bytes
+// of the file. errFileTooSmall is returned if the file does not contain enough
+// data yet.
+func fingerprint(path string, length int64) (string, error) {
+	f, err := file_helper.ReadOpen(path)
+	if err != nil {
+		return "", fmt.Errorf("failed opening %s: %s", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.CopyN(h, f, length)
+	if err == io.EOF || n < length {
+		return "", errFileTooSmall
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed reading %s: %s", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
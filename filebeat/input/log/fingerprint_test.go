@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package log
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/input/file"
+)
+
+func writeTestFile(t *testing.T, path, content string) os.FileInfo {
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info
+}
+
+func TestFingerprint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fingerprint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path1 := filepath.Join(dir, "test1.log")
+	path2 := filepath.Join(dir, "test2.log")
+	path3 := filepath.Join(dir, "test3.log")
+	writeTestFile(t, path1, "first line\nsecond line\n")
+	writeTestFile(t, path2, "first line\nother line\n")
+	writeTestFile(t, path3, "other line\n")
+
+	_, err = fingerprint(path1, 100)
+	assert.Equal(t, errFileTooSmall, err)
+
+	fp1, err := fingerprint(path1, 10)
+	assert.NoError(t, err)
+	fp2, err := fingerprint(path2, 10)
+	assert.NoError(t, err)
+	fp3, err := fingerprint(path3, 10)
+	assert.NoError(t, err)
+
+	assert.Len(t, fp1, 64)
+	assert.Equal(t, fp1, fp2)
+	assert.NotEqual(t, fp1, fp3)
+
+	_, err = fingerprint(filepath.Join(dir, "missing.log"), 10)
+	assert.Error(t, err)
+}
+
+func TestGetFileStateFingerprint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fingerprint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	p := &Input{
+		config: config{
+			FileIdentity: FileIdentityFingerprint,
+			Fingerprint:  fingerprintConfig{Length: 16},
+		},
+	}
+
+	path := filepath.Join(dir, "test.log")
+	info := writeTestFile(t, path, "short\n")
+	_, err = getFileState(path, info, p)
+	assert.Equal(t, errFileTooSmall, err)
+
+	info = writeTestFile(t, path, "long enough for a fingerprint\n")
+	state, err := getFileState(path, info, p)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.NotEmpty(t, state.Fingerprint)
+	assert.True(t, strings.HasPrefix(state.ID(), "fingerprint-"))
+
+	// Inode identity does not set a fingerprint
+	p.config.FileIdentity = FileIdentityInode
+	state, err = getFileState(path, info, p)
+	assert.NoError(t, err)
+	assert.Empty(t, state.Fingerprint)
+}
+
+func TestMigrateState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fingerprint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.log")
+	info := writeTestFile(t, path, "first line\nsecond line\n")
+
+	p := &Input{
+		config: config{
+			FileIdentity: FileIdentityFingerprint,
+			Fingerprint:  fingerprintConfig{Length: 10},
+		},
+		states: file.NewStates(),
+		outlet: TestOutlet{},
+	}
+
+	inodeState := file.NewState(info, path, "log", nil)
+	inodeState.Finished = true
+	inodeState.Offset = 11
+	p.states.Update(inodeState)
+
+	// Inode based states are not migrated in inode mode
+	p.config.FileIdentity = FileIdentityInode
+	assert.Empty(t, p.migratableStates())
+	assert.Equal(t, 0, p.unmigrated)
+
+	p.config.FileIdentity = FileIdentityFingerprint
+	migratable := p.migratableStates()
+	assert.Equal(t, 1, p.unmigrated)
+
+	newState, err := getFileState(path, info, p)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	lastState := p.states.FindPrevious(newState)
+	assert.True(t, lastState.IsEmpty())
+
+	migrated := p.migrateState(newState, migratable[newState.Source])
+	assert.Equal(t, newState.ID(), migrated.ID())
+	assert.Equal(t, int64(11), migrated.Offset)
+	assert.Equal(t, int64(11), p.states.FindPrevious(newState).Offset)
+
+	// The inode based state is marked for removal
+	assert.Equal(t, 0, int(p.states.FindPrevious(inodeState).TTL))
+	assert.Equal(t, 0, p.unmigrated)
+	assert.Empty(t, p.migratableStates())
+
+	// Nothing to migrate for a file without previous state
+	otherPath := filepath.Join(dir, "other.log")
+	otherInfo := writeTestFile(t, otherPath, "other first line\n")
+	otherState, err := getFileState(otherPath, otherInfo, p)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	lastState = p.migrateState(otherState, migratable[otherState.Source])
+	assert.True(t, lastState.IsEmpty())
+}
@@ -261,8 +261,15 @@ func (h *Harvester) Run() error {
 		if err != nil {
 			switch err {
 			case ErrFileTruncate:
-				logp.Info("File was truncated. Begin reading file from offset 0: %s", h.state.Source)
-				h.state.Offset = 0
+				// With fingerprint identity the truncated file is picked up as a new file
+				// once its content changed. The offset is kept as it belongs to the
+				// previous content, which might have been copied away by rotation.
+				if h.config.FileIdentity == FileIdentityFingerprint {
+					logp.Info("File was truncated. Keeping offset for previous file identity: %s", h.state.Source)
+				} else {
+					logp.Info("File was truncated. Begin reading file from offset 0: %s", h.state.Source)
+					h.state.Offset = 0
+				}
 				filesTruncated.Add(1)
 			case ErrRemoved:
 				logp.Info("File was removed: %s. Closing because close_removed is enabled.", h.state.Source)
@@ -33,6 +33,7 @@ import (
 	"github.com/elastic/beats/filebeat/util"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/atomic"
+	file_helper "github.com/elastic/beats/libbeat/common/file"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/monitoring"
 )
@@ -45,6 +46,7 @@ const (
 var (
 	filesRenamed     = monitoring.NewInt(nil, "filebeat.input.log.files.renamed")
 	filesTruncated   = monitoring.NewInt(nil, "filebeat.input.log.files.truncated")
+	filesMigrated    = monitoring.NewInt(nil, "filebeat.input.log.files.migrated")
 	harvesterSkipped = monitoring.NewInt(nil, "filebeat.harvester.skipped")
 
 	errHarvesterLimit = errors.New("harvester limit reached")
@@ -68,6 +70,10 @@ type Input struct {
 	done          chan struct{}
 	numHarvesters atomic.Uint32
 	meta          map[string]string
+
+	// unmigrated is the number of states keyed by the file identity not
+	// configured for the input. They are migrated when their file is found.
+	unmigrated int
 }
 
 // NewInput instantiates a new Log
@@ -160,6 +166,8 @@ func (p *Input) loadStates(states []file.State) error {
 		}
 	}
 
+	p.migratableStates()
+
 	logp.Debug("input", "input with previous states loaded: %v", p.states.Count())
 	return nil
 }
@@ -205,8 +213,7 @@ func (p *Input) Run() {
 				}
 			} else {
 				// Check if existing source on disk and state are the same. Remove if not the case.
-				newState := file.NewState(stat, state.Source, p.config.Type, p.meta)
-				if !newState.FileStateOS.IsSame(state.FileStateOS) {
+				if !p.isSameFile(state, stat) {
 					p.removeState(state)
 					logp.Debug("input", "Remove state for file as file removed or renamed: %s", state.Source)
 				}
@@ -215,6 +222,22 @@ func (p *Input) Run() {
 	}
 }
 
+// isSameFile checks if the file found at the source of the state is still the
+// file the state was created for. States with a fingerprint are compared by
+// the fingerprint of the file content, all others by the OS file state.
+func (p *Input) isSameFile(state file.State, info os.FileInfo) bool {
+	if state.Fingerprint == "" {
+		return file_helper.GetOSState(info).IsSame(state.FileStateOS)
+	}
+
+	fp, err := fingerprint(state.Source, p.config.Fingerprint.Length)
+	if err != nil {
+		logp.Debug("input", "Failed to fingerprint file %s: %s", state.Source, err)
+		return false
+	}
+	return fp == state.Fingerprint
+}
+
 func (p *Input) removeState(state file.State) {
 	// Only clean up files where state is Finished
 	if !state.Finished {
@@ -399,6 +422,17 @@ func getFileState(path string, info os.FileInfo, p *Input) (file.State, error) {
 	logp.Debug("input", "Check file for harvesting: %s", absolutePath)
 	// Create new state for comparison
 	newState := file.NewState(info, absolutePath, p.config.Type, p.meta)
+
+	if p.config.FileIdentity == FileIdentityFingerprint {
+		// Files are only picked up as soon as enough data is available for the fingerprint
+		if info.Size() < p.config.Fingerprint.Length {
+			return file.State{}, errFileTooSmall
+		}
+		newState.Fingerprint, err = fingerprint(absolutePath, p.config.Fingerprint.Length)
+		if err != nil {
+			return file.State{}, err
+		}
+	}
 	return newState, nil
 }
 
@@ -414,6 +448,7 @@ func getKeys(paths map[string]os.FileInfo) []string {
 func (p *Input) scan() {
 	var sortInfos []FileSortInfo
 	var files []string
+	var migratable map[string][]file.State
 
 	paths := p.getFiles()
 
@@ -451,12 +486,23 @@ func (p *Input) scan() {
 		}
 
 		newState, err := getFileState(path, info, p)
+		if err == errFileTooSmall {
+			logp.Debug("input", "Skipping file %s until it reaches the fingerprint length of %d bytes", path, p.config.Fingerprint.Length)
+			continue
+		}
 		if err != nil {
 			logp.Err("Skipping file %s due to error %s", path, err)
+			continue
 		}
 
 		// Load last state
 		lastState := p.states.FindPrevious(newState)
+		if lastState.IsEmpty() && p.unmigrated > 0 {
+			if migratable == nil {
+				migratable = p.migratableStates()
+			}
+			lastState = p.migrateState(newState, migratable[newState.Source])
+		}
 
 		// Ignores all files which fall under ignore_older
 		if p.isIgnoreOlder(newState) {
@@ -484,6 +530,58 @@ func (p *Input) scan() {
 	}
 }
 
+// migratableStates returns the states keyed by the file identity not
+// configured for the input by their source, and updates the number of states
+// left to migrate.
+func (p *Input) migratableStates() map[string][]file.State {
+	fingerprint := p.config.FileIdentity == FileIdentityFingerprint
+
+	states := map[string][]file.State{}
+	p.unmigrated = 0
+	for _, state := range p.states.GetStates() {
+		if state.TTL == 0 || (state.Fingerprint != "") == fingerprint {
+			continue
+		}
+		states[state.Source] = append(states[state.Source], state)
+		p.unmigrated++
+	}
+	return states
+}
+
+// migrateState looks for a finished state of the same file in the given
+// states keyed by the other file identity, e.g. an inode based state from
+// before file_identity was set to fingerprint. The state found is replaced by
+// a state keyed by the identity of newState which keeps the offset, and
+// returned. An empty state is returned if no state can be migrated.
+func (p *Input) migrateState(newState file.State, states []file.State) file.State {
+	for _, state := range states {
+		if !state.Finished ||
+			!state.FileStateOS.IsSame(newState.FileStateOS) ||
+			!p.matchesMeta(state.Meta) {
+			continue
+		}
+
+		migrated := state
+		migrated.Id = ""
+		migrated.Fileinfo = newState.Fileinfo
+		migrated.Fingerprint = newState.Fingerprint
+
+		p.removeState(state)
+		err := p.updateState(migrated)
+		if err != nil {
+			logp.Err("File identity migration state update error: %s", err)
+			return file.State{}
+		}
+		p.unmigrated--
+
+		logp.Info("Migrated state of file %s to file identity %s, offset: %d", migrated.Source, p.config.FileIdentity, migrated.Offset)
+		filesMigrated.Add(1)
+		return migrated
+	}
+
+	return file.State{}
+}
+
 // harvestExistingFile continues harvesting a file with a known state if needed
 func (p *Input) harvestExistingFile(newState file.State, oldState file.State) {
 	logp.Debug("input", "Update existing file for harvesting: %s, offset: %v", newState.Source, oldState.Offset)
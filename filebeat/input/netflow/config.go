@@ -0,0 +1,47 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"time"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/filebeat/inputsource/udp"
+)
+
+var defaultConfig = config{
+	ForwarderConfig: harvester.ForwarderConfig{
+		Type: "netflow",
+	},
+	Config: udp.Config{
+		MaxMessageSize: 10 * humanize.KiByte,
+		Host:           "localhost:2055",
+		Timeout:        time.Minute * 5,
+	},
+	ExpirationTimeout: 30 * time.Minute,
+}
+
+type config struct {
+	udp.Config                `config:",inline"`
+	harvester.ForwarderConfig `config:",inline"`
+	Protocols                 []string      `config:"protocols"`
+	ExpirationTimeout         time.Duration `config:"expiration_timeout" validate:"min=0"`
+	CustomDefinitions         []string      `config:"custom_definitions"`
+}
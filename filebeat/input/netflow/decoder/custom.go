@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+type fieldDefinition struct {
+	Name string `config:"name" validate:"required"`
+	PEN  uint32 `config:"pen"`
+	ID   uint16 `config:"id" validate:"required"`
+	Type string `config:"type" validate:"required"`
+}
+
+// LoadFieldDefinitions reads custom field definitions from YAML files. Each
+// file holds a list of fields under the `fields` key:
+//
+//   fields:
+//     - name: netscaler_round_trip_time
+//       pen: 5951
+//       id: 128
+//       type: unsigned32
+//
+// The type is one of the abstract data types of the IPFIX information model.
+// Definitions in later files override the earlier ones.
+func LoadFieldDefinitions(paths []string) (FieldDict, error) {
+	dict := FieldDict{}
+	for _, path := range paths {
+		cfg, err := common.LoadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read field definitions from %s", path)
+		}
+
+		definitions := struct {
+			Fields []fieldDefinition `config:"fields" validate:"required"`
+		}{}
+		if err := cfg.Unpack(&definitions); err != nil {
+			return nil, errors.Wrapf(err, "invalid field definitions in %s", path)
+		}
+
+		for _, def := range definitions.Fields {
+			typ, err := ParseType(def.Type)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid definition of field %s in %s", def.Name, path)
+			}
+			dict[FieldKey{PEN: def.PEN, ID: def.ID}] = &Field{Name: def.Name, Type: typ}
+		}
+	}
+	return dict, nil
+}
@@ -0,0 +1,312 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package decoder decodes NetFlow v1, v5, v6, v7, v8 and v9 and IPFIX
+// packets into flow records. The fields of all versions are named after the
+// information elements of the IPFIX information model.
+package decoder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+// Protocol versions in the version field of the packet headers.
+const (
+	versionV1    = 1
+	versionV5    = 5
+	versionV6    = 6
+	versionV7    = 7
+	versionV8    = 8
+	versionV9    = 9
+	versionIPFIX = 10
+)
+
+var protocolNames = map[string]uint16{
+	"v1":    versionV1,
+	"v5":    versionV5,
+	"v6":    versionV6,
+	"v7":    versionV7,
+	"v8":    versionV8,
+	"v9":    versionV9,
+	"ipfix": versionIPFIX,
+}
+
+var (
+	packetsDecoded   = monitoring.NewInt(nil, "netflow.packets.decoded")
+	packetsDropped   = monitoring.NewInt(nil, "netflow.packets.dropped")
+	recordsDecoded   = monitoring.NewInt(nil, "netflow.records.decoded")
+	templatesMissing = monitoring.NewInt(nil, "netflow.templates.missing")
+	templatesCurrent = monitoring.NewInt(nil, "netflow.templates.current")
+)
+
+var debugf = logp.MakeDebug("netflow")
+
+// RecordType tells flow records from options records, which describe the
+// exporter instead of a flow.
+type RecordType uint8
+
+const (
+	Flow RecordType = iota
+	Options
+)
+
+func (t RecordType) String() string {
+	if t == Options {
+		return "options"
+	}
+	return "flow"
+}
+
+// Exporter describes the device and packet a record was exported in.
+type Exporter struct {
+	Address   string
+	Version   uint16
+	SourceID  uint32    // source id (v9) or observation domain id (IPFIX)
+	Uptime    uint32    // system uptime in milliseconds, not set for IPFIX
+	Timestamp time.Time // export time
+}
+
+// ToMapStr returns the exporter fields of the events.
+func (e *Exporter) ToMapStr() common.MapStr {
+	m := common.MapStr{
+		"address":   e.Address,
+		"version":   e.Version,
+		"timestamp": e.Timestamp,
+	}
+	if e.Version >= versionV9 {
+		m["source_id"] = e.SourceID
+	}
+	if e.Version != versionIPFIX {
+		m["uptime_millis"] = e.Uptime
+	}
+	return m
+}
+
+// BootTime returns the time the exporter started, derived from its uptime.
+// IPFIX exporters don't report their uptime.
+func (e *Exporter) BootTime() (time.Time, bool) {
+	if e.Version == versionIPFIX {
+		return time.Time{}, false
+	}
+	return e.Timestamp.Add(-time.Duration(e.Uptime) * time.Millisecond), true
+}
+
+// Record is a decoded flow or options record.
+type Record struct {
+	Type     RecordType
+	Exporter Exporter
+	Fields   common.MapStr
+}
+
+// Config configures a Decoder.
+type Config struct {
+	// Protocols lists the enabled protocol versions: v1, v5, v6, v7, v8, v9
+	// and ipfix. All versions are enabled if empty.
+	Protocols []string
+
+	// ExpirationTimeout is the time after which templates not refreshed by
+	// the exporter are dropped. Templates don't expire if 0.
+	ExpirationTimeout time.Duration
+
+	// Fields holds custom field definitions, taking precedence over the
+	// IANA defined ones.
+	Fields FieldDict
+}
+
+// Decoder decodes NetFlow and IPFIX packets. Templates are cached per
+// exporter and observation domain. A Decoder must not be used concurrently.
+type Decoder struct {
+	protocols map[uint16]bool
+	timeout   time.Duration
+	fields    FieldDict
+
+	sessions  map[sessionKey]*session
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type sessionKey struct {
+	address string
+	domain  uint32
+}
+
+// session holds the templates of an exporter's observation domain.
+type session struct {
+	templates map[uint16]*template
+}
+
+// NewDecoder creates a Decoder.
+func NewDecoder(config Config) (*Decoder, error) {
+	d := &Decoder{
+		protocols: map[uint16]bool{},
+		timeout:   config.ExpirationTimeout,
+		fields:    config.Fields,
+		sessions:  map[sessionKey]*session{},
+		now:       time.Now,
+	}
+
+	for _, name := range config.Protocols {
+		version, found := protocolNames[name]
+		if !found {
+			return nil, fmt.Errorf("unknown protocol '%s'", name)
+		}
+		d.protocols[version] = true
+	}
+	if len(d.protocols) == 0 {
+		for _, version := range protocolNames {
+			d.protocols[version] = true
+		}
+	}
+	return d, nil
+}
+
+// Read decodes a packet received from an exporter. Records decoded before an
+// error are returned with the error.
+func (d *Decoder) Read(data []byte, source net.Addr) ([]Record, error) {
+	if len(data) < 2 {
+		packetsDropped.Inc()
+		return nil, errShortPacket
+	}
+
+	version := binary.BigEndian.Uint16(data)
+	if !d.protocols[version] {
+		packetsDropped.Inc()
+		return nil, fmt.Errorf("unsupported protocol version %d", version)
+	}
+
+	address := ""
+	if source != nil {
+		address = source.String()
+	}
+
+	var records []Record
+	var err error
+	switch version {
+	case versionV9:
+		records, err = d.readV9(data, address)
+	case versionIPFIX:
+		records, err = d.readIPFIX(data, address)
+	default:
+		records, err = readLegacy(data, address)
+	}
+	d.sweep()
+
+	if err != nil {
+		packetsDropped.Inc()
+	} else {
+		packetsDecoded.Inc()
+	}
+	recordsDecoded.Add(int64(len(records)))
+	return records, err
+}
+
+func (d *Decoder) session(address string, domain uint32) *session {
+	key := sessionKey{address, domain}
+	s := d.sessions[key]
+	if s == nil {
+		s = &session{templates: map[uint16]*template{}}
+		d.sessions[key] = s
+	}
+	return s
+}
+
+func (d *Decoder) addTemplate(s *session, t *template) {
+	if _, exists := s.templates[t.id]; !exists {
+		templatesCurrent.Inc()
+	}
+	t.updated = d.now()
+	s.templates[t.id] = t
+}
+
+func (d *Decoder) removeTemplate(s *session, id uint16) {
+	if _, exists := s.templates[id]; exists {
+		templatesCurrent.Dec()
+		delete(s.templates, id)
+	}
+}
+
+// template returns the template with the given id, unless it expired.
+func (d *Decoder) template(s *session, id uint16) *template {
+	t := s.templates[id]
+	if t != nil && d.expired(t) {
+		d.removeTemplate(s, id)
+		return nil
+	}
+	return t
+}
+
+func (d *Decoder) expired(t *template) bool {
+	return d.timeout > 0 && d.now().Sub(t.updated) > d.timeout
+}
+
+// sweep drops the expired templates of all sessions, at most once per
+// expiration timeout.
+func (d *Decoder) sweep() {
+	if d.timeout <= 0 {
+		return
+	}
+	now := d.now()
+	if now.Sub(d.lastSweep) < d.timeout {
+		return
+	}
+	d.lastSweep = now
+
+	for key, s := range d.sessions {
+		for id, t := range s.templates {
+			if d.expired(t) {
+				d.removeTemplate(s, id)
+			}
+		}
+		if len(s.templates) == 0 {
+			delete(d.sessions, key)
+		}
+	}
+}
+
+// dataRecords decodes a data set with the template of the given id.
+func (d *Decoder) dataRecords(
+	s *session,
+	id uint16,
+	data []byte,
+	exporter Exporter,
+) ([]Record, error) {
+	t := d.template(s, id)
+	if t == nil {
+		debugf("Dropping data set of unknown template %d from %s", id, exporter.Address)
+		templatesMissing.Inc()
+		return nil, nil
+	}
+
+	fields, err := t.decodeRecords(data)
+	recordType := Flow
+	if t.isOptions {
+		recordType = Options
+	}
+
+	records := make([]Record, len(fields))
+	for i, f := range fields {
+		records[i] = Record{Type: recordType, Exporter: exporter, Fields: f}
+	}
+	return records, err
+}
@@ -0,0 +1,413 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+var testExporter = &net.UDPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 50000}
+
+// packet builds big-endian encoded packets.
+type packet []byte
+
+func (p packet) u8(v uint8) packet   { return append(p, v) }
+func (p packet) u16(v uint16) packet { return append(p, byte(v>>8), byte(v)) }
+func (p packet) u32(v uint32) packet { return append(p, byte(v>>24), byte(v>>16), byte(v>>8), byte(v)) }
+func (p packet) ip(s string) packet  { return append(p, net.ParseIP(s).To4()...) }
+func (p packet) bytes(b ...byte) packet {
+	return append(p, b...)
+}
+
+// set appends a v9 or IPFIX set with its header.
+func (p packet) set(id uint16, body packet) packet {
+	return p.u16(id).u16(uint16(4 + len(body))).bytes(body...)
+}
+
+func v9Header(count uint16, sourceID uint32) packet {
+	return packet{}.u16(9).u16(count).u32(60000).u32(1500000000).u32(1).u32(sourceID)
+}
+
+func ipfixMessage(domain uint32, sets packet) packet {
+	p := packet{}.u16(10).u16(uint16(16 + len(sets))).u32(1500000000).u32(1).u32(domain)
+	return p.bytes(sets...)
+}
+
+func newTestDecoder(t *testing.T, config Config) *Decoder {
+	d, err := NewDecoder(config)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return d
+}
+
+func testV5Record(src, dst string, srcPort, dstPort uint16, proto uint8) packet {
+	return packet{}.
+		ip(src).ip(dst).ip("10.0.0.254").
+		u16(1).u16(2).         // input, output
+		u32(10).u32(1400).     // packets, octets
+		u32(50000).u32(59000). // first, last
+		u16(srcPort).u16(dstPort).
+		u8(0).u8(0x12).u8(proto).u8(0x28). // pad, tcp flags, protocol, tos
+		u16(64512).u16(64513).             // src and dst AS
+		u8(24).u8(16).u16(0)               // src and dst mask, pad
+}
+
+func TestReadV5(t *testing.T) {
+	p := packet{}.u16(5).u16(2).u32(60000).u32(1500000000).u32(500).
+		u32(42).u8(1).u8(2).u16(0x4064) // sequence, engine, sampling
+	p = p.bytes(testV5Record("10.0.0.1", "10.0.0.2", 40000, 80, 6)...)
+	p = p.bytes(testV5Record("10.0.0.3", "10.0.0.4", 53, 53, 17)...)
+
+	records, err := newTestDecoder(t, Config{}).Read(p, testExporter)
+	assert.NoError(t, err)
+	if !assert.Len(t, records, 2) {
+		return
+	}
+
+	r := records[0]
+	assert.Equal(t, Flow, r.Type)
+	assert.Equal(t, Exporter{
+		Address:   "192.0.2.1:50000",
+		Version:   5,
+		Uptime:    60000,
+		Timestamp: time.Unix(1500000000, 500).UTC(),
+	}, r.Exporter)
+	assert.Equal(t, common.MapStr{
+		"source_ipv4_address":            "10.0.0.1",
+		"destination_ipv4_address":       "10.0.0.2",
+		"ip_next_hop_ipv4_address":       "10.0.0.254",
+		"ingress_interface":              uint64(1),
+		"egress_interface":               uint64(2),
+		"packet_delta_count":             uint64(10),
+		"octet_delta_count":              uint64(1400),
+		"flow_start_sys_up_time":         uint64(50000),
+		"flow_end_sys_up_time":           uint64(59000),
+		"source_transport_port":          uint64(40000),
+		"destination_transport_port":     uint64(80),
+		"tcp_control_bits":               uint64(0x12),
+		"protocol_identifier":            uint64(6),
+		"ip_class_of_service":            uint64(0x28),
+		"bgp_source_as_number":           uint64(64512),
+		"bgp_destination_as_number":      uint64(64513),
+		"source_ipv4_prefix_length":      uint64(24),
+		"destination_ipv4_prefix_length": uint64(16),
+		"engine_type":                    uint64(1),
+		"engine_id":                      uint64(2),
+		"sampling_algorithm":             uint64(1),
+		"sampling_interval":              uint64(100),
+	}, r.Fields)
+
+	assert.Equal(t, "10.0.0.3", records[1].Fields["source_ipv4_address"])
+	assert.Equal(t, uint64(17), records[1].Fields["protocol_identifier"])
+}
+
+func TestReadLegacyShortPacket(t *testing.T) {
+	d := newTestDecoder(t, Config{})
+
+	// the header announces two records, but only one is included
+	p := packet{}.u16(5).u16(2).u32(0).u32(0).u32(0).u32(0).u32(0)
+	p = p.bytes(testV5Record("10.0.0.1", "10.0.0.2", 1, 2, 6)...)
+	_, err := d.Read(p, testExporter)
+	assert.Equal(t, errShortPacket, err)
+
+	_, err = d.Read(packet{}.u16(1).u16(1), testExporter)
+	assert.Equal(t, errShortPacket, err)
+}
+
+func TestReadLegacyTruncatedHeader(t *testing.T) {
+	d := newTestDecoder(t, Config{})
+
+	for version, headerLength := range map[uint16]int{1: 16, 5: 24, 6: 24, 7: 24, 8: 28} {
+		// v8 header with the protocol port aggregation scheme and no records
+		header := packet{}.u16(version).u16(0).u32(0).u32(0).u32(0).
+			u32(0).u8(0).u8(0).u8(2).u8(0).u32(0)[:headerLength]
+
+		_, err := d.Read(header, testExporter)
+		assert.NoError(t, err, "version %d", version)
+
+		for n := 2; n < headerLength; n++ {
+			_, err := d.Read(header[:n], testExporter)
+			assert.Equal(t, errShortPacket, err, "version %d, length %d", version, n)
+		}
+	}
+}
+
+func TestReadV8ProtocolPortAggregation(t *testing.T) {
+	p := packet{}.u16(8).u16(1).u32(60000).u32(1500000000).u32(0).
+		u32(1).u8(0).u8(0).u8(2).u8(2).u32(0) // sequence, engine, aggregation
+	// flows, packets, octets, first, last, protocol, pad, ports
+	p = p.u32(3).u32(30).u32(4500).u32(1000).u32(2000).
+		u8(17).u8(0).u16(0).u16(5353).u16(5353)
+
+	records, err := newTestDecoder(t, Config{}).Read(p, testExporter)
+	assert.NoError(t, err)
+	if !assert.Len(t, records, 1) {
+		return
+	}
+	assert.Equal(t, uint16(8), records[0].Exporter.Version)
+	assert.Equal(t, uint64(3), records[0].Fields["delta_flow_count"])
+	assert.Equal(t, uint64(17), records[0].Fields["protocol_identifier"])
+	assert.Equal(t, uint64(5353), records[0].Fields["destination_transport_port"])
+
+	p[22] = 99 // aggregation
+	_, err = newTestDecoder(t, Config{}).Read(p, testExporter)
+	assert.Error(t, err)
+}
+
+func TestReadProtocolDisabled(t *testing.T) {
+	d := newTestDecoder(t, Config{Protocols: []string{"v9", "ipfix"}})
+	p := packet{}.u16(5).u16(0).u32(0).u32(0).u32(0).u32(0).u32(0)
+	_, err := d.Read(p, testExporter)
+	assert.Error(t, err)
+
+	_, err = NewDecoder(Config{Protocols: []string{"v4"}})
+	assert.Error(t, err)
+}
+
+var v9Template = packet{}.u16(256).u16(5).
+	u16(8).u16(4).  // source ipv4 address
+	u16(12).u16(4). // destination ipv4 address
+	u16(1).u16(8).  // octet delta count
+	u16(4).u16(1).  // protocol
+	u16(40000).u16(2)
+
+func v9Data() packet {
+	return packet{}.
+		ip("10.1.0.1").ip("10.1.0.2").u32(0).u32(1000).u8(6).u16(7).
+		ip("10.1.0.3").ip("10.1.0.4").u32(0).u32(2000).u8(17).u16(8).
+		bytes(0, 0) // padding
+}
+
+func TestReadV9(t *testing.T) {
+	d := newTestDecoder(t, Config{})
+
+	// data before the template is dropped
+	records, err := d.Read(v9Header(2, 7).set(256, v9Data()), testExporter)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	p := v9Header(3, 7).set(0, v9Template).set(256, v9Data())
+	records, err = d.Read(p, testExporter)
+	assert.NoError(t, err)
+	if !assert.Len(t, records, 2) {
+		return
+	}
+	assert.Equal(t, Exporter{
+		Address:   "192.0.2.1:50000",
+		Version:   9,
+		SourceID:  7,
+		Uptime:    60000,
+		Timestamp: time.Unix(1500000000, 0).UTC(),
+	}, records[0].Exporter)
+	assert.Equal(t, common.MapStr{
+		"source_ipv4_address":      "10.1.0.1",
+		"destination_ipv4_address": "10.1.0.2",
+		"octet_delta_count":        uint64(1000),
+		"protocol_identifier":      uint64(6),
+		"field_40000":              "0007",
+	}, records[0].Fields)
+	assert.Equal(t, uint64(2000), records[1].Fields["octet_delta_count"])
+
+	// templates are cached per source id
+	records, err = d.Read(v9Header(2, 7).set(256, v9Data()), testExporter)
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	records, err = d.Read(v9Header(2, 8).set(256, v9Data()), testExporter)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestReadV9OptionsTemplate(t *testing.T) {
+	template := packet{}.u16(257).u16(4).u16(8).
+		u16(2).u16(4). // scope interface
+		u16(34).u16(4).u16(35).u16(1).
+		bytes(0, 0) // padding
+	data := packet{}.u32(3).u32(100).u8(2).bytes(0, 0, 0)
+
+	d := newTestDecoder(t, Config{})
+	records, err := d.Read(v9Header(2, 0).set(1, template).set(257, data), testExporter)
+	assert.NoError(t, err)
+	if !assert.Len(t, records, 1) {
+		return
+	}
+	assert.Equal(t, Options, records[0].Type)
+	assert.Equal(t, common.MapStr{
+		"scope_interface":    uint64(3),
+		"sampling_interval":  uint64(100),
+		"sampling_algorithm": uint64(2),
+	}, records[0].Fields)
+}
+
+func TestReadV9InvalidSetLength(t *testing.T) {
+	p := v9Header(1, 0).u16(256).u16(100).u32(0)
+	_, err := newTestDecoder(t, Config{}).Read(p, testExporter)
+	assert.Error(t, err)
+}
+
+func TestTemplateExpiration(t *testing.T) {
+	now := time.Now()
+	d := newTestDecoder(t, Config{ExpirationTimeout: time.Minute})
+	d.now = func() time.Time { return now }
+
+	records, err := d.Read(v9Header(3, 0).set(0, v9Template).set(256, v9Data()), testExporter)
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	now = now.Add(2 * time.Minute)
+	records, err = d.Read(v9Header(2, 0).set(256, v9Data()), testExporter)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+	assert.Empty(t, d.sessions)
+}
+
+func TestReadIPFIX(t *testing.T) {
+	template := packet{}.u16(300).u16(4).
+		u16(8).u16(4).               // source ipv4 address
+		u16(82).u16(variableLength). // interface name
+		u16(152).u16(8).             // flow start milliseconds
+		u16(enterpriseBit | 1).u16(2).u32(5951)
+	data := packet{}.
+		ip("10.2.0.1").u8(4).bytes([]byte("eth0")...).u32(0x15d).u32(0x3ef79800).u16(10).
+		ip("10.2.0.2").u8(0).u32(0x15d).u32(0x3ef79800).u16(11)
+
+	custom := FieldDict{{PEN: 5951, ID: 1}: {Name: "netscaler_rtt", Type: Unsigned16}}
+	d := newTestDecoder(t, Config{Fields: custom})
+	records, err := d.Read(ipfixMessage(1, packet{}.set(2, template).set(300, data)), testExporter)
+	assert.NoError(t, err)
+	if !assert.Len(t, records, 2) {
+		return
+	}
+
+	assert.Equal(t, uint32(1), records[0].Exporter.SourceID)
+	assert.Equal(t, uint16(10), records[0].Exporter.Version)
+	assert.Equal(t, common.MapStr{
+		"source_ipv4_address":     "10.2.0.1",
+		"interface_name":          "eth0",
+		"flow_start_milliseconds": time.Unix(1500000000, 0).UTC(),
+		"netscaler_rtt":           uint64(10),
+	}, records[0].Fields)
+	assert.Equal(t, "", records[1].Fields["interface_name"])
+
+	_, ok := records[0].Exporter.BootTime()
+	assert.False(t, ok)
+
+	// without the custom definition, the enterprise field is kept raw
+	d = newTestDecoder(t, Config{})
+	records, err = d.Read(ipfixMessage(1, packet{}.set(2, template).set(300, data)), testExporter)
+	assert.NoError(t, err)
+	assert.Equal(t, "000a", records[0].Fields["enterprise_5951_field_1"])
+}
+
+func TestReadIPFIXWithdrawal(t *testing.T) {
+	template := packet{}.u16(256).u16(1).u16(8).u16(4)
+	data := packet{}.ip("10.3.0.1")
+
+	d := newTestDecoder(t, Config{})
+	records, err := d.Read(ipfixMessage(5, packet{}.set(2, template).set(256, data)), testExporter)
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	withdrawal := packet{}.u16(256).u16(0)
+	records, err = d.Read(ipfixMessage(5, packet{}.set(2, withdrawal).set(256, data)), testExporter)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestReadIPFIXInvalidLength(t *testing.T) {
+	p := ipfixMessage(0, nil)
+	binary.BigEndian.PutUint16(p[2:], 100)
+	_, err := newTestDecoder(t, Config{}).Read(p, testExporter)
+	assert.Error(t, err)
+}
+
+func TestDecodeTypes(t *testing.T) {
+	tests := []struct {
+		typ      Type
+		data     []byte
+		expected interface{}
+	}{
+		{Unsigned64, []byte{1, 2}, uint64(0x102)},
+		{Unsigned32, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}, "010203040506070809"},
+		{Signed16, []byte{0xff, 0xfe}, int64(-2)},
+		{Signed64, []byte{0xff}, int64(-1)},
+		{Float32, []byte{0x3f, 0xc0, 0, 0}, float64(1.5)},
+		{Boolean, []byte{1}, true},
+		{Boolean, []byte{2}, false},
+		{MacAddress, []byte{0, 1, 2, 3, 4, 5}, "00:01:02:03:04:05"},
+		{String, []byte("abc\x00\x00"), "abc"},
+		{IPv4Address, []byte{192, 0, 2, 1}, "192.0.2.1"},
+		{IPv6Address, net.ParseIP("2001:db8::1"), "2001:db8::1"},
+		{IPv4Address, []byte{1, 2}, "0102"},
+		{DateTimeSeconds, []byte{0x59, 0x68, 0x2f, 0x00}, time.Unix(1500000000, 0).UTC()},
+		{DateTimeMicroseconds, []byte{0xdd, 0x12, 0xad, 0x80, 0x80, 0, 0, 0}, time.Unix(1500000000, 500000000).UTC()},
+		{OctetArray, []byte{0xab}, "ab"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.typ.decode(test.data), "type %d, data %x", test.typ, test.data)
+	}
+}
+
+func TestLoadFieldDefinitions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netflow")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "fields.yml")
+	content := `
+fields:
+  - name: netscaler_rtt
+    pen: 5951
+    id: 1
+    type: unsigned16
+  - name: octets
+    id: 1
+    type: unsigned64
+`
+	if !assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600)) {
+		return
+	}
+
+	dict, err := LoadFieldDefinitions([]string{path})
+	assert.NoError(t, err)
+	assert.Equal(t, FieldDict{
+		{PEN: 5951, ID: 1}: {Name: "netscaler_rtt", Type: Unsigned16},
+		{PEN: 0, ID: 1}:    {Name: "octets", Type: Unsigned64},
+	}, dict)
+
+	invalid := filepath.Join(dir, "invalid.yml")
+	content = "fields:\n  - name: x\n    id: 1\n    type: unsigned128\n"
+	if !assert.NoError(t, ioutil.WriteFile(invalid, []byte(content), 0600)) {
+		return
+	}
+	_, err = LoadFieldDefinitions([]string{invalid})
+	assert.Error(t, err)
+}
@@ -0,0 +1,424 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"time"
+)
+
+// Type is the abstract data type of an information element, as defined in
+// RFC 7012.
+type Type uint8
+
+const (
+	OctetArray Type = iota
+	Unsigned8
+	Unsigned16
+	Unsigned32
+	Unsigned64
+	Signed8
+	Signed16
+	Signed32
+	Signed64
+	Float32
+	Float64
+	Boolean
+	MacAddress
+	String
+	DateTimeSeconds
+	DateTimeMilliseconds
+	DateTimeMicroseconds
+	DateTimeNanoseconds
+	IPv4Address
+	IPv6Address
+)
+
+var typeNames = map[string]Type{
+	"octetarray":           OctetArray,
+	"unsigned8":            Unsigned8,
+	"unsigned16":           Unsigned16,
+	"unsigned32":           Unsigned32,
+	"unsigned64":           Unsigned64,
+	"signed8":              Signed8,
+	"signed16":             Signed16,
+	"signed32":             Signed32,
+	"signed64":             Signed64,
+	"float32":              Float32,
+	"float64":              Float64,
+	"boolean":              Boolean,
+	"macaddress":           MacAddress,
+	"string":               String,
+	"datetimeseconds":      DateTimeSeconds,
+	"datetimemilliseconds": DateTimeMilliseconds,
+	"datetimemicroseconds": DateTimeMicroseconds,
+	"datetimenanoseconds":  DateTimeNanoseconds,
+	"ipv4address":          IPv4Address,
+	"ipv6address":          IPv6Address,
+}
+
+// ntpEpochOffset is the number of seconds between the NTP epoch (1900) and
+// the Unix epoch, NTP timestamps are used by the micro and nanosecond types.
+const ntpEpochOffset = 2208988800
+
+// ParseType returns the type of the given name. Names are the abstract data
+// types of the IPFIX information model, matched case-insensitively.
+func ParseType(name string) (Type, error) {
+	t, found := typeNames[strings.ToLower(name)]
+	if !found {
+		return 0, fmt.Errorf("unknown field type '%s'", name)
+	}
+	return t, nil
+}
+
+// Field describes an information element.
+type Field struct {
+	Name string
+	Type Type
+}
+
+// FieldKey identifies an information element by its enterprise number and
+// element id. The enterprise number of IANA defined elements is 0.
+type FieldKey struct {
+	PEN uint32
+	ID  uint16
+}
+
+// FieldDict maps information elements to their definitions.
+type FieldDict map[FieldKey]*Field
+
+// decode converts the raw value of an information element. Values using
+// reduced-size encoding are supported for numeric types. Values that can't
+// be decoded as the type are returned as hex strings.
+func (t Type) decode(data []byte) interface{} {
+	if v, ok := t.decodeValue(data); ok {
+		return v
+	}
+	return hex.EncodeToString(data)
+}
+
+func (t Type) decodeValue(data []byte) (interface{}, bool) {
+	switch t {
+	case Unsigned8, Unsigned16, Unsigned32, Unsigned64:
+		return decodeUnsigned(data)
+	case Signed8, Signed16, Signed32, Signed64:
+		u, ok := decodeUnsigned(data)
+		if !ok {
+			return nil, false
+		}
+		shift := uint(64 - 8*len(data))
+		return int64(u<<shift) >> shift, true
+	case Float32, Float64:
+		switch len(data) {
+		case 4:
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(data))), true
+		case 8:
+			return math.Float64frombits(binary.BigEndian.Uint64(data)), true
+		}
+	case Boolean:
+		if len(data) == 1 {
+			return data[0] == 1, true
+		}
+	case MacAddress:
+		if len(data) == 6 {
+			return net.HardwareAddr(data).String(), true
+		}
+	case String:
+		return strings.TrimRight(string(data), "\x00"), true
+	case DateTimeSeconds:
+		if len(data) == 4 {
+			return time.Unix(int64(binary.BigEndian.Uint32(data)), 0).UTC(), true
+		}
+	case DateTimeMilliseconds:
+		if len(data) == 8 {
+			ms := int64(binary.BigEndian.Uint64(data))
+			return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)).UTC(), true
+		}
+	case DateTimeMicroseconds, DateTimeNanoseconds:
+		if len(data) == 8 {
+			secs := int64(binary.BigEndian.Uint32(data)) - ntpEpochOffset
+			fraction := uint64(binary.BigEndian.Uint32(data[4:]))
+			return time.Unix(secs, int64((fraction*uint64(time.Second))>>32)).UTC(), true
+		}
+	case IPv4Address:
+		if len(data) == net.IPv4len {
+			return net.IP(data).String(), true
+		}
+	case IPv6Address:
+		if len(data) == net.IPv6len {
+			return net.IP(data).String(), true
+		}
+	}
+	return nil, false
+}
+
+func decodeUnsigned(data []byte) (uint64, bool) {
+	if len(data) == 0 || len(data) > 8 {
+		return 0, false
+	}
+	var v uint64
+	for _, b := range data {
+		v = v<<8 | uint64(b)
+	}
+	return v, true
+}
+
+// fieldName returns the name used for information elements without a
+// definition.
+func fieldName(key FieldKey) string {
+	if key.PEN == 0 {
+		return fmt.Sprintf("field_%d", key.ID)
+	}
+	return fmt.Sprintf("enterprise_%d_field_%d", key.PEN, key.ID)
+}
+
+// ipfixFields holds the IANA assigned information elements, RFC 7012. The
+// element ids up to 127 are the field types of NetFlow v9.
+var ipfixFields = map[uint16]*Field{
+	1:   {"octet_delta_count", Unsigned64},
+	2:   {"packet_delta_count", Unsigned64},
+	3:   {"delta_flow_count", Unsigned64},
+	4:   {"protocol_identifier", Unsigned8},
+	5:   {"ip_class_of_service", Unsigned8},
+	6:   {"tcp_control_bits", Unsigned16},
+	7:   {"source_transport_port", Unsigned16},
+	8:   {"source_ipv4_address", IPv4Address},
+	9:   {"source_ipv4_prefix_length", Unsigned8},
+	10:  {"ingress_interface", Unsigned32},
+	11:  {"destination_transport_port", Unsigned16},
+	12:  {"destination_ipv4_address", IPv4Address},
+	13:  {"destination_ipv4_prefix_length", Unsigned8},
+	14:  {"egress_interface", Unsigned32},
+	15:  {"ip_next_hop_ipv4_address", IPv4Address},
+	16:  {"bgp_source_as_number", Unsigned32},
+	17:  {"bgp_destination_as_number", Unsigned32},
+	18:  {"bgp_next_hop_ipv4_address", IPv4Address},
+	19:  {"post_mcast_packet_delta_count", Unsigned64},
+	20:  {"post_mcast_octet_delta_count", Unsigned64},
+	21:  {"flow_end_sys_up_time", Unsigned32},
+	22:  {"flow_start_sys_up_time", Unsigned32},
+	23:  {"post_octet_delta_count", Unsigned64},
+	24:  {"post_packet_delta_count", Unsigned64},
+	25:  {"minimum_ip_total_length", Unsigned64},
+	26:  {"maximum_ip_total_length", Unsigned64},
+	27:  {"source_ipv6_address", IPv6Address},
+	28:  {"destination_ipv6_address", IPv6Address},
+	29:  {"source_ipv6_prefix_length", Unsigned8},
+	30:  {"destination_ipv6_prefix_length", Unsigned8},
+	31:  {"flow_label_ipv6", Unsigned32},
+	32:  {"icmp_type_code_ipv4", Unsigned16},
+	33:  {"igmp_type", Unsigned8},
+	34:  {"sampling_interval", Unsigned32},
+	35:  {"sampling_algorithm", Unsigned8},
+	36:  {"flow_active_timeout", Unsigned16},
+	37:  {"flow_idle_timeout", Unsigned16},
+	38:  {"engine_type", Unsigned8},
+	39:  {"engine_id", Unsigned8},
+	40:  {"exported_octet_total_count", Unsigned64},
+	41:  {"exported_message_total_count", Unsigned64},
+	42:  {"exported_flow_record_total_count", Unsigned64},
+	43:  {"ipv4_router_sc", IPv4Address},
+	44:  {"source_ipv4_prefix", IPv4Address},
+	45:  {"destination_ipv4_prefix", IPv4Address},
+	46:  {"mpls_top_label_type", Unsigned8},
+	47:  {"mpls_top_label_ipv4_address", IPv4Address},
+	48:  {"sampler_id", Unsigned8},
+	49:  {"sampler_mode", Unsigned8},
+	50:  {"sampler_random_interval", Unsigned32},
+	51:  {"class_id", Unsigned8},
+	52:  {"minimum_ttl", Unsigned8},
+	53:  {"maximum_ttl", Unsigned8},
+	54:  {"fragment_identification", Unsigned32},
+	55:  {"post_ip_class_of_service", Unsigned8},
+	56:  {"source_mac_address", MacAddress},
+	57:  {"post_destination_mac_address", MacAddress},
+	58:  {"vlan_id", Unsigned16},
+	59:  {"post_vlan_id", Unsigned16},
+	60:  {"ip_version", Unsigned8},
+	61:  {"flow_direction", Unsigned8},
+	62:  {"ip_next_hop_ipv6_address", IPv6Address},
+	63:  {"bgp_next_hop_ipv6_address", IPv6Address},
+	64:  {"ipv6_extension_headers", Unsigned32},
+	70:  {"mpls_top_label_stack_section", OctetArray},
+	71:  {"mpls_label_stack_section2", OctetArray},
+	72:  {"mpls_label_stack_section3", OctetArray},
+	73:  {"mpls_label_stack_section4", OctetArray},
+	74:  {"mpls_label_stack_section5", OctetArray},
+	75:  {"mpls_label_stack_section6", OctetArray},
+	76:  {"mpls_label_stack_section7", OctetArray},
+	77:  {"mpls_label_stack_section8", OctetArray},
+	78:  {"mpls_label_stack_section9", OctetArray},
+	79:  {"mpls_label_stack_section10", OctetArray},
+	80:  {"destination_mac_address", MacAddress},
+	81:  {"post_source_mac_address", MacAddress},
+	82:  {"interface_name", String},
+	83:  {"interface_description", String},
+	84:  {"sampler_name", String},
+	85:  {"octet_total_count", Unsigned64},
+	86:  {"packet_total_count", Unsigned64},
+	87:  {"flags_and_sampler_id", Unsigned32},
+	88:  {"fragment_offset", Unsigned16},
+	89:  {"forwarding_status", Unsigned32},
+	90:  {"mpls_vpn_route_distinguisher", OctetArray},
+	91:  {"mpls_top_label_prefix_length", Unsigned8},
+	92:  {"src_traffic_index", Unsigned32},
+	93:  {"dst_traffic_index", Unsigned32},
+	94:  {"application_description", String},
+	95:  {"application_id", OctetArray},
+	96:  {"application_name", String},
+	98:  {"post_ip_diff_serv_code_point", Unsigned8},
+	99:  {"multicast_replication_factor", Unsigned32},
+	100: {"class_name", String},
+	101: {"classification_engine_id", Unsigned8},
+	102: {"layer2_packet_section_offset", Unsigned16},
+	103: {"layer2_packet_section_size", Unsigned16},
+	104: {"layer2_packet_section_data", OctetArray},
+	128: {"bgp_next_adjacent_as_number", Unsigned32},
+	129: {"bgp_prev_adjacent_as_number", Unsigned32},
+	130: {"exporter_ipv4_address", IPv4Address},
+	131: {"exporter_ipv6_address", IPv6Address},
+	132: {"dropped_octet_delta_count", Unsigned64},
+	133: {"dropped_packet_delta_count", Unsigned64},
+	134: {"dropped_octet_total_count", Unsigned64},
+	135: {"dropped_packet_total_count", Unsigned64},
+	136: {"flow_end_reason", Unsigned8},
+	137: {"common_properties_id", Unsigned64},
+	138: {"observation_point_id", Unsigned64},
+	139: {"icmp_type_code_ipv6", Unsigned16},
+	140: {"mpls_top_label_ipv6_address", IPv6Address},
+	141: {"line_card_id", Unsigned32},
+	142: {"port_id", Unsigned32},
+	143: {"metering_process_id", Unsigned32},
+	144: {"exporting_process_id", Unsigned32},
+	145: {"template_id", Unsigned16},
+	146: {"wlan_channel_id", Unsigned8},
+	147: {"wlan_ssid", String},
+	148: {"flow_id", Unsigned64},
+	149: {"observation_domain_id", Unsigned32},
+	150: {"flow_start_seconds", DateTimeSeconds},
+	151: {"flow_end_seconds", DateTimeSeconds},
+	152: {"flow_start_milliseconds", DateTimeMilliseconds},
+	153: {"flow_end_milliseconds", DateTimeMilliseconds},
+	154: {"flow_start_microseconds", DateTimeMicroseconds},
+	155: {"flow_end_microseconds", DateTimeMicroseconds},
+	156: {"flow_start_nanoseconds", DateTimeNanoseconds},
+	157: {"flow_end_nanoseconds", DateTimeNanoseconds},
+	158: {"flow_start_delta_microseconds", Unsigned32},
+	159: {"flow_end_delta_microseconds", Unsigned32},
+	160: {"system_init_time_milliseconds", DateTimeMilliseconds},
+	161: {"flow_duration_milliseconds", Unsigned32},
+	162: {"flow_duration_microseconds", Unsigned32},
+	163: {"observed_flow_total_count", Unsigned64},
+	164: {"ignored_packet_total_count", Unsigned64},
+	165: {"ignored_octet_total_count", Unsigned64},
+	166: {"not_sent_flow_total_count", Unsigned64},
+	167: {"not_sent_packet_total_count", Unsigned64},
+	168: {"not_sent_octet_total_count", Unsigned64},
+	169: {"destination_ipv6_prefix", IPv6Address},
+	170: {"source_ipv6_prefix", IPv6Address},
+	171: {"post_octet_total_count", Unsigned64},
+	172: {"post_packet_total_count", Unsigned64},
+	173: {"flow_key_indicator", Unsigned64},
+	174: {"post_mcast_packet_total_count", Unsigned64},
+	175: {"post_mcast_octet_total_count", Unsigned64},
+	176: {"icmp_type_ipv4", Unsigned8},
+	177: {"icmp_code_ipv4", Unsigned8},
+	178: {"icmp_type_ipv6", Unsigned8},
+	179: {"icmp_code_ipv6", Unsigned8},
+	180: {"udp_source_port", Unsigned16},
+	181: {"udp_destination_port", Unsigned16},
+	182: {"tcp_source_port", Unsigned16},
+	183: {"tcp_destination_port", Unsigned16},
+	184: {"tcp_sequence_number", Unsigned32},
+	185: {"tcp_acknowledgement_number", Unsigned32},
+	186: {"tcp_window_size", Unsigned16},
+	187: {"tcp_urgent_pointer", Unsigned16},
+	188: {"tcp_header_length", Unsigned8},
+	189: {"ip_header_length", Unsigned8},
+	190: {"total_length_ipv4", Unsigned16},
+	191: {"payload_length_ipv6", Unsigned16},
+	192: {"ip_ttl", Unsigned8},
+	193: {"next_header_ipv6", Unsigned8},
+	194: {"mpls_payload_length", Unsigned32},
+	195: {"ip_diff_serv_code_point", Unsigned8},
+	196: {"ip_precedence", Unsigned8},
+	197: {"fragment_flags", Unsigned8},
+	198: {"octet_delta_sum_of_squares", Unsigned64},
+	199: {"octet_total_sum_of_squares", Unsigned64},
+	200: {"mpls_top_label_ttl", Unsigned8},
+	201: {"mpls_label_stack_length", Unsigned32},
+	202: {"mpls_label_stack_depth", Unsigned32},
+	203: {"mpls_top_label_exp", Unsigned8},
+	204: {"ip_payload_length", Unsigned32},
+	205: {"udp_message_length", Unsigned16},
+	206: {"is_multicast", Unsigned8},
+	207: {"ipv4_ihl", Unsigned8},
+	208: {"ipv4_options", Unsigned32},
+	209: {"tcp_options", Unsigned64},
+	210: {"padding_octets", OctetArray},
+	211: {"collector_ipv4_address", IPv4Address},
+	212: {"collector_ipv6_address", IPv6Address},
+	213: {"export_interface", Unsigned32},
+	214: {"export_protocol_version", Unsigned8},
+	215: {"export_transport_protocol", Unsigned8},
+	216: {"collector_transport_port", Unsigned16},
+	217: {"exporter_transport_port", Unsigned16},
+	218: {"tcp_syn_total_count", Unsigned64},
+	219: {"tcp_fin_total_count", Unsigned64},
+	220: {"tcp_rst_total_count", Unsigned64},
+	221: {"tcp_psh_total_count", Unsigned64},
+	222: {"tcp_ack_total_count", Unsigned64},
+	223: {"tcp_urg_total_count", Unsigned64},
+	224: {"ip_total_length", Unsigned64},
+	225: {"post_nat_source_ipv4_address", IPv4Address},
+	226: {"post_nat_destination_ipv4_address", IPv4Address},
+	227: {"post_napt_source_transport_port", Unsigned16},
+	228: {"post_napt_destination_transport_port", Unsigned16},
+	229: {"nat_originating_address_realm", Unsigned8},
+	230: {"nat_event", Unsigned8},
+	231: {"initiator_octets", Unsigned64},
+	232: {"responder_octets", Unsigned64},
+	233: {"firewall_event", Unsigned8},
+	234: {"ingress_vrf_id", Unsigned32},
+	235: {"egress_vrf_id", Unsigned32},
+	236: {"vrf_name", String},
+	237: {"post_mpls_top_label_exp", Unsigned8},
+	238: {"tcp_window_scale", Unsigned16},
+	239: {"biflow_direction", Unsigned8},
+	240: {"ethernet_header_length", Unsigned8},
+	241: {"ethernet_payload_length", Unsigned16},
+	242: {"ethernet_total_length", Unsigned16},
+	243: {"dot1q_vlan_id", Unsigned16},
+	244: {"dot1q_priority", Unsigned8},
+	245: {"dot1q_customer_vlan_id", Unsigned16},
+	246: {"dot1q_customer_priority", Unsigned8},
+	256: {"ethernet_type", Unsigned16},
+	281: {"post_nat_source_ipv6_address", IPv6Address},
+	282: {"post_nat_destination_ipv6_address", IPv6Address},
+	298: {"initiator_packets", Unsigned64},
+	299: {"responder_packets", Unsigned64},
+	322: {"observation_time_seconds", DateTimeSeconds},
+	323: {"observation_time_milliseconds", DateTimeMilliseconds},
+	324: {"observation_time_microseconds", DateTimeMicroseconds},
+	325: {"observation_time_nanoseconds", DateTimeNanoseconds},
+}
@@ -0,0 +1,137 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+const (
+	ipfixHeaderLength = 16
+
+	ipfixTemplateSetID        = 2
+	ipfixOptionsTemplateSetID = 3
+	ipfixMinDataSetID         = 256
+
+	// enterpriseBit marks information elements followed by an enterprise
+	// number in templates.
+	enterpriseBit = 0x8000
+)
+
+// readIPFIX decodes an IPFIX message, RFC 7011.
+func (d *Decoder) readIPFIX(data []byte, address string) ([]Record, error) {
+	if len(data) < ipfixHeaderLength {
+		return nil, errShortPacket
+	}
+
+	length := int(binary.BigEndian.Uint16(data[2:]))
+	if length < ipfixHeaderLength || length > len(data) {
+		return nil, fmt.Errorf("invalid IPFIX message length %d", length)
+	}
+	data = data[:length]
+
+	exporter := Exporter{
+		Address:   address,
+		Version:   versionIPFIX,
+		Timestamp: time.Unix(int64(binary.BigEndian.Uint32(data[4:])), 0).UTC(),
+		SourceID:  binary.BigEndian.Uint32(data[12:]),
+	}
+	s := d.session(address, exporter.SourceID)
+
+	var records []Record
+	err := forEachSet(data[ipfixHeaderLength:], func(id uint16, set []byte) error {
+		switch {
+		case id == ipfixTemplateSetID:
+			return d.readIPFIXTemplates(s, set, false)
+		case id == ipfixOptionsTemplateSetID:
+			return d.readIPFIXTemplates(s, set, true)
+		case id >= ipfixMinDataSetID:
+			recs, err := d.dataRecords(s, id, set, exporter)
+			records = append(records, recs...)
+			return err
+		default:
+			debugf("Ignoring reserved set %d from %s", id, address)
+			return nil
+		}
+	})
+	return records, err
+}
+
+// readIPFIXTemplates reads the template records of a template or options
+// template set. Templates with no fields withdraw previous templates.
+func (d *Decoder) readIPFIXTemplates(s *session, data []byte, isOptions bool) error {
+	headerLength := 4
+	if isOptions {
+		headerLength = 6
+	}
+
+	for len(data) >= 4 {
+		id := binary.BigEndian.Uint16(data)
+		count := int(binary.BigEndian.Uint16(data[2:]))
+		if count == 0 {
+			d.withdrawTemplate(s, id, isOptions)
+			data = data[4:]
+			continue
+		}
+		if len(data) < headerLength {
+			return errShortPacket
+		}
+		if id < ipfixMinDataSetID {
+			return fmt.Errorf("invalid template id %d", id)
+		}
+		data = data[headerLength:]
+
+		fields := make([]templateField, count)
+		for i := range fields {
+			if len(data) < 4 {
+				return errShortPacket
+			}
+			key := FieldKey{ID: binary.BigEndian.Uint16(data)}
+			length := binary.BigEndian.Uint16(data[2:])
+			data = data[4:]
+
+			if key.ID&enterpriseBit != 0 {
+				if len(data) < 4 {
+					return errShortPacket
+				}
+				key.ID &^= enterpriseBit
+				key.PEN = binary.BigEndian.Uint32(data)
+				data = data[4:]
+			}
+			fields[i] = lookupField(d.fields, key, length)
+		}
+		d.addTemplate(s, newTemplate(id, fields, isOptions))
+	}
+	return nil
+}
+
+// withdrawTemplate removes a template. Withdrawing the id of the set removes
+// all templates of its kind.
+func (d *Decoder) withdrawTemplate(s *session, id uint16, isOptions bool) {
+	if id != ipfixTemplateSetID && id != ipfixOptionsTemplateSetID {
+		d.removeTemplate(s, id)
+		return
+	}
+	for tid, t := range s.templates {
+		if t.isOptions == isOptions {
+			d.removeTemplate(s, tid)
+		}
+	}
+}
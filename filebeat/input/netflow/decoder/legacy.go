@@ -0,0 +1,410 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var errShortPacket = errors.New("packet is too short")
+
+// legacyField is a field of the fixed record formats. Fields with id 0 are
+// skipped.
+type legacyField struct {
+	id     uint16
+	length uint16
+}
+
+// legacyFormat describes the fixed header and record format of a NetFlow
+// version before v9.
+type legacyFormat struct {
+	headerLength int
+	record       *template
+}
+
+// Information elements used in the fixed record formats.
+const (
+	ieOctetDeltaCount             = 1
+	iePacketDeltaCount            = 2
+	ieDeltaFlowCount              = 3
+	ieProtocolIdentifier          = 4
+	ieIPClassOfService            = 5
+	ieTCPControlBits              = 6
+	ieSourceTransportPort         = 7
+	ieSourceIPv4Address           = 8
+	ieSourceIPv4PrefixLength      = 9
+	ieIngressInterface            = 10
+	ieDestinationTransportPort    = 11
+	ieDestinationIPv4Address      = 12
+	ieDestinationIPv4PrefixLength = 13
+	ieEgressInterface             = 14
+	ieIPNextHopIPv4Address        = 15
+	ieBGPSourceAsNumber           = 16
+	ieBGPDestinationAsNumber      = 17
+	ieBGPNextHopIPv4Address       = 18
+	ieFlowEndSysUpTime            = 21
+	ieFlowStartSysUpTime          = 22
+	ieSamplingInterval            = 34
+	ieSamplingAlgorithm           = 35
+	ieEngineType                  = 38
+	ieEngineID                    = 39
+	ieIPv4RouterSc                = 43
+	ieSourceIPv4Prefix            = 44
+	ieDestinationIPv4Prefix       = 45
+	iePostIPClassOfService        = 55
+)
+
+var (
+	// v1 records, the header is followed by 16 bytes of padding.
+	v1Record = []legacyField{
+		{ieSourceIPv4Address, 4},
+		{ieDestinationIPv4Address, 4},
+		{ieIPNextHopIPv4Address, 4},
+		{ieIngressInterface, 2},
+		{ieEgressInterface, 2},
+		{iePacketDeltaCount, 4},
+		{ieOctetDeltaCount, 4},
+		{ieFlowStartSysUpTime, 4},
+		{ieFlowEndSysUpTime, 4},
+		{ieSourceTransportPort, 2},
+		{ieDestinationTransportPort, 2},
+		{0, 2},
+		{ieProtocolIdentifier, 1},
+		{ieIPClassOfService, 1},
+		{ieTCPControlBits, 1},
+		{0, 7},
+	}
+
+	v5Record = []legacyField{
+		{ieSourceIPv4Address, 4},
+		{ieDestinationIPv4Address, 4},
+		{ieIPNextHopIPv4Address, 4},
+		{ieIngressInterface, 2},
+		{ieEgressInterface, 2},
+		{iePacketDeltaCount, 4},
+		{ieOctetDeltaCount, 4},
+		{ieFlowStartSysUpTime, 4},
+		{ieFlowEndSysUpTime, 4},
+		{ieSourceTransportPort, 2},
+		{ieDestinationTransportPort, 2},
+		{0, 1},
+		{ieTCPControlBits, 1},
+		{ieProtocolIdentifier, 1},
+		{ieIPClassOfService, 1},
+		{ieBGPSourceAsNumber, 2},
+		{ieBGPDestinationAsNumber, 2},
+		{ieSourceIPv4PrefixLength, 1},
+		{ieDestinationIPv4PrefixLength, 1},
+		{0, 2},
+	}
+
+	// v6 records extend v5 with the encapsulation sizes and the peer next hop.
+	v6Record = append(append([]legacyField{}, v5Record[:len(v5Record)-1]...),
+		legacyField{0, 2},
+		legacyField{ieBGPNextHopIPv4Address, 4},
+	)
+
+	// v7 records extend v5 with the valid fields flags and the router shortcut.
+	v7Record = append(append([]legacyField{}, v5Record[:len(v5Record)-1]...),
+		legacyField{0, 2},
+		legacyField{ieIPv4RouterSc, 4},
+	)
+
+	// v8 records of the router-based aggregation schemes all start with
+	// the flow counters.
+	v8Counters = []legacyField{
+		{ieDeltaFlowCount, 4},
+		{iePacketDeltaCount, 4},
+		{ieOctetDeltaCount, 4},
+		{ieFlowStartSysUpTime, 4},
+		{ieFlowEndSysUpTime, 4},
+	}
+
+	v8Records = map[uint8][]legacyField{
+		// AS
+		1: {
+			{ieBGPSourceAsNumber, 2},
+			{ieBGPDestinationAsNumber, 2},
+			{ieIngressInterface, 2},
+			{ieEgressInterface, 2},
+		},
+		// Protocol and port
+		2: {
+			{ieProtocolIdentifier, 1},
+			{0, 3},
+			{ieSourceTransportPort, 2},
+			{ieDestinationTransportPort, 2},
+		},
+		// Source prefix
+		3: {
+			{ieSourceIPv4Prefix, 4},
+			{ieSourceIPv4PrefixLength, 1},
+			{0, 1},
+			{ieBGPSourceAsNumber, 2},
+			{ieIngressInterface, 2},
+			{0, 2},
+		},
+		// Destination prefix
+		4: {
+			{ieDestinationIPv4Prefix, 4},
+			{ieDestinationIPv4PrefixLength, 1},
+			{0, 1},
+			{ieBGPDestinationAsNumber, 2},
+			{ieEgressInterface, 2},
+			{0, 2},
+		},
+		// Prefix
+		5: {
+			{ieSourceIPv4Prefix, 4},
+			{ieDestinationIPv4Prefix, 4},
+			{ieDestinationIPv4PrefixLength, 1},
+			{ieSourceIPv4PrefixLength, 1},
+			{0, 2},
+			{ieBGPSourceAsNumber, 2},
+			{ieBGPDestinationAsNumber, 2},
+			{ieIngressInterface, 2},
+			{ieEgressInterface, 2},
+		},
+		// AS and ToS
+		9: {
+			{ieBGPSourceAsNumber, 2},
+			{ieBGPDestinationAsNumber, 2},
+			{ieIngressInterface, 2},
+			{ieEgressInterface, 2},
+			{ieIPClassOfService, 1},
+			{0, 3},
+		},
+		// Protocol, port and ToS
+		10: {
+			{ieProtocolIdentifier, 1},
+			{ieIPClassOfService, 1},
+			{0, 2},
+			{ieSourceTransportPort, 2},
+			{ieDestinationTransportPort, 2},
+			{ieIngressInterface, 2},
+			{ieEgressInterface, 2},
+		},
+		// Source prefix and ToS
+		11: {
+			{ieSourceIPv4Prefix, 4},
+			{ieSourceIPv4PrefixLength, 1},
+			{ieIPClassOfService, 1},
+			{ieBGPSourceAsNumber, 2},
+			{ieIngressInterface, 2},
+			{0, 2},
+		},
+		// Destination prefix and ToS
+		12: {
+			{ieDestinationIPv4Prefix, 4},
+			{ieDestinationIPv4PrefixLength, 1},
+			{ieIPClassOfService, 1},
+			{ieBGPDestinationAsNumber, 2},
+			{ieEgressInterface, 2},
+			{0, 2},
+		},
+		// Prefix and ToS
+		13: {
+			{ieSourceIPv4Prefix, 4},
+			{ieDestinationIPv4Prefix, 4},
+			{ieDestinationIPv4PrefixLength, 1},
+			{ieSourceIPv4PrefixLength, 1},
+			{ieIPClassOfService, 1},
+			{0, 1},
+			{ieBGPSourceAsNumber, 2},
+			{ieBGPDestinationAsNumber, 2},
+			{ieIngressInterface, 2},
+			{ieEgressInterface, 2},
+		},
+		// Prefix and port
+		14: {
+			{ieSourceIPv4Prefix, 4},
+			{ieDestinationIPv4Prefix, 4},
+			{ieDestinationIPv4PrefixLength, 1},
+			{ieSourceIPv4PrefixLength, 1},
+			{ieIPClassOfService, 1},
+			{ieProtocolIdentifier, 1},
+			{ieSourceTransportPort, 2},
+			{ieDestinationTransportPort, 2},
+			{ieIngressInterface, 2},
+			{ieEgressInterface, 2},
+		},
+	}
+
+	// v8 records of the ToS-based aggregation schemes of the flow cache.
+	v8CacheRecords = map[uint8][]legacyField{
+		// Destination only
+		6: {
+			{ieDestinationIPv4Address, 4},
+			{iePacketDeltaCount, 4},
+			{ieOctetDeltaCount, 4},
+			{ieFlowStartSysUpTime, 4},
+			{ieFlowEndSysUpTime, 4},
+			{ieEgressInterface, 2},
+			{ieIPClassOfService, 1},
+			{iePostIPClassOfService, 1},
+			{0, 4},
+			{ieIPv4RouterSc, 4},
+		},
+		// Source and destination
+		7: {
+			{ieDestinationIPv4Address, 4},
+			{ieSourceIPv4Address, 4},
+			{iePacketDeltaCount, 4},
+			{ieOctetDeltaCount, 4},
+			{ieFlowStartSysUpTime, 4},
+			{ieFlowEndSysUpTime, 4},
+			{ieEgressInterface, 2},
+			{ieIngressInterface, 2},
+			{ieIPClassOfService, 1},
+			{iePostIPClassOfService, 1},
+			{0, 2},
+			{0, 4},
+			{ieIPv4RouterSc, 4},
+		},
+		// Full flow
+		8: {
+			{ieDestinationIPv4Address, 4},
+			{ieSourceIPv4Address, 4},
+			{ieDestinationTransportPort, 2},
+			{ieSourceTransportPort, 2},
+			{iePacketDeltaCount, 4},
+			{ieOctetDeltaCount, 4},
+			{ieFlowStartSysUpTime, 4},
+			{ieFlowEndSysUpTime, 4},
+			{ieEgressInterface, 2},
+			{ieIngressInterface, 2},
+			{ieIPClassOfService, 1},
+			{ieProtocolIdentifier, 1},
+			{iePostIPClassOfService, 1},
+			{0, 1},
+			{0, 4},
+			{ieIPv4RouterSc, 4},
+		},
+	}
+)
+
+var (
+	legacyFormats = map[uint16]legacyFormat{
+		versionV1: {headerLength: 16, record: newLegacyTemplate(v1Record)},
+		versionV5: {headerLength: 24, record: newLegacyTemplate(v5Record)},
+		versionV6: {headerLength: 24, record: newLegacyTemplate(v6Record)},
+		versionV7: {headerLength: 24, record: newLegacyTemplate(v7Record)},
+	}
+
+	v8Templates = newV8Templates()
+)
+
+const v8HeaderLength = 28
+
+func newLegacyTemplate(fields []legacyField) *template {
+	tf := make([]templateField, len(fields))
+	for i, f := range fields {
+		tf[i] = templateField{length: f.length}
+		if f.id != 0 {
+			field := ipfixFields[f.id]
+			tf[i].name, tf[i].typ = field.Name, field.Type
+		}
+	}
+	return newTemplate(0, tf, false)
+}
+
+func newV8Templates() map[uint8]*template {
+	templates := map[uint8]*template{}
+	for aggregation, fields := range v8Records {
+		record := append(append([]legacyField{}, v8Counters...), fields...)
+		templates[aggregation] = newLegacyTemplate(record)
+	}
+	for aggregation, fields := range v8CacheRecords {
+		templates[aggregation] = newLegacyTemplate(fields)
+	}
+	return templates
+}
+
+// readLegacy decodes the fixed format packets of the NetFlow versions before
+// v9. All versions share the first fields of the header.
+func readLegacy(data []byte, address string) ([]Record, error) {
+	if len(data) < 16 {
+		return nil, errShortPacket
+	}
+
+	version := binary.BigEndian.Uint16(data)
+	count := int(binary.BigEndian.Uint16(data[2:]))
+	exporter := Exporter{
+		Address: address,
+		Version: version,
+		Uptime:  binary.BigEndian.Uint32(data[4:]),
+		Timestamp: time.Unix(
+			int64(binary.BigEndian.Uint32(data[8:])),
+			int64(binary.BigEndian.Uint32(data[12:])),
+		).UTC(),
+	}
+
+	var format legacyFormat
+	header := map[string]interface{}{}
+	switch version {
+	case versionV8:
+		if len(data) < v8HeaderLength {
+			return nil, errShortPacket
+		}
+		aggregation := data[22]
+		format.record = v8Templates[aggregation]
+		if format.record == nil {
+			return nil, fmt.Errorf("unsupported NetFlow v8 aggregation scheme %d", aggregation)
+		}
+		format.headerLength = v8HeaderLength
+		header[ipfixFields[ieEngineType].Name] = uint64(data[20])
+		header[ipfixFields[ieEngineID].Name] = uint64(data[21])
+	default:
+		format = legacyFormats[version]
+		if format.record == nil {
+			return nil, fmt.Errorf("unsupported NetFlow version %d", version)
+		}
+		if len(data) < format.headerLength {
+			return nil, errShortPacket
+		}
+		if version == versionV5 || version == versionV6 {
+			sampling := binary.BigEndian.Uint16(data[22:])
+			header[ipfixFields[ieEngineType].Name] = uint64(data[20])
+			header[ipfixFields[ieEngineID].Name] = uint64(data[21])
+			header[ipfixFields[ieSamplingAlgorithm].Name] = uint64(sampling >> 14)
+			header[ipfixFields[ieSamplingInterval].Name] = uint64(sampling & 0x3fff)
+		}
+	}
+
+	body := data[format.headerLength:]
+	if len(body) < count*format.record.minLength {
+		return nil, errShortPacket
+	}
+
+	records := make([]Record, 0, count)
+	for i := 0; i < count; i++ {
+		fields, n, err := format.record.decodeRecord(body)
+		if err != nil {
+			return records, err
+		}
+		for k, v := range header {
+			fields[k] = v
+		}
+		records = append(records, Record{Type: Flow, Exporter: exporter, Fields: fields})
+		body = body[n:]
+	}
+	return records, nil
+}
@@ -0,0 +1,136 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// variableLength is the field length announcing a variable-length encoded
+// field in IPFIX templates.
+const variableLength = 0xffff
+
+var errShortRecord = errors.New("record is shorter than its template")
+
+type templateField struct {
+	name   string
+	typ    Type
+	length uint16
+}
+
+// template describes the layout of data records. The fixed formats of the
+// NetFlow versions before v9 are described by templates too.
+type template struct {
+	id        uint16
+	fields    []templateField
+	isOptions bool
+	minLength int
+	updated   time.Time
+}
+
+func newTemplate(id uint16, fields []templateField, isOptions bool) *template {
+	t := &template{id: id, fields: fields, isOptions: isOptions}
+	for _, f := range fields {
+		if f.length == variableLength {
+			t.minLength++
+		} else {
+			t.minLength += int(f.length)
+		}
+	}
+	return t
+}
+
+// lookupField returns the template field for an information element, using
+// the custom definitions before the IANA ones.
+func lookupField(custom FieldDict, key FieldKey, length uint16) templateField {
+	field := custom[key]
+	if field == nil && key.PEN == 0 {
+		field = ipfixFields[key.ID]
+	}
+	if field == nil {
+		return templateField{name: fieldName(key), typ: OctetArray, length: length}
+	}
+	return templateField{name: field.Name, typ: field.Type, length: length}
+}
+
+// decodeRecord decodes a data record and returns the number of bytes read.
+// Fields without a name are skipped.
+func (t *template) decodeRecord(data []byte) (common.MapStr, int, error) {
+	fields := common.MapStr{}
+	offset := 0
+	for _, f := range t.fields {
+		length := int(f.length)
+		if f.length == variableLength {
+			var err error
+			length, offset, err = readVariableLength(data, offset)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		if offset+length > len(data) {
+			return nil, 0, errShortRecord
+		}
+
+		if f.name != "" {
+			fields[f.name] = f.typ.decode(data[offset : offset+length])
+		}
+		offset += length
+	}
+	return fields, offset, nil
+}
+
+// readVariableLength reads the length prefix of a variable-length encoded
+// field, RFC 7011 section 7.
+func readVariableLength(data []byte, offset int) (int, int, error) {
+	if offset >= len(data) {
+		return 0, 0, errShortRecord
+	}
+	length := int(data[offset])
+	offset++
+	if length == 255 {
+		if offset+2 > len(data) {
+			return 0, 0, errShortRecord
+		}
+		length = int(binary.BigEndian.Uint16(data[offset:]))
+		offset += 2
+	}
+	return length, offset, nil
+}
+
+// decodeRecords decodes the records of a data set. Trailing bytes too short
+// for another record are padding.
+func (t *template) decodeRecords(data []byte) ([]common.MapStr, error) {
+	if t.minLength == 0 {
+		return nil, errors.New("template without fields")
+	}
+
+	var records []common.MapStr
+	for len(data) >= t.minLength {
+		fields, n, err := t.decodeRecord(data)
+		if err != nil {
+			return records, err
+		}
+		records = append(records, fields)
+		data = data[n:]
+	}
+	return records, nil
+}
@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+const (
+	v9HeaderLength = 20
+
+	v9TemplateSetID        = 0
+	v9OptionsTemplateSetID = 1
+	v9MinDataSetID         = 256
+)
+
+// v9ScopeNames names the scope field types of options templates, RFC 3954
+// section 6.1.
+var v9ScopeNames = map[uint16]string{
+	1: "scope_system",
+	2: "scope_interface",
+	3: "scope_line_card",
+	4: "scope_cache",
+	5: "scope_template",
+}
+
+// readV9 decodes a NetFlow v9 packet, RFC 3954.
+func (d *Decoder) readV9(data []byte, address string) ([]Record, error) {
+	if len(data) < v9HeaderLength {
+		return nil, errShortPacket
+	}
+
+	exporter := Exporter{
+		Address:   address,
+		Version:   versionV9,
+		Uptime:    binary.BigEndian.Uint32(data[4:]),
+		Timestamp: time.Unix(int64(binary.BigEndian.Uint32(data[8:])), 0).UTC(),
+		SourceID:  binary.BigEndian.Uint32(data[16:]),
+	}
+	s := d.session(address, exporter.SourceID)
+
+	var records []Record
+	err := forEachSet(data[v9HeaderLength:], func(id uint16, set []byte) error {
+		switch {
+		case id == v9TemplateSetID:
+			return d.readV9Templates(s, set)
+		case id == v9OptionsTemplateSetID:
+			return d.readV9OptionsTemplates(s, set)
+		case id >= v9MinDataSetID:
+			recs, err := d.dataRecords(s, id, set, exporter)
+			records = append(records, recs...)
+			return err
+		default:
+			debugf("Ignoring reserved flowset %d from %s", id, address)
+			return nil
+		}
+	})
+	return records, err
+}
+
+func (d *Decoder) readV9Templates(s *session, data []byte) error {
+	for len(data) >= 4 {
+		id := binary.BigEndian.Uint16(data)
+		count := int(binary.BigEndian.Uint16(data[2:]))
+		data = data[4:]
+		if len(data) < 4*count {
+			return errShortPacket
+		}
+
+		fields := make([]templateField, count)
+		for i := range fields {
+			key := FieldKey{ID: binary.BigEndian.Uint16(data[4*i:])}
+			fields[i] = lookupField(d.fields, key, binary.BigEndian.Uint16(data[4*i+2:]))
+		}
+		data = data[4*count:]
+
+		if err := d.addV9Template(s, newTemplate(id, fields, false)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readV9OptionsTemplates reads an options template flowset. Scope fields are
+// named after their scope type.
+func (d *Decoder) readV9OptionsTemplates(s *session, data []byte) error {
+	for len(data) >= 6 {
+		id := binary.BigEndian.Uint16(data)
+		scopeLength := int(binary.BigEndian.Uint16(data[2:]))
+		optionLength := int(binary.BigEndian.Uint16(data[4:]))
+		data = data[6:]
+		if scopeLength%4 != 0 || optionLength%4 != 0 {
+			return fmt.Errorf("invalid options template %d", id)
+		}
+		if len(data) < scopeLength+optionLength {
+			return errShortPacket
+		}
+
+		var fields []templateField
+		for i := 0; i < scopeLength+optionLength; i += 4 {
+			typ := binary.BigEndian.Uint16(data[i:])
+			length := binary.BigEndian.Uint16(data[i+2:])
+			if i < scopeLength {
+				name, found := v9ScopeNames[typ]
+				if !found {
+					name = fmt.Sprintf("scope_%d", typ)
+				}
+				fields = append(fields, templateField{name: name, typ: Unsigned64, length: length})
+				continue
+			}
+			fields = append(fields, lookupField(d.fields, FieldKey{ID: typ}, length))
+		}
+		data = data[scopeLength+optionLength:]
+
+		if err := d.addV9Template(s, newTemplate(id, fields, true)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Decoder) addV9Template(s *session, t *template) error {
+	if t.id < v9MinDataSetID {
+		return fmt.Errorf("invalid template id %d", t.id)
+	}
+	for _, f := range t.fields {
+		if f.length == variableLength {
+			return fmt.Errorf("variable length field in NetFlow v9 template %d", t.id)
+		}
+	}
+	d.addTemplate(s, t)
+	return nil
+}
+
+// forEachSet calls fn with the id and the contents of each set, or flowset,
+// of a v9 or IPFIX packet.
+func forEachSet(data []byte, fn func(id uint16, set []byte) error) error {
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return errShortPacket
+		}
+		id := binary.BigEndian.Uint16(data)
+		length := int(binary.BigEndian.Uint16(data[2:]))
+		if length < 4 || length > len(data) {
+			return fmt.Errorf("invalid length %d of set %d", length, id)
+		}
+
+		if err := fn(id, data[4:length]); err != nil {
+			return err
+		}
+		data = data[length:]
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"net"
+	"time"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder"
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/flowhash"
+)
+
+var communityID = flowhash.NewCommunityID(0)
+
+var transportNames = map[uint64]string{
+	uint64(flowhash.ICMP):   "icmp",
+	uint64(flowhash.TCP):    "tcp",
+	uint64(flowhash.UDP):    "udp",
+	uint64(flowhash.ICMPv6): "ipv6-icmp",
+	uint64(flowhash.SCTP):   "sctp",
+}
+
+// createEvent creates the event of a record. The decoded fields are reported
+// under netflow, and the flow records are summarized in the flow fields using
+// the layout of the Packetbeat flow events.
+func createEvent(record *decoder.Record) beat.Event {
+	netflow := common.MapStr{}
+	for k, v := range record.Fields {
+		netflow[k] = v
+	}
+	netflow["type"] = "netflow_" + record.Type.String()
+	netflow["exporter"] = record.Exporter.ToMapStr()
+
+	fields := common.MapStr{
+		"source":  record.Exporter.Address,
+		"netflow": netflow,
+	}
+	if record.Type == decoder.Flow {
+		fields["flow"] = flowFields(record)
+	}
+
+	return beat.Event{
+		Timestamp: record.Exporter.Timestamp,
+		Fields:    fields,
+	}
+}
+
+func flowFields(record *decoder.Record) common.MapStr {
+	f := record.Fields
+	flow := common.MapStr{}
+	source := common.MapStr{}
+	dest := common.MapStr{}
+
+	copyField(source, "ip", f, "source_ipv4_address")
+	copyField(dest, "ip", f, "destination_ipv4_address")
+	copyField(source, "ipv6", f, "source_ipv6_address")
+	copyField(dest, "ipv6", f, "destination_ipv6_address")
+	copyField(source, "port", f, "source_transport_port")
+	copyField(dest, "port", f, "destination_transport_port")
+	copyField(source, "mac", f, "source_mac_address")
+	copyField(dest, "mac", f, "destination_mac_address")
+	copyField(flow, "vlan", f, "vlan_id")
+
+	if proto, ok := f["protocol_identifier"].(uint64); ok {
+		if name, found := transportNames[proto]; found {
+			flow["transport"] = name
+		}
+	}
+
+	// Flow records are unidirectional, the counters are the traffic sent by
+	// the source.
+	stats := common.MapStr{}
+	copyField(stats, "net_bytes_total", f, "octet_delta_count", "octet_total_count")
+	copyField(stats, "net_packets_total", f, "packet_delta_count", "packet_total_count")
+	if len(stats) > 0 {
+		source["stats"] = stats
+	}
+
+	if start, end, ok := flowTimes(record); ok {
+		flow["start_time"] = common.Time(start)
+		flow["last_time"] = common.Time(end)
+	}
+
+	if id := flowCommunityID(f); id != "" {
+		flow["community_id"] = id
+	}
+
+	if len(source) > 0 {
+		flow["source"] = source
+	}
+	if len(dest) > 0 {
+		flow["dest"] = dest
+	}
+	return flow
+}
+
+// copyField copies the first of the given fields found.
+func copyField(to common.MapStr, key string, from common.MapStr, names ...string) {
+	for _, name := range names {
+		if v, found := from[name]; found {
+			to[key] = v
+			return
+		}
+	}
+}
+
+// flowTimes returns the start and end time of a flow. The absolute times are
+// used if exported, otherwise they are computed from the times relative to
+// the exporter uptime or the export time.
+func flowTimes(record *decoder.Record) (start, end time.Time, ok bool) {
+	f := record.Fields
+	for _, unit := range []string{"seconds", "milliseconds", "microseconds", "nanoseconds"} {
+		start, ok1 := f["flow_start_"+unit].(time.Time)
+		end, ok2 := f["flow_end_"+unit].(time.Time)
+		if ok1 && ok2 {
+			return start, end, true
+		}
+	}
+
+	startUptime, ok1 := f["flow_start_sys_up_time"].(uint64)
+	endUptime, ok2 := f["flow_end_sys_up_time"].(uint64)
+	if ok1 && ok2 {
+		boot, found := f["system_init_time_milliseconds"].(time.Time)
+		if !found {
+			boot, found = record.Exporter.BootTime()
+		}
+		if found {
+			return boot.Add(millis(startUptime)), boot.Add(millis(endUptime)), true
+		}
+	}
+
+	startDelta, ok1 := f["flow_start_delta_microseconds"].(uint64)
+	endDelta, ok2 := f["flow_end_delta_microseconds"].(uint64)
+	if ok1 && ok2 {
+		ts := record.Exporter.Timestamp
+		return ts.Add(-time.Duration(startDelta) * time.Microsecond),
+			ts.Add(-time.Duration(endDelta) * time.Microsecond), true
+	}
+
+	return time.Time{}, time.Time{}, false
+}
+
+func millis(ms uint64) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+// flowCommunityID computes the Community ID of a flow record. Exporters not
+// reporting the ICMP type and code separately encode them in the destination
+// port.
+func flowCommunityID(f common.MapStr) string {
+	var flow flowhash.Flow
+
+	proto, ok := f["protocol_identifier"].(uint64)
+	if !ok {
+		return ""
+	}
+	flow.Protocol = uint8(proto)
+
+	if src, ok := f["source_ipv4_address"].(string); ok {
+		dst, _ := f["destination_ipv4_address"].(string)
+		flow.SourceIP, flow.DestinationIP = net.ParseIP(src), net.ParseIP(dst)
+	} else if src, ok := f["source_ipv6_address"].(string); ok {
+		dst, _ := f["destination_ipv6_address"].(string)
+		flow.SourceIP, flow.DestinationIP = net.ParseIP(src), net.ParseIP(dst)
+	}
+
+	srcPort, _ := f["source_transport_port"].(uint64)
+	dstPort, _ := f["destination_transport_port"].(uint64)
+	flow.SourcePort, flow.DestinationPort = uint16(srcPort), uint16(dstPort)
+
+	switch flow.Protocol {
+	case flowhash.ICMP, flowhash.ICMPv6:
+		typeCode := dstPort
+		for _, name := range []string{"icmp_type_code_ipv4", "icmp_type_code_ipv6"} {
+			if v, ok := f[name].(uint64); ok {
+				typeCode = v
+			}
+		}
+		flow.ICMP.Type, flow.ICMP.Code = uint8(typeCode>>8), uint8(typeCode)
+	}
+
+	return communityID.Hash(flow)
+}
@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/input/netflow/decoder"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestCreateEventV5(t *testing.T) {
+	exportTime := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	record := decoder.Record{
+		Type: decoder.Flow,
+		Exporter: decoder.Exporter{
+			Address:   "192.0.2.1:50000",
+			Version:   5,
+			Uptime:    60000,
+			Timestamp: exportTime,
+		},
+		Fields: common.MapStr{
+			"source_ipv4_address":        "10.0.0.1",
+			"destination_ipv4_address":   "10.0.0.2",
+			"source_transport_port":      uint64(40000),
+			"destination_transport_port": uint64(80),
+			"protocol_identifier":        uint64(6),
+			"packet_delta_count":         uint64(10),
+			"octet_delta_count":          uint64(1400),
+			"flow_start_sys_up_time":     uint64(50000),
+			"flow_end_sys_up_time":       uint64(59000),
+		},
+	}
+
+	event := createEvent(&record)
+	assert.Equal(t, exportTime, event.Timestamp)
+	assert.Equal(t, "192.0.2.1:50000", event.Fields["source"])
+
+	netflow := event.Fields["netflow"].(common.MapStr)
+	assert.Equal(t, "netflow_flow", netflow["type"])
+	assert.Equal(t, uint64(1400), netflow["octet_delta_count"])
+	assert.Equal(t, common.MapStr{
+		"address":       "192.0.2.1:50000",
+		"version":       uint16(5),
+		"timestamp":     exportTime,
+		"uptime_millis": uint32(60000),
+	}, netflow["exporter"])
+
+	flow := event.Fields["flow"].(common.MapStr)
+	assert.Equal(t, common.MapStr{
+		"transport":  "tcp",
+		"start_time": common.Time(exportTime.Add(-10 * time.Second)),
+		"last_time":  common.Time(exportTime.Add(-time.Second)),
+		"source": common.MapStr{
+			"ip":   "10.0.0.1",
+			"port": uint64(40000),
+			"stats": common.MapStr{
+				"net_bytes_total":   uint64(1400),
+				"net_packets_total": uint64(10),
+			},
+		},
+		"dest": common.MapStr{
+			"ip":   "10.0.0.2",
+			"port": uint64(80),
+		},
+		"community_id": "1:3k1SoWXs/FltHw2aBCgUTgbDQF4=",
+	}, flow)
+
+	// the decoded fields are not modified
+	assert.NotContains(t, record.Fields, "type")
+}
+
+func TestCreateEventOptions(t *testing.T) {
+	record := decoder.Record{
+		Type:     decoder.Options,
+		Exporter: decoder.Exporter{Version: 10, SourceID: 3},
+		Fields:   common.MapStr{"sampling_interval": uint64(100)},
+	}
+
+	event := createEvent(&record)
+	assert.NotContains(t, event.Fields, "flow")
+	assert.Equal(t, common.MapStr{
+		"type":              "netflow_options",
+		"sampling_interval": uint64(100),
+		"exporter": common.MapStr{
+			"address":   "",
+			"version":   uint16(10),
+			"timestamp": time.Time{},
+			"source_id": uint32(3),
+		},
+	}, event.Fields["netflow"])
+}
+
+func TestFlowTimes(t *testing.T) {
+	exportTime := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	start, end := exportTime.Add(-time.Minute), exportTime.Add(-time.Second)
+
+	tests := []common.MapStr{
+		{"flow_start_milliseconds": start, "flow_end_milliseconds": end},
+		{
+			"system_init_time_milliseconds": exportTime.Add(-time.Hour),
+			"flow_start_sys_up_time":        uint64((time.Hour - time.Minute) / time.Millisecond),
+			"flow_end_sys_up_time":          uint64((time.Hour - time.Second) / time.Millisecond),
+		},
+		{
+			"flow_start_delta_microseconds": uint64(time.Minute / time.Microsecond),
+			"flow_end_delta_microseconds":   uint64(time.Second / time.Microsecond),
+		},
+	}
+
+	for _, fields := range tests {
+		record := decoder.Record{
+			Exporter: decoder.Exporter{Version: 10, Timestamp: exportTime},
+			Fields:   fields,
+		}
+		s, e, ok := flowTimes(&record)
+		assert.True(t, ok, "%v", fields)
+		assert.Equal(t, start, s, "%v", fields)
+		assert.Equal(t, end, e, "%v", fields)
+	}
+
+	// IPFIX exporters don't report their uptime
+	record := decoder.Record{
+		Exporter: decoder.Exporter{Version: 10, Timestamp: exportTime},
+		Fields: common.MapStr{
+			"flow_start_sys_up_time": uint64(1),
+			"flow_end_sys_up_time":   uint64(2),
+		},
+	}
+	_, _, ok := flowTimes(&record)
+	assert.False(t, ok)
+}
+
+func TestFlowCommunityIDICMP(t *testing.T) {
+	// NetFlow v5 encodes the ICMP type and code in the destination port
+	v5 := common.MapStr{
+		"source_ipv4_address":        "192.168.0.89",
+		"destination_ipv4_address":   "192.168.0.1",
+		"protocol_identifier":        uint64(1),
+		"destination_transport_port": uint64(8 << 8),
+	}
+	ipfix := common.MapStr{
+		"source_ipv4_address":      "192.168.0.89",
+		"destination_ipv4_address": "192.168.0.1",
+		"protocol_identifier":      uint64(1),
+		"icmp_type_code_ipv4":      uint64(8 << 8),
+	}
+
+	// value from the Community ID specification test vectors
+	assert.Equal(t, "1:X0snYXpgwiv9TZtqg64sgzUn6Dk=", flowCommunityID(v5))
+	assert.Equal(t, "1:X0snYXpgwiv9TZtqg64sgzUn6Dk=", flowCommunityID(ipfix))
+}
@@ -0,0 +1,140 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package netflow
+
+import (
+	"sync"
+
+	"github.com/elastic/beats/filebeat/channel"
+	"github.com/elastic/beats/filebeat/harvester"
+	"github.com/elastic/beats/filebeat/input"
+	"github.com/elastic/beats/filebeat/input/netflow/decoder"
+	"github.com/elastic/beats/filebeat/inputsource"
+	"github.com/elastic/beats/filebeat/inputsource/udp"
+	"github.com/elastic/beats/filebeat/util"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/paths"
+)
+
+func init() {
+	err := input.Register("netflow", NewInput)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Input collects NetFlow and IPFIX records exported to a UDP port.
+type Input struct {
+	sync.Mutex
+	udp     *udp.Server
+	started bool
+	outlet  channel.Outleter
+	log     *logp.Logger
+}
+
+// NewInput creates a new netflow input
+func NewInput(
+	cfg *common.Config,
+	outlet channel.Connector,
+	context input.Context,
+) (input.Input, error) {
+	cfgwarn.Experimental("NetFlow input type is used")
+
+	log := logp.NewLogger("netflow")
+
+	out, err := outlet(cfg, context.DynamicFields)
+	if err != nil {
+		return nil, err
+	}
+
+	config := defaultConfig
+	if err = cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	definitions := make([]string, len(config.CustomDefinitions))
+	for i, path := range config.CustomDefinitions {
+		definitions[i] = paths.Resolve(paths.Config, path)
+	}
+	fields, err := decoder.LoadFieldDefinitions(definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := decoder.NewDecoder(decoder.Config{
+		Protocols:         config.Protocols,
+		ExpirationTimeout: config.ExpirationTimeout,
+		Fields:            fields,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The UDP server calls back from a single goroutine, so the decoder is
+	// not accessed concurrently.
+	forwarder := harvester.NewForwarder(out)
+	callback := func(data []byte, metadata inputsource.NetworkMetadata) {
+		records, err := dec.Read(data, metadata.RemoteAddr)
+		if err != nil {
+			log.Warnw("Error decoding NetFlow packet", "error", err, "exporter", metadata.RemoteAddr)
+		}
+		for _, record := range records {
+			forwarder.Send(&util.Data{Event: createEvent(&record)})
+		}
+	}
+
+	return &Input{
+		outlet:  out,
+		udp:     udp.New(&config.Config, callback),
+		started: false,
+		log:     log,
+	}, nil
+}
+
+// Run starts listening for NetFlow packets.
+func (p *Input) Run() {
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.started {
+		p.log.Info("Starting NetFlow input")
+		err := p.udp.Start()
+		if err != nil {
+			p.log.Errorw("Error starting the UDP server", "error", err)
+		}
+		p.started = true
+	}
+}
+
+// Stop stops the netflow input.
+func (p *Input) Stop() {
+	defer p.outlet.Close()
+	p.Lock()
+	defer p.Unlock()
+
+	p.log.Info("Stopping NetFlow input")
+	p.udp.Stop()
+	p.started = false
+}
+
+// Wait stops the netflow input.
+func (p *Input) Wait() {
+	p.Stop()
+}
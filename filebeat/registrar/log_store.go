@@ -0,0 +1,407 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/elastic/beats/filebeat/input/file"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+const (
+	opSet    = "set"
+	opRemove = "remove"
+)
+
+var (
+	logEntriesWritten = monitoring.NewInt(nil, "registrar.log.entries")
+	logCheckpoints    = monitoring.NewInt(nil, "registrar.log.checkpoints")
+)
+
+// logStore appends changed and removed states to a log file next to the
+// registry file. Once the log exceeds checkpointSize entries, all states are
+// written to the registry file (the checkpoint) and the log is truncated.
+//
+// The registry file uses the same format as written by the fileStore, such
+// that an existing registry is used as initial checkpoint.
+type logStore struct {
+	registryFile   string
+	logFile        string
+	fileMode       os.FileMode
+	checkpointSize int
+
+	log     *os.File
+	entries int   // number of entries in the log
+	offset  int64 // size of the log up to the last complete entry
+	torn    bool  // log might end with a partial write after offset
+
+	// states as persisted by checkpoint and log. A checkpoint is written on
+	// next write if states is nil.
+	states map[string]file.State
+}
+
+// logEntry is a single line in the log file.
+type logEntry struct {
+	Op    string      `json:"op"`
+	ID    string      `json:"id,omitempty"`
+	State *file.State `json:"state,omitempty"`
+}
+
+func newLogStore(registryFile string, fileMode os.FileMode, checkpointSize int) *logStore {
+	return &logStore{
+		registryFile:   registryFile,
+		logFile:        registryFile + ".log",
+		fileMode:       fileMode,
+		checkpointSize: checkpointSize,
+	}
+}
+
+// Load reads the last checkpoint and applies all log entries. If the last
+// entry in the log is incomplete, e.g. due to a crash while writing, it is
+// dropped and the log is truncated.
+func (s *logStore) Load() ([]file.State, error) {
+	checkpoint, err := readStatesFile(s.registryFile)
+	if err != nil {
+		return nil, err
+	}
+
+	states := newOrderedStates(checkpoint)
+	if err := s.replay(states); err != nil {
+		return nil, err
+	}
+
+	// Continue appending to the log. Writing a checkpoint here would make the
+	// log stale, which is replayed on top of the newer checkpoint if the
+	// truncation of the log does not happen due to a crash.
+	list := states.list()
+	s.states = make(map[string]file.State, len(list))
+	for i := range list {
+		s.states[list[i].ID()] = list[i]
+	}
+	return list, nil
+}
+
+// replay applies all log entries to states and sets the number of entries
+// and the offset of the log.
+func (s *logStore) replay(states *orderedStates) error {
+	f, err := os.Open(s.logFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	logp.Info("Loading registrar log from %s", s.logFile)
+
+	reader := bufio.NewReader(f)
+	s.entries, s.offset = 0, 0
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			if len(line) > 0 {
+				logp.Warn("Registry log %s ends with an incomplete entry. Dropping the entry.", s.logFile)
+				return os.Truncate(s.logFile, s.offset)
+			}
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		var entry logEntry
+		if err := json.Unmarshal(line, &entry); err != nil || !entry.valid() {
+			logp.Err("Registry log %s contains an invalid entry at offset %v. Dropping all following entries.", s.logFile, s.offset)
+			return os.Truncate(s.logFile, s.offset)
+		}
+
+		switch entry.Op {
+		case opSet:
+			states.set(*entry.State)
+		case opRemove:
+			states.remove(entry.ID)
+		}
+		s.offset += int64(len(line))
+		s.entries++
+	}
+
+	logp.Info("Registry log entries applied: %v", s.entries)
+	return nil
+}
+
+// Write appends all states changed or removed since the last write to the
+// log. A checkpoint is written if the log grows too big.
+func (s *logStore) Write(states []file.State) error {
+	if s.states == nil {
+		return s.checkpoint(states)
+	}
+
+	entries := s.diff(states)
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := s.append(entries); err != nil {
+		// The log might contain a partial write, which is dropped before the
+		// next append. The entries are computed and written again on next
+		// write, as states is only updated after a successful append.
+		if err := s.dropTorn(); err != nil {
+			logp.Err("Failed to truncate registry log %s: %v", s.logFile, err)
+		}
+		return err
+	}
+	s.apply(entries)
+
+	// The log is complete at this point, so it can be safely replayed on top
+	// of the checkpoint if the checkpoint is written but truncating the log
+	// fails.
+	if s.entries > s.checkpointSize {
+		return s.checkpoint(states)
+	}
+	return nil
+}
+
+// Close compacts the log into the registry file and removes the log.
+func (s *logStore) Close() error {
+	if s.states != nil && s.entries > 0 {
+		states := make([]file.State, 0, len(s.states))
+		for _, state := range s.states {
+			states = append(states, state)
+		}
+		if err := s.checkpoint(states); err != nil {
+			return err
+		}
+	}
+
+	if s.log != nil {
+		if err := s.log.Close(); err != nil {
+			return err
+		}
+		s.log = nil
+	}
+
+	if s.states != nil {
+		if err := os.Remove(s.logFile); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// diff computes the log entries required to update the persisted states to
+// the given states.
+func (s *logStore) diff(states []file.State) []logEntry {
+	var entries []logEntry
+
+	current := make(map[string]struct{}, len(states))
+	for i := range states {
+		state := &states[i]
+		id := state.ID()
+		current[id] = struct{}{}
+
+		if old, exists := s.states[id]; exists && stateEqual(&old, state) {
+			continue
+		}
+		entries = append(entries, logEntry{Op: opSet, State: state})
+	}
+
+	for id := range s.states {
+		if _, exists := current[id]; !exists {
+			entries = append(entries, logEntry{Op: opRemove, ID: id})
+		}
+	}
+
+	return entries
+}
+
+// apply updates the persisted states with the entries appended to the log.
+func (s *logStore) apply(entries []logEntry) {
+	for _, entry := range entries {
+		switch entry.Op {
+		case opSet:
+			s.states[entry.State.ID()] = *entry.State
+		case opRemove:
+			delete(s.states, entry.ID)
+		}
+	}
+}
+
+func (s *logStore) append(entries []logEntry) error {
+	if err := s.openLog(); err != nil {
+		return err
+	}
+	if err := s.dropTorn(); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for i := range entries {
+		if err := encoder.Encode(&entries[i]); err != nil {
+			return err
+		}
+	}
+
+	s.torn = true
+	if _, err := s.log.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	if err := s.log.Sync(); err != nil {
+		return err
+	}
+	s.torn = false
+
+	s.offset += int64(buf.Len())
+	s.entries += len(entries)
+	logEntriesWritten.Add(int64(len(entries)))
+	logp.Debug("registrar", "Registry log updated. %d entries written.", len(entries))
+	return nil
+}
+
+// checkpoint writes all states to the registry file and truncates the log.
+// The log must be complete and up to date with states, so it can be replayed
+// on top of the new checkpoint if truncating the log fails.
+func (s *logStore) checkpoint(states []file.State) error {
+	if err := writeStatesFile(s.registryFile, s.fileMode, states); err != nil {
+		return err
+	}
+
+	s.states = make(map[string]file.State, len(states))
+	for i := range states {
+		s.states[states[i].ID()] = states[i]
+	}
+
+	if err := s.openLog(); err != nil {
+		return err
+	}
+	if err := s.log.Truncate(0); err != nil {
+		return err
+	}
+	if err := s.log.Sync(); err != nil {
+		return err
+	}
+
+	s.entries, s.offset, s.torn = 0, 0, false
+
+	logCheckpoints.Inc()
+	logp.Debug("registrar", "Registry checkpoint written. %d states written.", len(states))
+	return nil
+}
+
+// dropTorn truncates a partial write from the end of the log.
+func (s *logStore) dropTorn() error {
+	if !s.torn {
+		return nil
+	}
+	if err := s.log.Truncate(s.offset); err != nil {
+		return err
+	}
+	s.torn = false
+	return nil
+}
+
+func (s *logStore) openLog() error {
+	if s.log != nil {
+		return nil
+	}
+
+	f, err := os.OpenFile(s.logFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, s.fileMode)
+	if err != nil {
+		return err
+	}
+	s.log = f
+	return nil
+}
+
+func (e *logEntry) valid() bool {
+	switch e.Op {
+	case opSet:
+		return e.State != nil
+	case opRemove:
+		return e.ID != ""
+	default:
+		return false
+	}
+}
+
+// stateEqual checks if the persisted fields of two states are equal.
+func stateEqual(a, b *file.State) bool {
+	if a.Source != b.Source ||
+		a.Offset != b.Offset ||
+		!a.Timestamp.Equal(b.Timestamp) ||
+		a.TTL != b.TTL ||
+		a.Type != b.Type ||
+		a.FileStateOS != b.FileStateOS ||
+		a.Fingerprint != b.Fingerprint ||
+		len(a.Meta) != len(b.Meta) {
+		return false
+	}
+
+	for k, v := range a.Meta {
+		if other, exists := b.Meta[k]; !exists || other != v {
+			return false
+		}
+	}
+	return true
+}
+
+// orderedStates collects states by ID, keeping the order states have been
+// added in.
+type orderedStates struct {
+	ids    []string
+	states map[string]file.State
+}
+
+func newOrderedStates(states []file.State) *orderedStates {
+	o := &orderedStates{states: map[string]file.State{}}
+	for _, state := range states {
+		o.set(state)
+	}
+	return o
+}
+
+func (o *orderedStates) set(state file.State) {
+	id := state.ID()
+	if _, exists := o.states[id]; !exists {
+		o.ids = append(o.ids, id)
+	}
+	o.states[id] = state
+}
+
+func (o *orderedStates) remove(id string) {
+	delete(o.states, id)
+}
+
+func (o *orderedStates) list() []file.State {
+	list := make([]file.State, 0, len(o.states))
+	for _, id := range o.ids {
+		if state, exists := o.states[id]; exists {
+			list = append(list, state)
+			// drop id, in case the state was removed and set again
+			delete(o.states, id)
+		}
+	}
+	return list
+}
@@ -0,0 +1,265 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package registrar
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/filebeat/input/file"
+)
+
+func makeState(id string, offset int64) file.State {
+	return file.State{
+		Source:    "/var/log/" + id + ".log",
+		Offset:    offset,
+		Timestamp: time.Unix(1500000000, 0).UTC(),
+		TTL:       -1,
+		Type:      "log",
+		// the meta data makes the state ID unique, independent of the OS
+		Meta: map[string]string{"id": id},
+	}
+}
+
+func offsets(states []file.State) map[string]int64 {
+	m := map[string]int64{}
+	for _, state := range states {
+		m[state.Meta["id"]] = state.Offset
+	}
+	return m
+}
+
+func setup(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "registrar")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return filepath.Join(dir, "registry"), func() { os.RemoveAll(dir) }
+}
+
+func countLines(t *testing.T, path string) int {
+	content, err := ioutil.ReadFile(path)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	n := 0
+	for _, b := range content {
+		if b == '\n' {
+			n++
+		}
+	}
+	return n
+}
+
+func TestLogStoreAppendsChanges(t *testing.T) {
+	registryFile, cleanup := setup(t)
+	defer cleanup()
+
+	store := newLogStore(registryFile, 0600, 100)
+	states, err := store.Load()
+	if !assert.NoError(t, err) || !assert.Len(t, states, 0) {
+		t.FailNow()
+	}
+
+	a, b := makeState("a", 10), makeState("b", 20)
+	if !assert.NoError(t, store.Write([]file.State{a, b})) {
+		t.FailNow()
+	}
+	assert.Equal(t, 2, countLines(t, registryFile+".log"))
+
+	// only changed and removed states are appended
+	a.Offset = 15
+	assert.NoError(t, store.Write([]file.State{a, b}))
+	assert.NoError(t, store.Write([]file.State{a}))
+	assert.Equal(t, 4, countLines(t, registryFile+".log"))
+
+	// unchanged states are not written
+	assert.NoError(t, store.Write([]file.State{a}))
+	assert.Equal(t, 4, countLines(t, registryFile+".log"))
+
+	// a new store instance reads checkpoint and log
+	states, err = newLogStore(registryFile, 0600, 100).Load()
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int64{"a": 15}, offsets(states))
+	}
+}
+
+func TestLogStoreCheckpoint(t *testing.T) {
+	registryFile, cleanup := setup(t)
+	defer cleanup()
+
+	store := newLogStore(registryFile, 0600, 2)
+	store.Load()
+
+	// writes are appended until the log exceeds the checkpoint size
+	a := makeState("a", 0)
+	for i := int64(1); i <= 3; i++ {
+		a.Offset = i
+		assert.NoError(t, store.Write([]file.State{a}))
+	}
+
+	assert.Equal(t, 0, countLines(t, registryFile+".log"))
+	states, err := readStatesFile(registryFile)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int64{"a": 3}, offsets(states))
+	}
+}
+
+func TestLogStoreCrashAfterCheckpoint(t *testing.T) {
+	registryFile, cleanup := setup(t)
+	defer cleanup()
+
+	store := newLogStore(registryFile, 0600, 100)
+	store.Load()
+	a, b := makeState("a", 10), makeState("b", 20)
+	assert.NoError(t, store.Write([]file.State{a, b}))
+	assert.NoError(t, store.Write([]file.State{a}))
+
+	// a restarted store continues the log, such that the log stays up to
+	// date with the states written by a checkpoint
+	store = newLogStore(registryFile, 0600, 100)
+	states, err := store.Load()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, map[string]int64{"a": 10}, offsets(states))
+
+	// simulate a crash after writing the registry file of a checkpoint, but
+	// before truncating the log
+	logFile := registryFile + ".log"
+	log, err := ioutil.ReadFile(logFile)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	a.Offset, b.Offset = 30, 5
+	assert.NoError(t, store.Write([]file.State{a, b}))
+	if countLines(t, logFile) == 0 {
+		assert.NoError(t, ioutil.WriteFile(logFile, log, 0600))
+	}
+
+	states, err = newLogStore(registryFile, 0600, 100).Load()
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int64{"a": 30, "b": 5}, offsets(states))
+	}
+}
+
+func TestLogStoreFailedAppend(t *testing.T) {
+	registryFile, cleanup := setup(t)
+	defer cleanup()
+
+	store := newLogStore(registryFile, 0600, 100)
+	store.Load()
+	a := makeState("a", 10)
+	assert.NoError(t, store.Write([]file.State{a}))
+
+	// simulate an append failing after a partial write
+	logFile := registryFile + ".log"
+	f, err := os.OpenFile(logFile, os.O_WRONLY|os.O_APPEND, 0600)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	f.WriteString(`{"op":"set","state":{"source":"/var/log/a.log","off`)
+	f.Close()
+	store.torn = true
+
+	// the partial write is dropped before the next entries are appended
+	a.Offset = 20
+	assert.NoError(t, store.Write([]file.State{a}))
+	assert.Equal(t, 2, countLines(t, logFile))
+
+	states, err := newLogStore(registryFile, 0600, 100).Load()
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int64{"a": 20}, offsets(states))
+	}
+}
+
+func TestLogStoreTornWrite(t *testing.T) {
+	registryFile, cleanup := setup(t)
+	defer cleanup()
+
+	store := newLogStore(registryFile, 0600, 100)
+	store.Load()
+
+	a := makeState("a", 10)
+	assert.NoError(t, store.Write([]file.State{a}))
+	a.Offset = 20
+	assert.NoError(t, store.Write([]file.State{a}))
+
+	// simulate a crash while appending the next entry
+	logFile := registryFile + ".log"
+	f, err := os.OpenFile(logFile, os.O_WRONLY|os.O_APPEND, 0600)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	info, _ := f.Stat()
+	validSize := info.Size()
+	f.WriteString(`{"op":"set","state":{"source":"/var/log/a.log","off`)
+	f.Close()
+
+	states, err := newLogStore(registryFile, 0600, 100).Load()
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int64{"a": 20}, offsets(states))
+	}
+
+	info, err = os.Stat(logFile)
+	if assert.NoError(t, err) {
+		assert.Equal(t, validSize, info.Size())
+	}
+}
+
+func TestLogStoreMigratesRegistryFile(t *testing.T) {
+	registryFile, cleanup := setup(t)
+	defer cleanup()
+
+	fs := &fileStore{registryFile: registryFile, fileMode: 0600}
+	assert.NoError(t, fs.Write([]file.State{makeState("a", 10), makeState("b", 20)}))
+
+	states, err := newLogStore(registryFile, 0600, 100).Load()
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int64{"a": 10, "b": 20}, offsets(states))
+	}
+}
+
+func TestLogStoreClose(t *testing.T) {
+	registryFile, cleanup := setup(t)
+	defer cleanup()
+
+	store := newLogStore(registryFile, 0600, 100)
+	store.Load()
+	assert.NoError(t, store.Write([]file.State{makeState("a", 10)}))
+	assert.NoError(t, store.Write([]file.State{makeState("a", 20)}))
+	assert.NoError(t, store.Close())
+
+	// the log is compacted into the registry file, which can be read by the
+	// file store again
+	_, err := os.Stat(registryFile + ".log")
+	assert.True(t, os.IsNotExist(err))
+
+	states, err := (&fileStore{registryFile: registryFile}).Load()
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]int64{"a": 20}, offsets(states))
+	}
+}
@@ -18,7 +18,6 @@
 package registrar
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -26,7 +25,6 @@ import (
 	"time"
 
 	"github.com/elastic/beats/filebeat/input/file"
-	helper "github.com/elastic/beats/libbeat/common/file"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/monitoring"
 	"github.com/elastic/beats/libbeat/paths"
@@ -38,9 +36,11 @@ type Registrar struct {
 	done         chan struct{}
 	registryFile string      // Path to the Registry File
 	fileMode     os.FileMode // Permissions to apply on the Registry File
+	store        registryStore
 	wg           sync.WaitGroup
 
 	states               *file.States // Map with all file paths inside and the corresponding state
+	initRequired         bool         // initRequired is set if no registry file exists yet
 	gcRequired           bool         // gcRequired is set if registry state needs to be gc'ed before the next write
 	gcEnabled            bool         // gcEnabled indictes the registry contains some state that can be gc'ed in the future
 	flushTimeout         time.Duration
@@ -62,7 +62,13 @@ var (
 
 // New creates a new Registrar instance, updating the registry file on
 // `file.State` updates. New fails if the file can not be opened or created.
-func New(registryFile string, fileMode os.FileMode, flushTimeout time.Duration, out successLogger) (*Registrar, error) {
+func New(
+	registryFile string,
+	fileMode os.FileMode,
+	flushTimeout time.Duration,
+	storeSettings StoreSettings,
+	out successLogger,
+) (*Registrar, error) {
 	r := &Registrar{
 		registryFile: registryFile,
 		fileMode:     fileMode,
@@ -74,7 +80,19 @@ func New(registryFile string, fileMode os.FileMode, flushTimeout time.Duration,
 		wg:           sync.WaitGroup{},
 	}
 	err := r.Init()
+	if err != nil {
+		return r, err
+	}
+
+	r.store, err = newStore(storeSettings, r.registryFile, r.fileMode)
+	if err != nil {
+		return r, err
+	}
 
+	// No registry exists yet, write empty state to check if registry can be written
+	if r.initRequired {
+		err = r.writeRegistry()
+	}
 	return r, err
 }
 
@@ -94,8 +112,8 @@ func (r *Registrar) Init() error {
 	fileInfo, err := os.Lstat(r.registryFile)
 	if os.IsNotExist(err) {
 		logp.Info("No registry file found under: %s. Creating a new registry file.", r.registryFile)
-		// No registry exists yet, write empty state to check if registry can be written
-		return r.writeRegistry()
+		r.initRequired = true
+		return nil
 	}
 	if err != nil {
 		return err
@@ -123,22 +141,11 @@ func (r *Registrar) GetStates() []file.State {
 // loadStates fetches the previous reading state from the configure RegistryFile file
 // The default file is `registry` in the data path.
 func (r *Registrar) loadStates() error {
-	f, err := os.Open(r.registryFile)
+	states, err := r.store.Load()
 	if err != nil {
 		return err
 	}
 
-	defer f.Close()
-
-	logp.Info("Loading registrar data from %s", r.registryFile)
-
-	decoder := json.NewDecoder(f)
-	states := []file.State{}
-	err = decoder.Decode(&states)
-	if err != nil {
-		return fmt.Errorf("Error decoding states: %s", err)
-	}
-
 	states = resetStates(states)
 	r.states.SetStates(states)
 	logp.Info("States Loaded from registrar: %+v", len(states))
@@ -176,6 +183,9 @@ func (r *Registrar) Run() {
 	// Writes registry on shutdown
 	defer func() {
 		r.writeRegistry()
+		if err := r.store.Close(); err != nil {
+			logp.Err("Closing registry store returned error: %v", err)
+		}
 		r.wg.Done()
 	}()
 
@@ -286,48 +296,13 @@ func (r *Registrar) writeRegistry() error {
 
 	registryWrites.Inc()
 
-	tempfile, err := writeTmpFile(r.registryFile, r.fileMode, states)
-	if err != nil {
-		registryFails.Inc()
-		return err
-	}
-
-	err = helper.SafeFileRotate(r.registryFile, tempfile)
-	if err != nil {
+	if err := r.store.Write(states); err != nil {
 		registryFails.Inc()
 		return err
 	}
 
-	logp.Debug("registrar", "Registry file updated. %d states written.", len(states))
+	logp.Debug("registrar", "Registry updated. %d states.", len(states))
 	registrySuccess.Inc()
 
 	return nil
 }
-
-func writeTmpFile(baseName string, perm os.FileMode, states []file.State) (string, error) {
-	logp.Debug("registrar", "Write registry file: %s", baseName)
-
-	tempfile := baseName + ".new"
-	f, err := os.OpenFile(tempfile, os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_SYNC, perm)
-	if err != nil {
-		logp.Err("Failed to create tempfile (%s) for writing: %s", tempfile, err)
-		return "", err
-	}
-
-	defer f.Close()
-
-	encoder := json.NewEncoder(f)
-
-	if err := encoder.Encode(states); err != nil {
-		logp.Err("Error when encoding the states: %s", err)
-		return "", err
-	}
-
-	// Commit the changes to storage to avoid corrupt registry files
-	if err = f.Sync(); err != nil {
-		logp.Err("Error when syncing new registry file contents: %s", err)
-		return "", err
-	}
-
-	return tempfile, nil
-}
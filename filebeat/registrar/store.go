@@ -0,0 +1,140 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package registrar
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/elastic/beats/filebeat/input/file"
+	helper "github.com/elastic/beats/libbeat/common/file"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// StoreSettings configures the backend persisting the registry.
+type StoreSettings struct {
+	// Type of the store. The "file" store rewrites the registry file on every
+	// flush. The "log" store appends changed states to a log file, which is
+	// compacted into the registry file from time to time.
+	Type string
+
+	// CheckpointSize is the number of log entries after which the log store
+	// compacts the log into the registry file.
+	CheckpointSize int
+}
+
+// registryStore persists the registrar states.
+type registryStore interface {
+	// Load reads all states from the registry. No states are returned if
+	// the registry does not exist yet.
+	Load() ([]file.State, error)
+
+	// Write persists the complete list of current states.
+	Write(states []file.State) error
+
+	// Close releases all resources held by the store.
+	Close() error
+}
+
+func newStore(settings StoreSettings, registryFile string, fileMode os.FileMode) (registryStore, error) {
+	switch settings.Type {
+	case "", "file":
+		return &fileStore{registryFile: registryFile, fileMode: fileMode}, nil
+	case "log":
+		return newLogStore(registryFile, fileMode, settings.CheckpointSize), nil
+	default:
+		return nil, fmt.Errorf("unknown registry store type '%v'", settings.Type)
+	}
+}
+
+// fileStore writes all states to the registry file on every write.
+type fileStore struct {
+	registryFile string
+	fileMode     os.FileMode
+}
+
+func (s *fileStore) Load() ([]file.State, error) {
+	return readStatesFile(s.registryFile)
+}
+
+func (s *fileStore) Write(states []file.State) error {
+	return writeStatesFile(s.registryFile, s.fileMode, states)
+}
+
+func (s *fileStore) Close() error {
+	return nil
+}
+
+// readStatesFile reads all states from a JSON registry file. No states are
+// returned if the file does not exist.
+func readStatesFile(path string) ([]file.State, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []file.State{}, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	logp.Info("Loading registrar data from %s", path)
+
+	states := []file.State{}
+	if err := json.NewDecoder(f).Decode(&states); err != nil {
+		return nil, fmt.Errorf("Error decoding states: %s", err)
+	}
+	return states, nil
+}
+
+// writeStatesFile replaces the JSON registry file with the given states.
+func writeStatesFile(path string, perm os.FileMode, states []file.State) error {
+	tempfile, err := writeTmpFile(path, perm, states)
+	if err != nil {
+		return err
+	}
+	return helper.SafeFileRotate(path, tempfile)
+}
+
+func writeTmpFile(baseName string, perm os.FileMode, states []file.State) (string, error) {
+	logp.Debug("registrar", "Write registry file: %s", baseName)
+
+	tempfile := baseName + ".new"
+	f, err := os.OpenFile(tempfile, os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_SYNC, perm)
+	if err != nil {
+		logp.Err("Failed to create tempfile (%s) for writing: %s", tempfile, err)
+		return "", err
+	}
+
+	defer f.Close()
+
+	encoder := json.NewEncoder(f)
+
+	if err := encoder.Encode(states); err != nil {
+		logp.Err("Error when encoding the states: %s", err)
+		return "", err
+	}
+
+	// Commit the changes to storage to avoid corrupt registry files
+	if err = f.Sync(); err != nil {
+		logp.Err("Error when syncing new registry file contents: %s", err)
+		return "", err
+	}
+
+	return tempfile, nil
+}
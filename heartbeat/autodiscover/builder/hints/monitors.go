@@ -0,0 +1,213 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package hints
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/autodiscover"
+	"github.com/elastic/beats/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/libbeat/autodiscover/template"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddBuilder("hints", NewMonitorHints)
+}
+
+const (
+	monitorType = "type"
+	name        = "name"
+	hosts       = "hosts"
+	schedule    = "schedule"
+	timeout     = "timeout"
+	ssl         = "ssl"
+)
+
+type monitorHints struct {
+	Key             string
+	DefaultSchedule string
+}
+
+// NewMonitorHints builds a new monitor builder based on hints
+func NewMonitorHints(cfg *common.Config) (autodiscover.Builder, error) {
+	cfgwarn.Beta("The hints builder is beta")
+	config := defaultConfig()
+	err := cfg.Unpack(&config)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to unpack hints config due to error: %v", err)
+	}
+
+	return &monitorHints{config.Key, config.DefaultSchedule}, nil
+}
+
+// Create configs based on hints passed from providers
+func (m *monitorHints) CreateConfig(event bus.Event) []*common.Config {
+	var config []*common.Config
+	host, _ := event["host"].(string)
+	if host == "" {
+		return config
+	}
+
+	port, _ := common.TryToInt(event["port"])
+
+	hints, ok := event["hints"].(common.MapStr)
+	if !ok {
+		return config
+	}
+
+	if builder.IsNoOp(hints, m.Key) {
+		return config
+	}
+
+	monitorsConfig := m.getMonitors(hints)
+	if monitorsConfig != nil {
+		configs := []*common.Config{}
+		for _, cfg := range monitorsConfig {
+			if config, err := common.NewConfigFrom(cfg); err == nil {
+				configs = append(configs, config)
+			}
+		}
+		logp.Debug("hints.builder", "generated config %+v", configs)
+		// Apply information in event to the template to generate the final config
+		return template.ApplyConfigTemplate(event, configs)
+	}
+
+	typ := m.getType(hints)
+	if typ == "" {
+		return config
+	}
+
+	hsts := m.getHostsWithPort(hints, typ, port)
+	if len(hsts) == 0 {
+		return config
+	}
+
+	monitorConfig := common.MapStr{
+		"type":        typ,
+		hostsKey(typ): hsts,
+		"schedule":    m.getSchedule(hints),
+		"enabled":     true,
+	}
+
+	if n := m.getName(hints); n != "" {
+		monitorConfig["name"] = n
+	}
+	if tout := m.getTimeout(hints); tout != "" {
+		monitorConfig["timeout"] = tout
+	}
+	if sslConf := m.getSSLConfig(hints); len(sslConf) > 0 {
+		monitorConfig["ssl"] = sslConf
+	}
+	if procs := m.getProcessors(hints); len(procs) > 0 {
+		monitorConfig["processors"] = procs
+	}
+
+	logp.Debug("hints.builder", "generated config: %v", monitorConfig.String())
+
+	// Create config object
+	cfg, err := common.NewConfigFrom(monitorConfig)
+	if err != nil {
+		logp.Debug("hints.builder", "config merge failed with error: %v", err)
+		return config
+	}
+	config = append(config, cfg)
+
+	// Apply information in event to the template to generate the final config
+	// This especially helps in a scenario where endpoints are configured as:
+	// co.elastic.monitor/hosts= "${data.host}:8080"
+	return template.ApplyConfigTemplate(event, config)
+}
+
+// hostsKey returns the setting used by a monitor type to configure the
+// endpoints to check.
+func hostsKey(typ string) string {
+	if typ == "http" {
+		return "urls"
+	}
+	return hosts
+}
+
+func (m *monitorHints) getType(hints common.MapStr) string {
+	return builder.GetHintString(hints, m.Key, monitorType)
+}
+
+func (m *monitorHints) getName(hints common.MapStr) string {
+	return builder.GetHintString(hints, m.Key, name)
+}
+
+// getHostsWithPort returns the hosts configured via hints. If no hosts are
+// configured, the host and port of the event are used.
+func (m *monitorHints) getHostsWithPort(hints common.MapStr, typ string, port int) []string {
+	thosts := builder.GetHintAsList(hints, m.Key, hosts)
+	if len(thosts) == 0 {
+		return []string{defaultHost(typ, port)}
+	}
+
+	// Only pick hosts that have ${data.port} or the port on current event. This will make
+	// sure that incorrect meta mapping doesn't happen
+	var result []string
+	for _, h := range thosts {
+		if strings.Contains(h, "data.port") || strings.Contains(h, fmt.Sprintf(":%d", port)) ||
+			// Use the event that has no port config if there is a ${data.host}:9090 like input
+			(port == 0 && strings.Contains(h, "data.host")) {
+			result = append(result, h)
+		}
+	}
+
+	return result
+}
+
+func defaultHost(typ string, port int) string {
+	host := "${data.host}"
+	if port != 0 && typ != "icmp" {
+		host += ":${data.port}"
+	}
+	if typ == "http" {
+		host = "http://" + host
+	}
+	return host
+}
+
+func (m *monitorHints) getSchedule(hints common.MapStr) string {
+	if sched := builder.GetHintString(hints, m.Key, schedule); sched != "" {
+		return sched
+	}
+	return m.DefaultSchedule
+}
+
+func (m *monitorHints) getTimeout(hints common.MapStr) string {
+	return builder.GetHintString(hints, m.Key, timeout)
+}
+
+func (m *monitorHints) getSSLConfig(hints common.MapStr) common.MapStr {
+	return builder.GetHintMapStr(hints, m.Key, ssl)
+}
+
+func (m *monitorHints) getMonitors(hints common.MapStr) []common.MapStr {
+	return builder.GetHintAsConfigs(hints, m.Key)
+}
+
+func (m *monitorHints) getProcessors(hints common.MapStr) []common.MapStr {
+	return builder.GetProcessors(hints, m.Key)
+}
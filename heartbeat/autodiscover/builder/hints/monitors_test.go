@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package hints
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+)
+
+func TestGenerateHints(t *testing.T) {
+	tests := []struct {
+		message string
+		event   bus.Event
+		len     int
+		result  common.MapStr
+	}{
+		{
+			message: "Empty event hints should return empty config",
+			event: bus.Event{
+				"host": "1.2.3.4",
+				"docker": common.MapStr{
+					"container": common.MapStr{
+						"name": "foobar",
+						"id":   "abc",
+					},
+				},
+			},
+			len:    0,
+			result: common.MapStr{},
+		},
+		{
+			message: "Hints without host should return nothing",
+			event: bus.Event{
+				"hints": common.MapStr{
+					"monitor": common.MapStr{
+						"type": "tcp",
+					},
+				},
+			},
+			len:    0,
+			result: common.MapStr{},
+		},
+		{
+			message: "Only type hint should use event host and port",
+			event: bus.Event{
+				"host": "1.2.3.4",
+				"port": 6379,
+				"hints": common.MapStr{
+					"monitor": common.MapStr{
+						"type": "tcp",
+					},
+				},
+			},
+			len: 1,
+			result: common.MapStr{
+				"type":     "tcp",
+				"hosts":    []interface{}{"1.2.3.4:6379"},
+				"schedule": "@every 5s",
+				"enabled":  true,
+			},
+		},
+		{
+			message: "http monitor uses urls",
+			event: bus.Event{
+				"host": "1.2.3.4",
+				"port": 8080,
+				"hints": common.MapStr{
+					"monitor": common.MapStr{
+						"type":     "http",
+						"hosts":    "http://${data.host}:${data.port}/health",
+						"schedule": "@every 10s",
+						"timeout":  "3s",
+					},
+				},
+			},
+			len: 1,
+			result: common.MapStr{
+				"type":     "http",
+				"urls":     []interface{}{"http://1.2.3.4:8080/health"},
+				"schedule": "@every 10s",
+				"timeout":  "3s",
+				"enabled":  true,
+			},
+		},
+		{
+			message: "Hosts for another port should return nothing",
+			event: bus.Event{
+				"host": "1.2.3.4",
+				"port": 9090,
+				"hints": common.MapStr{
+					"monitor": common.MapStr{
+						"type":  "tcp",
+						"hosts": "${data.host}:6379",
+					},
+				},
+			},
+			len:    0,
+			result: common.MapStr{},
+		},
+		{
+			message: "Disabled hints should return nothing",
+			event: bus.Event{
+				"host": "1.2.3.4",
+				"port": 6379,
+				"hints": common.MapStr{
+					"monitor": common.MapStr{
+						"type":    "tcp",
+						"disable": "true",
+					},
+				},
+			},
+			len:    0,
+			result: common.MapStr{},
+		},
+		{
+			message: "Raw config is used as is",
+			event: bus.Event{
+				"host": "1.2.3.4",
+				"port": 53,
+				"hints": common.MapStr{
+					"monitor": common.MapStr{
+						"raw": `{"type": "dns", "hosts": ["${data.host}"], "query_name": "example.com", "schedule": "@every 1m"}`,
+					},
+				},
+			},
+			len: 1,
+			result: common.MapStr{
+				"type":       "dns",
+				"hosts":      []interface{}{"1.2.3.4"},
+				"query_name": "example.com",
+				"schedule":   "@every 1m",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		m := monitorHints{
+			Key:             defaultConfig().Key,
+			DefaultSchedule: defaultConfig().DefaultSchedule,
+		}
+		cfgs := m.CreateConfig(test.event)
+		assert.Equal(t, test.len, len(cfgs), test.message)
+
+		if len(cfgs) != 0 {
+			config := common.MapStr{}
+			err := cfgs[0].Unpack(&config)
+			assert.Nil(t, err, test.message)
+			assert.Equal(t, test.result, config, test.message)
+		}
+	}
+}
@@ -18,13 +18,16 @@
 package beater
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/elastic/beats/libbeat/autodiscover"
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
 
+	hbautodiscover "github.com/elastic/beats/heartbeat/autodiscover"
 	"github.com/elastic/beats/heartbeat/config"
 	"github.com/elastic/beats/heartbeat/monitors"
 	"github.com/elastic/beats/heartbeat/scheduler"
@@ -33,10 +36,16 @@ import (
 type Heartbeat struct {
 	done chan struct{}
 
-	scheduler *scheduler.Scheduler
-	manager   *monitorManager
+	scheduler    *scheduler.Scheduler
+	runOnce      *runOnce
+	manager      *monitorManager
+	autodiscover *autodiscover.Autodiscover
 }
 
+// runOnceFlushTimeout is the maximum time to wait for events being published
+// in run_once mode.
+const runOnceFlushTimeout = 30 * time.Second
+
 func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 	logp.Warn("Beta: Heartbeat is beta software")
 
@@ -55,21 +64,51 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 		return nil, err
 	}
 
-	sched := scheduler.NewWithLocation(limit, location)
-	manager, err := newMonitorManager(b.Publisher, sched, monitors.Registry, config.Monitors)
+	if len(config.Monitors) == 0 && config.Autodiscover == nil {
+		return nil, errors.New("no monitor configured")
+	}
+
+	bt := &Heartbeat{
+		done: make(chan struct{}),
+	}
+
+	var jobs jobControl
+	if config.RunOnce {
+		if config.Autodiscover != nil {
+			return nil, errors.New("autodiscover is not supported with run_once")
+		}
+		bt.runOnce = newRunOnce()
+		jobs = bt.runOnce
+	} else {
+		bt.scheduler = scheduler.NewWithLocation(limit, location)
+		jobs = bt.scheduler
+	}
+
+	factory := newMonitorFactory(monitors.Registry, jobs, newStateTracking(config.State))
+	if config.RunOnce {
+		factory.waitClose = runOnceFlushTimeout
+	}
+
+	bt.manager, err = newMonitorManager(b.Publisher, factory, config.Monitors)
 	if err != nil {
 		return nil, err
 	}
 
-	bt := &Heartbeat{
-		done:      make(chan struct{}),
-		scheduler: sched,
-		manager:   manager,
+	if config.Autodiscover != nil {
+		adapter := hbautodiscover.NewAutodiscoverAdapter(factory)
+		bt.autodiscover, err = autodiscover.NewAutodiscover("heartbeat", b.Publisher, adapter, config.Autodiscover)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return bt, nil
 }
 
 func (bt *Heartbeat) Run(b *beat.Beat) error {
+	if bt.runOnce != nil {
+		return bt.runAllOnce()
+	}
+
 	logp.Info("heartbeat is running! Hit CTRL-C to stop it.")
 
 	if err := bt.scheduler.Start(); err != nil {
@@ -77,14 +116,33 @@ func (bt *Heartbeat) Run(b *beat.Beat) error {
 	}
 	defer bt.scheduler.Stop()
 
+	if bt.autodiscover != nil {
+		bt.autodiscover.Start()
+	}
+
 	<-bt.done
 
+	if bt.autodiscover != nil {
+		bt.autodiscover.Stop()
+	}
 	bt.manager.Stop()
 
 	logp.Info("Shutting down.")
 	return nil
 }
 
+// runAllOnce executes every monitor once and waits for the events being
+// published.
+func (bt *Heartbeat) runAllOnce() error {
+	logp.Info("heartbeat is running all monitors once.")
+
+	bt.runOnce.Run()
+	bt.manager.Stop()
+
+	logp.Info("All monitors did run. Shutting down.")
+	return nil
+}
+
 func (bt *Heartbeat) Stop() {
 	close(bt.done)
 }
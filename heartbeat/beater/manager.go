@@ -22,9 +22,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/cfgfile"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/processors"
@@ -36,22 +38,39 @@ import (
 )
 
 type monitorManager struct {
-	monitors   []monitor
+	monitors []*monitor
+}
+
+// monitorFactory creates monitors from configuration. The factory
+// implements cfgfile.RunnerFactory, so monitors can be created and removed by
+// autodiscover.
+type monitorFactory struct {
+	registry   *monitors.Registrar
 	jobControl jobControl
+	states     *stateTracking
+
+	// time to wait for published events being ACKed when closing a monitor
+	waitClose time.Duration
 }
 
 type monitor struct {
-	manager *monitorManager
-	watcher watcher.Watch
+	jobControl jobControl
+	states     *stateTracking
+	watcher    watcher.Watch
 
 	name       string
 	uniqueName string
 	factory    monitors.Factory
 	config     *common.Config
+	watchPath  string
+	watchPoll  time.Duration
 
+	mutex  sync.Mutex
 	active map[string]monitorTask
 
-	pipeline beat.Pipeline
+	pipeline  beat.Pipeline
+	meta      *common.MapStrPointer
+	waitClose time.Duration
 }
 
 type monitorTask struct {
@@ -79,105 +98,166 @@ type jobCanceller func() error
 
 var defaultFilePollInterval = 5 * time.Second
 
+type watchConfig struct {
+	Path string        `config:"watch.poll_file.path"`
+	Poll time.Duration `config:"watch.poll_file.interval" validate:"min=1"`
+}
+
 func newMonitorManager(
 	pipeline beat.Pipeline,
-	jobControl jobControl,
-	registry *monitors.Registrar,
+	factory *monitorFactory,
 	configs []*common.Config,
 ) (*monitorManager, error) {
-	type watchConfig struct {
-		Path string        `config:"watch.poll_file.path"`
-		Poll time.Duration `config:"watch.poll_file.interval" validate:"min=1"`
+	m := &monitorManager{}
+
+	// check monitors exist
+	for _, config := range configs {
+		monitor, err := factory.newMonitor(pipeline, config, nil)
+		if err != nil {
+			return nil, err
+		}
+		if monitor != nil {
+			m.monitors = append(m.monitors, monitor)
+		}
 	}
-	defaultWatchConfig := watchConfig{
-		Poll: defaultFilePollInterval,
+
+	// load initial monitors and start monitor resource watchers if configured
+	for _, monitor := range m.monitors {
+		monitor.Start()
+	}
+
+	return m, nil
+}
+
+func (m *monitorManager) Stop() {
+	for _, m := range m.monitors {
+		m.Stop()
 	}
+}
 
-	m := &monitorManager{
+func newMonitorFactory(
+	registry *monitors.Registrar,
+	jobControl jobControl,
+	states *stateTracking,
+) *monitorFactory {
+	return &monitorFactory{
+		registry:   registry,
 		jobControl: jobControl,
+		states:     states,
 	}
+}
 
-	if len(configs) == 0 {
-		return nil, errors.New("no monitor configured")
+// Create creates a new monitor from the given configuration. The monitor
+// tasks are scheduled once the monitor is started.
+func (f *monitorFactory) Create(
+	pipeline beat.Pipeline,
+	config *common.Config,
+	meta *common.MapStrPointer,
+) (cfgfile.Runner, error) {
+	monitor, err := f.newMonitor(pipeline, config, meta)
+	if err != nil {
+		return nil, err
+	}
+	if monitor == nil {
+		return nil, errors.New("monitor is disabled")
 	}
+	return monitor, nil
+}
 
-	// check monitors exist
-	for _, config := range configs {
-		plugin := struct {
-			Type    string `config:"type" validate:"required"`
-			Enabled bool   `config:"enabled"`
-		}{
-			Enabled: true,
-		}
+// CheckConfig checks if the configuration can be used to create a monitor.
+func (f *monitorFactory) CheckConfig(config *common.Config) error {
+	monitor, err := f.newMonitor(nil, config, nil)
+	if err != nil || monitor == nil {
+		return err
+	}
 
-		if err := config.Unpack(&plugin); err != nil {
-			return nil, err
-		}
+	_, err = monitor.factory(config)
+	return err
+}
 
-		if !plugin.Enabled {
-			continue
-		}
+// newMonitor creates a monitor for the given configuration. No monitor is
+// returned if the monitor is disabled.
+func (f *monitorFactory) newMonitor(
+	pipeline beat.Pipeline,
+	config *common.Config,
+	meta *common.MapStrPointer,
+) (*monitor, error) {
+	plugin := struct {
+		Type    string `config:"type" validate:"required"`
+		Enabled bool   `config:"enabled"`
+	}{
+		Enabled: true,
+	}
 
-		info, found := registry.Query(plugin.Type)
-		if !found {
-			return nil, fmt.Errorf("Monitor type '%v' does not exist", plugin.Type)
-		}
-		logp.Info("Select (%v) monitor %v", info.Type, info.Name)
+	if err := config.Unpack(&plugin); err != nil {
+		return nil, err
+	}
 
-		factory := registry.GetFactory(plugin.Type)
-		if factory == nil {
-			return nil, fmt.Errorf("Found non-runnable monitor %v", plugin.Type)
-		}
+	if !plugin.Enabled {
+		return nil, nil
+	}
 
-		m.monitors = append(m.monitors, monitor{
-			manager:  m,
-			name:     info.Name,
-			factory:  factory,
-			config:   config,
-			active:   map[string]monitorTask{},
-			pipeline: pipeline,
-		})
+	info, found := f.registry.Query(plugin.Type)
+	if !found {
+		return nil, fmt.Errorf("Monitor type '%v' does not exist", plugin.Type)
 	}
+	logp.Info("Select (%v) monitor %v", info.Type, info.Name)
 
-	// load watcher configs
-	watchConfigs := make([]watchConfig, len(m.monitors))
-	for i, monitor := range m.monitors {
-		watchConfigs[i] = defaultWatchConfig
-		if err := monitor.config.Unpack(&watchConfigs[i]); err != nil {
-			return nil, err
-		}
+	factory := f.registry.GetFactory(plugin.Type)
+	if factory == nil {
+		return nil, fmt.Errorf("Found non-runnable monitor %v", plugin.Type)
 	}
 
-	// load initial monitors
-	for _, monitor := range m.monitors {
-		err := monitor.Update([]*common.Config{monitor.config})
-		if err != nil {
-			logp.Err("failed to load monitor tasks: %v", err)
-		}
+	// load watcher config
+	watch := watchConfig{
+		Poll: defaultFilePollInterval,
+	}
+	if err := config.Unpack(&watch); err != nil {
+		return nil, err
 	}
 
-	// start monitor resource watchers if configured (will drop registered monitoring tasks and install new one if resource is available)
-	for i := range m.monitors {
-		monitor := &m.monitors[i]
-		path := watchConfigs[i].Path
-		if path == "" {
-			continue
-		}
+	return &monitor{
+		jobControl: f.jobControl,
+		states:     f.states,
+		name:       info.Name,
+		factory:    factory,
+		config:     config,
+		watchPath:  watch.Path,
+		watchPoll:  watch.Poll,
+		active:     map[string]monitorTask{},
+		pipeline:   pipeline,
+		meta:       meta,
+		waitClose:  f.waitClose,
+	}, nil
+}
 
-		poll := watchConfigs[i].Poll
-		monitor.watcher, _ = watcher.NewFilePoller(path, poll, createWatchUpdater(monitor))
+// Start schedules the monitor tasks and starts the monitor resource watcher
+// if configured (will drop registered monitoring tasks and install new one if
+// resource is available).
+func (m *monitor) Start() {
+	err := m.Update([]*common.Config{m.config})
+	if err != nil {
+		logp.Err("failed to load monitor tasks: %v", err)
 	}
 
-	return m, nil
+	if m.watchPath != "" {
+		m.watcher, _ = watcher.NewFilePoller(m.watchPath, m.watchPoll, createWatchUpdater(m))
+	}
 }
 
-func (m *monitorManager) Stop() {
-	for _, m := range m.monitors {
-		m.Close()
+// Stop stops the resource watcher and removes all monitor tasks from the
+// scheduler.
+func (m *monitor) Stop() {
+	if m.watcher != nil {
+		m.watcher.Stop()
 	}
+	m.Close()
 }
 
 func (m *monitor) Update(configs []*common.Config) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	all := map[string]monitorTask{}
 	for i, upd := range configs {
 		config, err := common.MergeConfigs(m.config, upd)
@@ -224,14 +304,16 @@ func (m *monitor) Update(configs []*common.Config) error {
 		client, err := m.pipeline.ConnectWith(beat.ClientConfig{
 			EventMetadata: t.config.EventMetadata,
 			Processor:     processors,
+			DynamicFields: m.meta,
+			WaitClose:     m.waitClose,
 		})
 		if err != nil {
 			logp.Critical("Fail to connect job '%v' to publisher pipeline: %v", id, err)
 			continue
 		}
 
-		job := t.createJob(client)
-		jobCancel := m.manager.jobControl.Add(t.config.Schedule, id, job)
+		job := t.createJob(client, m.states)
+		jobCancel := m.jobControl.Add(t.config.Schedule, id, job)
 		t.cancel = func() error {
 			client.Close()
 			return jobCancel()
@@ -244,9 +326,14 @@ func (m *monitor) Update(configs []*common.Config) error {
 }
 
 func (m *monitor) Close() {
-	for _, mt := range m.active {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for id, mt := range m.active {
 		mt.cancel()
+		m.states.remove(id)
 	}
+	m.active = map[string]monitorTask{}
 }
 
 func createWatchUpdater(monitor *monitor) func(content []byte) {
@@ -282,7 +369,7 @@ func createWatchUpdater(monitor *monitor) func(content []byte) {
 	}
 }
 
-func (m *monitorTask) createJob(client beat.Client) scheduler.TaskFunc {
+func (m *monitorTask) createJob(client beat.Client, states *stateTracking) scheduler.TaskFunc {
 	name := m.config.Name
 	if name == "" {
 		name = m.config.Type
@@ -294,10 +381,15 @@ func (m *monitorTask) createJob(client beat.Client) scheduler.TaskFunc {
 			"type": m.config.Type,
 		},
 	}
-	return m.prepareSchedulerJob(client, meta, m.job.Run)
+	return m.prepareSchedulerJob(client, states, meta, m.job.Run)
 }
 
-func (m *monitorTask) prepareSchedulerJob(client beat.Client, meta common.MapStr, run monitors.JobRunner) scheduler.TaskFunc {
+func (m *monitorTask) prepareSchedulerJob(
+	client beat.Client,
+	states *stateTracking,
+	meta common.MapStr,
+	run monitors.JobRunner,
+) scheduler.TaskFunc {
 	return func() []scheduler.TaskFunc {
 		event, next, err := run()
 		if err != nil {
@@ -306,7 +398,7 @@ func (m *monitorTask) prepareSchedulerJob(client beat.Client, meta common.MapStr
 
 		if event.Fields != nil {
 			event.Fields.DeepUpdate(meta)
-			client.Publish(event)
+			client.PublishAll(states.apply(event))
 		}
 
 		if len(next) == 0 {
@@ -315,7 +407,7 @@ func (m *monitorTask) prepareSchedulerJob(client beat.Client, meta common.MapStr
 
 		cont := make([]scheduler.TaskFunc, len(next))
 		for i, n := range next {
-			cont[i] = m.prepareSchedulerJob(client, meta, n)
+			cont[i] = m.prepareSchedulerJob(client, states, meta, n)
 		}
 		return cont
 	}
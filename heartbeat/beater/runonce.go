@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"sync"
+
+	"github.com/elastic/beats/heartbeat/scheduler"
+)
+
+// runOnce is a jobControl executing every task including its continuations
+// exactly once, instead of scheduling the tasks.
+type runOnce struct {
+	mutex sync.Mutex
+	tasks map[string]scheduler.TaskFunc
+}
+
+func newRunOnce() *runOnce {
+	return &runOnce{tasks: map[string]scheduler.TaskFunc{}}
+}
+
+// Add registers a task to be executed by Run. The schedule is ignored.
+func (r *runOnce) Add(_ scheduler.Schedule, name string, task scheduler.TaskFunc) func() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.tasks[name] = task
+	return func() error {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		delete(r.tasks, name)
+		return nil
+	}
+}
+
+// Run executes all registered tasks concurrently and waits for the tasks and
+// their continuations to finish.
+func (r *runOnce) Run() {
+	r.mutex.Lock()
+	tasks := make([]scheduler.TaskFunc, 0, len(r.tasks))
+	for _, task := range r.tasks {
+		tasks = append(tasks, task)
+	}
+	r.mutex.Unlock()
+
+	runAll(tasks)
+}
+
+func runAll(tasks []scheduler.TaskFunc) {
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func(task scheduler.TaskFunc) {
+			defer wg.Done()
+			runAll(task())
+		}(task)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/elastic/beats/heartbeat/config"
+	"github.com/elastic/beats/heartbeat/state"
+)
+
+// stateTracking annotates check events with the monitor state and creates
+// transition events if the status of a monitor changes.
+type stateTracking struct {
+	tracker     *state.Tracker
+	transitions bool
+}
+
+func newStateTracking(config config.State) *stateTracking {
+	if !config.Enabled {
+		return nil
+	}
+
+	return &stateTracking{
+		tracker:     state.NewTracker(config.Flapping.Window, config.Flapping.Threshold),
+		transitions: config.TransitionEvents,
+	}
+}
+
+// apply updates the state of the monitor reporting the event. It returns
+// the events to be published.
+func (s *stateTracking) apply(event beat.Event) []beat.Event {
+	if s == nil {
+		return []beat.Event{event}
+	}
+
+	status, _ := event.Fields.GetValue("monitor.status")
+	id, _ := event.Fields.GetValue("monitor.id")
+	ip, _ := event.Fields.GetValue("monitor.ip")
+
+	statusStr, ok := status.(string)
+	if !ok {
+		return []beat.Event{event}
+	}
+	idStr, _ := id.(string)
+	ipStr, _ := ip.(string)
+
+	st, trans := s.tracker.Update(idStr, ipStr, statusStr, event.Timestamp)
+	stateFields := st.Fields()
+	event.Fields.Put("monitor.state", stateFields)
+
+	events := []beat.Event{event}
+	if trans != nil && s.transitions {
+		monitor, _ := event.Fields.GetValue("monitor")
+		fields, _ := monitor.(common.MapStr)
+
+		// Transition events do not report a status, so they are not counted
+		// as check results. The status is reported by the transition fields.
+		fields = fields.Clone()
+		fields.Delete("status")
+		events = append(events, beat.Event{
+			Timestamp: event.Timestamp,
+			Fields: common.MapStr{
+				"monitor":    fields,
+				"transition": trans.Fields(),
+			},
+		})
+	}
+	return events
+}
+
+// remove drops the state of a monitor.
+func (s *stateTracking) remove(id string) {
+	if s != nil {
+		s.tracker.Remove(id)
+	}
+}
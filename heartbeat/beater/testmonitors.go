@@ -0,0 +1,130 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/elastic/beats/heartbeat/config"
+	"github.com/elastic/beats/heartbeat/monitors"
+)
+
+// TestMonitors runs every configured monitor once and prints the resulting
+// events to w. An error is returned if a monitor can not be created or if
+// any check reports the monitored service being down.
+func TestMonitors(cfg *common.Config, w io.Writer) error {
+	config := config.DefaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return fmt.Errorf("Error reading config file: %v", err)
+	}
+
+	if len(config.Monitors) == 0 {
+		return errors.New("no monitor configured")
+	}
+
+	once := newRunOnce()
+	factory := newMonitorFactory(monitors.Registry, once, nil)
+	for _, monitorConfig := range config.Monitors {
+		if err := factory.CheckConfig(monitorConfig); err != nil {
+			return err
+		}
+	}
+
+	pipeline := &printPipeline{w: w}
+	manager, err := newMonitorManager(pipeline, factory, config.Monitors)
+	if err != nil {
+		return err
+	}
+
+	once.Run()
+	manager.Stop()
+
+	if pipeline.failed > 0 {
+		return fmt.Errorf("%v of %v checks failed", pipeline.failed, pipeline.total)
+	}
+	return nil
+}
+
+// printPipeline is a beat.Pipeline printing all published events. It counts
+// the events reporting the monitored service being down.
+type printPipeline struct {
+	mutex sync.Mutex
+	w     io.Writer
+
+	total, failed int
+}
+
+type printClient struct {
+	pipeline   *printPipeline
+	processors beat.ProcessorList
+}
+
+func (p *printPipeline) Connect() (beat.Client, error) {
+	return p.ConnectWith(beat.ClientConfig{})
+}
+
+func (p *printPipeline) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
+	return &printClient{pipeline: p, processors: cfg.Processor}, nil
+}
+
+func (p *printPipeline) SetACKHandler(beat.PipelineACKHandler) error {
+	return nil
+}
+
+func (p *printPipeline) print(event beat.Event) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.total++
+	if status, _ := event.Fields.GetValue("monitor.status"); status != "up" {
+		p.failed++
+	}
+
+	fields := event.Fields.Clone()
+	fields["@timestamp"] = common.Time(event.Timestamp)
+	fmt.Fprintln(p.w, fields.StringToPrint())
+}
+
+func (c *printClient) Publish(event beat.Event) {
+	if c.processors != nil {
+		for _, p := range c.processors.All() {
+			out, err := p.Run(&event)
+			if err != nil || out == nil {
+				return
+			}
+			event = *out
+		}
+	}
+	c.pipeline.print(event)
+}
+
+func (c *printClient) PublishAll(events []beat.Event) {
+	for _, event := range events {
+		c.Publish(event)
+	}
+}
+
+func (c *printClient) Close() error {
+	return nil
+}
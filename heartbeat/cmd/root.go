@@ -22,6 +22,7 @@ import (
 	_ "github.com/elastic/beats/heartbeat/monitors/defaults"
 
 	"github.com/elastic/beats/heartbeat/beater"
+	"github.com/elastic/beats/heartbeat/cmd/test"
 	cmd "github.com/elastic/beats/libbeat/cmd"
 )
 
@@ -30,3 +31,7 @@ var Name = "heartbeat"
 
 // RootCmd to handle beats cli
 var RootCmd = cmd.GenRootCmd(Name, "", beater.New)
+
+func init() {
+	RootCmd.TestCmd.AddCommand(test.GenTestMonitorsCmd(Name, ""))
+}
@@ -0,0 +1,62 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/libbeat/cmd/instance"
+
+	"github.com/elastic/beats/heartbeat/beater"
+)
+
+// GenTestMonitorsCmd creates the `test monitors` command, running every
+// configured monitor once.
+func GenTestMonitorsCmd(name, beatVersion string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "monitors",
+		Short: "Run all configured monitors once and print the resulting events",
+		Run: func(cmd *cobra.Command, args []string) {
+			b, err := instance.NewBeat(name, "", beatVersion)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+
+			err = b.Init()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+
+			cfg, err := b.BeatConfig()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading config file: %s\n", err)
+				os.Exit(1)
+			}
+
+			if err := beater.TestMonitors(cfg, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error testing monitors: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+}
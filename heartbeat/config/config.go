@@ -20,12 +20,20 @@
 
 package config
 
-import "github.com/elastic/beats/libbeat/common"
+import (
+	"github.com/elastic/beats/libbeat/autodiscover"
+	"github.com/elastic/beats/libbeat/common"
+)
 
 type Config struct {
 	// Modules is a list of module specific configuration data.
-	Monitors  []*common.Config `config:"monitors"         validate:"required"`
-	Scheduler Scheduler        `config:"scheduler"`
+	Monitors     []*common.Config     `config:"monitors"`
+	Scheduler    Scheduler            `config:"scheduler"`
+	Autodiscover *autodiscover.Config `config:"autodiscover"`
+	State        State                `config:"state"`
+
+	// run every monitor once, publish the events and exit
+	RunOnce bool `config:"run_once"`
 }
 
 type Scheduler struct {
@@ -33,4 +41,28 @@ type Scheduler struct {
 	Location string `config:"location"`
 }
 
-var DefaultConfig = Config{}
+// State configures tracking of the monitor status between checks.
+type State struct {
+	Enabled          bool     `config:"enabled"`
+	TransitionEvents bool     `config:"transition_events"`
+	Flapping         Flapping `config:"flapping"`
+}
+
+// Flapping configures when a monitor is considered flapping. A monitor is
+// flapping if its status changed at least Threshold times within the last
+// Window checks.
+type Flapping struct {
+	Window    int `config:"window"    validate:"min=2"`
+	Threshold int `config:"threshold" validate:"min=1"`
+}
+
+var DefaultConfig = Config{
+	State: State{
+		Enabled:          true,
+		TransitionEvents: false,
+		Flapping: Flapping{
+			Window:    10,
+			Threshold: 4,
+		},
+	},
+}
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzsW91v47gRf89fMdinFnCE3m53UeSh6DZ37Rl3exfsps8OTY4tXihSR47i+NA/vuCHvizJH7H3mgL1y0YSNTOc+fE3M6T26hoecXsDS2R0BUCSFN7A3+OVQMetLEkafQN/vQIAuDWamNQOuCkKo8N7sJKohAP2xKRiS4UgNTClAJ9QE9C2RJddQRp2cxUEXYNmBUbFmf8z3B3V6X/3OYYXwKyAcgwWgkMtpF6HG8qsoUDn2BpdBvPOqPCadI0oh+QN9M+50Su5rizz6mAlFc78ff+QETwxVfk3oXIogkxJ/lIb6goLr0BuHCVNafy9Cap6dsz8s3DrwV8+NHJMmPG0XdnQabXGw45rbGMOLFJlNQpYboMqU6JXo9fgto6wAKNhk0uet4Z3fGcrraVej1hDssDfjD7Cmnrk17TmCa2TRh82Jg2sYRXgHIK/Ru1NQQGUSxehnPWh++ZvfiqOWFG+SUI91m9AMKr9YPHXSloUN0C2qm+ujC0Y9cbhMytKv/Q+VuvKEbz9QDm8/dM3H2bwzdubd+9v3r/L3r17e5x3g0mwiUDGtAz9ArHIjRWwYa6d386kiK3dfi0f7VKSZXYbxkZvceapIOC9RBsDxbQIF2SZdoxTG4/opx3FkR16fjTLX5DXay1eLOKTR9xujBX7DW24qnJo2zXlCSoq27EArTW2Z8Damqrcr+Q7/1LNgDxq9PhlQkg/limQemX8yubMBf4KelxWgyGxYi2wtiaRWXO/tonwmTo3J8xqTUtysoECbsRQujJ6fYp0L2Qo2ssaiO7H7CjpESYpRXFlKtHmqFt/CaU1T1KgnyYxwYiNp61P6SmsrCmipOZV52PVUhATYhEGLGqRfiRH54ydzGJ+aBbeymqxuwsb+YHV+1MnvfUtzODOOCc9cENOcsAseoEzWHOcgbEg5FoSU4Yj09mkbVI7YprjQh5YOvM0EObf1ib5JAIF47nUu0t3TMPhzNTo6Ob147SkAYsOzho/09usQCGrYr/2T1FEgNhpylOZI5Wk7aKT8hoLKneNzNH1N/wAkXYEQciIss120kVzpGvT3B7IBW5sotqYkp5cPx8PvfSKt+WfxqwVxpU2rd3i+mCq/RzGHJpfWujC8MewftJK/7a+HhEen4EjRp5+lULuc3ZY5vGZX7MuN5YWMQPcwIop54PGNM+NrfVdN6v8qk/K9ZQbs2A0P0zxeMoJaDMpzuPEf2n5a4WtQJBijNUbdcVY+jhJYxcXQVxdnSYDfCGxrKQiMHqfKR0yeKElt41OL2ufLsWWqNxAW6+WgP31xAFb5sETUU8DWg/mFrLfx6sRIXNfDHSA6rPcgHpabPr7B5GZdJ+Gy/Nj8n1qK4bRuBDSI0GMgJxZnktCTpW9wBx64uAPmK0zeP7Lh8WHP8+A2WIGZclnUMjS/XFoinFZqRj5kv48S37+ArWgZANHTcbNoFpWmqoZbKQWZjNhRL/jebkNSc6ojhUrpNqerSKKSZO0KHJGMxC4lEzPYGURl07sm60sByb0bu3R/qN05AltfnfNhLDoHLqhgoLx8yZZq8mZFRtmsVU2g8pVTKktfPp427Wh5pHHaolWI6Fr2eSH7r0Rte3zpgzu17StUOhyyf602L50kIB6RsNJNFQacYH00PFAaUTktlFV1bnUtKPJyxulVlcyfrlJtRKHynwHdlEPeokTLjw2uR6nKEqDgpVDTUxrQ2H/62LqOiLHdV6yYOno5b3aZZ/aC5Rso3qj3LqPDju3Lbu8uY1buTkyS2EDrDBakrFvdthmYvGn0ZMrf3J3JmhNb/d3ZKbp4vydhfscG6WdbSi4aGnUUeKanSecIqZzOamnbVUpBb+YpW/dWdyL9mmgie7IfEXaaR5Y0Q3jwIZ7Q0zVesM2PDoak7Uby67qyvVuT2xDDXR/W++NSw2F5NY45EYLN5yb4zmeG82PMU1DZVWSl8E/jK3bbHggXj7M4IGU8//kRP6SaRH/dg8jPu/U7C+0qi6/faHh0D5JjrBEH4gUExQZ3MaN2UI6J/V6BrIdK/uub17yaJnfjZh8Rt01v9tr5bxrVd+S+uxi1pMXTlBk+RCxVTOdC/ctOqOeUIAsIRVYTZvFK2tRU5A6MkNHjHqIHN2+f2G85lpIzjztyFXDQNxUSsATU1Iwiu117QkyPnLNoVpbIqYJdhg8dDHKmMeqPJK0WxlwCml3FDWMHZ9MEfbvg/NLg7XFTaXbJn0tn1BPYcfScJ57+bPhsBpkPuIpLsC0NyNsY+WDNvt3ptQEO2f4o3vfQd2Xn29/+PLedxTP2yNh18g4CXVdRWBRhWO5vgum4HdyVHYy649fQLEtWrABCWRlGY/Yjo0GN1r3i9VpQw4YEwySBfYAg47YUkmXA6t1+SA+SVa7zQ/SojRS71oBsGS+PDC6c3bfEUKm5/ps5/WxacM+IMI+MA4mPw3IGpGkOk3yGx8r1Nxu41F6CNuRsCQ13bhOnbW2yOgB8hAf/tcBmTMtXM4e8atBciW1x6M3tVHWQZqyyMS2gziNtDH2cSC4ReKrQV692UtUdtPv/f3diU1TkjDu+Knk69WchrbKqgHajj9A/pKyra976z4iTbMbkaJSJBfDmDSQZ5urYSCGNcABpN13aqMxi+A+l87Xjwy00ddMM7X9rfZU/EwhfpuzqtQunowFtl5bXMftgbH0jq402g1rwBMWb+3PWhaUzLICCe3RqzdWqIuds/vWGqkJ180p1VF+Bfhc2xOlTxzqn8lcAb3nUVddJr+Muf49mHezzpdIG0QNK2kdwXJLodhM6+3Xyve1seLcWEmE2vd2A2lNVOPQtGcaMZos9yhtle4w4kDggCF7jDgY/pMhD4BVqyx9rAZevDdpacQWjAWj1RYYlBZX8nkWDmNHKNH/dFUs0YIwGCWtKqV8CVZadOHbvtz3KcRUCCRoRIFDz6QwmWBI/M7KiNdTTowpq6G28JZeHG+mG6R++c9E2DjodYQj3BN/0Y3/RwJ8RST4JY+LRAMvQsJeHHS/YeWmKBUS9phnhDGGTDFVU73GGmpMWY3wRY5MDNLXC93cL0xrju863BGztBsF7/wRco9pwK/NTpYIDXqKVo/9fTzayO3pu/7XI8eNJtSUvWzX4XAzYZGsxCcUzacnnm1q0yDZlo0bFwjp4uzdNS9l+QY43a9dM/ji8eVgIykfiAvf1GhJkim4v73r9t2MCIuSMvhOi/g2sBWhbfl8IE1IATxH/thLGK85N7wWVKeWTvKi29LNbz/dHdnKpTfhlFZufgel9/WRewaRfIYHocNqf9/HXDFKcgV+cvAdz83nJDjw3yX2NBvJ8LlDmJ+x9HjoV/1H1vyX3s2st454N9p+/Z20X8RPjrhXUVP7S/aNSmOHsTgp/nX36SWlJXuJkO/sT92e2+RdeMN0lLW7m6Y73HtCV9aeE7wWLvsKXfMej7ZdjL9yhGXrPXyWLvw/nr57X4uj/hMAAP//xuOAGw=="
+	return "eJzsXf9v47aS/11/BbE/vQJe4bp7LQ6Lh+Jy2d3X4DXZXOIe+ptDS2ObjUxqSSqJi/vjD8MvEiVR8tcW2XvGBmhtSfP5cGY4HA4pOnlLHmHzgcyB6oQQzXQBH8h/2U85qEyyUjPBP5CfEkIIuRRcU8YVycR6Lbh5jiwYFLki9Imygs4LIIwTWhQEnoBrojclqDQh7rYPiRH0lnC6Bguc4v+ab6OY+DddgXmAiAXRKzAMiQKeM740XxRiSdagFF2CSslVcJd5jKlalAKNBPF6JviCLStJsYlkwQqY4HN4kWryRIsKCFOkUpAbmUzjRy50KMw8QlZCaYfk7p8KA9XiMcFr5v4HvPmhliNMi4d5pX2lecTtiqu5UUUk6EpyyMl8Y3iIErD5fEnURmlYE8HJ84plq4Z4oDtZcc74MsJGszX8IfgObPydfyabJ5CKCb6djLvRuxU+bI2/BI6KgZzoFVPWldO26775T2yK0nRdvnFC0dc/kJxqrwcJXysmIf9AtKz8lwsh11S37oMXui6x611Uy0pp8u5HvSLv/u37Hyfk+3cf3v/w4Yf36fv377Y3qKZEnq0jg+uG2EEkZELm5Jmqpn2dRmm6VOMoF3LOtKRyY+612soohgLj7yVIayjKc/NBS8oVzXRjD2JiQgfYRgd3B17/QMT8d8h8X7MfZvbKI2yehczHidaxqlIgmz6FAcqCdRiAlEK6py3MUoqqHAf5hA85eYiB0RFjEs1zhvfSgjC+ENizM6oAHc3gmIhISBMVvUDPxgWz+nvPScNLE34GaTXUnJy0B5CJvC+9EHy5j3QU0heNsoKbYzbbSTo+mPohKitElTdj1CV+JKUUTywHbKamOdU0Pmxdu6tkIcWaZK1HFaF53oQgmuczc8PMi0SQDJQScnAUw1tT81TqxXY7NmRbeu9NMLy1GabkVijF0HHNmKQIlUAgezchywwmREiSsyXTtBAZUJ4OcmNcacozmLEtXefK3UiuPnpKOIiQNc1WjMMOCNtHphojHNd3Q3E3zAI/q/Ws36VryFm1Hke/tiJMp9oP3KU5rGB6MwuGvJpBpd4CVfrt99k4hYtAEEFBhDWjHVMmpcB0oh7mhhiVUpjYyPIuFXfl7cs4k9D13CPI5R9CLAuwPW0YXcJy61B7Z+7Z1j7X0XORPYJsevpH/zki3F4jSlONOWlRQKYht93cXsM+q1ZC6pkdAT6QBS0Uug3l2UpIj/e27uVBJw+bXNOKjw/hI+FjbkwAmbL8uJj4K2dfK2gEEpanY3BrujwyCod+YcT57NQRwERiXrFCE8HHqATB4EAmbiwHafxvDKugcyhUD62VS2zJJ7ZwuTKasDi102JnbVz2Z/spIuQKk4HAUYWMhJ7GN1HsVs902Pv55fE2+dlNK/rWOJGnY7uiTk5ltmIaMl3JE7ShJY78DdJlSl7+48fZj/8+IVSuJ6QsswlZs1J916ciVFoWVGNKfxyTL/fEC3IcMuBaqAmp5hXX1YQ8M56L5wES7RnP4RycnCjGgq5ZsTkawopxjZSQr6iekBzmjPIJWUiAucrHWsvKHgVW7ob+C1MaA9rV7Vua5xKUAtUHWNOsh7BXIz3Misr8mUpowLAAUNGi2JDri8uQg48jj9UcJAcNqokm/wy/i8A21+s0uJ3TNkKbXHbrsNg8tDUANbfuHYZKkZ9geAg0UIrciE6iUBXLT4qE8npACKdKmp2uUY3EPhjOwE6qQS5yGFDhroPrbkBWGlnTso9EORfa1L9OBheIjGOeMmEJcGuxA0ptYE+QskVxrVwXYWzltokuby7NF2QFVGpTAFsLzrSQbzrRZqDzu7sHe/4AWYfqnnaS02RLuDi+soBFMg8alKFCkOOtH4CouvIEQ4GJ5SdEW1RFQX4Xc5y6U1u7xmGgtm6kvbmrNAdC+2bscZgKTQuPiyUvDUrHZHVtGUJXYe8eLEP1sD86FJxRrlkmhYJM8Fz126ayFRxrzQs7TJNKFk5eSj4L6afZ5EFn5cOEPOhC4X9WWuNHrH+a/1cPEZ0HOfuR6TcmGgrkE8uwfo2GcDbBRYtLW5hdM6UYX04Ia+4NCun4Vz+E3nJ1myanzLuubkdZXoWs2kz82sWkJQ+TmgdWPtg44SOdMlMpCUoUT5ATVhKXYNXTrKySEtekUGqkhVhGaHlktHx/oL2ueM4yimGHLXwbSSaqIseVJoaLAYaj14QWaLl6UW2ALuzXaduU7lGAn+A3ageel4JxPSFa0uwRDQH6GYCTbAXZo0p37NyK8VYOFF0h2YEm/k3rdQ1Hd8Gk0pYQkVAKqf1SoDeytWaaRLlF4tSwCnv8kI1tnkH0CiMrqgjTqkfBCxnW2WhQHA2MBwTHEMxoUCU7gm2x0k21noNEj8oEV5BVmj2BgzjESouCliXrsbD05kIUQPl+DO9x9dd6kPUPkq0oX5qaIXytgOtiQ56ZXrml2IJ6L/McPTezrsUCH+r7zgCXz6bPoJYaGXaVTk1IWc0LplaQd2iKBaFNH61l1a5nm6FSMu3KJLkgXGgfJb2QtO2cXaesTSDFkZWNWwlPTFQqaEnYadKkh6nFcYg38Lw7WOnoJduiwAggVvVwTReVTuei0q6U3mp4moyHgBOGprDJZEXzbXSGKP0LRCWzfm1DEwzoyc+cXGYRTJ2wBEoKIR6rcsfZUiMjbtOBpgRATmyaxM321yaYp84Sm4St4k11fMmegA8lbVL32xlqdNgTfXZHtPC2JZQjDbN+5FPPsKN0tT3aQXbz1+GO4dxOrXUZ+Nz99fSWKFC4wWVHp3MS4voZMEgIs6PLLSWAbo/WO5ij7QP/cDJwc5f29Uv0PpCELrTdyMEh0/XGn+2G6aX1Yx1iCz/8u5VCi0wUpIAnKDojjVdCmkS59Ld1DG7t2IFJra16l0fSBVSaSq0LdYxR7qcXd9PpL/dm1x/luZlHAse9fvmrNEKXcEQvGS3t2juDo3RzGcghNH8CqZmruTSua3YP1qTcFkGzcbAlKxuQJbjrBpmpIvkegMmGBJdXv1JLtJrUt0bIq2BKn46VX4MJCZxi9GgjtaKkNIOWlqy02/B2NUgkbg6TGR4/Kq5Z0YpBZqefhAzYU9s9hhiFrHouMjqk7TGsxcBCKx2phlHHe8UqiATqI5rfjYCmHIkhfEV5rlb0EV6NMlyaw3BNpklzrq4v9k1znIS41gY6cQjjhKZJXCEj3TVupHOac05zzmnOOc359tOcVpQ8pznnNOec5hya5pSifB+kObdfbt/vmeY4CXGtDXTiEMYJTZO4Qka6a9xI5zTnnOac05xzmvPtpzmtKHlOc85pzjnNOTTNWbTWrD5Pb/dMchb7r1gFIE5kmsSVMdJV4wY6pzjnFOec4pxTnG8/xfk8PRdyzoWccyHn8EKOS3CUyB7VD0GOc//l8p/3P+ALdi+bHZOcWkZccQPdOAQiEgo8xaWjjfankwUPHKAKugF5cOhwI0Hr2hCRHXeFt7Zx4R5xs3+W0HDUeWLUq02LemdkT9ycusGr3nUfCtHCyzA2fi3+WKfcdpOPd0e0FfBMbszz1mw7umXTqfuGGTBI4Bkth0yTuHJejUPW4aV1dYjKIS65YBz9sRXLAk8rJNB8ExyexkE/C/nYE9x4Yti2ofb9JZ7XBcow/1vgWy6QbFPliBp/AboIZeGOXJxd9ZLUZFwHnpaqui+M7pWqfWQKs53K7sr3O2KRRsjRgaRRBkypqj475E8iYDHi+IryQ8H7Hn5vW0pooUFyal7xQBhF/vbx5t7wUxPcRYtbTmBNWdETUb/4/l2kJQNNAMloMePmDZNDG3NvhBBebwjvKpFi7lUIThSU1J7/toIXorTsTN8bYlzo2RwWQkKU1bZXne5x20+MinkpDI8GKkEykQ+Dm22xB2F/4vnByPBSMglqxvgspxuV7BhdtvhWs1XfvMeKkoN5QkjS4afkBpbGB3uy2KL3EFN1wLXPDzROsSWneBzIjBZLIZleraMN3MHlLrwAs+cTBwQU3mUWmegFV2fZijJ+TEi94hoknouFqsN+KYXQIYQaCrITdyhlS5yQeOZav+KVmhMeOy9lUlK4qa19cV+d4/Y5bp/j9jlu/7+I227ylfNw8oV5kHtJasc5l30+HtsHdI8g7el/msSDqgfRknKFtddku0IGQPFv6sVgDcIuLHgdfa1AbsLX8CX5W5WXeESlzsrvIsOceeKYoe2/UYB9XUiLFnToI12VjJz3MaaVLVzw73OFxzt9rWjBFgxykos1Zdy01r0+h01mQy7cOeHkSDKoHCThTv5FSRNSsEcgFxNycXFxMSGXNxfXnybk+rcJmf42RUvd3/1PxFASVCm4gmNsdedk1EVee1LjIUaTnfNrj1RUzQzFOhXdfPl0d/flDlVy89vHL9cXVzdxm9FKrzDaxOLZEe+qTyWeP77w6rGawxK5T7wob0MbS/lULMpUy4pj3Mr/ZJZ4EMIcT4yoAQc0x9UzSDXLRNWrx7mXR7mGJcj9ODXDkXV85U9Wt3hExQopHU4doXFf32NdZw8mcc/fErK2dYAe1S/P/kwqn0xYiukgaiQ27Y063ZS74+liEC6So/SxsBanBSnYUwcT/cGVsYbh3XnSRzW37w/4786SQAAXa1D9wfvPYkEuTB6OMdpxVqQ+Zav9783fSwkLkMAz+In8HV7sWRA/vTEvUF//5p9Pk1PXX00KcmDltTvwDxPYQal1jdKfVBP+NoRBMtq0w47/3kerfTvfn1VPdakknhkV5JI/T6e3eyaTTkJcowOaNDD7pZOVLJItW0IGwPDv3h0pUEmXPs43vpmhQdZVodmsS6EhIelz8O1Y7xzhYs9QUyOMyBR/BgLrR4QL/pZyWmz+8JqyP4JgX+tdVN2YhZ2QLpfSzHcEj3ZD54nJtq6wgz7rMRgLmGvQIHfuknajxWwwszpoLK4zK7fPIv6TAUcGIuO9x60E+UO4oi3vUunR+d/tIcmeWDXf2CO3XH/7WuGpeXZe8CyZ1sAxUvWk1Va1t7oTWa2POuZCpgFoZ4GpJ7C34BQsdaZJ7/YbodEBFg2Y+ykcguKR0lzkG0yVBS82hGI5ccFecMtQ56C55p8r5+TCHDqqTelgg6+z20qkGa61OWUQBxbCAXLIB2SZIzzcQTfIJLT6kOX/ipiexMCcDmGGTE/ubyI0UrgSiZZC5/KXoTUK9gQiubMn/LmegF0eZi4MHOQJo34QZkGZwMMqNbQiTyRi9AQOLlG3IsYrVrL38NkKaPPLLEequb3OH0s7la8Qh1+i8nuynDFM3wxGCVMbcdZqRX+0R2O5nrjtmwu+FcvhGhJwnfZABwH3sJkZMrRk8OQO/nJoNbVmhhglZ4amg5xpLHqH9NwoXztO+FtaqV2BUOawwp44fIJxpnHVZHp5G9ibUK1hXeqUfOK5fdrtU6/jeU9aznJ30mZ72vR6x4ZX49XBnG72u8Afu9t053bu6wPmeIHEuPMNZMzuAEq/D9uv4YYi64lPElegp6E0lP3y3dB0YYBPu2RnRIanZvtj4By1NOmxWFBWQD7DJ5PtU8ExFkE1DKXZmZ0Vjz0OmdnegoTs9/ZG3Nge/jIk/oMXPGwwekwePqOSbYFjhGlTVlRVoevt6x7SkFJm8d5+hd5plu3DrtG16pYS4/DcukO1q8Y0itCuH+wF8OvdLz6FCTYsaCgndTCTzIx48FLieaiCxzmsQa9EfigN03+tiLpiMN7mU7/LcGfMH+p6Qh6q8gGDcJhWuNMuVZVlZho1IQ+5eOYPROgVyGemIM63DvYnqA2MVwJaqFqnZng9cOQnPw0PsJ0QbVegwhTNq61ev+7J6i9zuPFuaJnD/ORjeszS3mfKikq2f69u2Mss3jGvbrV+PNIDBvGuXvhm2TqsVl5dXt/uOIK5J+PRb8CaV7cEz4N28tIkHsY8grOkSrY76/aRiS0INo58ylbizgnGZef8FGX1WjJxos1c4A7KYtMtaAUiuu0OKRzYcQYzGWdunYXWxtRyr53lWZkM6WTAAgjhZy2uwLdXkTq63WEv+/vCKkpy2egpTN4GmV4eW790KX7r2hCRfeLl0OsVnWlFd9LZE9hMQpt1rrBtQ+0bdepRx97duZMYmI/pJ9doU6BDLhjFm3ItvNhNgx31vhZFuShQ5WEU+PXjflHAPh3X5YAOf/34LxEFfv34LUQB/JnvqjTTDSSMb7GBPgeA3QNArFros87Xv0yddIE8wWSbjkb0M7IzzC3Lpsl40z0bxf6AZMdGjxDCv3v2R51s+0biSxV0KemaME7mGw0qTf5vAMgdPcY="
 }
@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package banner implements monitors for line based protocols exchanging a
+// server greeting and capabilities, like SMTP, IMAP, POP3 and FTP.
+package banner
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+
+	"github.com/elastic/beats/heartbeat/monitors"
+	"github.com/elastic/beats/heartbeat/monitors/active/dialchain"
+)
+
+func init() {
+	for name, p := range protocols {
+		monitors.RegisterActive(name, makeCreate(p))
+	}
+}
+
+var debugf = logp.MakeDebug("banner")
+
+func makeCreate(p *protocol) monitors.ActiveBuilder {
+	return func(info monitors.Info, cfg *common.Config) ([]monitors.Job, error) {
+		return create(p, cfg)
+	}
+}
+
+func create(p *protocol, cfg *common.Config) ([]monitors.Job, error) {
+	config := defaultConfig(p.name)
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	tls, err := loadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := collectHosts(p, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := sessionSettings{
+		timeout:           config.Timeout,
+		localName:         config.LocalName,
+		certificateExpiry: config.Check.CertificateExpiry,
+		check:             makeCheck(&config.Check),
+	}
+	if settings.localName == "" {
+		settings.localName = localHostname()
+	}
+
+	var jobs []monitors.Job
+	for scheme, eps := range endpoints {
+		builderSettings := dialchain.BuilderSettings{
+			Timeout: config.Timeout,
+			Socks5:  config.Socks5,
+		}
+
+		schemeSettings := settings
+		if scheme == p.tlsScheme {
+			builderSettings.TLS = tls
+			builderSettings.CertificateExpiry = config.Check.CertificateExpiry
+		} else if config.StartTLS {
+			schemeSettings.startTLS = tls
+		}
+
+		db, err := dialchain.NewBuilder(builderSettings)
+		if err != nil {
+			return nil, err
+		}
+
+		epJobs, err := dialchain.MakeDialerJobs(db, config.Name, scheme, eps, config.Mode,
+			func(dialer transport.Dialer, addr string) (common.MapStr, error) {
+				return p.run(dialer, addr, &schemeSettings)
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, epJobs...)
+	}
+	return jobs, nil
+}
+
+// loadTLSConfig loads the TLS settings. The default settings are used if no
+// TLS settings are configured, as TLS might still be required by the host
+// scheme or STARTTLS.
+func loadTLSConfig(config *tlscommon.Config) (*transport.TLSConfig, error) {
+	if config == nil {
+		config = &tlscommon.Config{}
+	}
+	return outputs.LoadTLSConfig(config)
+}
+
+func collectHosts(p *protocol, config *Config) (map[string][]dialchain.Endpoint, error) {
+	endpoints := map[string][]dialchain.Endpoint{}
+	for _, h := range config.Hosts {
+		scheme := p.name
+		host := ""
+		u, err := url.Parse(h)
+
+		if err != nil || u.Host == "" {
+			host = h
+		} else {
+			scheme = u.Scheme
+			host = u.Host
+		}
+		debugf("Add %v endpoint '%v://%v'.", p.name, scheme, host)
+
+		defaultPort := p.port
+		switch scheme {
+		case p.name:
+		case p.tlsScheme:
+			defaultPort = p.tlsPort
+		default:
+			err := fmt.Errorf("'%v' is no supported connection scheme in '%v'", scheme, h)
+			return nil, err
+		}
+
+		pair := strings.SplitN(host, ":", 2)
+		ports := config.Ports
+		if len(pair) == 2 {
+			port, err := strconv.ParseUint(pair[1], 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("'%v' is no valid port number in '%v'", pair[1], h)
+			}
+
+			ports = []uint16{uint16(port)}
+			host = pair[0]
+		} else if len(ports) == 0 {
+			ports = []uint16{defaultPort}
+		}
+
+		endpoints[scheme] = append(endpoints[scheme], dialchain.Endpoint{
+			Host:  host,
+			Ports: ports,
+		})
+	}
+	return endpoints, nil
+}
+
+func localHostname() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		return "localhost"
+	}
+	return hostname
+}
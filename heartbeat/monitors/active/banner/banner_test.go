@@ -0,0 +1,290 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package banner
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/match"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+
+	"github.com/elastic/beats/heartbeat/monitors/active/dialchain"
+	"github.com/elastic/beats/heartbeat/reason"
+)
+
+// testConn is the server side of a test session.
+type testConn struct {
+	conn net.Conn
+	text *textproto.Conn
+}
+
+func (c *testConn) send(lines ...string) {
+	for _, line := range lines {
+		c.text.PrintfLine("%s", line)
+	}
+}
+
+func (c *testConn) recv() string {
+	line, _ := c.text.ReadLine()
+	return line
+}
+
+func (c *testConn) startTLS() {
+	// reuse the test certificate of the httptest package
+	server := httptest.NewUnstartedServer(http.NotFoundHandler())
+	server.StartTLS()
+	server.Close()
+
+	tlsConn := tls.Server(c.conn, &tls.Config{Certificates: server.TLS.Certificates})
+	tlsConn.Handshake()
+	c.conn = tlsConn
+	c.text = textproto.NewConn(tlsConn)
+}
+
+// startServer runs a server on localhost, serving a single session.
+func startServer(t *testing.T, handler func(*testConn)) (string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handler(&testConn{conn: conn, text: textproto.NewConn(conn)})
+	}()
+
+	return l.Addr().String(), func() { l.Close() }
+}
+
+func runSession(
+	t *testing.T,
+	name string,
+	startTLS bool,
+	check checkConfig,
+	handler func(*testConn),
+) (common.MapStr, error) {
+	addr, stop := startServer(t, handler)
+	defer stop()
+
+	settings := sessionSettings{
+		timeout:   time.Second,
+		localName: "heartbeat.local",
+		check:     makeCheck(&check),
+	}
+	if startTLS {
+		settings.startTLS = &transport.TLSConfig{Verification: transport.VerifyNone}
+	}
+
+	db, err := dialchain.NewBuilder(dialchain.BuilderSettings{Timeout: time.Second})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return db.Run(addr, func(dialer transport.Dialer) (common.MapStr, error) {
+		return protocols[name].run(dialer, addr, &settings)
+	})
+}
+
+func smtpServer(c *testConn) {
+	c.send("220 mail.example.com ESMTP ready")
+	for {
+		cmd := c.recv()
+		switch {
+		case strings.HasPrefix(cmd, "EHLO "):
+			caps := []string{"250-mail.example.com", "250-SIZE 1000", "250-STARTTLS"}
+			if _, ok := c.conn.(*tls.Conn); ok {
+				caps = []string{"250-mail.example.com", "250-SIZE 1000"}
+			}
+			c.send(append(caps, "250 AUTH PLAIN LOGIN")...)
+		case cmd == "STARTTLS":
+			c.send("220 2.0.0 Ready to start TLS")
+			c.startTLS()
+		case cmd == "QUIT":
+			c.send("221 Bye")
+			return
+		default:
+			c.send("500 unknown command")
+			return
+		}
+	}
+}
+
+func TestSMTP(t *testing.T) {
+	check := checkConfig{
+		Greeting:     []match.Matcher{match.MustCompile("ESMTP")},
+		Capabilities: []string{"STARTTLS", "auth"},
+	}
+	event, err := runSession(t, "smtp", false, check, smtpServer)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	status, _ := event.GetValue("smtp.greeting.status")
+	assert.Equal(t, "220", status)
+	message, _ := event.GetValue("smtp.greeting.message")
+	assert.Equal(t, "mail.example.com ESMTP ready", message)
+	capabilities, _ := event.GetValue("smtp.capabilities.list")
+	assert.Equal(t, []string{"SIZE 1000", "STARTTLS", "AUTH PLAIN LOGIN"}, capabilities)
+	_, err = event.GetValue("smtp.rtt.capabilities.us")
+	assert.NoError(t, err)
+}
+
+func TestSMTPStartTLS(t *testing.T) {
+	check := checkConfig{Capabilities: []string{"AUTH"}}
+	event, err := runSession(t, "smtp", true, check, smtpServer)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	status, _ := event.GetValue("smtp.starttls.status")
+	assert.Equal(t, "220", status)
+	_, err = event.GetValue("smtp.rtt.starttls.us")
+	assert.NoError(t, err)
+	_, err = event.GetValue("tls.certificate.not_after")
+	assert.NoError(t, err)
+
+	// capabilities are listed again on the secured connection
+	capabilities, _ := event.GetValue("smtp.capabilities.list")
+	assert.Equal(t, []string{"SIZE 1000", "AUTH PLAIN LOGIN"}, capabilities)
+}
+
+func TestSMTPNotReady(t *testing.T) {
+	_, err := runSession(t, "smtp", false, checkConfig{}, func(c *testConn) {
+		c.send("554 no service")
+	})
+	if assert.Error(t, err) {
+		assert.Equal(t, "validate", err.(reason.Reason).Type())
+	}
+}
+
+func TestMissingCapability(t *testing.T) {
+	check := checkConfig{Capabilities: []string{"PIPELINING"}}
+	event, err := runSession(t, "smtp", false, check, smtpServer)
+	if assert.Error(t, err) {
+		assert.Equal(t, "validate", err.(reason.Reason).Type())
+		assert.Contains(t, err.Error(), "PIPELINING")
+	}
+
+	// session details are reported if validation fails
+	status, _ := event.GetValue("smtp.capabilities.status")
+	assert.Equal(t, "250", status)
+}
+
+func TestFTP(t *testing.T) {
+	event, err := runSession(t, "ftp", false, checkConfig{}, func(c *testConn) {
+		c.send("220 FTP server ready")
+		if c.recv() == "FEAT" {
+			c.send("211-Features:", " MDTM", " UTF8", "211 End")
+		}
+		if c.recv() == "QUIT" {
+			c.send("221 Goodbye")
+		}
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	capabilities, _ := event.GetValue("ftp.capabilities.list")
+	assert.Equal(t, []string{"MDTM", "UTF8"}, capabilities)
+}
+
+func TestPOP3(t *testing.T) {
+	event, err := runSession(t, "pop3", false, checkConfig{}, func(c *testConn) {
+		c.send("+OK POP3 server ready")
+		if c.recv() == "CAPA" {
+			c.send("+OK Capability list follows", "TOP", "SASL PLAIN", "STLS", ".")
+		}
+		if c.recv() == "QUIT" {
+			c.send("+OK Bye")
+		}
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	status, _ := event.GetValue("pop3.greeting.status")
+	assert.Equal(t, "+OK", status)
+	capabilities, _ := event.GetValue("pop3.capabilities.list")
+	assert.Equal(t, []string{"TOP", "SASL PLAIN", "STLS"}, capabilities)
+}
+
+func TestPOP3Error(t *testing.T) {
+	_, err := runSession(t, "pop3", false, checkConfig{}, func(c *testConn) {
+		c.send("-ERR maintenance")
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "maintenance")
+	}
+}
+
+func TestIMAP(t *testing.T) {
+	event, err := runSession(t, "imap", false, checkConfig{Capabilities: []string{"IMAP4rev1"}},
+		func(c *testConn) {
+			c.send("* OK IMAP4rev1 server ready")
+			if c.recv() == "a001 CAPABILITY" {
+				c.send("* CAPABILITY IMAP4rev1 STARTTLS AUTH=PLAIN", "a001 OK CAPABILITY completed")
+			}
+			if c.recv() == "a002 LOGOUT" {
+				c.send("* BYE logging out", "a002 OK LOGOUT completed")
+			}
+		})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	status, _ := event.GetValue("imap.greeting.status")
+	assert.Equal(t, "OK", status)
+	capabilities, _ := event.GetValue("imap.capabilities.list")
+	assert.Equal(t, []string{"IMAP4rev1", "STARTTLS", "AUTH=PLAIN"}, capabilities)
+}
+
+func TestCollectHosts(t *testing.T) {
+	config := defaultConfig("imap")
+	config.Hosts = []string{"mail.example.com", "imaps://mail.example.com", "imap://localhost:1143"}
+
+	endpoints, err := collectHosts(protocols["imap"], &config)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, map[string][]dialchain.Endpoint{
+		"imap": {
+			{Host: "mail.example.com", Ports: []uint16{143}},
+			{Host: "localhost", Ports: []uint16{1143}},
+		},
+		"imaps": {
+			{Host: "mail.example.com", Ports: []uint16{993}},
+		},
+	}, endpoints)
+
+	config.Hosts = []string{"pop3://mail.example.com"}
+	_, err = collectHosts(protocols["imap"], &config)
+	assert.Error(t, err)
+}
@@ -0,0 +1,79 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package banner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common/match"
+)
+
+type sessionCheck struct {
+	greeting     []match.Matcher
+	capabilities []string
+}
+
+func makeCheck(config *checkConfig) *sessionCheck {
+	return &sessionCheck{
+		greeting:     config.Greeting,
+		capabilities: config.Capabilities,
+	}
+}
+
+// checkGreeting validates the server being ready and the greeting message
+// matching all configured patterns.
+func (c *sessionCheck) checkGreeting(r reply) error {
+	if !r.ok {
+		return fmt.Errorf("server not ready, greeting status %v: %v", r.status, r.message)
+	}
+
+	for _, m := range c.greeting {
+		if !m.MatchString(r.message) {
+			return fmt.Errorf("greeting does not match '%v'", m.String())
+		}
+	}
+	return nil
+}
+
+// checkCapabilities validates all required capabilities being advertised by
+// the server.
+func (c *sessionCheck) checkCapabilities(capabilities []string) error {
+	for _, required := range c.capabilities {
+		if !hasCapability(capabilities, required) {
+			return fmt.Errorf("capability '%v' not supported", required)
+		}
+	}
+	return nil
+}
+
+// hasCapability checks if name is in the list of capabilities. Capabilities
+// having parameters (e.g. 'AUTH PLAIN LOGIN') match by name only.
+func hasCapability(capabilities []string, name string) bool {
+	for _, capability := range capabilities {
+		if strings.EqualFold(capability, name) {
+			return true
+		}
+
+		fields := strings.Fields(capability)
+		if len(fields) > 0 && strings.EqualFold(fields[0], name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package banner
+
+import (
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/match"
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+
+	"github.com/elastic/beats/heartbeat/monitors"
+)
+
+type Config struct {
+	Name string `config:"name"`
+
+	// use default port of the protocol if host does not contain port
+	Hosts []string `config:"hosts" validate:"required"`
+	Ports []uint16 `config:"ports"`
+
+	Mode monitors.IPSettings `config:",inline"`
+
+	Socks5 transport.ProxyConfig `config:",inline"`
+
+	// configure tls, used with implicit TLS schemes and STARTTLS
+	TLS      *tlscommon.Config `config:"ssl"`
+	StartTLS bool              `config:"starttls"`
+
+	Timeout time.Duration `config:"timeout"`
+
+	// name announced by the SMTP EHLO command, defaults to the hostname
+	LocalName string `config:"local_name"`
+
+	// validate session
+	Check checkConfig `config:"check"`
+}
+
+type checkConfig struct {
+	Greeting     []match.Matcher `config:"greeting"`
+	Capabilities []string        `config:"capabilities"`
+
+	// mark check as failed if a certificate expires within the given duration
+	CertificateExpiry time.Duration `config:"certificate.expires_within" validate:"min=0"`
+}
+
+// defaultConfig returns the default configuration of the monitor type.
+func defaultConfig(name string) Config {
+	return Config{
+		Name:    name,
+		Timeout: 16 * time.Second,
+		Mode:    monitors.DefaultIPSettings,
+	}
+}
+
+func (c *Config) Validate() error {
+	if c.Socks5.URL != "" {
+		if c.Mode.Mode != monitors.PingAny && !c.Socks5.LocalResolve {
+			return errors.New("ping all ips only supported if proxy_use_local_resolver is enabled`")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package banner
+
+import (
+	"fmt"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// protocol describes the commands used to check a line based protocol.
+type protocol struct {
+	name string
+	port uint16
+
+	// scheme and default port for implicit TLS
+	tlsScheme string
+	tlsPort   uint16
+
+	greeting     func(*session) (reply, error)
+	capabilities func(*session) (reply, []string, error)
+	startTLS     func(*session) (reply, error)
+	quit         func(*session) (reply, error)
+}
+
+// reply is the protocol level status returned by the server.
+type reply struct {
+	status  string
+	message string
+	ok      bool
+}
+
+// session is a connection to the server, exchanging text lines.
+type session struct {
+	text      *textproto.Conn
+	localName string
+	tag       int
+}
+
+var protocols = map[string]*protocol{
+	"smtp": {
+		name:      "smtp",
+		port:      25,
+		tlsScheme: "smtps",
+		tlsPort:   465,
+
+		greeting: readCodeReply,
+		capabilities: func(s *session) (reply, []string, error) {
+			r, err := s.codeCommand("EHLO %s", s.localName)
+			if err != nil || !r.ok {
+				return r, nil, err
+			}
+			// first line of the reply is the server name
+			return r, trimLines(splitLines(r.message)[1:]), nil
+		},
+		startTLS: func(s *session) (reply, error) { return s.codeCommand("STARTTLS") },
+		quit:     func(s *session) (reply, error) { return s.codeCommand("QUIT") },
+	},
+
+	"ftp": {
+		name:      "ftp",
+		port:      21,
+		tlsScheme: "ftps",
+		tlsPort:   990,
+
+		greeting: readCodeReply,
+		capabilities: func(s *session) (reply, []string, error) {
+			r, err := s.codeCommand("FEAT")
+			if err != nil || !r.ok {
+				return r, nil, err
+			}
+			// features are listed between the first and the last line
+			lines := splitLines(r.message)
+			if len(lines) < 2 {
+				return r, nil, nil
+			}
+			return r, trimLines(lines[1 : len(lines)-1]), nil
+		},
+		startTLS: func(s *session) (reply, error) { return s.codeCommand("AUTH TLS") },
+		quit:     func(s *session) (reply, error) { return s.codeCommand("QUIT") },
+	},
+
+	"pop3": {
+		name:      "pop3",
+		port:      110,
+		tlsScheme: "pop3s",
+		tlsPort:   995,
+
+		greeting: readStatusReply,
+		capabilities: func(s *session) (reply, []string, error) {
+			r, err := s.statusCommand("CAPA")
+			if err != nil || !r.ok {
+				return r, nil, err
+			}
+			lines, err := s.text.ReadDotLines()
+			return r, trimLines(lines), err
+		},
+		startTLS: func(s *session) (reply, error) { return s.statusCommand("STLS") },
+		quit:     func(s *session) (reply, error) { return s.statusCommand("QUIT") },
+	},
+
+	"imap": {
+		name:      "imap",
+		port:      143,
+		tlsScheme: "imaps",
+		tlsPort:   993,
+
+		greeting: readUntaggedReply,
+		capabilities: func(s *session) (reply, []string, error) {
+			r, untagged, err := s.taggedCommand("CAPABILITY")
+			if err != nil || !r.ok {
+				return r, nil, err
+			}
+
+			var capabilities []string
+			for _, line := range untagged {
+				fields := strings.Fields(line)
+				if len(fields) > 2 && strings.EqualFold(fields[1], "CAPABILITY") {
+					capabilities = append(capabilities, fields[2:]...)
+				}
+			}
+			return r, capabilities, nil
+		},
+		startTLS: func(s *session) (reply, error) {
+			r, _, err := s.taggedCommand("STARTTLS")
+			return r, err
+		},
+		quit: func(s *session) (reply, error) {
+			r, _, err := s.taggedCommand("LOGOUT")
+			return r, err
+		},
+	},
+}
+
+func newSession(conn net.Conn, localName string) *session {
+	return &session{
+		text:      textproto.NewConn(conn),
+		localName: localName,
+	}
+}
+
+// reset continues the session on a new connection, e.g. after the TLS
+// handshake.
+func (s *session) reset(conn net.Conn) {
+	s.text = textproto.NewConn(conn)
+}
+
+// codeCommand sends a command and reads a reply with 3 digit status code,
+// like used by SMTP and FTP.
+func (s *session) codeCommand(format string, args ...interface{}) (reply, error) {
+	if err := s.text.PrintfLine(format, args...); err != nil {
+		return reply{}, err
+	}
+	return readCodeReply(s)
+}
+
+// statusCommand sends a command and reads a '+OK' or '-ERR' status reply, like
+// used by POP3.
+func (s *session) statusCommand(format string, args ...interface{}) (reply, error) {
+	if err := s.text.PrintfLine(format, args...); err != nil {
+		return reply{}, err
+	}
+	return readStatusReply(s)
+}
+
+// taggedCommand sends an IMAP command and reads all lines until the tagged
+// status reply. The untagged lines are returned as well.
+func (s *session) taggedCommand(command string) (reply, []string, error) {
+	s.tag++
+	tag := fmt.Sprintf("a%03d", s.tag)
+	if err := s.text.PrintfLine("%s %s", tag, command); err != nil {
+		return reply{}, nil, err
+	}
+
+	var untagged []string
+	for {
+		line, err := s.text.ReadLine()
+		if err != nil {
+			return reply{}, untagged, err
+		}
+
+		if !strings.HasPrefix(line, tag+" ") {
+			untagged = append(untagged, line)
+			continue
+		}
+
+		status, message := splitStatus(line[len(tag)+1:])
+		return reply{status: status, message: message, ok: status == "OK"}, untagged, nil
+	}
+}
+
+func readCodeReply(s *session) (reply, error) {
+	code, message, err := s.text.ReadResponse(0)
+	if err != nil {
+		return reply{}, err
+	}
+	return reply{
+		status:  strconv.Itoa(code),
+		message: message,
+		ok:      200 <= code && code < 400,
+	}, nil
+}
+
+func readStatusReply(s *session) (reply, error) {
+	line, err := s.text.ReadLine()
+	if err != nil {
+		return reply{}, err
+	}
+
+	status, message := splitStatus(line)
+	switch status {
+	case "+OK", "-ERR":
+	default:
+		return reply{}, textproto.ProtocolError(fmt.Sprintf("invalid status reply: %v", line))
+	}
+	return reply{status: status, message: message, ok: status == "+OK"}, nil
+}
+
+func readUntaggedReply(s *session) (reply, error) {
+	line, err := s.text.ReadLine()
+	if err != nil {
+		return reply{}, err
+	}
+	if !strings.HasPrefix(line, "* ") {
+		return reply{}, textproto.ProtocolError(fmt.Sprintf("invalid greeting: %v", line))
+	}
+
+	status, message := splitStatus(line[2:])
+	return reply{
+		status:  status,
+		message: message,
+		ok:      status == "OK" || status == "PREAUTH",
+	}, nil
+}
+
+func splitStatus(line string) (string, string) {
+	parts := strings.SplitN(line, " ", 2)
+	status := strings.ToUpper(parts[0])
+	if len(parts) == 1 {
+		return status, ""
+	}
+	return status, parts[1]
+}
+
+func splitLines(s string) []string {
+	return strings.Split(s, "\n")
+}
+
+func trimLines(lines []string) []string {
+	var trimmed []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			trimmed = append(trimmed, line)
+		}
+	}
+	return trimmed
+}
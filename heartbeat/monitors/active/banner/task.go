@@ -0,0 +1,146 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package banner
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+
+	"github.com/elastic/beats/heartbeat/look"
+	"github.com/elastic/beats/heartbeat/monitors/active/dialchain"
+	"github.com/elastic/beats/heartbeat/reason"
+)
+
+type sessionSettings struct {
+	timeout   time.Duration
+	localName string
+
+	// TLS settings used to upgrade the connection via STARTTLS. STARTTLS is
+	// disabled if nil.
+	startTLS          *transport.TLSConfig
+	certificateExpiry time.Duration
+
+	check *sessionCheck
+}
+
+// run executes the session: read the server greeting, list the
+// capabilities and optionally upgrade the connection via STARTTLS. The
+// capabilities are listed again after STARTTLS, as servers commonly
+// advertise different capabilities on secured connections.
+func (p *protocol) run(
+	dialer transport.Dialer,
+	addr string,
+	settings *sessionSettings,
+) (common.MapStr, error) {
+	start := time.Now()
+
+	conn, err := dialer.Dial("tcp", addr)
+	if err != nil {
+		debugf("dial failed with: %v", err)
+		return nil, reason.IOFailed(err)
+	}
+	defer func() { conn.Close() }()
+
+	if err := conn.SetDeadline(start.Add(settings.timeout)); err != nil {
+		debugf("setting connection deadline failed with: %v", err)
+		return nil, reason.IOFailed(err)
+	}
+
+	fields := common.MapStr{}
+	event := common.MapStr{p.name: fields}
+	s := newSession(conn, settings.localName)
+
+	stepStart := time.Now()
+	greeting, err := p.greeting(s)
+	if err != nil {
+		debugf("reading greeting failed with: %v", err)
+		return event, reason.IOFailed(err)
+	}
+	fields.Put("rtt.greeting", look.RTT(time.Since(stepStart)))
+	fields["greeting"] = common.MapStr{
+		"status":  greeting.status,
+		"message": greeting.message,
+	}
+	if err := settings.check.checkGreeting(greeting); err != nil {
+		return event, reason.ValidateFailed(err)
+	}
+
+	capabilities, err := p.listCapabilities(s, fields)
+	if err != nil {
+		debugf("listing capabilities failed with: %v", err)
+		return event, reason.IOFailed(err)
+	}
+
+	if settings.startTLS != nil {
+		stepStart = time.Now()
+		r, err := p.startTLS(s)
+		if err != nil {
+			debugf("STARTTLS failed with: %v", err)
+			return event, reason.IOFailed(err)
+		}
+		fields["starttls"] = common.MapStr{"status": r.status}
+		if !r.ok {
+			return event, reason.ValidateFailed(
+				fmt.Errorf("STARTTLS failed with status %v: %v", r.status, r.message))
+		}
+
+		tlsConn, err := dialchain.StartTLS(event, conn, addr,
+			settings.startTLS, settings.timeout, settings.certificateExpiry)
+		if err != nil {
+			debugf("TLS handshake failed with: %v", err)
+			return event, reason.IOFailed(err)
+		}
+		fields.Put("rtt.starttls", look.RTT(time.Since(stepStart)))
+
+		conn = tlsConn
+		s.reset(conn)
+		capabilities, err = p.listCapabilities(s, fields)
+		if err != nil {
+			debugf("listing capabilities failed with: %v", err)
+			return event, reason.IOFailed(err)
+		}
+	}
+
+	if err := settings.check.checkCapabilities(capabilities); err != nil {
+		return event, reason.ValidateFailed(err)
+	}
+
+	// close the session gracefully, errors are not relevant for the check
+	// result anymore
+	p.quit(s)
+	return event, nil
+}
+
+func (p *protocol) listCapabilities(s *session, fields common.MapStr) ([]string, error) {
+	start := time.Now()
+	r, capabilities, err := p.capabilities(s)
+	if err != nil {
+		return nil, err
+	}
+	fields.Put("rtt.capabilities", look.RTT(time.Since(start)))
+
+	capabilityFields := common.MapStr{"status": r.status}
+	if len(capabilities) > 0 {
+		capabilityFields["list"] = capabilities
+	}
+	fields["capabilities"] = capabilityFields
+	return capabilities, nil
+}
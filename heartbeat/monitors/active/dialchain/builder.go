@@ -45,6 +45,10 @@ type BuilderSettings struct {
 	Timeout time.Duration
 	Socks5  transport.ProxyConfig
 	TLS     *transport.TLSConfig
+
+	// CertificateExpiry fails the TLS handshake if a certificate expires
+	// within the configured duration. Disabled if 0.
+	CertificateExpiry time.Duration
 }
 
 // Endpoint configures a host with all port numbers to be monitored by a dialer
@@ -73,7 +77,7 @@ func NewBuilder(settings BuilderSettings) (*Builder, error) {
 
 	// add tls layer doing the TLS handshake based on the original address
 	if tls := settings.TLS; tls != nil {
-		d.AddLayer(TLSLayer(tls, settings.Timeout))
+		d.AddLayer(TLSLayer(tls, settings.Timeout, settings.CertificateExpiry))
 	}
 
 	// validate dialerchain
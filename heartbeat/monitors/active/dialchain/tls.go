@@ -18,7 +18,11 @@
 package dialchain
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/elastic/beats/heartbeat/look"
@@ -32,10 +36,15 @@ import (
 //
 //  {
 //    "tls": {
-//        "rtt": { "handshake": { "us": ... }}
+//        "rtt": { "handshake": { "us": ... }},
+//        "certificate": { ... },
+//        "certificate_chain": [ ... ]
 //    }
 //  }
-func TLSLayer(cfg *transport.TLSConfig, to time.Duration) Layer {
+//
+// If expiresWithin is > 0, the dial fails if any certificate presented by the
+// server expires within the given duration.
+func TLSLayer(cfg *transport.TLSConfig, to, expiresWithin time.Duration) Layer {
 	return func(event common.MapStr, next transport.Dialer) (transport.Dialer, error) {
 		var timer timer
 
@@ -49,11 +58,119 @@ func TLSLayer(cfg *transport.TLSConfig, to time.Duration) Layer {
 		}
 
 		return afterDial(dialer, func(conn net.Conn) (net.Conn, error) {
-			// TODO: extract TLS connection parameters from connection object.
-
 			timer.stop()
 			event.Put("tls.rtt.handshake", look.RTT(timer.duration()))
+
+			tlsConn, ok := conn.(*tls.Conn)
+			if !ok {
+				return conn, nil
+			}
+
+			certs := tlsConn.ConnectionState().PeerCertificates
+			if len(certs) == 0 {
+				return conn, nil
+			}
+
+			now := time.Now()
+			event.Put("tls.certificate", certFields(certs[0], now))
+			if len(certs) > 1 {
+				chain := make([]common.MapStr, len(certs)-1)
+				for i, cert := range certs[1:] {
+					chain[i] = certFields(cert, now)
+				}
+				event.Put("tls.certificate_chain", chain)
+			}
+
+			if expiresWithin > 0 {
+				if err := checkExpiry(certs, now, expiresWithin); err != nil {
+					conn.Close()
+					return nil, err
+				}
+			}
 			return conn, nil
 		}), nil
 	}
 }
+
+// StartTLS runs the TLS handshake on an already established connection, e.g.
+// after a protocol level STARTTLS command. The event is updated the same way
+// as by TLSLayer. The address is used to verify the server certificate.
+func StartTLS(
+	event common.MapStr,
+	conn net.Conn,
+	address string,
+	cfg *transport.TLSConfig,
+	to, expiresWithin time.Duration,
+) (net.Conn, error) {
+	established := makeDialer(func(_, _ string) (net.Conn, error) {
+		return conn, nil
+	})
+
+	dialer, err := TLSLayer(cfg, to, expiresWithin).build(event, established)
+	if err != nil {
+		return nil, err
+	}
+	return dialer.Dial("tcp", address)
+}
+
+// checkExpiry returns an error if any of the certificates expires before
+// now + window.
+func checkExpiry(certs []*x509.Certificate, now time.Time, window time.Duration) error {
+	deadline := now.Add(window)
+	for _, cert := range certs {
+		if cert.NotAfter.Before(deadline) {
+			return fmt.Errorf("certificate '%v' expires in %v days (%v)",
+				cert.Subject.CommonName,
+				expiresInDays(cert, now),
+				cert.NotAfter.UTC().Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+func certFields(cert *x509.Certificate, now time.Time) common.MapStr {
+	fields := common.MapStr{
+		"subject":             cert.Subject.String(),
+		"issuer":              cert.Issuer.String(),
+		"serial_number":       formatSerial(cert),
+		"not_before":          common.Time(cert.NotBefore),
+		"not_after":           common.Time(cert.NotAfter),
+		"expires_in_days":     expiresInDays(cert, now),
+		"signature_algorithm": cert.SignatureAlgorithm.String(),
+	}
+
+	var sans []string
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	sans = append(sans, cert.EmailAddresses...)
+	if len(sans) > 0 {
+		fields["san"] = sans
+	}
+	return fields
+}
+
+// expiresInDays returns the number of full days left until the certificate
+// expires. The result is negative for already expired certificates.
+func expiresInDays(cert *x509.Certificate, now time.Time) int {
+	return int(cert.NotAfter.Sub(now) / (24 * time.Hour))
+}
+
+// formatSerial formats the certificate serial number as colon separated hex
+// string, like it is presented by most TLS tools.
+func formatSerial(cert *x509.Certificate) string {
+	if cert.SerialNumber == nil {
+		return ""
+	}
+
+	b := cert.SerialNumber.Bytes()
+	if len(b) == 0 {
+		return "00"
+	}
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = fmt.Sprintf("%02x", v)
+	}
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dialchain
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+)
+
+func dialTLS(t *testing.T, expiresWithin time.Duration) (common.MapStr, error) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	d := &DialerChain{Net: TCPDialer(time.Second)}
+	d.AddLayer(TLSLayer(&transport.TLSConfig{Verification: transport.VerifyNone}, time.Second, expiresWithin))
+
+	event := common.MapStr{}
+	dialer, err := d.Build(event)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	conn, err := dialer.Dial("tcp", server.Listener.Addr().String())
+	if conn != nil {
+		conn.Close()
+	}
+	return event, err
+}
+
+func TestTLSLayerCertificateFields(t *testing.T) {
+	event, err := dialTLS(t, 0)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	cert, err := event.GetValue("tls.certificate")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	fields := cert.(common.MapStr)
+	assert.Contains(t, fields["san"], "example.com")
+	assert.Contains(t, fields["san"], "127.0.0.1")
+	assert.NotEmpty(t, fields["serial_number"])
+	assert.NotEmpty(t, fields["signature_algorithm"])
+	assert.True(t, fields["expires_in_days"].(int) > 0)
+
+	_, err = event.GetValue("tls.rtt.handshake.us")
+	assert.NoError(t, err)
+}
+
+func TestTLSLayerCertificateExpiry(t *testing.T) {
+	event, err := dialTLS(t, 100*365*24*time.Hour)
+	assert.Error(t, err)
+
+	// certificate details are still reported if the check fails
+	_, err = event.GetValue("tls.certificate.not_after")
+	assert.NoError(t, err)
+}
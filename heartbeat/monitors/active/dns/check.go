@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/beats/libbeat/common/match"
+)
+
+type RespCheck func(*dns.Msg) error
+
+func makeValidateResponse(config *checkConfig) RespCheck {
+	var checks []RespCheck
+
+	if config.RCode != "" {
+		checks = append(checks, checkRCode(dns.StringToRcode[strings.ToUpper(config.RCode)]))
+	}
+
+	if len(config.Answers) > 0 {
+		checks = append(checks, checkAnswers(config.Answers))
+	}
+
+	return checkAll(checks...)
+}
+
+func checkOK(_ *dns.Msg) error { return nil }
+
+func checkAll(checks ...RespCheck) RespCheck {
+	switch len(checks) {
+	case 0:
+		return checkOK
+	case 1:
+		return checks[0]
+	}
+
+	return func(m *dns.Msg) error {
+		for _, check := range checks {
+			if err := check(m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func checkRCode(rcode int) RespCheck {
+	return func(m *dns.Msg) error {
+		if m.Rcode == rcode {
+			return nil
+		}
+		return fmt.Errorf("received response code %v expecting %v",
+			dns.RcodeToString[m.Rcode], dns.RcodeToString[rcode])
+	}
+}
+
+// checkAnswers requires every matcher to match the data of at least one
+// answer record.
+func checkAnswers(matchers []match.Matcher) RespCheck {
+	return func(m *dns.Msg) error {
+		data := make([]string, len(m.Answer))
+		for i, rr := range m.Answer {
+			data[i] = answerData(rr)
+		}
+
+		for _, matcher := range matchers {
+			if !matcher.MatchAnyString(data) {
+				return fmt.Errorf("no answer matching '%v' in %v", matcher.String(), data)
+			}
+		}
+		return nil
+	}
+}
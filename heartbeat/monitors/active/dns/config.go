@@ -0,0 +1,95 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/beats/libbeat/common/match"
+
+	"github.com/elastic/beats/heartbeat/monitors"
+)
+
+type Config struct {
+	Name string `config:"name"`
+
+	// resolvers to query, port 53 is used if host does not contain a port
+	Hosts []string            `config:"hosts" validate:"required"`
+	Mode  monitors.IPSettings `config:",inline"`
+
+	Transport string        `config:"transport"`
+	Timeout   time.Duration `config:"timeout"`
+
+	// query parameters
+	QueryName        string `config:"query_name" validate:"required"`
+	QueryType        string `config:"query_type"`
+	RecursionDesired bool   `config:"recursion_desired"`
+
+	// validate response
+	Check checkConfig `config:"check"`
+}
+
+type checkConfig struct {
+	RCode   string          `config:"rcode"`
+	Answers []match.Matcher `config:"answers"`
+}
+
+var DefaultConfig = Config{
+	Name:             "dns",
+	Mode:             monitors.DefaultIPSettings,
+	Transport:        "udp",
+	Timeout:          16 * time.Second,
+	QueryType:        "A",
+	RecursionDesired: true,
+	Check: checkConfig{
+		RCode: "NOERROR",
+	},
+}
+
+func (c *Config) Validate() error {
+	if err := validateTransport(c.Transport); err != nil {
+		return err
+	}
+	if _, ok := dns.StringToType[strings.ToUpper(c.QueryType)]; !ok {
+		return fmt.Errorf("unknown query type '%v'", c.QueryType)
+	}
+	return nil
+}
+
+func (c *checkConfig) Validate() error {
+	if c.RCode == "" {
+		return nil
+	}
+	if _, ok := dns.StringToRcode[strings.ToUpper(c.RCode)]; !ok {
+		return fmt.Errorf("unknown response code '%v'", c.RCode)
+	}
+	return nil
+}
+
+func validateTransport(transport string) error {
+	switch transport {
+	case "udp", "tcp":
+		return nil
+	default:
+		return fmt.Errorf("'%v' is no supported transport", transport)
+	}
+}
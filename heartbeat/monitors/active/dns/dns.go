@@ -0,0 +1,121 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/elastic/beats/heartbeat/monitors"
+)
+
+func init() {
+	monitors.RegisterActive("dns", create)
+}
+
+var debugf = logp.MakeDebug("dns")
+
+const defaultPort = 53
+
+type resolver struct {
+	Transport string
+	Host      string
+	Port      uint16
+}
+
+func create(
+	info monitors.Info,
+	cfg *common.Config,
+) ([]monitors.Job, error) {
+	config := DefaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	resolvers, err := collectResolvers(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	q := newQuery(&config)
+	validator := makeValidateResponse(&config.Check)
+
+	jobs := make([]monitors.Job, len(resolvers))
+	for i, r := range resolvers {
+		jobName := fmt.Sprintf("%v-%v@%v:%v", config.Name, r.Transport, r.Host, r.Port)
+		settings := monitors.MakeHostJobSettings(jobName, r.Host, config.Mode)
+		settings = settings.WithFields(common.MapStr{
+			"monitor": common.MapStr{
+				"scheme": r.Transport,
+			},
+		})
+
+		transport := r.Transport
+		pingFactory := monitors.MakePingAllIPPortFactory([]uint16{r.Port},
+			func(ip *net.IPAddr, port uint16) (common.MapStr, error) {
+				addr := net.JoinHostPort(ip.String(), strconv.Itoa(int(port)))
+				return q.exec(transport, addr, validator)
+			})
+
+		jobs[i], err = monitors.MakeByHostJob(settings, pingFactory)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
+
+// collectResolvers parses the configured hosts. A host can be given as
+// `host`, `host:port` or `transport://host:port`.
+func collectResolvers(config *Config) ([]resolver, error) {
+	resolvers := make([]resolver, 0, len(config.Hosts))
+	for _, h := range config.Hosts {
+		transport := config.Transport
+		host := h
+
+		if u, err := url.Parse(h); err == nil && u.Host != "" {
+			transport = u.Scheme
+			host = u.Host
+		}
+		if err := validateTransport(transport); err != nil {
+			return nil, fmt.Errorf("%v in '%v'", err, h)
+		}
+
+		port := uint16(defaultPort)
+		if hostname, portStr, err := net.SplitHostPort(host); err == nil {
+			p, err := strconv.ParseUint(portStr, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("'%v' is no valid port number in '%v'", portStr, h)
+			}
+			host, port = hostname, uint16(p)
+		}
+
+		debugf("Add dns resolver '%v://%v:%v'.", transport, host, port)
+		resolvers = append(resolvers, resolver{
+			Transport: transport,
+			Host:      host,
+			Port:      port,
+		})
+	}
+	return resolvers, nil
+}
@@ -0,0 +1,125 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/elastic/beats/heartbeat/look"
+	"github.com/elastic/beats/heartbeat/reason"
+)
+
+type query struct {
+	name             string
+	qtype            uint16
+	recursionDesired bool
+	timeout          time.Duration
+}
+
+func newQuery(config *Config) *query {
+	return &query{
+		name:             dns.Fqdn(config.QueryName),
+		qtype:            dns.StringToType[strings.ToUpper(config.QueryType)],
+		recursionDesired: config.RecursionDesired,
+		timeout:          config.Timeout,
+	}
+}
+
+// exec sends the query to the resolver at addr and validates the response.
+func (q *query) exec(transport, addr string, validator RespCheck) (common.MapStr, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(q.name, q.qtype)
+	msg.RecursionDesired = q.recursionDesired
+
+	client := &dns.Client{
+		Net:     transport,
+		Timeout: q.timeout,
+	}
+
+	fields := common.MapStr{
+		"query": common.MapStr{
+			"name": q.name,
+			"type": dns.TypeToString[q.qtype],
+		},
+		"transport": transport,
+	}
+	event := common.MapStr{"dns": fields}
+
+	resp, rtt, err := client.Exchange(msg, addr)
+	if err != nil {
+		debugf("query failed with: %v", err)
+		return event, reason.IOFailed(err)
+	}
+
+	answers := make([]common.MapStr, len(resp.Answer))
+	for i, rr := range resp.Answer {
+		hdr := rr.Header()
+		answers[i] = common.MapStr{
+			"name": hdr.Name,
+			"type": dns.TypeToString[hdr.Rrtype],
+			"ttl":  hdr.Ttl,
+			"data": answerData(rr),
+		}
+	}
+
+	fields["rtt"] = common.MapStr{"query": look.RTT(rtt)}
+	fields["response"] = common.MapStr{
+		"rcode":         dns.RcodeToString[resp.Rcode],
+		"authoritative": resp.Authoritative,
+		"truncated":     resp.Truncated,
+		"answers_count": len(resp.Answer),
+		"answers":       answers,
+	}
+
+	if err := validator(resp); err != nil {
+		return event, reason.ValidateFailed(err)
+	}
+	return event, nil
+}
+
+// answerData formats the data section of a resource record. For record types
+// not handled explicitly, the presentation format as used in zone files is
+// returned.
+func answerData(rr dns.RR) string {
+	switch v := rr.(type) {
+	case *dns.A:
+		return v.A.String()
+	case *dns.AAAA:
+		return v.AAAA.String()
+	case *dns.CNAME:
+		return v.Target
+	case *dns.NS:
+		return v.Ns
+	case *dns.PTR:
+		return v.Ptr
+	case *dns.MX:
+		return fmt.Sprintf("%d %s", v.Preference, v.Mx)
+	case *dns.SRV:
+		return fmt.Sprintf("%d %d %d %s", v.Priority, v.Weight, v.Port, v.Target)
+	case *dns.TXT:
+		return strings.Join(v.Txt, "")
+	default:
+		return strings.TrimSpace(strings.TrimPrefix(rr.String(), rr.Header().String()))
+	}
+}
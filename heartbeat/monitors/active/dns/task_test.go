@@ -0,0 +1,163 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/match"
+
+	"github.com/elastic/beats/heartbeat/reason"
+)
+
+// startServer runs a DNS server on a random UDP port on localhost, answering
+// queries for example.com.
+func startServer(t *testing.T) (string, func()) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	handler := dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+
+		q := req.Question[0]
+		if q.Name != "example.com." {
+			resp.Rcode = dns.RcodeNameError
+			w.WriteMsg(resp)
+			return
+		}
+
+		hdr := func(typ uint16) dns.RR_Header {
+			return dns.RR_Header{Name: q.Name, Rrtype: typ, Class: dns.ClassINET, Ttl: 300}
+		}
+		switch q.Qtype {
+		case dns.TypeA:
+			resp.Answer = []dns.RR{
+				&dns.A{Hdr: hdr(dns.TypeA), A: net.ParseIP("192.0.2.1")},
+				&dns.A{Hdr: hdr(dns.TypeA), A: net.ParseIP("192.0.2.2")},
+			}
+		case dns.TypeMX:
+			resp.Answer = []dns.RR{
+				&dns.MX{Hdr: hdr(dns.TypeMX), Preference: 10, Mx: "mail.example.com."},
+			}
+		}
+		w.WriteMsg(resp)
+	})
+
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        conn,
+		Handler:           handler,
+		NotifyStartedFunc: func() { close(started) },
+	}
+	go server.ActivateAndServe()
+	<-started
+
+	return conn.LocalAddr().String(), func() { server.Shutdown() }
+}
+
+func execQuery(t *testing.T, name, qtype string, check checkConfig) (common.MapStr, error) {
+	addr, stop := startServer(t)
+	defer stop()
+
+	config := DefaultConfig
+	config.QueryName = name
+	config.QueryType = qtype
+	config.Timeout = time.Second
+	config.Check = check
+
+	return newQuery(&config).exec("udp", addr, makeValidateResponse(&config.Check))
+}
+
+func TestQueryAnswers(t *testing.T) {
+	event, err := execQuery(t, "example.com", "A", DefaultConfig.Check)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	rcode, _ := event.GetValue("dns.response.rcode")
+	assert.Equal(t, "NOERROR", rcode)
+
+	count, _ := event.GetValue("dns.response.answers_count")
+	assert.Equal(t, 2, count)
+
+	answers, _ := event.GetValue("dns.response.answers")
+	assert.Equal(t, []common.MapStr{
+		{"name": "example.com.", "type": "A", "ttl": uint32(300), "data": "192.0.2.1"},
+		{"name": "example.com.", "type": "A", "ttl": uint32(300), "data": "192.0.2.2"},
+	}, answers)
+
+	_, err = event.GetValue("dns.rtt.query.us")
+	assert.NoError(t, err)
+}
+
+func TestQueryCheckAnswers(t *testing.T) {
+	check := checkConfig{
+		RCode:   "NOERROR",
+		Answers: []match.Matcher{match.MustCompile(`^10 mail\.example\.com\.$`)},
+	}
+	_, err := execQuery(t, "example.com", "MX", check)
+	assert.NoError(t, err)
+
+	check.Answers = append(check.Answers, match.MustCompile(`backup`))
+	_, err = execQuery(t, "example.com", "MX", check)
+	if assert.Error(t, err) {
+		assert.Equal(t, "validate", err.(reason.Reason).Type())
+		assert.Contains(t, err.Error(), "backup")
+	}
+}
+
+func TestQueryCheckRCode(t *testing.T) {
+	event, err := execQuery(t, "missing.example.com", "A", DefaultConfig.Check)
+	if assert.Error(t, err) {
+		assert.Equal(t, "validate", err.(reason.Reason).Type())
+	}
+	rcode, _ := event.GetValue("dns.response.rcode")
+	assert.Equal(t, "NXDOMAIN", rcode)
+
+	_, err = execQuery(t, "missing.example.com", "A", checkConfig{RCode: "NXDOMAIN"})
+	assert.NoError(t, err)
+}
+
+func TestCollectResolvers(t *testing.T) {
+	config := DefaultConfig
+	config.Hosts = []string{"192.0.2.53", "ns.example.com:5353", "tcp://[2001:db8::1]:53", "2001:db8::2"}
+
+	resolvers, err := collectResolvers(&config)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, []resolver{
+		{Transport: "udp", Host: "192.0.2.53", Port: 53},
+		{Transport: "udp", Host: "ns.example.com", Port: 5353},
+		{Transport: "tcp", Host: "2001:db8::1", Port: 53},
+		{Transport: "udp", Host: "2001:db8::2", Port: 53},
+	}, resolvers)
+
+	config.Hosts = []string{"https://ns.example.com"}
+	_, err = collectResolvers(&config)
+	assert.Error(t, err)
+}
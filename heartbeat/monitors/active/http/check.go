@@ -18,12 +18,16 @@
 package http
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 
+	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/match"
+	"github.com/elastic/beats/libbeat/processors"
 )
 
 type RespCheck func(*http.Response) error
@@ -32,7 +36,7 @@ var (
 	errBodyMismatch = errors.New("body mismatch")
 )
 
-func makeValidateResponse(config *responseParameters) RespCheck {
+func makeValidateResponse(config *responseParameters) (RespCheck, error) {
 	var checks []RespCheck
 
 	if config.Status > 0 {
@@ -49,7 +53,15 @@ func makeValidateResponse(config *responseParameters) RespCheck {
 		checks = append(checks, checkBody(config.RecvBody))
 	}
 
-	return checkAll(checks...)
+	if len(config.RecvJSON) > 0 {
+		check, err := checkJSON(config.RecvJSON)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+
+	return checkAll(checks...), nil
 }
 
 func checkOK(_ *http.Response) error { return nil }
@@ -103,7 +115,7 @@ func checkHeaders(headers map[string]string) RespCheck {
 
 func checkBody(body []match.Matcher) RespCheck {
 	return func(r *http.Response) error {
-		content, err := ioutil.ReadAll(r.Body)
+		content, err := readBody(r)
 		if err != nil {
 			return err
 		}
@@ -115,3 +127,91 @@ func checkBody(body []match.Matcher) RespCheck {
 		return errBodyMismatch
 	}
 }
+
+type jsonCheck struct {
+	description string
+	condition   *processors.Condition
+}
+
+func checkJSON(configs []*jsonResponseParameters) (RespCheck, error) {
+	checks := make([]jsonCheck, len(configs))
+	for i, config := range configs {
+		cond, err := processors.NewCondition(config.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("invalid condition in JSON check '%v': %v", config.Description, err)
+		}
+		description := config.Description
+		if description == "" {
+			description = fmt.Sprintf("json[%d]", i)
+		}
+		checks[i] = jsonCheck{description: description, condition: cond}
+	}
+
+	return func(r *http.Response) error {
+		content, err := readBody(r)
+		if err != nil {
+			return err
+		}
+
+		doc, err := decodeJSONBody(content)
+		if err != nil {
+			return err
+		}
+
+		for _, check := range checks {
+			if !check.condition.Check(doc) {
+				return fmt.Errorf("JSON body did not match check '%v'", check.description)
+			}
+		}
+		return nil
+	}, nil
+}
+
+// decodeJSONBody decodes the response body into a document conditions can be
+// evaluated against. Numbers are converted to int64 if possible, so equals
+// conditions work on integer values.
+func decodeJSONBody(content []byte) (common.MapStr, error) {
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+
+	var doc map[string]interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("could not decode JSON body: %v", err)
+	}
+	return normalizeJSON(doc).(common.MapStr), nil
+}
+
+func normalizeJSON(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		m := make(common.MapStr, len(val))
+		for k, sub := range val {
+			m[k] = normalizeJSON(sub)
+		}
+		return m
+	case []interface{}:
+		for i, sub := range val {
+			val[i] = normalizeJSON(sub)
+		}
+		return val
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		f, _ := val.Float64()
+		return f
+	default:
+		return v
+	}
+}
+
+// readBody reads the complete response body and replaces r.Body with a
+// reader on the buffered content, so multiple checks can read the body.
+func readBody(r *http.Response) ([]byte, error) {
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(content))
+	return content, nil
+}
@@ -24,6 +24,9 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/match"
 )
 
@@ -125,3 +128,128 @@ func TestCheckBody(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckJSON(t *testing.T) {
+	body := `{"status": "ok", "version": "6.4.0", "uptime": 3600.5, "cluster": {"nodes": 3}}`
+
+	var jsonTests = []struct {
+		description string
+		condition   map[string]interface{}
+		result      bool
+	}{
+		{
+			"equals string",
+			map[string]interface{}{"equals": map[string]interface{}{"status": "ok"}},
+			true,
+		},
+		{
+			"equals nested integer",
+			map[string]interface{}{"equals": map[string]interface{}{"cluster.nodes": 3}},
+			true,
+		},
+		{
+			"equals mismatch",
+			map[string]interface{}{"equals": map[string]interface{}{"status": "failed"}},
+			false,
+		},
+		{
+			"range",
+			map[string]interface{}{"range": map[string]interface{}{"uptime.gte": 60}},
+			true,
+		},
+		{
+			"range mismatch",
+			map[string]interface{}{"range": map[string]interface{}{"cluster.nodes.gt": 3}},
+			false,
+		},
+		{
+			"regexp",
+			map[string]interface{}{"regexp": map[string]interface{}{"version": "^6\\."}},
+			true,
+		},
+		{
+			"has_fields",
+			map[string]interface{}{"has_fields": []string{"status", "cluster.nodes"}},
+			true,
+		},
+		{
+			"has_fields missing",
+			map[string]interface{}{"has_fields": []string{"cluster.health"}},
+			false,
+		},
+	}
+
+	for _, test := range jsonTests {
+		t.Run(test.description, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintln(w, body)
+			}))
+			defer ts.Close()
+
+			cfg, err := common.NewConfigFrom(map[string]interface{}{
+				"json": []map[string]interface{}{
+					{"description": test.description, "condition": test.condition},
+				},
+			})
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+			config := responseParameters{}
+			if !assert.NoError(t, cfg.Unpack(&config)) {
+				t.FailNow()
+			}
+
+			check, err := checkJSON(config.RecvJSON)
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+
+			res, err := http.Get(ts.URL)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer res.Body.Close()
+
+			err = check(res)
+			if test.result {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.description)
+			}
+		})
+	}
+}
+
+func TestCheckBodyAndJSON(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"status": "ok"}`)
+	}))
+	defer ts.Close()
+
+	cfg, err := common.NewConfigFrom(map[string]interface{}{
+		"body": "ok",
+		"json": []map[string]interface{}{
+			{"condition": map[string]interface{}{"equals.status": "ok"}},
+		},
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	config := responseParameters{}
+	if !assert.NoError(t, cfg.Unpack(&config)) {
+		t.FailNow()
+	}
+
+	check, err := makeValidateResponse(&config)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	res, err := http.Get(ts.URL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	assert.NoError(t, check(res))
+}
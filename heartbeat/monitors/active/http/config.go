@@ -24,6 +24,7 @@ import (
 
 	"github.com/elastic/beats/libbeat/common/match"
 	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/libbeat/processors"
 
 	"github.com/elastic/beats/heartbeat/monitors"
 )
@@ -50,8 +51,9 @@ type Config struct {
 }
 
 type checkConfig struct {
-	Request  requestParameters  `config:"request"`
-	Response responseParameters `config:"response"`
+	Request     requestParameters     `config:"request"`
+	Response    responseParameters    `config:"response"`
+	Certificate certificateParameters `config:"certificate"`
 }
 
 type requestParameters struct {
@@ -68,9 +70,20 @@ type requestParameters struct {
 
 type responseParameters struct {
 	// expected HTTP response configuration
-	Status      uint16            `config:"status" verify:"min=0, max=699"`
-	RecvHeaders map[string]string `config:"headers"`
-	RecvBody    []match.Matcher   `config:"body"`
+	Status      uint16                    `config:"status" verify:"min=0, max=699"`
+	RecvHeaders map[string]string         `config:"headers"`
+	RecvBody    []match.Matcher           `config:"body"`
+	RecvJSON    []*jsonResponseParameters `config:"json"`
+}
+
+type jsonResponseParameters struct {
+	Description string                      `config:"description"`
+	Condition   *processors.ConditionConfig `config:"condition" validate:"required"`
+}
+
+type certificateParameters struct {
+	// mark check as failed if a certificate expires within the given duration
+	ExpiresWithin time.Duration `config:"expires_within" validate:"min=0"`
 }
 
 type compressionConfig struct {
@@ -99,7 +112,7 @@ var defaultConfig = Config{
 
 func (r *requestParameters) Validate() error {
 	switch strings.ToUpper(r.Method) {
-	case "HEAD", "GET", "POST":
+	case "", "HEAD", "GET", "POST": // no method configured defaults to GET
 	default:
 		return fmt.Errorf("HTTP method '%v' not supported", r.Method)
 	}
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
@@ -32,6 +33,7 @@ import (
 
 func init() {
 	monitors.RegisterActive("http", create)
+	monitors.RegisterActive("http_journey", createJourney)
 }
 
 var debugf = logp.MakeDebug("http")
@@ -70,12 +72,15 @@ func create(
 		body = buf.Bytes()
 	}
 
-	validator := makeValidateResponse(&config.Check.Response)
+	validator, err := makeValidateResponse(&config.Check.Response)
+	if err != nil {
+		return nil, err
+	}
 
 	jobs := make([]monitors.Job, len(config.URLs))
 
 	if config.ProxyURL != "" {
-		transport, err := newRoundTripper(&config, tls)
+		transport, err := newRoundTripper(config.ProxyURL, config.Timeout, tls)
 		if err != nil {
 			return nil, err
 		}
@@ -98,18 +103,22 @@ func create(
 	return jobs, nil
 }
 
-func newRoundTripper(config *Config, tls *transport.TLSConfig) (*http.Transport, error) {
+func newRoundTripper(
+	proxyURL string,
+	timeout time.Duration,
+	tls *transport.TLSConfig,
+) (*http.Transport, error) {
 	var proxy func(*http.Request) (*url.URL, error)
-	if config.ProxyURL != "" {
-		url, err := url.Parse(config.ProxyURL)
+	if proxyURL != "" {
+		url, err := url.Parse(proxyURL)
 		if err != nil {
 			return nil, err
 		}
 		proxy = http.ProxyURL(url)
 	}
 
-	dialer := transport.NetDialer(config.Timeout)
-	tlsDialer, err := transport.TLSDialer(dialer, tls, config.Timeout)
+	dialer := transport.NetDialer(timeout)
+	tlsDialer, err := transport.TLSDialer(dialer, tls, timeout)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,365 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+
+	"github.com/elastic/beats/heartbeat/look"
+	"github.com/elastic/beats/heartbeat/monitors"
+	"github.com/elastic/beats/heartbeat/reason"
+)
+
+// journey executes a list of HTTP requests in order. Cookies and variables
+// extracted from responses are passed on to the following steps.
+type journey struct {
+	steps        []*step
+	variables    map[string]string
+	transport    *http.Transport
+	timeout      time.Duration
+	maxRedirects int
+}
+
+type step struct {
+	name      string
+	config    *journeyStep
+	validator RespCheck
+	extract   []extractor
+}
+
+type extractor struct {
+	variable string
+	fn       func(*http.Response) (string, error)
+}
+
+// varPattern matches variable references like `{{token}}` in URLs, headers
+// and request bodies. The `${...}` syntax is reserved for the configuration
+// file itself.
+var varPattern = regexp.MustCompile(`\{\{\s*([\w.-]+)\s*\}\}`)
+
+func createJourney(
+	info monitors.Info,
+	cfg *common.Config,
+) ([]monitors.Job, error) {
+	config := defaultJourneyConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	tls, err := outputs.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := newJourney(&config, tls)
+	if err != nil {
+		return nil, err
+	}
+
+	jobName := fmt.Sprintf("%v@%v", config.Name, config.Steps[0].URL)
+	settings := monitors.MakeJobSetting(jobName)
+	return []monitors.Job{
+		monitors.MakeSimpleJob(settings, j.run),
+	}, nil
+}
+
+func newJourney(config *journeyConfig, tls *transport.TLSConfig) (*journey, error) {
+	rt, err := newRoundTripper(config.ProxyURL, config.Timeout, tls)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]*step, len(config.Steps))
+	for i := range config.Steps {
+		cfg := &config.Steps[i]
+
+		compression := cfg.Request.Compression
+		if _, err := getContentEncoder(compression.Type, compression.Level); err != nil {
+			return nil, err
+		}
+
+		validator, err := makeValidateResponse(&cfg.Response)
+		if err != nil {
+			return nil, err
+		}
+
+		extract := make([]extractor, len(cfg.Extract))
+		for k, e := range cfg.Extract {
+			extract[k] = extractor{variable: e.Var, fn: makeExtract(e)}
+		}
+
+		steps[i] = &step{
+			name:      cfg.stepName(i),
+			config:    cfg,
+			validator: validator,
+			extract:   extract,
+		}
+	}
+
+	return &journey{
+		steps:        steps,
+		variables:    config.Variables,
+		transport:    rt,
+		timeout:      config.Timeout,
+		maxRedirects: config.MaxRedirects,
+	}, nil
+}
+
+// run executes all steps in order and stops on the first failing step. The
+// event contains the results of all steps executed.
+func (j *journey) run() (common.MapStr, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{
+		CheckRedirect: makeCheckRedirect(j.maxRedirects),
+		Transport:     j.transport,
+		Timeout:       j.timeout,
+		Jar:           jar,
+	}
+
+	vars := make(map[string]string, len(j.variables))
+	for k, v := range j.variables {
+		vars[k] = v
+	}
+
+	var results []common.MapStr
+	var failed reason.Reason
+	for _, s := range j.steps {
+		fields, err := s.exec(client, vars, j.timeout)
+		fields["name"] = s.name
+		fields["status"] = look.Status(err)
+		if err != nil {
+			fields["error"] = reason.Fail(err)
+		}
+		results = append(results, fields)
+
+		if err != nil {
+			failed = wrapStepReason(s.name, err)
+			break
+		}
+	}
+
+	event := common.MapStr{
+		"http_journey": common.MapStr{
+			"steps":       results,
+			"steps_count": len(j.steps),
+		},
+	}
+	if failed != nil {
+		event.Put("http_journey.failed_step", results[len(results)-1]["name"])
+		return event, failed
+	}
+	return event, nil
+}
+
+func (s *step) exec(
+	client *http.Client,
+	vars map[string]string,
+	timeout time.Duration,
+) (common.MapStr, reason.Reason) {
+	fields := common.MapStr{}
+
+	req, body, err := s.buildRequest(vars)
+	if err != nil {
+		return fields, reason.ValidateFailed(err)
+	}
+	fields["url"] = req.URL.String()
+	fields["method"] = req.Method
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req = req.WithContext(ctx)
+	if len(body) > 0 {
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		fields["rtt"] = common.MapStr{"total": look.RTT(time.Since(start))}
+		return fields, reason.IOFailed(err)
+	}
+	defer resp.Body.Close()
+
+	err = s.validator(resp)
+	fields["rtt"] = common.MapStr{"total": look.RTT(time.Since(start))}
+	fields["response"] = common.MapStr{"status_code": resp.StatusCode}
+	if err != nil {
+		return fields, reason.ValidateFailed(err)
+	}
+
+	for _, e := range s.extract {
+		value, err := e.fn(resp)
+		if err != nil {
+			return fields, reason.ValidateFailed(
+				fmt.Errorf("failed to extract variable '%v': %v", e.variable, err))
+		}
+		vars[e.variable] = value
+	}
+	return fields, nil
+}
+
+// buildRequest creates the HTTP request and body for the step, replacing all
+// variable references with the current values.
+func (s *step) buildRequest(vars map[string]string) (*http.Request, []byte, error) {
+	cfg := s.config
+
+	url, err := expandVars(cfg.URL, vars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	method := strings.ToUpper(cfg.Request.Method)
+	if method == "" {
+		method = "GET"
+	}
+
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Close = true
+
+	if cfg.Username != "" {
+		req.SetBasicAuth(cfg.Username, cfg.Password)
+	}
+	for k, v := range cfg.Request.SendHeaders {
+		value, err := expandVars(v, vars)
+		if err != nil {
+			return nil, nil, err
+		}
+		req.Header.Add(k, value)
+	}
+
+	if cfg.Request.SendBody == "" {
+		return req, nil, nil
+	}
+
+	content, err := expandVars(cfg.Request.SendBody, vars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compression := cfg.Request.Compression
+	enc, err := getContentEncoder(compression.Type, compression.Level)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := enc.Encode(buf, strings.NewReader(content)); err != nil {
+		return nil, nil, err
+	}
+	enc.AddHeaders(&req.Header)
+
+	return req, buf.Bytes(), nil
+}
+
+// expandVars replaces all `{{name}}` references in s. Unknown variables
+// result in an error.
+func expandVars(s string, vars map[string]string) (string, error) {
+	var missing []string
+	out := varPattern.ReplaceAllStringFunc(s, func(ref string) string {
+		name := varPattern.FindStringSubmatch(ref)[1]
+		value, ok := vars[name]
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("undefined variables %v", missing)
+	}
+	return out, nil
+}
+
+func makeExtract(cfg extractConfig) func(*http.Response) (string, error) {
+	switch {
+	case cfg.Header != "":
+		header := cfg.Header
+		return func(r *http.Response) (string, error) {
+			if _, exists := r.Header[http.CanonicalHeaderKey(header)]; !exists {
+				return "", fmt.Errorf("header '%v' not found", header)
+			}
+			return r.Header.Get(header), nil
+		}
+
+	case cfg.JSON != "":
+		path := cfg.JSON
+		return func(r *http.Response) (string, error) {
+			content, err := readBody(r)
+			if err != nil {
+				return "", err
+			}
+			doc, err := decodeJSONBody(content)
+			if err != nil {
+				return "", err
+			}
+			value, err := doc.GetValue(path)
+			if err != nil {
+				return "", fmt.Errorf("field '%v' not found in JSON body", path)
+			}
+			return fmt.Sprint(value), nil
+		}
+
+	default:
+		// the regular expression has been validated when loading the config
+		re := regexp.MustCompile(cfg.Regexp)
+		return func(r *http.Response) (string, error) {
+			content, err := readBody(r)
+			if err != nil {
+				return "", err
+			}
+			m := re.FindSubmatch(content)
+			if m == nil {
+				return "", fmt.Errorf("body does not match '%v'", cfg.Regexp)
+			}
+			if len(m) > 1 {
+				return string(m[1]), nil
+			}
+			return string(m[0]), nil
+		}
+	}
+}
+
+// wrapStepReason adds the step name to the error message, keeping the error
+// type of the failed step.
+func wrapStepReason(name string, r reason.Reason) reason.Reason {
+	err := fmt.Errorf("journey step '%v' failed: %v", name, r)
+	if _, ok := r.(reason.ValidateError); ok {
+		return reason.ValidateFailed(err)
+	}
+	return reason.IOFailed(err)
+}
@@ -0,0 +1,113 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/transport/tlscommon"
+)
+
+type journeyConfig struct {
+	Name string `config:"name"`
+
+	ProxyURL     string        `config:"proxy_url"`
+	Timeout      time.Duration `config:"timeout"`
+	MaxRedirects int           `config:"max_redirects"`
+
+	// configure tls (if not configured HTTPS will use system defaults)
+	TLS *tlscommon.Config `config:"ssl"`
+
+	// initial set of variables available to all steps
+	Variables map[string]string `config:"variables"`
+
+	Steps []journeyStep `config:"steps" validate:"required"`
+}
+
+type journeyStep struct {
+	Name string `config:"name"`
+	URL  string `config:"url" validate:"required"`
+
+	// authentication
+	Username string `config:"username"`
+	Password string `config:"password"`
+
+	Request  requestParameters  `config:"request"`
+	Response responseParameters `config:"response"`
+
+	// values to extract from the response into variables
+	Extract []extractConfig `config:"extract"`
+}
+
+type extractConfig struct {
+	Var string `config:"var" validate:"required"`
+
+	// source of the value, exactly one must be configured
+	Header string `config:"header"`
+	JSON   string `config:"json"`
+	Regexp string `config:"regexp"`
+}
+
+var defaultJourneyConfig = journeyConfig{
+	Name:         "http_journey",
+	Timeout:      16 * time.Second,
+	MaxRedirects: 10,
+}
+
+func (c *journeyConfig) Validate() error {
+	names := map[string]bool{}
+	for i := range c.Steps {
+		name := c.Steps[i].stepName(i)
+		if names[name] {
+			return fmt.Errorf("duplicate journey step name '%v'", name)
+		}
+		names[name] = true
+	}
+	return nil
+}
+
+// stepName returns the configured name of the step at index i, or a name
+// derived from the step position if no name is configured.
+func (s *journeyStep) stepName(i int) string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return fmt.Sprintf("step%d", i+1)
+}
+
+func (c *extractConfig) Validate() error {
+	n := 0
+	for _, s := range []string{c.Header, c.JSON, c.Regexp} {
+		if s != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return errors.New("extract requires exactly one of header, json or regexp")
+	}
+
+	if c.Regexp != "" {
+		if _, err := regexp.Compile(c.Regexp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func newJourneyServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+		fmt.Fprintln(w, `{"auth": {"token": "abc"}}`)
+	})
+	mux.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "s1" || r.Header.Get("Authorization") != "Bearer abc" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintln(w, `{"status": "ok"}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func runJourney(t *testing.T, steps []map[string]interface{}) (common.MapStr, error) {
+	cfg, err := common.NewConfigFrom(map[string]interface{}{"steps": steps})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	config := defaultJourneyConfig
+	if !assert.NoError(t, cfg.Unpack(&config)) {
+		t.FailNow()
+	}
+
+	j, err := newJourney(&config, nil)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return j.run()
+}
+
+func TestJourney(t *testing.T) {
+	server := newJourneyServer()
+	defer server.Close()
+
+	event, err := runJourney(t, []map[string]interface{}{
+		{
+			"name":           "login",
+			"url":            server.URL + "/login",
+			"request.method": "POST",
+			"extract": []map[string]interface{}{
+				{"var": "token", "json": "auth.token"},
+			},
+		},
+		{
+			"name":                          "api",
+			"url":                           server.URL + "/api",
+			"request.headers.Authorization": "Bearer {{token}}",
+			"response.status":               200,
+			"response.json": []map[string]interface{}{
+				{"condition": map[string]interface{}{"equals.status": "ok"}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	steps, _ := event.GetValue("http_journey.steps")
+	if assert.Len(t, steps, 2) {
+		for i, name := range []string{"login", "api"} {
+			step := steps.([]common.MapStr)[i]
+			assert.Equal(t, name, step["name"])
+			assert.Equal(t, "up", step["status"])
+			assert.Equal(t, 200, step["response"].(common.MapStr)["status_code"])
+		}
+	}
+}
+
+func TestJourneyFailedStep(t *testing.T) {
+	server := newJourneyServer()
+	defer server.Close()
+
+	// the api step fails, as no token and cookie have been acquired
+	event, err := runJourney(t, []map[string]interface{}{
+		{"name": "api", "url": server.URL + "/api"},
+		{"name": "never", "url": server.URL + "/api"},
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "'api'")
+	}
+
+	failed, _ := event.GetValue("http_journey.failed_step")
+	assert.Equal(t, "api", failed)
+
+	steps, _ := event.GetValue("http_journey.steps")
+	if assert.Len(t, steps, 1) {
+		assert.Equal(t, "down", steps.([]common.MapStr)[0]["status"])
+	}
+}
+
+func TestJourneyExtract(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "42")
+		fmt.Fprintln(w, "next=/item/7")
+	}))
+	defer server.Close()
+
+	_, err := runJourney(t, []map[string]interface{}{
+		{
+			"url": server.URL,
+			"extract": []map[string]interface{}{
+				{"var": "id", "header": "X-Request-Id"},
+				{"var": "path", "regexp": `next=(\S+)`},
+			},
+		},
+		{
+			"url":           server.URL + "{{path}}?id={{id}}",
+			"response.body": "next",
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = runJourney(t, []map[string]interface{}{
+		{"url": server.URL + "/{{missing}}"},
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "missing")
+	}
+}
+
+func TestExpandVars(t *testing.T) {
+	vars := map[string]string{"a": "1", "b.c": "2"}
+
+	s, err := expandVars("x{{a}}-{{ b.c }}", vars)
+	assert.NoError(t, err)
+	assert.Equal(t, "x1-2", s)
+
+	_, err = expandVars("{{a}}{{d}}", vars)
+	assert.Error(t, err)
+}
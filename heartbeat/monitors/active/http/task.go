@@ -145,7 +145,7 @@ func createPingFactory(
 		// TODO: add socks5 proxy?
 
 		if isTLS {
-			d.AddLayer(dialchain.TLSLayer(tls, timeout))
+			d.AddLayer(dialchain.TLSLayer(tls, timeout, config.Check.Certificate.ExpiresWithin))
 		}
 
 		dialer, err := d.Build(event)
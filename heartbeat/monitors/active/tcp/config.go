@@ -46,6 +46,9 @@ type Config struct {
 	// validate connection
 	SendString    string `config:"check.send"`
 	ReceiveString string `config:"check.receive"`
+
+	// mark check as failed if a certificate expires within the given duration
+	CertificateExpiry time.Duration `config:"check.certificate.expires_within" validate:"min=0"`
 }
 
 var DefaultConfig = Config{
@@ -83,6 +83,8 @@ func create(
 			Timeout: timeout,
 			Socks5:  config.Socks5,
 			TLS:     schemeTLS,
+
+			CertificateExpiry: config.CertificateExpiry,
 		})
 		if err != nil {
 			return nil, err
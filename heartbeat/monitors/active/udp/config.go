@@ -0,0 +1,56 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common/match"
+
+	"github.com/elastic/beats/heartbeat/monitors"
+)
+
+type Config struct {
+	Name string `config:"name"`
+
+	// check all ports if host does not contain port
+	Hosts []string `config:"hosts" validate:"required"`
+	Ports []uint16 `config:"ports"`
+
+	Mode monitors.IPSettings `config:",inline"`
+
+	Timeout time.Duration `config:"timeout"`
+
+	// validate response
+	SendString string          `config:"check.send"`
+	Receive    []match.Matcher `config:"check.receive"`
+}
+
+var DefaultConfig = Config{
+	Name:    "udp",
+	Timeout: 16 * time.Second,
+	Mode:    monitors.DefaultIPSettings,
+}
+
+func (c *Config) Validate() error {
+	if c.SendString == "" {
+		return errors.New("check.send is required, as UDP services only respond to requests")
+	}
+	return nil
+}
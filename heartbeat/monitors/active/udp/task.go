@@ -0,0 +1,101 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+
+	"github.com/elastic/beats/heartbeat/look"
+	"github.com/elastic/beats/heartbeat/reason"
+)
+
+// maxDatagramSize is the maximum payload size of an UDP datagram.
+const maxDatagramSize = 65507
+
+type responseCheck func([]byte) error
+
+var errNoDataReceived = errors.New("no data")
+
+func makeCheck(config *Config) responseCheck {
+	matchers := config.Receive
+	return func(response []byte) error {
+		if len(response) == 0 {
+			return errNoDataReceived
+		}
+		for _, m := range matchers {
+			if !m.Match(response) {
+				return fmt.Errorf("response does not match '%v'", m.String())
+			}
+		}
+		return nil
+	}
+}
+
+func pingHost(
+	dialer transport.Dialer,
+	host string,
+	timeout time.Duration,
+	payload []byte,
+	check responseCheck,
+) (common.MapStr, error) {
+	conn, err := dialer.Dial("udp", host)
+	if err != nil {
+		debugf("dial failed with: %v", err)
+		return nil, reason.IOFailed(err)
+	}
+	defer conn.Close()
+
+	start := time.Now()
+	if err := conn.SetDeadline(start.Add(timeout)); err != nil {
+		debugf("setting connection deadline failed with: %v", err)
+		return nil, reason.IOFailed(err)
+	}
+
+	if _, err := conn.Write(payload); err != nil {
+		debugf("sending request failed with: %v", err)
+		return nil, reason.IOFailed(err)
+	}
+
+	buf := make([]byte, maxDatagramSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		debugf("receiving response failed with: %v", err)
+		return nil, reason.IOFailed(err)
+	}
+	end := time.Now()
+
+	event := common.MapStr{
+		"udp": common.MapStr{
+			"rtt": common.MapStr{
+				"validate": look.RTT(end.Sub(start)),
+			},
+			"response": common.MapStr{
+				"size": n,
+			},
+		},
+	}
+	if err := check(buf[:n]); err != nil {
+		return event, reason.ValidateFailed(err)
+	}
+	return event, nil
+}
@@ -0,0 +1,102 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+
+	"github.com/elastic/beats/heartbeat/monitors"
+	"github.com/elastic/beats/heartbeat/monitors/active/dialchain"
+)
+
+func init() {
+	monitors.RegisterActive("udp", create)
+}
+
+var debugf = logp.MakeDebug("udp")
+
+func create(
+	info monitors.Info,
+	cfg *common.Config,
+) ([]monitors.Job, error) {
+	config := DefaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	endpoints, err := collectHosts(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := dialchain.NewBuilder(dialchain.BuilderSettings{
+		Timeout: config.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := config.Timeout
+	payload := []byte(config.SendString)
+	check := makeCheck(&config)
+	return dialchain.MakeDialerJobs(db, config.Name, "udp", endpoints, config.Mode,
+		func(dialer transport.Dialer, addr string) (common.MapStr, error) {
+			return pingHost(dialer, addr, timeout, payload, check)
+		})
+}
+
+func collectHosts(config *Config) ([]dialchain.Endpoint, error) {
+	var endpoints []dialchain.Endpoint
+	for _, h := range config.Hosts {
+		host := h
+		if u, err := url.Parse(h); err == nil && u.Host != "" {
+			if u.Scheme != "udp" {
+				return nil, fmt.Errorf("'%v' is no supported connection scheme in '%v'", u.Scheme, h)
+			}
+			host = u.Host
+		}
+		debugf("Add udp endpoint '%v'.", host)
+
+		pair := strings.SplitN(host, ":", 2)
+		ports := config.Ports
+		if len(pair) == 2 {
+			port, err := strconv.ParseUint(pair[1], 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("'%v' is no valid port number in '%v'", pair[1], h)
+			}
+
+			ports = []uint16{uint16(port)}
+			host = pair[0]
+		} else if len(config.Ports) == 0 {
+			return nil, fmt.Errorf("host '%v' missing port number", h)
+		}
+
+		endpoints = append(endpoints, dialchain.Endpoint{
+			Host:  host,
+			Ports: ports,
+		})
+	}
+	return endpoints, nil
+}
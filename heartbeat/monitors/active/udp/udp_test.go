@@ -0,0 +1,146 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package udp
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/match"
+	"github.com/elastic/beats/libbeat/outputs/transport"
+
+	"github.com/elastic/beats/heartbeat/monitors/active/dialchain"
+	"github.com/elastic/beats/heartbeat/reason"
+)
+
+// startEchoServer runs an UDP server on localhost, answering every request
+// with the request payload.
+func startEchoServer(t *testing.T) (string, func()) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			conn.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	return conn.LocalAddr().String(), func() { conn.Close() }
+}
+
+func ping(t *testing.T, addr, send string, receive ...string) (common.MapStr, error) {
+	config := DefaultConfig
+	config.Timeout = time.Second
+	config.SendString = send
+	for _, pattern := range receive {
+		config.Receive = append(config.Receive, match.MustCompile(pattern))
+	}
+
+	db, err := dialchain.NewBuilder(dialchain.BuilderSettings{Timeout: config.Timeout})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return db.Run(addr, func(dialer transport.Dialer) (common.MapStr, error) {
+		return pingHost(dialer, addr, config.Timeout, []byte(config.SendString), makeCheck(&config))
+	})
+}
+
+func TestPingResponse(t *testing.T) {
+	addr, stop := startEchoServer(t)
+	defer stop()
+
+	event, err := ping(t, addr, "ping", "^pi")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Nil(t, event["error"])
+	size, _ := event.GetValue("udp.response.size")
+	assert.Equal(t, 4, size)
+	_, err = event.GetValue("udp.rtt.validate.us")
+	assert.NoError(t, err)
+}
+
+func TestPingResponseMismatch(t *testing.T) {
+	addr, stop := startEchoServer(t)
+	defer stop()
+
+	event, err := ping(t, addr, "ping", "pong")
+	if assert.Error(t, err) {
+		assert.Equal(t, "validate", err.(reason.Reason).Type())
+		assert.Contains(t, err.Error(), "pong")
+	}
+
+	// response details are reported if validation fails
+	size, _ := event.GetValue("udp.response.size")
+	assert.Equal(t, 4, size)
+}
+
+func TestPingNoResponse(t *testing.T) {
+	// bind a socket, that never answers
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer conn.Close()
+
+	addr := conn.LocalAddr().String()
+	config := DefaultConfig
+	config.SendString = "ping"
+
+	db, err := dialchain.NewBuilder(dialchain.BuilderSettings{Timeout: time.Second})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	_, err = db.Run(addr, func(dialer transport.Dialer) (common.MapStr, error) {
+		return pingHost(dialer, addr, 100*time.Millisecond, []byte("ping"), makeCheck(&config))
+	})
+	assert.Error(t, err)
+}
+
+func TestCollectHosts(t *testing.T) {
+	config := DefaultConfig
+	config.Hosts = []string{"localhost:53", "udp://example.com:123", "other"}
+	config.Ports = []uint16{161}
+
+	endpoints, err := collectHosts(&config)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, []dialchain.Endpoint{
+		{Host: "localhost", Ports: []uint16{53}},
+		{Host: "example.com", Ports: []uint16{123}},
+		{Host: "other", Ports: []uint16{161}},
+	}, endpoints)
+
+	config.Hosts = []string{"tcp://localhost:53"}
+	_, err = collectHosts(&config)
+	assert.Error(t, err)
+}
@@ -26,7 +26,10 @@ modules.
 package defaults
 
 import (
+	_ "github.com/elastic/beats/heartbeat/monitors/active/banner"
+	_ "github.com/elastic/beats/heartbeat/monitors/active/dns"
 	_ "github.com/elastic/beats/heartbeat/monitors/active/http"
 	_ "github.com/elastic/beats/heartbeat/monitors/active/icmp"
 	_ "github.com/elastic/beats/heartbeat/monitors/active/tcp"
+	_ "github.com/elastic/beats/heartbeat/monitors/active/udp"
 )
@@ -0,0 +1,153 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package state tracks the status of monitors between checks, in order to
+// report since when a monitor is up or down, detect flapping monitors and
+// generate transition events.
+package state
+
+import (
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/elastic/beats/heartbeat/look"
+)
+
+// Tracker keeps the state of all monitor endpoints. Endpoints are identified
+// by the monitor ID and the IP address being checked.
+type Tracker struct {
+	mutex  sync.Mutex
+	states map[string]map[string]*State
+
+	window    int
+	threshold int
+}
+
+// State is the current state of a monitor endpoint.
+type State struct {
+	Status   string
+	Since    time.Time
+	Last     time.Time
+	Checks   int
+	Flapping bool
+
+	// status of the last checks, used for flapping detection
+	history []string
+}
+
+// Transition describes a change of the status of a monitor endpoint.
+type Transition struct {
+	From string
+	To   string
+
+	// Duration and number of checks of the previous status.
+	Duration time.Duration
+	Checks   int
+}
+
+// NewTracker creates a new Tracker. A monitor endpoint is flapping if its
+// status changed at least threshold times within the last window checks.
+func NewTracker(window, threshold int) *Tracker {
+	return &Tracker{
+		states:    map[string]map[string]*State{},
+		window:    window,
+		threshold: threshold,
+	}
+}
+
+// Update records the result of a check and returns the new state. A
+// transition is returned if the status differs from the previous check.
+func (t *Tracker) Update(id, ip, status string, ts time.Time) (State, *Transition) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	endpoints := t.states[id]
+	if endpoints == nil {
+		endpoints = map[string]*State{}
+		t.states[id] = endpoints
+	}
+
+	st := endpoints[ip]
+	if st == nil {
+		st = &State{Status: status, Since: ts}
+		endpoints[ip] = st
+	}
+
+	var trans *Transition
+	if st.Status != status {
+		trans = &Transition{
+			From:     st.Status,
+			To:       status,
+			Duration: ts.Sub(st.Since),
+			Checks:   st.Checks,
+		}
+		st.Status = status
+		st.Since = ts
+		st.Checks = 0
+	}
+
+	st.Checks++
+	st.Last = ts
+	st.history = append(st.history, status)
+	if len(st.history) > t.window {
+		st.history = st.history[len(st.history)-t.window:]
+	}
+	st.Flapping = changes(st.history) >= t.threshold
+
+	return *st, trans
+}
+
+// Remove drops the state of all endpoints of a monitor.
+func (t *Tracker) Remove(id string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.states, id)
+}
+
+func changes(history []string) int {
+	n := 0
+	for i := 1; i < len(history); i++ {
+		if history[i] != history[i-1] {
+			n++
+		}
+	}
+	return n
+}
+
+// Fields returns the state as event fields.
+func (s *State) Fields() common.MapStr {
+	return common.MapStr{
+		"since":    look.Timestamp(s.Since),
+		"duration": look.RTT(s.Last.Sub(s.Since)),
+		"checks":   s.Checks,
+		"flapping": s.Flapping,
+	}
+}
+
+// Fields returns the transition as event fields.
+func (t *Transition) Fields() common.MapStr {
+	return common.MapStr{
+		"from": t.From,
+		"to":   t.To,
+		"previous": common.MapStr{
+			"duration": look.RTT(t.Duration),
+			"checks":   t.Checks,
+		},
+	}
+}
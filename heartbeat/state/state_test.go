@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrackerTransitions(t *testing.T) {
+	tracker := NewTracker(10, 4)
+	start := time.Now()
+	at := func(i int) time.Time { return start.Add(time.Duration(i) * time.Second) }
+
+	st, trans := tracker.Update("http@x", "127.0.0.1", "up", at(0))
+	assert.Nil(t, trans)
+	assert.Equal(t, "up", st.Status)
+	assert.Equal(t, at(0), st.Since)
+	assert.Equal(t, 1, st.Checks)
+
+	st, trans = tracker.Update("http@x", "127.0.0.1", "up", at(1))
+	assert.Nil(t, trans)
+	assert.Equal(t, at(0), st.Since)
+	assert.Equal(t, 2, st.Checks)
+
+	st, trans = tracker.Update("http@x", "127.0.0.1", "down", at(2))
+	if !assert.NotNil(t, trans) {
+		t.FailNow()
+	}
+	assert.Equal(t, "up", trans.From)
+	assert.Equal(t, "down", trans.To)
+	assert.Equal(t, 2*time.Second, trans.Duration)
+	assert.Equal(t, 2, trans.Checks)
+	assert.Equal(t, "down", st.Status)
+	assert.Equal(t, at(2), st.Since)
+	assert.Equal(t, 1, st.Checks)
+	assert.False(t, st.Flapping)
+}
+
+func TestTrackerEndpoints(t *testing.T) {
+	tracker := NewTracker(10, 4)
+	now := time.Now()
+
+	tracker.Update("tcp@x", "10.0.0.1", "up", now)
+	_, trans := tracker.Update("tcp@x", "10.0.0.2", "down", now)
+	assert.Nil(t, trans, "endpoints of a monitor must be tracked separately")
+
+	tracker.Remove("tcp@x")
+	st, trans := tracker.Update("tcp@x", "10.0.0.1", "down", now)
+	assert.Nil(t, trans, "state must be reset after removing the monitor")
+	assert.Equal(t, 1, st.Checks)
+}
+
+func TestTrackerFlapping(t *testing.T) {
+	tracker := NewTracker(4, 2)
+	now := time.Now()
+
+	var st State
+	for _, status := range []string{"up", "down", "up"} {
+		st, _ = tracker.Update("icmp@x", "10.0.0.1", status, now)
+	}
+	assert.True(t, st.Flapping)
+
+	// the status changes drop out of the window
+	for i := 0; i < 3; i++ {
+		st, _ = tracker.Update("icmp@x", "10.0.0.1", "up", now)
+	}
+	assert.False(t, st.Flapping)
+}
+
+func TestFields(t *testing.T) {
+	tracker := NewTracker(10, 4)
+	start := time.Now()
+
+	tracker.Update("http@x", "", "down", start)
+	st, trans := tracker.Update("http@x", "", "up", start.Add(3*time.Second))
+	st, _ = tracker.Update("http@x", "", "up", start.Add(5*time.Second))
+
+	fields := st.Fields()
+	assert.Equal(t, 2, fields["checks"])
+	assert.Equal(t, false, fields["flapping"])
+	duration, _ := fields.GetValue("duration.us")
+	assert.EqualValues(t, 2000000, duration)
+
+	fields = trans.Fields()
+	assert.Equal(t, "down", fields["from"])
+	assert.Equal(t, "up", fields["to"])
+	checks, _ := fields.GetValue("previous.checks")
+	assert.Equal(t, 1, checks)
+	duration, _ = fields.GetValue("previous.duration.us")
+	assert.EqualValues(t, 3000000, duration)
+}
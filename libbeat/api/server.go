@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/common/cfgwarn"
@@ -29,6 +30,28 @@ import (
 	"github.com/elastic/beats/libbeat/monitoring"
 )
 
+var (
+	handlersMutex sync.Mutex
+	handlers      = map[string]http.HandlerFunc{}
+)
+
+// AddHandler registers an additional handler for the given path on the
+// metrics api endpoint. Handlers must be added before the endpoint is started.
+func AddHandler(path string, handler http.HandlerFunc) error {
+	handlersMutex.Lock()
+	defer handlersMutex.Unlock()
+
+	switch path {
+	case "/", "/stats", "/dataset":
+		return fmt.Errorf("path '%s' is reserved", path)
+	}
+	if _, exists := handlers[path]; exists {
+		return fmt.Errorf("handler for path '%s' already registered", path)
+	}
+	handlers[path] = handler
+	return nil
+}
+
 // Start starts the metrics api endpoint on the configured host and port
 func Start(cfg *common.Config) {
 	cfgwarn.Experimental("Metrics endpoint is enabled.")
@@ -44,6 +67,12 @@ func Start(cfg *common.Config) {
 		mux.HandleFunc("/stats", statsHandler)
 		mux.HandleFunc("/dataset", datasetHandler)
 
+		handlersMutex.Lock()
+		for path, handler := range handlers {
+			mux.HandleFunc(path, handler)
+		}
+		handlersMutex.Unlock()
+
 		url := config.Host + ":" + strconv.Itoa(config.Port)
 		logp.Info("Metrics endpoint listening on: %s", url)
 		endpoint := http.ListenAndServe(url, mux)
@@ -24,22 +24,33 @@ type Endpoint struct {
 	Name    string
 	Cmdline string
 	Proc    string
+
+	// Tunnel the endpoint was reached through, if any. It is published
+	// as part of the event, not of the endpoint.
+	Tunnel Tunnel `json:"-"`
+
+	// Interface the endpoint was seen on, if known.
+	Interface string `json:"-"`
 }
 
 // MakeEndpointPair returns source and destination endpoints from a TCP or IP tuple
 // and a command-line tuple.
 func MakeEndpointPair(tuple BaseTuple, cmdlineTuple *CmdlineTuple) (src Endpoint, dst Endpoint) {
 	src = Endpoint{
-		IP:      tuple.SrcIP.String(),
-		Port:    tuple.SrcPort,
-		Proc:    string(cmdlineTuple.Src),
-		Cmdline: string(cmdlineTuple.SrcCommand),
+		IP:        tuple.SrcIP.String(),
+		Port:      tuple.SrcPort,
+		Proc:      string(cmdlineTuple.Src),
+		Cmdline:   string(cmdlineTuple.SrcCommand),
+		Tunnel:    tuple.Tunnel,
+		Interface: tuple.Interface,
 	}
 	dst = Endpoint{
-		IP:      tuple.DstIP.String(),
-		Port:    tuple.DstPort,
-		Proc:    string(cmdlineTuple.Dst),
-		Cmdline: string(cmdlineTuple.DstCommand),
+		IP:        tuple.DstIP.String(),
+		Port:      tuple.DstPort,
+		Proc:      string(cmdlineTuple.Dst),
+		Cmdline:   string(cmdlineTuple.DstCommand),
+		Tunnel:    tuple.Tunnel,
+		Interface: tuple.Interface,
 	}
 	return src, dst
 }
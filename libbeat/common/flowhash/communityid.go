@@ -0,0 +1,180 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package flowhash computes flow hashes that are interoperable with other
+// network monitoring tools.
+package flowhash
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"net"
+	"strings"
+)
+
+// IP protocol numbers of the transports supported by the Community ID.
+const (
+	ICMP   uint8 = 1
+	TCP    uint8 = 6
+	UDP    uint8 = 17
+	ICMPv6 uint8 = 58
+	SCTP   uint8 = 132
+)
+
+var transportNames = map[string]uint8{
+	"icmp":      ICMP,
+	"tcp":       TCP,
+	"udp":       UDP,
+	"icmpv6":    ICMPv6,
+	"ipv6-icmp": ICMPv6,
+	"sctp":      SCTP,
+}
+
+// TransportNumber returns the IP protocol number of a transport name like
+// tcp or udp.
+func TransportNumber(name string) (uint8, bool) {
+	proto, found := transportNames[strings.ToLower(name)]
+	return proto, found
+}
+
+// Flow holds the addresses and transport of a flow. For ICMP and ICMPv6 flows
+// the ports are not used, the message type and code are used instead.
+type Flow struct {
+	SourceIP, DestinationIP     net.IP
+	SourcePort, DestinationPort uint16
+	Protocol                    uint8
+	ICMP                        struct{ Type, Code uint8 }
+}
+
+// CommunityID computes version 1 of the Community ID flow hash, as used by
+// Zeek and Suricata. See https://github.com/corelight/community-id-spec.
+type CommunityID struct {
+	seed uint16
+}
+
+const communityIDVersion = "1:"
+
+// Mapping of ICMP message types to the type of the message sent in the other
+// direction. Types not found here are considered one-way messages.
+var (
+	icmpCounterparts = map[uint8]uint8{
+		8:  0,  // echo request
+		0:  8,  // echo reply
+		13: 14, // timestamp
+		14: 13, // timestamp reply
+		15: 16, // information request
+		16: 15, // information reply
+		10: 9,  // router solicitation
+		9:  10, // router advertisement
+		17: 18, // address mask request
+		18: 17, // address mask reply
+	}
+
+	icmp6Counterparts = map[uint8]uint8{
+		128: 129, // echo request
+		129: 128, // echo reply
+		130: 131, // multicast listener query
+		131: 130, // multicast listener report
+		133: 134, // router solicitation
+		134: 133, // router advertisement
+		135: 136, // neighbor solicitation
+		136: 135, // neighbor advertisement
+		139: 140, // who are you request
+		140: 139, // who are you reply
+		144: 145, // home agent address discovery request
+		145: 144, // home agent address discovery reply
+	}
+)
+
+// NewCommunityID creates a Community ID hasher using the given seed. Zeek and
+// Suricata use a seed of 0 by default.
+func NewCommunityID(seed uint16) *CommunityID {
+	return &CommunityID{seed: seed}
+}
+
+// Hash returns the Community ID of the flow. An empty string is returned if
+// the flow addresses are missing or of different families.
+func (c *CommunityID) Hash(flow Flow) string {
+	src, dst := normalizeIP(flow.SourceIP), normalizeIP(flow.DestinationIP)
+	if src == nil || dst == nil || len(src) != len(dst) {
+		return ""
+	}
+
+	srcPort, dstPort := flow.SourcePort, flow.DestinationPort
+	hasPorts, oneWay := false, false
+	switch flow.Protocol {
+	case TCP, UDP, SCTP:
+		hasPorts = true
+	case ICMP, ICMPv6:
+		hasPorts = true
+		counterparts := icmpCounterparts
+		if flow.Protocol == ICMPv6 {
+			counterparts = icmp6Counterparts
+		}
+
+		srcPort = uint16(flow.ICMP.Type)
+		if other, found := counterparts[flow.ICMP.Type]; found {
+			dstPort = uint16(other)
+		} else {
+			dstPort = uint16(flow.ICMP.Code)
+			oneWay = true
+		}
+	}
+
+	// Both directions of a flow must produce the same hash, so the endpoints
+	// are ordered, unless the flow is one-way.
+	if !oneWay && !endpointsOrdered(src, dst, srcPort, dstPort) {
+		src, dst = dst, src
+		srcPort, dstPort = dstPort, srcPort
+	}
+
+	buf := make([]byte, 0, 2+2*len(src)+2+4)
+	buf = appendUint16(buf, c.seed)
+	buf = append(buf, src...)
+	buf = append(buf, dst...)
+	buf = append(buf, flow.Protocol, 0)
+	if hasPorts {
+		buf = appendUint16(buf, srcPort)
+		buf = appendUint16(buf, dstPort)
+	}
+
+	sum := sha1.Sum(buf)
+	return communityIDVersion + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func normalizeIP(ip net.IP) net.IP {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip.To16()
+}
+
+func endpointsOrdered(src, dst net.IP, srcPort, dstPort uint16) bool {
+	for i := range src {
+		if src[i] != dst[i] {
+			return src[i] < dst[i]
+		}
+	}
+	return srcPort <= dstPort
+}
+
+func appendUint16(buf []byte, v uint16) []byte {
+	var tmp [2]byte
+	binary.BigEndian.PutUint16(tmp[:], v)
+	return append(buf, tmp[:]...)
+}
@@ -0,0 +1,121 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package flowhash
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommunityID(t *testing.T) {
+	flow := func(proto uint8, src, dst string, sport, dport uint16) Flow {
+		return Flow{
+			SourceIP:        net.ParseIP(src),
+			DestinationIP:   net.ParseIP(dst),
+			SourcePort:      sport,
+			DestinationPort: dport,
+			Protocol:        proto,
+		}
+	}
+	icmp := func(proto uint8, src, dst string, typ, code uint8) Flow {
+		f := flow(proto, src, dst, 0, 0)
+		f.ICMP.Type, f.ICMP.Code = typ, code
+		return f
+	}
+
+	tests := []struct {
+		name     string
+		flow     Flow
+		expected string
+	}{
+		{
+			"tcp",
+			flow(TCP, "128.232.110.120", "66.35.250.204", 34855, 80),
+			"1:LQU9qZlK+B5F3KDmev6m5PMibrg=",
+		},
+		{
+			"tcp reversed",
+			flow(TCP, "66.35.250.204", "128.232.110.120", 80, 34855),
+			"1:LQU9qZlK+B5F3KDmev6m5PMibrg=",
+		},
+		{
+			"udp",
+			flow(UDP, "192.168.1.52", "8.8.8.8", 54585, 53),
+			"1:d/FP5EW3wiY1vCndhwleRRKHowQ=",
+		},
+		{
+			"sctp",
+			flow(SCTP, "192.168.170.8", "192.168.170.56", 7, 80),
+			"1:jQgCxbku+pNGw8WPbEc/TS/uTpQ=",
+		},
+		{
+			"icmp echo request",
+			icmp(ICMP, "192.168.0.89", "192.168.0.1", 8, 0),
+			"1:X0snYXpgwiv9TZtqg64sgzUn6Dk=",
+		},
+		{
+			"icmp echo reply",
+			icmp(ICMP, "192.168.0.1", "192.168.0.89", 0, 0),
+			"1:X0snYXpgwiv9TZtqg64sgzUn6Dk=",
+		},
+		{
+			"icmpv6 neighbor solicitation",
+			icmp(ICMPv6, "fe80::200:86ff:fe05:80da", "fe80::260:97ff:fe07:69ea", 135, 0),
+			"1:dGHyGvjMfljg6Bppwm3bg0LO8TY=",
+		},
+	}
+
+	hasher := NewCommunityID(0)
+	for _, test := range tests {
+		assert.Equal(t, test.expected, hasher.Hash(test.flow), test.name)
+	}
+}
+
+func TestCommunityIDSeed(t *testing.T) {
+	flow := Flow{
+		SourceIP:        net.ParseIP("128.232.110.120"),
+		DestinationIP:   net.ParseIP("66.35.250.204"),
+		SourcePort:      34855,
+		DestinationPort: 80,
+		Protocol:        TCP,
+	}
+	assert.NotEqual(t, NewCommunityID(0).Hash(flow), NewCommunityID(1).Hash(flow))
+}
+
+func TestCommunityIDInvalid(t *testing.T) {
+	hasher := NewCommunityID(0)
+	assert.Equal(t, "", hasher.Hash(Flow{Protocol: TCP}))
+	assert.Equal(t, "", hasher.Hash(Flow{
+		SourceIP:      net.ParseIP("10.0.0.1"),
+		DestinationIP: net.ParseIP("fe80::1"),
+		Protocol:      UDP,
+	}))
+}
+
+func TestTransportNumber(t *testing.T) {
+	proto, found := TransportNumber("TCP")
+	assert.True(t, found)
+	assert.Equal(t, TCP, proto)
+
+	_, found = TransportNumber("gre")
+	assert.False(t, found)
+}
@@ -35,6 +35,61 @@ type HashableIPPortTuple [MaxIPPortTupleRawSize]byte
 type BaseTuple struct {
 	SrcIP, DstIP     net.IP
 	SrcPort, DstPort uint16
+
+	// Tunnel the packets were encapsulated in, not part of the hashables.
+	Tunnel Tunnel
+
+	// Interface the packets were captured on, not part of the hashables.
+	Interface string
+}
+
+// TunnelType is the encapsulation protocol of a tunnel.
+type TunnelType uint8
+
+const (
+	TunnelNone TunnelType = iota
+	TunnelGRE
+	TunnelVXLAN
+	TunnelGENEVE
+	TunnelMPLS
+)
+
+var tunnelTypeNames = []string{
+	TunnelNone:   "",
+	TunnelGRE:    "gre",
+	TunnelVXLAN:  "vxlan",
+	TunnelGENEVE: "geneve",
+	TunnelMPLS:   "mpls",
+}
+
+func (t TunnelType) String() string {
+	if int(t) < len(tunnelTypeNames) {
+		return tunnelTypeNames[t]
+	}
+	return fmt.Sprintf("tunnel(%d)", uint8(t))
+}
+
+// Tunnel identifies the outermost tunnel a packet was received through.
+type Tunnel struct {
+	Type TunnelType
+
+	// ID is the VXLAN or GENEVE VNI, the GRE key or the MPLS label. HasID is
+	// false if the tunnel has no identifier, as GRE keys are optional.
+	ID    uint32
+	HasID bool
+}
+
+// Fields returns the tunnel information as event fields, or nil if the
+// packets were not encapsulated.
+func (t Tunnel) Fields() MapStr {
+	if t.Type == TunnelNone {
+		return nil
+	}
+	fields := MapStr{"type": t.Type.String()}
+	if t.HasID {
+		fields["id"] = t.ID
+	}
+	return fields
 }
 
 type IPPortTuple struct {
@@ -112,10 +167,12 @@ func TCPTupleFromIPPort(t *IPPortTuple, streamID uint32) TCPTuple {
 	tuple := TCPTuple{
 		IPLength: t.IPLength,
 		BaseTuple: BaseTuple{
-			SrcIP:   t.SrcIP,
-			DstIP:   t.DstIP,
-			SrcPort: t.SrcPort,
-			DstPort: t.DstPort,
+			SrcIP:     t.SrcIP,
+			DstIP:     t.DstIP,
+			SrcPort:   t.SrcPort,
+			DstPort:   t.DstPort,
+			Tunnel:    t.Tunnel,
+			Interface: t.Interface,
 		},
 		StreamID: streamID,
 	}
@@ -146,6 +203,8 @@ func (t TCPTuple) String() string {
 func (t TCPTuple) IPPort() *IPPortTuple {
 	ipport := NewIPPortTuple(t.IPLength, t.SrcIP, t.SrcPort,
 		t.DstIP, t.DstPort)
+	ipport.Tunnel = t.Tunnel
+	ipport.Interface = t.Interface
 	return &ipport
 }
 
@@ -88,3 +88,42 @@ func TestTuples_tuples_ipv6(t *testing.T) {
 	assert.Equal(tuple.raw[:], tcpTuple.raw[0:36], "Wrong TCP tuple hashable")
 	assert.Equal([]byte{0, 0, 0, 1}, tcpTuple.raw[36:40], "stream_id")
 }
+
+func TestTuples_tunnel(t *testing.T) {
+	tuple := NewIPPortTuple(4, net.IPv4(192, 168, 0, 1), 9200, net.IPv4(192, 168, 0, 2), 9201)
+	raw := tuple.Hashable()
+	assert.Nil(t, tuple.Tunnel.Fields())
+
+	tuple.Tunnel = Tunnel{Type: TunnelVXLAN, ID: 42, HasID: true}
+	tuple.ComputeHashebles()
+	assert.Equal(t, raw, tuple.Hashable(), "tunnel must not change the hashable")
+	assert.Equal(t, MapStr{"type": "vxlan", "id": uint32(42)}, tuple.Tunnel.Fields())
+
+	tcpTuple := TCPTupleFromIPPort(&tuple, 1)
+	assert.Equal(t, tuple.Tunnel, tcpTuple.Tunnel)
+	assert.Equal(t, tuple.Tunnel, tcpTuple.IPPort().Tunnel)
+
+	src, dst := MakeEndpointPair(tcpTuple.BaseTuple, &CmdlineTuple{})
+	assert.Equal(t, tuple.Tunnel, src.Tunnel)
+	assert.Equal(t, tuple.Tunnel, dst.Tunnel)
+
+	gre := Tunnel{Type: TunnelGRE}
+	assert.Equal(t, MapStr{"type": "gre"}, gre.Fields())
+}
+
+func TestTuples_interface(t *testing.T) {
+	tuple := NewIPPortTuple(4, net.IPv4(192, 168, 0, 1), 9200, net.IPv4(192, 168, 0, 2), 9201)
+	raw := tuple.Hashable()
+
+	tuple.Interface = "eth1"
+	tuple.ComputeHashebles()
+	assert.Equal(t, raw, tuple.Hashable(), "interface must not change the hashable")
+
+	tcpTuple := TCPTupleFromIPPort(&tuple, 1)
+	assert.Equal(t, "eth1", tcpTuple.Interface)
+	assert.Equal(t, "eth1", tcpTuple.IPPort().Interface)
+
+	src, dst := MakeEndpointPair(tcpTuple.BaseTuple, &CmdlineTuple{})
+	assert.Equal(t, "eth1", src.Interface)
+	assert.Equal(t, "eth1", dst.Interface)
+}
@@ -0,0 +1,210 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package communityid
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/common/flowhash"
+	"github.com/elastic/beats/libbeat/processors"
+)
+
+const processorName = "community_id"
+
+func init() {
+	processors.RegisterPlugin(processorName, New)
+}
+
+type processor struct {
+	config
+	hasher *flowhash.CommunityID
+}
+
+// New constructs a processor that computes the Community ID flow hash of an
+// event from its addresses and transport fields. The hash is the same as the
+// one computed by Packetbeat, Zeek and Suricata for the same flow.
+func New(cfg *common.Config) (processors.Processor, error) {
+	cfgwarn.Beta("Beta community_id processor is used.")
+
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+	if c.Target == "" {
+		return nil, errors.Errorf("%v target field must not be empty", processorName)
+	}
+
+	return &processor{
+		config: c,
+		hasher: flowhash.NewCommunityID(c.Seed),
+	}, nil
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("%v=[target=%v, seed=%v]", processorName, p.Target, p.Seed)
+}
+
+// Run adds the Community ID to the event. Events without the required fields
+// are returned unchanged.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	flow, ok := p.buildFlow(event)
+	if !ok {
+		return event, nil
+	}
+
+	id := p.hasher.Hash(flow)
+	if id == "" {
+		return event, nil
+	}
+
+	if _, err := event.PutValue(p.Target, id); err != nil {
+		return event, errors.Wrapf(err, "failed to set %v field", p.Target)
+	}
+	return event, nil
+}
+
+func (p *processor) buildFlow(event *beat.Event) (flowhash.Flow, bool) {
+	var flow flowhash.Flow
+
+	flow.SourceIP = ipValue(event, p.Fields.SourceIP)
+	flow.DestinationIP = ipValue(event, p.Fields.DestinationIP)
+	if flow.SourceIP == nil || flow.DestinationIP == nil {
+		return flow, false
+	}
+
+	v, err := event.GetValue(p.Fields.Transport)
+	if err != nil {
+		return flow, false
+	}
+	proto, ok := transportValue(v)
+	if !ok {
+		return flow, false
+	}
+	flow.Protocol = proto
+
+	switch proto {
+	case flowhash.TCP, flowhash.UDP, flowhash.SCTP:
+		srcPort, ok := uintValue(event, p.Fields.SourcePort, math.MaxUint16)
+		if !ok {
+			return flow, false
+		}
+		dstPort, ok := uintValue(event, p.Fields.DestinationPort, math.MaxUint16)
+		if !ok {
+			return flow, false
+		}
+		flow.SourcePort, flow.DestinationPort = uint16(srcPort), uint16(dstPort)
+
+	case flowhash.ICMP, flowhash.ICMPv6:
+		// Some tools, like Zeek, report the ICMP type and code as ports.
+		typ, ok := uintValue(event, p.Fields.ICMPType, math.MaxUint8)
+		if !ok {
+			if typ, ok = uintValue(event, p.Fields.SourcePort, math.MaxUint8); !ok {
+				return flow, false
+			}
+		}
+		code, ok := uintValue(event, p.Fields.ICMPCode, math.MaxUint8)
+		if !ok {
+			if code, ok = uintValue(event, p.Fields.DestinationPort, math.MaxUint8); !ok {
+				return flow, false
+			}
+		}
+		flow.ICMP.Type, flow.ICMP.Code = uint8(typ), uint8(code)
+	}
+
+	return flow, true
+}
+
+func ipValue(event *beat.Event, field string) net.IP {
+	v, err := event.GetValue(field)
+	if err != nil {
+		return nil
+	}
+
+	switch ip := v.(type) {
+	case net.IP:
+		return ip
+	case string:
+		return net.ParseIP(ip)
+	}
+	return nil
+}
+
+// transportValue accepts a transport name like tcp or an IP protocol number.
+func transportValue(v interface{}) (uint8, bool) {
+	if name, ok := v.(string); ok {
+		if proto, found := flowhash.TransportNumber(name); found {
+			return proto, true
+		}
+	}
+
+	n, ok := toUint(v, math.MaxUint8)
+	return uint8(n), ok
+}
+
+func uintValue(event *beat.Event, field string, max uint64) (uint64, bool) {
+	v, err := event.GetValue(field)
+	if err != nil {
+		return 0, false
+	}
+	return toUint(v, max)
+}
+
+func toUint(v interface{}, max uint64) (uint64, bool) {
+	var n uint64
+	switch x := v.(type) {
+	case int:
+		if x < 0 {
+			return 0, false
+		}
+		n = uint64(x)
+	case int64:
+		if x < 0 {
+			return 0, false
+		}
+		n = uint64(x)
+	case uint8:
+		n = uint64(x)
+	case uint16:
+		n = uint64(x)
+	case uint32:
+		n = uint64(x)
+	case uint64:
+		n = x
+	case float64:
+		if x < 0 || x != math.Trunc(x) {
+			return 0, false
+		}
+		n = uint64(x)
+	case string:
+		var err error
+		if n, err = strconv.ParseUint(x, 10, 64); err != nil {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	return n, n <= max
+}
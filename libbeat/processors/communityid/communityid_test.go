@@ -0,0 +1,126 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package communityid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func runProcessor(t *testing.T, cfg common.MapStr, fields common.MapStr) common.MapStr {
+	c, err := common.NewConfigFrom(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := p.Run(&beat.Event{Fields: fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return event.Fields
+}
+
+func TestRunTCP(t *testing.T) {
+	fields := runProcessor(t, nil, common.MapStr{
+		"source":      common.MapStr{"ip": "128.232.110.120", "port": 34855},
+		"destination": common.MapStr{"ip": "66.35.250.204", "port": 80},
+		"network":     common.MapStr{"transport": "tcp"},
+	})
+
+	id, _ := fields.GetValue("network.community_id")
+	assert.Equal(t, "1:LQU9qZlK+B5F3KDmev6m5PMibrg=", id)
+}
+
+func TestRunProtocolNumber(t *testing.T) {
+	fields := runProcessor(t, nil, common.MapStr{
+		"source":      common.MapStr{"ip": "192.168.1.52", "port": float64(54585)},
+		"destination": common.MapStr{"ip": "8.8.8.8", "port": "53"},
+		"network":     common.MapStr{"transport": 17},
+	})
+
+	id, _ := fields.GetValue("network.community_id")
+	assert.Equal(t, "1:d/FP5EW3wiY1vCndhwleRRKHowQ=", id)
+}
+
+func TestRunICMPAsPorts(t *testing.T) {
+	// Zeek reports the ICMP type and code as ports.
+	fields := runProcessor(t,
+		common.MapStr{
+			"fields": common.MapStr{
+				"source_ip":        "id.orig_h",
+				"source_port":      "id.orig_p",
+				"destination_ip":   "id.resp_h",
+				"destination_port": "id.resp_p",
+				"transport":        "proto",
+			},
+			"target": "community_id",
+		},
+		common.MapStr{
+			"id": common.MapStr{
+				"orig_h": "192.168.0.89",
+				"orig_p": 8,
+				"resp_h": "192.168.0.1",
+				"resp_p": 0,
+			},
+			"proto": "icmp",
+		})
+
+	assert.Equal(t, "1:X0snYXpgwiv9TZtqg64sgzUn6Dk=", fields["community_id"])
+}
+
+func TestRunMissingFields(t *testing.T) {
+	fields := runProcessor(t, nil, common.MapStr{
+		"source":  common.MapStr{"ip": "128.232.110.120", "port": 34855},
+		"network": common.MapStr{"transport": "tcp"},
+	})
+
+	_, err := fields.GetValue("network.community_id")
+	assert.Error(t, err)
+
+	fields = runProcessor(t, nil, common.MapStr{
+		"source":      common.MapStr{"ip": "128.232.110.120", "port": 70000},
+		"destination": common.MapStr{"ip": "66.35.250.204", "port": 80},
+		"network":     common.MapStr{"transport": "tcp"},
+	})
+
+	_, err = fields.GetValue("network.community_id")
+	assert.Error(t, err)
+}
+
+func TestRunSeed(t *testing.T) {
+	event := func() common.MapStr {
+		return common.MapStr{
+			"source":      common.MapStr{"ip": "128.232.110.120", "port": 34855},
+			"destination": common.MapStr{"ip": "66.35.250.204", "port": 80},
+			"network":     common.MapStr{"transport": "tcp"},
+		}
+	}
+
+	unseeded, _ := runProcessor(t, nil, event()).GetValue("network.community_id")
+	seeded, _ := runProcessor(t, common.MapStr{"seed": 123}, event()).GetValue("network.community_id")
+	assert.NotEqual(t, unseeded, seeded)
+}
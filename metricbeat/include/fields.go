@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzs/WtzHLmRN4q/96dA6B//GGqX6pmxx/YTc2I3jobSeLTWhY9I2eeJjY0WugrdDbMKqAFQbPbE+fAnkADqCtStq5qULb6wR2R35g8JIJGZSGT+7gW6I8cf0YZg9TuEFFUJ+RH9ZP4VExkJminK2Y/oP3+HEEJXnClMmUQRT1PO4HtoS0kSS4TvMU3wJiGIMoSTBJF7whRSx4zI1e+Q/diPvwNCLxDDKTGMV/o/4bdenvrndk/gC4hvkdoTQIgkYTFlO/hFwncoJVLiHZEr9KbyKfgalQUpSZQGqP8ecbalu1xgzQ5taUIu9e/1H7FC9zjJ9TdRLkkMNKnS/2RcVYnBV9CeS2U52c/fcmBVw3Gp/wa/+qz/+bmgw2HEYVyrttAcx37BFdiwRIKoXDASo80RWPGMaDZsh+RRKpIiztBhT6N9CbwiO5EzRtnOg0bRlPzG2QA07pNLorknQlLO+sHYD7plBcsZJn9HmIZCYqT2VJqlvKov3Wf/tx6KVDjNnlmieq3/iGKsnBwE+TWngsQ/IiVy98stFylWtc+RB5xmeuu9zHe5VOj3f1J79Pvvvv/TJfr+9z/+4Y8//vEPqz/84ffDpAuQ0MEsZGK3od4ggkRcxOiAZTm+xqAU3sluLi/FhiqBxRE+a6QVYa0KYL1nRJiJwiyGfyiBmcSRKufDyKnB2GiHmhz55h8kcnvN/GNt/nJHjgcu4m6gha7KJRHlntIKyjBrICBCcFEDsBM8z7qZvNZfchowMhz1+sVxTPVncYIo23K9syMsQX8BH7lyi8FqRUfQobHKrPi9w6TIg6r8MgCrhGbprFoMIh63qSec7cZQ10TapDWtFun6nA2ibpaJPaKihOdxeUZd6X+iTPB7GhM9TIVjrLD/2Hpn/4q2gqeGUvFVqeeqVEE4jtfwgbUjqT8ZESm5CJ5i+qMr+NbKkW1ubBL17N73leOtjnCFrrmUVC9cOJMkwoJogpdoF5FLxAWK6Y4qnPCIYLYKYqNMKswisqY9W+eN/SB688pB0ocISnG0p6y5dX0c+k+mgkf1XB/GxX5gXVlnhZzV71cpiWmednN/Z0jAEhvH3Jo5NKHquK4ceQWCXL4gWKoX30c9irRCCMGJSMvTjkoDh8rymOtYcqAbi1ktoNi/vHgYvvTsVzSWv3C+S4jZaWHugux6j9qP8Jm+8dmNHvPoDvaP3emv3L89xM3fkFRYafWbJCTSZzZsc/M3vWflngu1NifAj2iLE6knDbNoz4Xj96LY5b+rK2U35AIW8p4PIT1uzwQiVjQ+TSd+YvTXnJQEEY19Wr1gl/qOj1Ecq+sCyDnr1ALQhsQmp4lCnHVBqSiDiUiuCp6aVhevBG9IIlvcarYE6rYnerC8AUkYPsWi1Yu5XLK/mH95iLzRxkBloepTrqV6yrWpf9+7Mi3vcevy9Dn5xboV7dmYaaUbBeFZ5FhEe6pIpHIxwxhq5NAFWe1W6OF//Wn9px8uERbpJcqy6BKlNJPP21C4XGUJVtqkPw3JhxvkCFkMEWGKy0uUb3Km8kt0oCzmhwCIusczHYOl4+WxxSlNjiezMGTsIAWJ91hdophsKGaXaCsI2ci4a7Q0a0Go/aqD+1sqlVZob65f4DgWREoi2wxSHJ02SMdmj0V8wIKUzC5RLnOcJEf07uVVFYPTI3f5hghGFJGlNvlr9XcetuXfCzO4btOWRFFVl3Qfi+WXehVQDTQapYYyHs9wPFQkkPHY6DYvq/xU1dTgpOl5VavMcDTfoEqKbWbaA5tVgppiQIRDD9dhjAw1lOKszQkzxhXEv2ZjVyHp5zmnwVLhG9Vsly62M5hsXr6GrvOjIXJbcaTdvweEe0+J9KZECRpJolYpj/NkZMDXfKcdmyPe0FzJa3xoufjueGYVQyzAzNlMBTPrhoLDUkY6SwzaxC7MxRW63VfinyBmlOIjYlyhjfbdiNQTUYT+4CiokUAJj/T5ExyDUHVbshIR8gYzQ3ExWBGC5yxGStAMApN6uaQ0ElySiLNWAK4+a1UNOiLq9959VYs4PjKc0qik3GRZiSB4R1d40iWF/tUU8yhP3YZYoZfJAR+luQzg6FnMo2cNFJKIexrV1HgZN0qwVJqztlKHu/GWpMVtrmmIivZElgtDL7rCgcFEcJnRO1Jqhmcv3e+e+dVD8XdUbGlBEoIlxOEhGOczF6qs0PBga5cn4z9uqyR79KaPSHMstX1XAVIFEyWUMFX7UwhNDyL9cwXUTHhj1firD0UVSUwSokjrz11oBiCqoDIMqvF96UfahRYFAvB+0I3w9Ejc+ud9nm6I0LskCo8CFP8W04TE6EDVHmFmwPlGVjXF1HqrNd6TGYMgMk+01tfnNJwSgK97GDKPtLV/tkFYfts86RhPN2R9uPC8ue2Wg9wnd40nRjxXbdgOsiDYt07m2pya/Je+NZtjaNyc+ZE+2iZsoW3twC9m/7UWz5PcfX6BD9h6B0EXPRiB/pe++TyDmHgsPv56bo/lSS7okMgDS7o09LSlP5fJ+YrKOyQVF3hHzLIdaXcWwYFVFvlEaJDJCCckXm8Tjn0fcikyGRFR254eMAwEt/5Y5sJ6PCllNM1TiIrQXc5ziWIYKviKWDulEmIZ+rfSGkzOKQjrkq0gjz/Qa/NlPWPa6dXDinCGI6qOANBeM1E5ZESKK5ysNscymtseVGDxu8GEvjxgKLeauyFQjMVOUZLwyARieGMwCJQ/NaHL8MggL+/pDUzDMpH6gWMq7v0O6XojCI72pGntmNFsOE8IZuMUwJstBEAuK979HkvkGKFv9nS3f3HAiogX/62H8f+mJOXi+D8vskh907vUymCP/tJcWusdUKvrrbEO8xPcykZIvZt5zPqPscKPtglepjxnqjIyHkV5Rs36NzGpkwZHWUwenuboIIdCwztxiPIJj9GEdbE4mpESeeJYn9RRZMc7WVs37pRQx/3OAIBlmLtFt3YzWLmSGMi1iymPCWpR7LqJPHWUxVWkj6G5B2zO7uQz5CNkRwd8tb7DI8VSEZ+T1rlKB6zC0ksQFl91qdk7Iyy0CavoPbFAPA5Ozb47C1DKmrukVAfBbSIVz9bgCfkn9hSbpgmHShTlQhCmEnOHpm3MA2U744qV2V36/N3XbkbgF6FrEfgj+uX29voVXMSY/GR7f9RMU9QHg/3GgWzs58NZi8WlxA7/Dt6fKMp28kf038+kTJ79T+jKxQ0AjUlu/2y+9tldJcvq5aTxTQ9EECQjgTM3HjOWvvR2vcny9tV+c9vWRztgtj8bwuMgV6bAfB1ltZT57iufxkOc+oAmqT2LZd9KsEPNfbzGEOsI7BXPZu7hbM48VmxlQx7uRolsasU6kjvfUTsXjjuacHMSw/6I/UAczHVGxFqSyAumw4rvPSAMeXjWYmwdPw5AuhCIn0AKwxFYmSyGwtL3wzhwcacPo00u/a7e+JVRHjOaaMGhkz2NE//uPIW9JtrNPs8UDaiFCebJJ6A2zToxh8raC6hTGgNw6Z8bc8gZ+vrcLxI8QojOA8U/L1GWzzUpV9efps1Iwjuu/3oCDUNuIq4/obcce26aKg5W2FydBwC8u0s6UZhnngviuDHvSKMsD4OI9jSJBWHrM8iEbwt2IJ8BqBaXUROXYRjYO5wxEjXzMtFJe6ggOW0rLeXQGBukMuDwXGF5ZNFKOw3Uy+1UKC81/QoUZFn1IbojJFvjhN4voWkNKM2CxAiYjBFWlHC5qLASLkkcRtShiCevZK1xEb4nAqoQjFzGz75/NnWP6z9TtltvcaS4+BF9/91300RXHUDxNinBUqGUslx1xOue/fEpo/+jxd+xKp99/6RH8H1gCMVBGnFBNhy3XM3Ji/mmoOjc59FWp8JCa6m1N5/iZEPPUkd51p1lpRH4vaA5YHw0HAIuck0cplzJWpAsaXpDswjElkP5qOmHYWiFvdSR8FdCMnMUhPnHTK4Tzu8WWRSvmERvgXjHRNijZ12eDQsguTJMhh2JCd/tljkM3wYoO847gSOyzZPkuN5SRuV+GRh/Kdiggk1YHNq1XkcJwWyRRfJGe+6WfMek8Ewb3wlfQmt8yLSdnfAufXHAYOOtt1wsu1T/bhjBgTNouS5qcZcFP0i2r4Tcr0i2DwTc9Z/KJwg9cXObzD80cm5RoFFxc/2lcSHoq9fXv/TFzKMkl4qIdUzl3e+aUh/x1iFAZ8pzhzLZa87o78vifVlx+2qqouSeS7bwTW9/RtNp4elZwAWTkqZi+2QuqCdCa661PcGJ2s+x2lqUpqw3rm3jJFm3ro/QaTcuHwxdd/3TIbhqjmi0J9GdXJGMR/uZZu8dzlD7Lb+XLTyuW0FFoJmYF8TNw71hAOaeipvaFNSBNFfn2FvExkobsGpbHLpWp2/ips8GnGpWGp1LQuDtlkYr7eusR2mSriSZ3pdpIB+bhL8XPN/ts1xVLqY6scL9+tnB2gTr0WhBsjzrvNGbML9VEVKeyXHCWwyPkdIQQCmVWYIjsvIZhKfCcMRRtrMXwd0YurKBZkBhyQ+CAoU7vUA6Aknd6aNj4bYhlM8FdgLHLrtuZnE54j2TVmBYZtIKFAMmrYDyeJNWwA1PWraDxN2za8xsZ9Jyw/ZrtjMV8c4ODdedhBA4k5xybnCqdBBCwLThf3ZceeEcBGCBwUPAqKsVcewF1wPgege2Nal+rhtCxPPAQ/rx3G/2/CDRnh9QitkRZTtTQpMyC4pve4fewjezsWcnSPbbe1zGq22e+FX3tPRIqYmiFtEqR0awWISrJtzDOk/XXMZznRKNxaApF4W3Oxw+hyTPlgajlyY3gIrl+em6ExRljwHqzftOUIKkOMtIvM5250b28fW7l9fXr18F8c3pswOtpo1RGIWcUcUHB1ImuKp1DrYWqq2F7H6GxfDaj59Oj+BZy8j58+8++NdMSzzotCjCL0YYPVzLod9tZh/5X3/qY28Ck7OxNlHJfrYQcpyNK8Qb+5kmWKp1nsVYBR4rVqrhDx0vTYmpdXXAEvloL/f2pm+4UnFB1iafKeG7WSO8b/muDPA2AQyJOVfBpVRGs6J7R2U0Hzwp1azobm5u5wO3+MXCqQB7N92ExYelau21ysm6jrfDDznykBFBU8LqkYiuuCzJ9mA2wgPqXOIdgQYLWip1g7nryKNzSQMMWB6TJsVexdNo4zCGVdA1MYUg1lGCW9U2TtB2BV9N4xLRJCE7nMC/EGVRkscE7eP4EkkZI6KijjcisFXO4O0W68O44fc8yQMiK27fzonKrNoOVBNvVefAVkZVOvBluzXLmxnJU7eQbPrmyNV5cA8dufRvLpi8kM26VNCuMYtZ8V7fqwyVICeVJSzUnSYEim6Ar3A2zccF2uTRHVHn1IENpkFtqOpNaNDpWrDBuUMfchlfwvO9SyQ4Vx1q8ZhVurHUcc4yJ5rUG78b7PL9Z5oby7V4RZBQPXxJMmzK526OUEUYB94ViFzu1wdCd3v/fp7wSK04uYA48hAvT84s4HyOnwTg2CboWJEHKgMXHpOiaO4huFQ0SQx1vQYYVxffvzgSeYm+e8H4c78eFzTF4rjG2y1lVPmf6E0QvIK3zFra5jm4yZAtGzBZvkHFLkjHQpiQ1rAnBUkNKMR27kyGMpkE9AFV+qzLkxhtCMozaNvED/4Y7zKmHFT+BcoIKBfQEqjy02vEbbHat/KITsSTYUGYVVbgU5sDv6HHWodrxnlyciKcj8ijHqpv4qINE+f+gPhyoYwgS+NLnjXVr4VlmOercc57pVy+93V3ycOkdYa0vhNktEAE8G5Tw1U0G8ij/QbLau2MK/e7QDbvO5sp26iTUXzNvmaWA2pkjM31raBF4xJ+3TdHZv26r/Wl/hpVeIquK8Vn1arnUc8U3becPjI4w3bzfFxvK00Au7hC7bTgzu5yvyeHK+lvgAyeUptuDlTtKbMwC10gMxLRLSWxvQKD9YaFtnskjfUBSxn6+PJdYFxU3q1se4DZ9SY4y5Z4B/uzirWsYmZdeRKjC2DwvKta4WOBbJUeKzZxJ+hfc67wSuD0ESB/fPluIt5c+i4iu2M2vSGfvuyUWtXFAvvFlgtXV8se/88R3mntrmqNuvU4zQCl3rAX///ACHnWXRbmJPvE9M7mrL86DFUkXc+ZX1OpiqJIKhGWkkcU3H4oFO1TrI1c7nnOtsjlQc5zuO3jeAVlQc+1gX4WhEDbOBQLvepsnbJadkr3JtKQzUYK304tgfyXEjSwt7F394h5MPbzojb3bS2Bl1FwqDU8Aj6cEPAm4zEk3ywoPBb45rgO1oc9O3Qwd8Ys/RQ/rI2WmVW5vcMPRXATjjfrTnQdYlD/dG2thnQuR8tWOTb7y6IodtgbKC7r+j5dvNOS6jxoz4qumMAuXNpUqequ8+4jfexPVl1N6PqUhyKX50df2/6mvKW9nxmxl8rxPIJGC4xlAu7zTwNOp01DcDSPcSDqCaidgYNl/0irZdI6eZQjr4l20ClXBFuqofCTDGVYiTNZyVEar3dkfl9iBydummIW+4OZEHVbxTySK+3Hr7Xz9VgxhLr9AoJGGln3MqyOACt83hG80ktP2ghTBXHTe8NmtQwYiMywojjpGcupwTDjipf9AixXdE/JYZC8C5idi2aRhTEaK3zwMZCaBTEW51knfjjELF/niib0N4iQrAVW4Wz36WGk2z0xhS9LVkizCgeErGGwhrDJrG/5K92lrPFheAyAMefD0GYh53B8KFwSnmTrzW5tQshz3bT649MoI0JrWhIjSVlEila6RECOOVQ0C1Wf3hG13tMFbh71OagJB5z32cuP31Zqj7sLhvDspFHsHN2iRdPjxMgj06DIRsvLflHdQYMSviC2uPiZEDp+g64egmHI2a4XKs2FyIAT3+JaKgfezWIzEjcY2Ow38O/atzD9YHi2QCZCLdJv9RVn7dvkOhR5wNli06WJG1ty4L4DNLPPUbmoDaDSoukEY6uFz3ctA0+T4lxAL5Girb/p2ILhuWkxiehCkiiA634D1SJphNf6iDbn8+zrCah+67rWy+rlMfAu+6DEPLqDqxqXy/EKfhFI5DB/tA8SG9kc5m+rdkMTb0KHL1mjwILGZGq8Kd+KIMzMdTmFBvsIb3iuLNlvJBI5Y7aAo8KUVar8B+uzuU+e4qJboRW0Op303WAX3bjR89kIV4agTWzBEpEHEuW20bFe681xhNJ+yYwPAl9pWxseBNrLWStCjc9yCjUdmjfz86pgbSgHrmGzNY5jEe7fciLvN9eooB8YN/1ttv4UlTHrY2BizWDBuVpvR7d768EGP/agsr4kThJYJVuakPL9v3+11gAelsDmPNwSEWysPb4naEMIc8sXcYGiPWa7wkOBP1DOAnlSOPD0HguBm/nefcmpKd4RoOi5xK+11Bit9D7mDDqHaOd5Fn13RwQjyQyPg6oPewZJqZ5K4vqhGDyoox+qRaxodDdbOaTrTwjoDUNg2z48vsxgnxrwaXFd0tWUwv5tbtkZskaEAbtREvEEBGbFBEmCHRMMaBdbYH3c7b3o+YV1W6wneLIXCmIKsvq3IDwTeWz8yfxyfebZrm8MKg30rkGFp7x/WKf4FbXl4YFazf+s1T0bbzRTeYfefPthnsNDkPlagryMojzNEzi7NV3pvELo7EC3xanvcx5CQFFngAHNYJBUm3lOAF1JXPc4970AZ2jeaxrUQWnNydhF2wHphT5Kti4yb2TcU2wTPjRWd8YkEwSiMT9CB9SpW3kUUiggusQGMl1Zv7QdNA31U9lCGr0i7AvYRVbOQxbno++jYVhlnqZY+J/lznIWYfalbip92Ffi4F/o5oLzyU3Cl7HLvM8Mwgb/Y2201vr2gC1uUKFWHBS1P8kIXSRyu8U0oWwnlSD4zitNyhTZtZZGj6QizqIcJlQzIDFqDn+54OgvpbjdE/montdWufq/Dz32maD/gJgV9ti4JGHx2hOQRl3R6gGQmvIgLPZTqkyGUOdAAoy6sfBcZXm4O5J/cUyAEuBTqaih1q0VVEXi3yHThNJarkU0P6079lP1B9CZR3cE6hJM2Dq3e2KBJfhIhETwqJRuaTtU3reT7EGyzLJBnxj9NXdYS5BoR+8JQ3nGGaJKBqLmVZimLsVCKN+UwOwJD4AvEd0iqmyNmEtzU1peHxeJXDC4mAoSqeQIDEmr2faCN2xw71fU3izQD7hmm/G6ydxGwG2OWZJj1+E9FSpfpI1Z7TYHRNPVoHKXJzjcdXq2+y6NBScJsilIAKuWiOdeulUXWYnbO58J3pBkamRxku3wxuogzbcH3LmuvhpF2Uar/Tdsy4s6ERssTS7PXqlM/vjttyY13CRLRDz9lrAdZeRbQbZEEBaRb3FGvzV/XwuSckXWOKPr++9Xv//h2//ftzH0Azm+MFcZLw40Ji8q5TJPTSpwmRFzbWrXhiuq3Z+P3toZzmVL5aEZ9lQzm9Uw8qSItDHZdJIzgAonrrRRScWz7CyispwGoVq2o3uJCc7ZLlH1GFKTVJi1UKy1t+dSeY0pPw7Q2l4sp6dmF9IwXNqaziRWnqLrbArlZOM26CF3dAuZns7/M6aJefIbSl9KaEoHv3s6oTyrlRuwC7xnC9SJm6CEP97cuOzbSdp30u6dIaRWyxsu6t5IW5Sjw9Jv2SloyOJBQ252B0J/FwBdIRy60m/znDzxn6TxPKdPfYofHmHi3+GHskROp7X9dKa6WT145sNw7u3UkGvxHpWoAxcnRU3fGxIznwlU6+wtjuYsTWaBFqSBVcBe8BfV9e/JUwLeb1jEU31m25mwydahAkD9O/ix7kNa78TcwIBmeHfEotuA7dnVE5BZjiXCDEMptjBGIgRv+UhoNnvWkNdO43BI9gNnsLG7MVVCyWfaMR9yteP/jDuGu4E92R1TIHw6O2Y4pPPtmG5M5RGzafXoPvs5Uz6SrUVs2m9kv5xtNNfBM996+HrgLHPgnHP/BE6df8L9M9cxNP/++Xr8TDx+3ONPwbMD/Q2D41Q8AC1+GXgEeuN9/Vl8azWwXPeId6FVmGj429D2q846LisFkmB4LkqwgAaiThCvq78PyKL2GZTyOE+GlisfPv4mwJNEYC96TF2qVaPMRMiZ7lh0dQm4elfGkQ6yrUXfZ2NK4w6WpsXzvIxNZW4a+64QY/JwShClSeB8BdtN1clwJCScBOg7ZPsOObgN9YX60RLnRvEg3j5BAyl3nGhwVUsS0k63WASe4TQaJvSHXAVrNPVCnc1mqGYmAGY9gB6rQZIdjPVsi4DaqqqWbT8wW33kqZhjntLxDqpH3H7NtGrnoS+qoUx7KBq46PyqN06E91VvfNUbvfjH6Q309cT/unO/7twvcOcWXsGJlYUb338CnaRcc9hAZS0iZL1f+Bz8LFU/y3/c+1smT8jU+K+/vYO80dE5z95R9418ACAHyisA1MohW+0JzlaUUX9zf3SGbfMLwRnSCGo7RY+hXydVB5Hih8cdQ4ofpg+Bcfb4U/GesxczTIcby2POSDGU4bNSej08IlKu0oRHdzjxm1CTWgS/2TriSNM2Ndqs0LwHAYS/5KnHwbpJZVJrVRbTaIQnOERLW5qoiS+EBT2WPVpk5BYW39O1RZ+QBfel2W7OLO5xsNCMJv0kkx2Y9mixf9ynWqirjPPAy6CJ5o41azXdSRuXJ+Ft4YM0UEofkrgNrT1vYXyonj4bnE7UN6VowJJsDirwEdvzyr8Eq4Azgu+eCOJrgu+GQl4/HUED7HSYtIPVks8Pu+xGHdZRR553vHc6Yc/9H0346657Coi/7rqntutkLu7pPQ+/KD5h491Y2l/33lNA/HXvPfbeC1rAu2hlM57aeWSTreC/XKGC6LTnWTyJHa6uKOCJ5nDJAe2iqYoh5CuiIZM+AGihgCavrB4mNStoYakbg+hfW+7evbiVntwFdMom/JkmrhZ1oDzfoOexjxJxKKQSaiV0LgBFU5+zomjm+GWExZTt1grLk14wfvYR/OwyuiXCyP4B6T9UKVU3aXcQVBKh1lzErSJWUx//vwGSqE2ykoTDBVX+jTOe37WPXGGz8lzM+VTzBuit0M9cIPKA0yzRA8rVixRnWbMIR7EvaUrWlK1/zUlOVrO12bm1pbqBbGstyj0W7STTEYsPCNjFM3A1mfQq/8ROul+4FTlBdGtKfFMJsULLxODzhwpNwHYuMe9JJQIMSBqs/StPtUtgnbDwbiFKihVpgnDJ4yqqJs+/VlEobV5/Er0bkyHuzQU3/NDwFHCEPusvVdRXpUOSafZDoDMUtqn8r0sWofTxhOD4tKZIVw4MiMXQqwDzPhofccm05UnCD0TYK4rZLPb6orSFNqHrVB5FRMptnqCPeKvQx+srJMivOZHjjfom+JUlHjxVQ9UQgwQ18MHUegyygnaCFWHRbHYZsvSQ4ojgaI8yQkSjr/ZkwVraK3xPhL/ww4Cns2GyfRURJ5JN8QNN82bOyelkKeskO3R5FQSlwizGIn5F7inudJGCiIOaBgX1efsoJsl2Ph2lqc2ooWYsQKU3RG6rZJZlKF3fz5TUDuUOKVO25asRAh+CDdG4KHlt6xBaZd/AFYADbye1ITcYkYeIaQZ5/jH5jaRZk+LAJDFsv5F12l7ugkT3K5xp/8UeTx2FtibVoS6PR8OmOAYNVuiYucfSJbCQUG+M6H61wSw+ULX3lELvVXiDUYLPWakWUYFJpcZB6L129S6cmkOcJcfnYdTZ3W5RwIU8p2P2W86ExcsuDd8gwAKtrwzZPDprCJ/AopDW+7+w2x6Eu0K3+jPQ8V1/KGcx2VJmin9KynaJ08XOegm1cNSjfPRFNP8YQ+7aSP0+QCWSWjFjN6hKu/ezupCEqj0R7ojgorCX2gaL4uKknO3bIqen4uNRFiU5JMlVGuVCZ1wtqUr3B89W3GMWJ/XSBVOMnR0JejFhdVL7ssdj6fFWaoI9hb88nb/JrZuMwH79NAx5FuMTMNivn4bB1BKfjMF+/UQMPM2wIJjF0LB8MpY6mbkwnbhSWoTG4/LSJQ8ZFWSEPeClcsAq2oeKSzsKNrC24wmGkKELrf0FfhEIrpk/lgUY/MUVpgbdCizo9MoL5CG7xyeFzloUpmjkKI0TymYOlFqiRajUyE1b+juBU9uARNtu7ST1PcHZqSdfg93ePEAoDz3ykHGbwG9keOqx9lSE6D82Iy+uKWkKWGzwjlRzFcw96aQ6wmsosd72DU6/NLcJzn+5MlXcUZwbywbxe21/0S0Bs5AXjyemXqmzpVIM3M8rh5wy9L6vrPp6qSz8Vhr+X67AiN6Dk9guaFUREHlQ61209lXT7kU2QxY+ek8eVDV94kCTxOI2bsEvBGcvk4RH6D/NNsOplmJ4SFGWr7cCd2d8nCDrny3tWkNg997oL1dddaSW2k5vsVTt3VRrzZdyRhWHf2ZEUO5ZE08jSwV+rjQTu5AbKqIYEy1KW3WMqJqc6Yubo175j4EdUmtjMXfPBBqi4NCQ6RgztgHKDtWzSxcX9zv88M8r7FHSxvchrvNJ+6W5gfsXlLg/2ACJaHNZbvBw1Rprl6btmIls6mOcb6A+pb31N4y/rM4QZiy+caALmada0/8/Dw83R4k2JOGH5+FD1NF4hKH8jWKYp5ujvOwY0Qr8mbU8SvQf5j9pnBD07/a/WS47Og5IhaNmq9FzjM30xHVWDKBAWFtdWHFxadrO7YkkI1dl9cIFvLbHmDfX79cAcM3WPtz0bHA7fG1IzbrLK3Snumcp0Fi2y7PlEZ7RrSCLPH4tIQCHLlXwDxKdpS6rnTISO55Pr2WIWeQl0AtyTxiiRojxc6T2gue7vbtMcD52eCAFqScwGK1+GFfoSJQZD7qQOCUIS2RcQ7zh96Tj1NAa+NHGQRnwRzLDrGPlaK/y/lFRMs5eFEiLxMmdwNmewj1bEeG1vwrEeN03wkmU3thtyQedHr2VRNyflvjYojAl8GhToL0HyKTA42tD0DB1U7THmeAPx8oM/fLyWv8mMEH2r+X89EThd6E5KxmPmLKSf6PxUGguT23z+RfCiMCJ517VAXEpLic2fS7IeOd7fGZ1eRR6AHYhWLNWtGP66wFTvsachn3sA8ly09m+edXDUuT23cCJjL3EFZZ3p06ml7BJdFtJ4o/9j5fXlc1vM4S1Nje5In0LxlZhCb1RDcI5paOiSY61gdxa3TzoHG6LnKALbah8846kKX5Yv/vpG5vNEnF2T4RtmwyffN4zxhyi22t/xbnxonb4K3X7M8LQliZEFsmcrYsLL7KIp5kIXR+PcDBG+g+bLGw1j4xD+0ySAVHkVpe2OogT3yA2ubVbXC3KTmBFgjcqs3Et1xBj3vetS68iT9pbN9vhrLvYVyGARjjn1C4+q02GfXH0GdgVC6nnLcR0f9odTuVSDSlGdKorPdi7H4dFyqT3rcjp8rm5eTsB17JymoZpQjfaAYjcyTse07J4xmHZYxGv5wPkt4rdi7ZwSfATLVjJTYui9kBmIV8MYCH6Gc3mKm3Zd07mXWHv0JOt8ep7K0j4OJ6PTdfCPY1LGbqRwfrQfZMzwYRZhYyIuSUHzLoTbWZmtsxc+SdNDm888BQm7IzTdbbJGqIjOFOk1XoRjTLbJ9rqd+S47py+IcMdPmQv6z5rem7GVpWtBcklWflb4KNhr4LNT6MV/klAi2gAju6+rokZGGt78Ioz9tHnGBdeFo72JF4nnN/lHWGYATZgN4t1Sj2R6GkMulX/bwndrFOSriF+d6pNNfJAONfhvbzZQ+PEpyJ6VUO3SugpyCRVjd7oqxVvi9EZblX8NobCKvcb7dNqFQE9dPHp+hK9+vD395fo/Ye3P12idy/fvL+9RFyY/7q4p/j5arXqCzMfCN3t/dM3oagOON+GJLrYcuHUtHwOyMztZO0D5le90fCYH1jwHedUoI4ouihvHp6bKlAO96VJECyepKo9CWFBRRj9sOcJcSQuIQkAyqGbnLEqieIrVgwDIu+cEabWeuBeWUx6OX3l6AIRdPHdfzib6xJ9/x/FQH7/HwYmzOUf/sM4098mVCrCiOibQnfLF7htmwS8vHBDF9+BMLdUSIUokwqziFyi75FZZXpyTUqY5IizPrB6oDQi63nLKdwYqsADXfz88cP729fvXxnchcB/enn1V/fbQvRcIMyO5ovlthkse8rOdlHmEjN6EPHc3z9mOUiaYzemBEu1jvaYBQyCSUv0faXNBGiYShN3zRB9un7xn1qR6znW///iPz9dIyUwk1TVav55Mau94ErNcgi37aCB17cOQ4VQQ7GZVzEy4Qd4YNaiRKXNJIKVzrhTuNvyO/ozlJlP9W5ec7DPEkd0P830Oq2+JcLFAKGyhOF76R7layEwcmjRsh6WhNGCaAR5EVOZYRXtKduZM8ieCfYIAmMDCZJxAXUt2sePqpe0A3zVKagg7FtUQuudeDVbYsSbV+7kg9Sbby0kujWsKNvp6SUMb5JecBOuDjsNNXSG25urPM0TrJduZYbGBeAFUYIu0qrnfWtNl8iQ4vU1bmEMuQmjKVn533iciti96rA4kcseSaV5jFno1u+/+/0PnkJ53nVlPzb/oooJW2biProqK4YD2pAI3riYsyYXVB21iCIiOleY+fk30Dm3V9dFWc4KOYxSeFwfIxVlL6ykoOSj1v8iTzw5uVWyv9ze9tDdK1US1mcHFhltkg6KGHKp4jNcdhpG5ZLyOQstTe2vXGp+qsVyLPEDVZDyXOw7LCXdsUGbzgpi2ZtDczjzbUPsA+ARIdoF7ueAZreCZbBCNzwt2lBlXEq6SQiCZScRFqQn3KaXLcEiOSJFREqZyb6EMIEpD0kJU5doQ7ZcGEvOrdw91utb2zrhQOe/mWqoALVJNPgV82fQdOHEoOJjUQKlCIJHZfmFeywozyXa4EoptQao8N52w9Yng9mxYeMC1XSts3bOdKJVgVaZw/nGOFhrZrfBlvZS7bHHemwvP8kDNTDwAR9B7AOEV87qarH99D5QSo0weEpNBDxwsGtYiSM8peReUsUhXVoSA9WmCZh4iSpbOBlMbJwkjlg5SVmSS/CZK8aMERdi3L+BsJQ8ovB4Q+tghFGGhaJRnuAidnUh82iPsAHomO4x1MNjfgEYn6Uv0oUWSlIrfx4rN635U64tMAt8teLqVhxJcKbVmfnjaU+4T4ffzqQNDePR08VCEE0JR6+mDpjGMuOsVQdiBtv4LArMgC9sg2qRQVO8rqm/Y1x7K1NBJTCTWyIkwhsO6dxFx3SjHezbEmiIu7LKzn7U89ILQe8BbanAAWTVU7998m/oIKhyI0KcVQ5rm+OELg6cfaPQhpjiFyRuhmQ0xOcB8ltMk1wQhLMsAd2+pYnSw7bvUFsL4km4g8VMD/MHXcj49up6iFLuceNG36dVfz5a5MNcuZZL5aXZ72Zp5wpCUM84oEPRnkR3cBH7bEhKoFK+c+icqdTfPyyt7a3idNMDdsH3Dw8o4nGXY1oF+ftHAfn7cSD/8Cgg/zAO5A+PAvKHcSD/+Cgg/zgOJBw4jwDTHHQaqEQXmeCKRzwx55hPB3utkbGploONkeXCR6U14kq6uyuAR08Dr7ZBHwxpUuW1IWE2eMybCzD2AY1htZTPNI9LNCLZaohX5OZhvEPUOY7+V0CzjKMyhcBRW6eMHHyjGgh8xly3MV7dcNBeLQW21GAdNU9emTfXCPXkLAwUks07sjFVWIZ7ghO1NyNdoQ9QVbQ3svrp/V8N5v9EObtj/BCKTb55/8Z9kDKqKE7ob81ebBV4H67++vrjR/1p6wDBoRL49NsfPvzV0gb0KMNSmjrzCT4SgX64RIyjPNPzDr+RSBGptCtkbymDlG8/fLoFyobS9y9+6Inavv3h6sN71PhKJWqVCb5JSHoJzoq/NkP759lVSUCQbS5J/AxdqChDQqrnYPS/50jwXBHt1O25VM/QBY3SzO8TIvT2Tz0y+1Pwiw2R/Ald3Ny8fd4nlj99vLlGta9Rdo8TGpeO3gtUtyFCpP7cA/3PHV+8qn5RawBIy8BJcmyTqc0R+uG7H8Dq6Z2smEq9pl5w9uKH734IYmmI8c/oQptY3968u73uFeafG8L88wnCvLm9qZOqh1jqQgArsGoTh80vHo+uJDNAcb0FkH988WdgcInottJPdIDbb+vrLYDstgxTQHCdKqQ4v9P7cUsZlfuAqh3gmsPHV/qry5wGtyYIlCcqfCIMhtnZL26Wmlymkx7AGmLe4h1hqlt6Mz6BA3DLOh0wcLPIrCwOe5qQZnpQng3ZEP4jez60RUJcmQTnv2ivZuV6aVZu5EpSLoZZy9JFG6LPdj22S+1K7MHcbjU2tUOtJ/NWaYPmw9HeXXT12IjmetUGiL3G4ok5hzFWOBy1bl7v9vndjdpSaL7np8HV1kpB6xPNAPEgm4pLBCQOu+Z2TNKYIGxS1IZEhP3zNhPAoXPoblRrrZO8FO1lR6yPnNo9hTu+7XXykJF3l32baeCNO12jv7AgRa5oSjAzRXyhtRA56r96iZqT5ggJ4BFm7gbMk/KQcByjDU4wi4L7FzUeonljC+cRhWHf8X7Ar3hsCZ+Wkbe0hxpIREdnqBjYuna2Nqup27Qti6yWwhkw/aE8djRgBSw+KJJCQk6h7scMbHM03s0TmC8zGFjyDhWMB4ZcDgpdXF1/+vanv5u406A8C6f4Hn+MjWB52Tnbja83L7pa1LCOf8HtvNDh98kcyTCkcDW9KhLPQx00j6tjngVq+kO1a7iy33IzMXOJhupPJaILI7Nx3YsUP8C/nzcyC+wbDrWHtP5t0Dov0kgdIW3Kf4cu3KnO+PCd3JtdcJIEtLdre3lbEUzOOPf8h6uHaq7Ti2Kot7fXoUqoWlekrTK1uzFNxyqX9+2lOapobTtvfvSDXFTLT6qWPK18rKuG6T7cVtoUvx7nP926w8XQ7egZmxK15/P12i45G8LtMjzF8cxjv7I/jW2Gj2AA1wbckQY2faqtofL05tolxwUM51aMcg6xm4sbH3UbRv0RNQPDxbG/FziQmefHVFB8zxXa8rxVRGMkaA+AMy3S1kw5vv+QtdU0epHq77s6z6S6nobVAQgGTEYDGV3O254l/+AJv6O4cpz8l/lN4ESxfx3c4XLUUVOC8csgOH77RXhnhCmTbkqKVov3FBcfgpht8KiqIrcyusPbu6qE/qr/HZAP/G1s/0+fLBzTEZIIra6IM5mnRDSX0+hFFiI0pXT8RvC7uWKECP0E1LQBCgDRXzQJ9KZSEX2P74l5cANvaiCKc2G/Bt2lfTfGxhe2NAFWKzf3BMdnegjc4qaBBuJV/jiOPSWY0Twej8XR5OGd8MAT4QEKvjmrr7oZKZ5Rf93xAbxu9ZfrPqL/MMVC0eDT4r53z9fu272D4dtt/WAZzqRYuIaGdmx4idveY+jN0I0gJa0Ud8NfkYdOCyrKpeJpCUMTMiFqqUR1t3nZQoR5FbSixr8sB4VaoPm2FIR5mtSRElm/hJlLZb20bzVdNr6eCcgauSfMhZlDq+wEpTNwv18ZUG9eoQsjOHuGu5sgGj/v1z17fvqtsgVSIxVkmJJ0Q0S7cMxorpQpIpipo1zR/pZBddd2KYTRB6yPyJTDtUNrTFmpLgnC0i1yu3b0nrAS9NijkZFD+xk9muOGGAg7vece94ZgVsSWxMsA+gCE+wFNOGomzGd5+mht/CQMmhISHfIYLvF59nPgeAuEEY3RhTFQu7puyTACLARuOrRDIVBYKZo8onFHRy1BsoRGvudnp7+RB8p+MYTlweSRRes+WBvOE+LNlxiA7A2LaYShbtTWCcDU+ylvrPXeouyFBuM+oog+v9DFm5uPQ0YStD3QDMJ9XZgb5ozfElPKZ7A+APt2Nbt9BGS9xlCb+bzFzoba3GYpzldrqO1Febj5/acTRtvnMxVRB7rBrBZ2gF+E4g7wx7GBh6GBmQILOj0aIRWudbUcbSTZoQIdSNMQOXsB1yyNhmtoovUUJblURKzzfLrP2p73T5/K2lavEywVjSTBIto7fkhxZHoj2xHazG7p34nz7kHL0pVDbNxiVvnOK5UmX03dz/eeCKmVo7dEzukILPlWIzjUejWx4vdE4CSBlKj5p8BSB2aBKbAFMueyBy1jS9W3i9CQrr2tduHdoAYA0z/vqs3TXFjXbqPG4vE9mx/ybmtPcBZsEVcfSMdbx74kj4HjRUVNhrKBnAZYdpEzaTp/+1/d5S1gUHlXWs65R/Wy2RCPMj2aIYMRRNKYMLWWRK0l/S2ch3PuQcGFfzEwmeGIIB5FeUaNMZpi/T9mvM3meeiNKyqhJ+vS2ISS7FKoGQUVaBWupsf7REMeTKTiyUiktmH5Fl39+7+7vtJow3MG2XP/he/xDVAr/pZihnfhtV3ovmX8z7I88FDdUpyHptdlukRtknLLgH3jslebGruoJSZyBgkxes3RJKGtJpnIc0m/hiJUcx0mcO9qc6brZTas8h55rOD73UKyjSJTa5PELmXHZOXXUMvLonBUmXNHGcLojy/si1sY3IGymB/Cq0QfL4sPIzVPU2ceRiN9Z6GVYhJ6pq0RY5etoVhAUMTelI+BQv4rOb4wSWgZpqL6eKNMx4BMmHquvVHivopvVJmPw45ue9voEQo/FQnu7XGEwcVUOi/lDBDtTUmFaa3MXAfmylW4rauwrhTVnSme8L4JtMICpTgmLm/cvcR4z5VV6hvBD5IICU8OJGExSvNE0SwhSFL9n5gRDgutpKh4uW3yhCr4cFEHlGjnWCEOte3tMjzaFw0vtNlFpMKbhMp9rfxj4Kyw7fBm3fp/Jxt0Y+hO3Pdz1hWq/lSRQdJmlmDKFHlQgHyimxHayGi5inkHsnGP3KsrpzL1nbKdrY1y9aclXMIiccz0+aUx33wx0r0ZK97CZL/X+ifhPFvHJGndE0xWP/oQAtpI00ZAG0U4iax5AHV1ipvNDWHRPsXijrLdyhuly08K09kyEGbuTozIfY11zYrgS4p1+U+GySfPOxtBai1LNOC8GWQ9oDmqG5UdpaOu5tJNgAETvVtiAzG5wtl6rihEr6fG27qNRjSjwm4akCXnLqOnifccR0vL1K2CczdC+YYIRkyEprgVKn4ZuhkqPlDZS/35uf4LJO/lUBUWGpO4W83PtOEuu7S0CDIe16JCJR83XaErJpzR0zOqK3J7ef3GWdZtdTTlPLP7dNWRUTZJrdpkqZibt87ErxCaKASRPBfRjOrd6grof+Ch3QQg882SGELkWzAiHuiVdgoABGTRhb3luwSmECi+LKTzvBPXPRGb+WGBg9Ai3Vqh2rueyUANRRw6AWjTlUXHlcyb10tTYRTNUCzpS1eiw/ybmshYSiPBbfx0EMA5JeXmqEA4UWibXDsnXlDecJj55Xom2GhPpcI7gVNkgMiWprYPNk7T1OXBUNLrUtSdT3JQ3QYWah3sJhm3TeIeAd1Af7IWwUIaWT6XnXt1/al+ZzrS1PU1u+0G1EW1NkgeqLzRR7yPQZWJ1yqvs+mw2dBwuw2ZZ8920WmpX3FBrOgZZl4V0kKLGddiWdocNouCxD0MC1g8XqKrt39hjR6GFjCWCFd7Cbo6S+ag4TFBOEl4hBVkL19df+ocrykg+yUOOCZbyoo6qu6euVSGF/o3QaEgukU5g++S2G+KJHw3mwv+lu8kvOYdqZKKWnyLqKWncn0O0imGiiKc4YgqSJbwUy/0qv3kP790zEoeLpnW0/gq7n8aqYBiHy4UyjpvS0874duWcJ36XPGScp8Eh1PC2gqydMMig0ozGgIosC7nBwRLwwOokrrHxfCKQF8VNfKsQK8Qz2FQPy2BpJX0ry/Suqzlr40zMANLAH0JNuaQYZ9gZrqUz6ClWRWS6Hiv/s+wUz7e3HTvEwf4wOF6tv2WE/1TyePvZpjwRmuQXDK8I1ucJ4tkHZW3/Bn0p/fzKRP7/sHFmfAAryCqYvdwrrbDvbXeS89/Bb/iI+cKbWlC5FEqko52Mf41TB6/lKpG+L+6J+aXkLW/H88jO4On8cnjY9QSnn7XN6AORrd7Ur2PtSUyirpbKOJJYtJBzV+Kosc7wojAtrKte2lgs+RrHGxNb76t8Kl8YPglb9gJDki6U75XkP/vGh5HXMThDtKVFsQmC2yPJbxFEaIx+113HkBP4bS51MYeIIXXTYVUawuDtS54O+5SBiy9WwcWejdoTqjkpH/XXHlVZAk+EzAo99mNq/TEZcvwCd/1dsJ4Z0jZZVPp6mqqdoQQCIJlo9TERAAfgdJY/prZHNxvjxmp1ykJy1zhVh/f0HLvEbmh1OoPHgZx3s0YCYJ9fQhmW+rAgHLWLfmlEjvf47SY826ekH8xL2Pz9o8y+4bbqOADluUZ5LfWbQrI2iZlzgfqbzbLsyqQ7nyced+722YZMWGKbql5314C8eQfFNfuJNmuE8r8TfGmgfn4FgmSCSI1GpscFVIIlN3z5J7Eaw/GpfSC4+mTS5eGwBmdf+W8vH5T5Ajb1dMxXXe0VRj2RN6a4gDG8yoPVlEeHUyX26+O8gjRz1+gopt3NXZ7ij1fydiBSOHXZJ2vyTqBn7mTdeCVzJedp/P12s738/XarvEz37Xd13uZBuCv9zJ+4F/vZTruZRhRet3Mpq9FuNn/P8Pi+2h7NnTQKuLL0I1w4UP540OITxGt+eeekFuBmSz6xD2JObn1zkkRev56CWp+Bkrz56/3nyMF9PXqs/xpCedf4daztAFCOZZNUOdIji1RPY202Ep/9UBqbGHT5CwYwZmit2mqLcCF0pzDZ0I/gz4maOAOR0NDJEN2OhoXSnmTgsU7/tRAA08O9K8sxgFnCxqj7P4FReg/gQpntdaO8ZQYdsbjLzKE/dUjNT9fPdLy50uakC/OI/2XuDN6IrckLVhP9L3JmNfM/2ovmPXBWjwvkc33JcOeLs98S/b1QqgB+6nuq6/vuGbdbIMfc30ZpV0mJ860D+ICV6CRUKuUZlgSIdRVctm+3cW7bwDNQXRp6mI4wAhd2Gz5S3TAVMF/KCJSynD3Ez6CWx29S5Qn9QArEQITv3xrCVvaCwrHgChTZOftJjcSjOETiPB15FdXwZw0f383M4QuClRXkI+rJ+1KYLl/y3n2E47u+HZ7iV4LAX7zdZ4kl6j4T/v39tTqH60T7OxTzjSjNEuIIvFlKYkrzBhXH3N2aXqdXaIPH979lSYJiZ/b4QeKc4+wjvt2idHPIavw1KvfesUf4GI4dky7q6d2DkSiqH3n51eXUpf93IMrEyTSiuBHpETu00qjoRdgBgq0C/wAfEvJ/ayZW2YaQ6Zf5xD77MZRIrB2gTErem9+3Aw+Pu5y2srORP6YYUyyhB/TE5+zVayakuAsZs28KdF/9eJs8SizfzxB6K4DfxJ7w8V36pcLC5p+zlawyo/DcZlSyiomkjYf5VVBnmSSvKpMVVn52HAsUV/IjERh+63/Qn8ujOU9R2DeKr43Ox+sCq8BwLLY+65qdlCGTxvQE0/Mn9P/6fYsTrKfITe86lSgC202XJry19r4zdkd4wcW3jc5k9GexHn3Ij3J/wGUNT5dynBOo7oSA+gxZEMRj6HD08ZUNeLQbca6G+fFrOsCU3G3fb6s/IrMH8tSeh8KAA2tSfi4yC3a7rwE/60wmm3ulmrj1ZqbjHtesbQmZFE4EElsZsc83Wt36n9e3fr1cDNRI3tz7WW251Ktl+GoSYfYjjyExzG2h+W0i8gF45kNmDag+dEFNK8JiynbrVarqXHMOdGdZndYa6DDBp0Ta8HNh/eyjbbpmZG53GdL0L5QecL+cxVo0IFe0HGt8g970HM8Ejyh3WOtsE3hqWZEoI/mHzeeB1dDferHwtWtQeZDpbXHWGx8A21tlhKarXwB78ktJ9ekswQHF3uCJ4nHPy4CnHhDunTbXFLc5klydNx6pVk9W8k2T+ZTa47i09drNaRBxeavOxOcux72es6KQjNFiRx0QTIe7Z/DffaNhdVc/GfQtDWJFEtokrJdeHuW677YnbUlHxIiegSt24pfdgF04Er9s/Q8VzQdLZuaPa3pLia5AvZpTLOb3AHACp0Led5zqVuTNF5JfplD5/oSYNBpire8sgqq2q9lUFo/X8ugtH++VkDxkPua2/m12Ecb8NdiH37gX4t9NLg5NPc8ydO5rmENsSfoBP7NAAsaIl+rL9ifr9UXvlZf8H/ga/WF0wbtqznvg3KGEgc/D+z5db7eaBaM6x1/n1aaxt/dp4Fu8eQhI4KmhCmc/Pf//A5+d3efopTHeRLsAH/vPPH2svFiDjVuj3maklQqfFIg1UuluzB248NdjAdOhX2H4yUc3j4dR3UVU/gub+AiqaAL8SqsGZx4M6d71+toID5Owfc6nex7Wb/iKaasTbVT/t2yH8oTSNtdmfCdVFjuK1vzrf1VYH+6P5cbstmaQhKltLvyI/rvZ1Imz/4nsGkrvNHpO/fUxMPG94f326hnVsxn3v7CZceFxuzFn4t5/ZuHsuP6j3t/D/wJsbb/+ts79GZ84rJ/3H1jH4AHlQXrww6nRwmc7tldV6jW3rRqnSRPXdNroOLct9WJS9y0t5lrDbw2zXKa4wyBQDW7cYGZeMMinlK2c118wFYiYhVEwfNwSGY6jA+52vExMLY0UWSZ25GfLekWFnuApCSNcLQH3u4Eeed+FzhCir+XZ4jcc6HWEWdbuvvRZPF4ThbfKVIFgE4/Rk7ec00CU3ZZW89Mvam+gpY/CmWCR0RK9OaVe4JdToLC8q5cW15AeaZoSlaSRDPhKrnDlaBwHLphqL0guHVBMBVDGVGzdI3LavNDIiu5FlIryh6oEWeMRJq1XFlSs8PmGWFVRqa/CZUtzJd6f+VJjDYExiZ9xiUYv4gzhJPEfk+iOBdaETEuUpxohuZ2dMTgfXm+JwzdjFzmkZ6DbZ44XggrRdJMlUKw0yUpiwiiphnZhhBmLihJ3DOGHVGrPQ2cdafMWgX7sx1Rz1DE0xSzWKILmDSkuT63uAFrnl2imN7TGGavaYEht2KfRWm8BopmKhXXgzCL2REWWPVtMj3wlErZiiOcPvQtpgmJ7bDtm0c9KRHOJUGMq71ebAcskV2+pjIElfqsadF13X/NR82Y9XcV54gnffMbpfFq17pROX2MjTkVrmSXnU+7KM206iGbE1WPm4Jibo/zQASprhou9PcGDK99YTTD8GRteHo8NCqHZ8bVg00rW++DjCA8E6cMRDv7krVMCZFiBECinJet4KnpaGhq0ZndVOiPnqEcBFWKsMcejSQsdt2tJo2DKpIud1ABdXeiJnCfUIH2jd3ugyCOOk5OEXQTvVTcmsHC/TevDWOFbvU/qESMo2cpfqBpnsKXW7Rhyzs1vckVwtaujhFlkdD2ItghmtkRMXIAMo4tSI8Mkhm5p+YYPq/Eij6nJOW1PWbOIcVNGd48c/e8Ws+7YbbD1+6AKM0aQcz/U/WNRE7UlpYNP6ELqAKwTvEDjOV56bJwtuPxpuqw6N+8+inoroDr7Hq8uvHYLzlTadXvxezGRMdKlGi4V4MKVA1/P+Tu6DGd7PI4nlZAlIONpahUWmxdkYfBN8eFIOogJ0W6XmGFN1iam+NeS9YNaT7+V6WYBiCY9878iqcbVzuHb1HsRIFZXJ2+AbhAEa/AaQs865qQVw2Hmy3zBBl/oBkSHt1JrfZSGgmXJeav4GNQ+e64TsNUHrgaDEC0EZEeMemPe5FMfXkeEHnnEAcME9lGqoX0teA1vyHSb2EMXTLOlwRTQBswF6hhsJFzyhAYjhWiQbm8FEtwI5b0rzkRlMi5974WnC315lgM2/MOz5y7vhSRg0IeSJQPChukXIRW2EnCwfLOLSrNQtvTUm9SfhgoKIdtGUGZPvDYwcLyzjrwAFafNH0GPpNEqCUkZyhDIIscUMyjPIVg9iCpWVjLCM1hsVyGbUBThGUJQQFlLaeRMrKIFpaR4TJMRsb1WEJGMUnIFBlZRAvLCNANlJGL4iwhJaMuQUzOxiyCRoPEVYBbSGAtVG0FXzofJ7tIlgTKBL+nsdaTDPF7Iu4pOWg4GGVYKBrlCRbG9SsQ2riCm+EaWSpRyiW8YI2IYK6rN7T6Nnm70lAviJ3kheH73Zpv/rGW9Dd/LZieuEIzrNDjcvmpN0tZ+f1FrPCyMAd6pN7vbmlClkVHWUwezsBiCGW/W5una/KggtkE/RRskGnit6XiAu8WngUmgf463axS/3LpBworGRZMV8rPFG8S3hEMcy/CNCibRKMIUMISWG8FIeuEBtKnpgyO5e2w67ihBVdGgFAFm3eFBAvhTI63lZdJleI3p2n42dPK3jCpMIuIoxyyH8EEacV+p57zBVdD2FgcSUI7LY6ERziZ8+HqW03QhLEwFEDhQpUJBm4WqcXaY6xhqR0EuRJkp42E2Q0iS9fygXSCTPA4j0rEzZskH7wDFtqDnx2epXsqvFTODy2VJ8PKZeCR9im4NNFTgQmeJNpOnT8XoKA8BqIX6wZHd1p7sni9TXK5Dy2/CQmKr7QhjTc8N2kULovKbd1cEsg3MWE0sLoVRzGVd85o17+i7QOEs+RYeT205aJQAxKpPVaatNES715e33/vzBVE2I4y0gwc9h2GIJZF6lKily4j0MC2xrs0N9GN2BB0/nB+195TzdYMBsDGkIYEgpWFUIMDNGF/z9XhPOUhVOMOoHqWoItUPjeD1+Mz13ZupRCJ9vje/3hBZtpf1yOE20q6Iivk1u/zxmL6yd6AKnvD7K3cihDeSJ7kipib5UvtFkoaE5MnY/O7tuizXQ6f4dbnM74nYAmn8jNSzXRs82O9VrQhSs90xJkiD6rMzomxytPw7FgOy82PZWCOWiNXJ0knQ6jnAb80sZEw2gRLtSDUVG8Xtycum5bJZbmSNA6LuMi785JVnN/pYUa2FUb30NZbyqjcdyQLewydgQN8WSmGZNNMYRQOWdwcz/A8wlk1uiA7LGJYHZVMT2tHQ9NimwdeTa7ErC0yq8JpQtWx6G3kNJzvfB3w1Nibg4Nmr2fUyhttITdpDlFCIZQIB5qXrqVJWZTksVXzhUz3BLJJJdj+6MqnkLxEPxeno9ZKCMexvfSwusdlefaqnuWqBTYEmjNIH66+Cfasoqqc/VQjzJzC7Tnw1v6qZnOMzR7rEDxMEv9QLHe3eqpZUIPWSV0sWMFRaRMJo4TL3ru2f/BcMJwsZ++VDF4IksBgC9UFCkGfKuDta/8N/VcID6rW5LLGX5YRLKS9IAObodMCbFGsW4QABuRXYkaE6XUYj9ZAPE3bic9odg2kBGYS2wVgEyndYrKjcPnn7iTxUnWfhfm26CFKLO5xEt5C9mskbsfm5hpredgbR4ChlOywSdm8ePfT8+qo2yP2koXzdOKIwZpeK74uYoryCYwdThk3IjP9XqJABkA3V8VUeWizRJijaSEhVDggUBtVIyGw5jvx6o25pmxtptKTXDQH8qvCRDVD0EyNTuIRHOwxOuxpQhCuJ5BItCdJS904moYMZfVvURbTCCtNy3nVzLSX0GYCRnuC74/mC/79wHFsstf0OaW16TYXak8EiineMS5pR5oNwSI5rs+m78AJdqMsWz5ht2I3ZKtNHDhMO6ppT9Z4ACA4zFCpjUHvJsvTrRItKc9Gt5gaJ12fsrvHgvJcmrrpYJlXTjebBh1UAF6KYSENqykSB1xDNGf1jJabCFfFes9F+2ayrba/9sSurXqSTfPnkwsgwEC11nF+fc2oJojD/jEkzWfBVPdspKpoMkGydcJ3602+3RLxKHIyvr9GgoV1/o2u6VOx7ucmxUlChB1wqZ1s5MOts6rZ1ymU4sh1nB9PKjhSOU6SYxFyasgEKvgEydrSPjIjJDamb0zuaUTMjtpibbZqzwVvtyRSEwRUtUkeTUYV0VTMDbytzD1lu/Dy0SJcTFSCpDhbZ4LeY0XW95QcHlFSACYzLmF2fMHZC7PR7CMM/cdg9SWENHi5mn232ZP8EcWiDRCHYgjUui33NIB3mXpBwgET0Ou3kwcl8NrTtXCy336dYKWXiB5MRLc08h6FfY7vnuBsDSUnH61dVHnbIOlvZVk5PVkaHZIZjkjtnXoRtGu9S6/+fKhfOHGGPjH68O1byvKHsMGY4R1ZL1bR0nezUqlqaZahNpOpICaGXwZfVug61KjefluQrTZieDViU6Poi3gG7j8wvL21dzKaBpXsG1Uv/4cjRe+d/ut702OeaD6t1U/9r1jRmVZ9qZXcA1Yl8HZLo8tiF1yWr3bd41ZfXmN9WDx/vAa/g8clQQWbV5KDRuUez59pWxZv9esTMPCCnmf2jni2W5yX9ezZYhtX9roGeMxGX5WbPPwzidUm/VdQN8oFlD5zwJu1fjQ4wa26Fs2x/ZqTVq3ypYbmns0EB1QH76XbOyDzIOBMI7KvD841WSaR/0xjs68GzjU2+xDpTIOz3M42Ovui4EyjK19VSJmXt3GlZTPDCHu0+tr2xAk1WJpRwTtOcI/2ZSn7UkiD9L6Xrk91Pi29Xxll7xEwx2Y76xFQGdyQ02DGKTzraVAZ5pCDYcZhnvdgqIxz0Bkx40DPe0ZUBjrouPASDpluA4+LkZ1yxiVquHSjatuQxp3TtGyszTIXg68pXLH86QfEBfrD7y9RTDLTCxhxZi96FBY7ohAW0Z4qEqlcmPJm1skPRFcrV+N24BFPM5rYpmahGFHpV0oaE6bOkRfw8eW7y3ZewGWlTpQvzuUl3DuueypUjpNFhlWOyjscvnXcy+VZDit8EvaOycTYzzFThpOF7x2kb6b8kUiXylzLoTeUX1g+cOtyaUIhJkmQSpTQO5Ictb7aBPST/gsSPN/tkyMiv+b0HidaKVgVVwmr8i068lxU4ga+N2yo+HvvJKwPVO3LO76nMCMmza7QBT7ta35g6rY8fMFVv6rb4OhOrn/NSR6w9jecJ6SVoN73ZlzkBB32BO70BUG4fhZDaAybzSLL48egsKVqlDgGobvXnvb5xLw1lV4iqazShfByJoiEsgDwBgKnxUlUJhr7H3P4pU4FideK7gIvhCYcnjdlca7yCP275nOr2Zz42CTizA50Xc3wC26JE/JOvAOppRXWag5mgu9E4IpkSPKHqcrjr/mNZryke19N0naLxuYxlIPrG1ETd1cq9LnQ0+iOKFlenAzBbVKd7VfPht2cFjXYfbfzOH6stQHFsCYtDUD9qCvDYB+3MEwdtEdcF1XQHU4ejrxO4zJ6z9TWhKR0UN6u9Gej+uZU5WerbAbv0tAQcc/Yb+ydrfpphg2P9TvxazPnyYC/qVzu11KCa+dVcL4qcSEq1PFJDksW49IQiyTwznVYzzyQK73NzrazgaXZ2ZRZn2Ew0q7kmKWg1hLoR6EF3bB4c7wmXsu1F29Zj8EHYBnlaQ56vDdVJXeFP1taxVP1ZmcZEXTmDWqKmeoB1tKLhphhT2UI7xulz90uKPZswgMJmpWTbN1dgekxxuXOsy2kkPYeZ+En/YOAjxZ24+HukCVzPnBm9w4FJyMc7HQ/PzbNbTi0I4vOCO3Ioho0VyA9Z9BeqyiPrv89ol0nfN4847FFifTHuFAQwiiKElW/GiqCbpEgr+IPDhi+Bq8aMGWyBsLqdWw/AyDxrnxw3S6U7sfrRHWUvyZVUR1v/vfbUB15/Td/0aZWbXn90aEV5b2Cs7hGCe4zfOtzKTgTOTQIIbgFrUj4Rv+1BrUgAVfNfUXndzghAq8HFsIKrZcBy/5zjdPwgbnkNjNHetZgXIgz9BegWN3FnQUTsyyZ7c7rpSbmQBmuY2NznPOwQxrz3B8DGKBYfuEHxLf6HNZDpq7FDYmNbn4hiTLCgCuuXL3g2xdcxESgiwwLnCQkob+ZOyqy3dKIEhYdnw+o5805b7YDmXtE5Qjg+o0jmfADFCWACTHrp/wMVXu0p7u9lgH5lfFvpFldRjAxPDqkfnsSob+ZlxDw/B2yl78rE5kx2sGLe4F2OEMbog7QzUqD0V7/FssKjkG10CmL+WER6b20FVliakP1Di5IRipAnPADscVLC7fXSQlE11cepJ28M3lzXRGh6NalFp20yWKSybUb+KNLFwTpOoJBiSbM0IagjEtJNxWJU4bcVkQXGVeEKYoTFJOdIMR4cbWNOmR3lmVIg3KY63UtYcqVjAffsjafgGMQXvM8dNFZC1RAKPJN3IObakC3yODoremc5FLNd1V0ZcidtiG0+bJepHVuszmHHT1Kif6N3NMMRXvMdlCHK8sIC6apoIbLvgBY14uzUkfMwCzWrEE/BGHTdKpjPKkJ8k1RBgku4J1II55mnBGmVujWPIG5RIc9MU+F9U7UljSVUMfa1Jy+/vjm3cuP/wdxgd5/eL92/ywJ9axk3yvu6QvZPJJ/8tZTbddDJ0QADqVnchAsmE2nn+4n1AaaeraXQxl0upvyQIEsg2nFaLeVwlNUog8//3xZLt49ltCQ8UhUyRwML1YU92rtBo9DD5UfU3zUp2xs+nCmVBofLrd1z9DVnkR3QJIIAT2OTGePTGiH3BWvULWCvl4xkfvZXtWg1/cS/Txpa0Bsdxl99JZKm0vx6dObV99IV5NKz5kvqNxUovUBmk+b70aY6fkW5B+8roFRzhRN0JHnSBBwZPRfqTBudaW640BVHdYap2nqPT8YyYDdwnBiuLnF+vpvN+hacMUjnvSsom3CD+tI+TsrTlhKP2uv5IozJXgyUdtmOJddVQpP0LfactwKq2SLd9BFdjpktf789tPNL+jm9uXtpxuXNVtk+BRvELSGNkDdVteShECD4IE6E2+YrbChZ15eoj0/oDSP9ibmkOB7jWCn9ZP27UynkcNYC8GAWnfk3cyRYlx5QA6FfawozCpMCZa5bVjJMGu3+wiCFyS6XwD3R6JywZq1aX++Wl+//HTz2nW4r50Hziiv59NxW5qt+Jj0VhTVP59YQu+I6dJhjY97LCje2BRHUtTPLaqpckZQzIk5jASRRNlGoCBtUEq5WQ61aFuXDlqmoOQEeT5BQdWKoxYmilcRTuiFA29kf82J1i1GRpfaIAYD6LJZJNVaRxUTsA8zjwLdcCfo7Pd6koon5LN4fpvtGm+4WOadcrsxo9ZIVZe6DO0CChPZNRq2XnwQPG7KXNeioNxRI/q03mKa5GKR0tpDx2ebqtcDHorIkH+FzlPqsR99seBPPRFOSAaor3nNJbDS0cDrfkhJ9n5ivhs8F0i4MInQCjPCc/kcJYTt1N4pFRgMwOmQbwv6Gt+H0PXYXSMG8LGA5jDbCt6xqXTmwnBDbLJwMSYXS7c1h/EmOaIEi52pVc/Qd6vvtI3CypLbcExZr8AE98t4OsISQuxBdhiOuiOkrhexPG00HmiSoB1hRJg6hwlX1UdZsF33gitVT70fMFcpflh4qenzy/XXDi2vcbM0ZFjUX+h85mFRtuSwSs2VJfh4DhXbjBfjo3ROCZbH1Nx3mkJNO4FZnmBB1UDzMeyHzaZ8TQ2UfxLlq0X2RJXvTQHNr3yHOsOnKd6i1ptfDWobuaiXs4dHXVmuzKPVL0ZFlovgn0pFzjasM4TI3rjAmMnbKLyan2/e2SiF0Z89fpYgOPbncUyKQ/+9cm9i84GoST2GB444ikimir7WfdCMqTGXF/h3sHhuiHKUT737W6aHeev0q1hqhfUFQWETa4OI74C1OKlU2ODD2r0+PQFs1a6Ya84/VnTwaakPWOH1eUQYm0YurrDBgKm9I2cxwzSb8cDOJLXx4GBFngmdWf0bqkZiXErJdOqYoqTGhbSvzCubd0hyzCOomrZMgxkFfmXSlWw5U5ZlVedMaFFtAn1zacaXNm542hloWigtMdXhlk7t0GfRQ45YRCimtmM4zxXkHBYtsQpK9kI6GeIn2m8tP1Ab8sRKkTRT0jQ/A96u2EM117rnIN1Q1n5pNHHB9K4EePEUU3m3zr2lTKbIqYfbQoy6tNjk7fYTPOmBVvljN1nADkYDXunMuzjNs6S6WYeTpGikNsAfmnBdN1O12uYw3NHWP4Ciyche+zGzrYhbQ27amoANsFSRrYreBTZu5EMU5WJ92xrAXIe24cgCSVcLYCszrAajEznzNJeeH5vl04OsIbNgl8rht8Z+8t7FstABpdLMHE8KLt6XPqE0O6j2dA5Gyw2pUqURH881VZA3uLjgTKnStV+rz84t+G50timCd6xrVwVxif2a4oc15F4trBd4Rpox4IVW2ll2qFSC4NnaLocVweJKgMMzA78CnedA8NYOXWjuFy08W564r16/fX37uigZbO4VIPM2zwa921mwkHOJ8s37m9cfbyejlCQhHVnSc6G8ef329dV0lItWVC5Rfrp+9TI84/Z5NdtR9lB5Xv1e/zvwvBr+Nux5tesrmPI4T0ykfchDa0mUomwnf0T//UzK5Nn/BB5fO9Ro1ONr+FZv9ExGAmduHPCVvtfWUuWbWaJ7jsw4jJBXs3tY75XK1pqIfY29NsJ3M5XhHTkt8rfnUs1b2NGsKEfXX1nStP05Uc27n9tKkchyp9jOQjZmVw3ylTnJf8emcV3lryG8EclaIbpTADeLTRsOJPYA9kPaYxa3O53OCclyGIwoFtzTvm5ORJbDYESBfj9zQrJQHCc/Drs6F13vg4BodyTU+H02IJWtdoCytEYj2Lt6Fyy3QNGe4Lj58Kjq2Dwi3KKvJcCVGWeSoA2O7ly83Mg8gB0vj53GiVfDWd6Q84+dpAvzINtn622WVgyE61+u1z9fvwuYCBuisCtSc/3L9Yufr98NMxjshwcYCprFCFOhHAEaZSzY742s1VKMuW4OQL3ICCdJnymR1YttjDciNIGRmPVnHG777q5GU5P0Gg52JtxPl+kwr9lw2yj73ERYkacZzzrFDIfqO0+CADXuoksUHxlOaYS4QJzFpJ22NzSGOenFdXGHaDKtykzPogLfsIB+3XbxxKY7PJNev6TulVAW8VQrnKLjXZEc3lAYeywLQP+Xhy4UM8fVm1RafgFRZU6RlN9XF3pCpSKsmThal4K39PrpMug+T0AKe3xP0IZAsT2qKFYkvkSbXCHGfV7tkahiwCvz9rpa0J9x9uI3IriWhTpmNILm1CnBjh1OvHU49qTSeNNORvEgF7Lvyxfnm1weL5F5uenKyjNfj88KgYK24oa8cWHIQ2GSrNBHTKX/IuJzlq5S/LCO9jSJBWGf0YXtsh5X0/0izKxViqh6rg+VPInRniS+VOo7QjIjPDs5CT+szDNzczuvjmjLk4QfKsV+tzhSIEcPQbeUzXKT6J5ihJHk0R1R6OL26lorDChEuMGSxM+dCHPtcuyJ8D8ZkrxS6n6PBY4UEbbSJt9a8q0tXw2bGjxrfxr3qQvc5eh6ulrS4pVgbirdmF4sHkLVPWFCFlqO8LLR3/KneKlXGdo6aUVuTx+ey/eBRWZmsjmefjej2AZzHQLXhuBcJ4A2ExfU/mZzFuvhs7M+FXeS+WxHckFXZBXQe6X60fT09zGYWM/tnjUa0G75LU6SooCGv8cUqBTK1jLDgqwtxs+wJZ22afzJ9lN3/TTC+g5Zy0Pr48/WWPgcWsLeUMcS4ndHkQVZShC9MCajTUvXoL//7CF7ITm8PkZcH8HbXB8s4GEYDpfOD8oTZV5nbYh9Q4xj9Pm7z89DIgCfeUnzQ4P8dxfx8ZSZqMPRU7/QrLS1ZRPUOP1XPRPXgvgTM04BXSnRT1P3ZNwt84SmVAFMMF8s/8uQ2ZalyBQT0yYAlP+A5nAOP7o4cHEnEWeJPnh9vYOyFH1j99M3sFO/cfb3N8/9qlcm/LBeIMzD6nIpvejysbN58qOlQx4iQmLPzHy2X1prmAnfrfVXeK4+Bwajhbb29ZKbOpRK1VpTRmLI8qvD0ZC9aDz3HS6dimQ82q8Ny/GyhxaRYDppAbeguigGl2onSL2Q7LX5ZUc12UCkovieU6enlJGtQUNjIhQVHNbD7wsugH6h6nhKgOEDVISI8hSOD6jAWfUPLrUgoKIKZWaGzVvJBKuyFaIvxOmwGQMLqwrBPNoj7AwcPdfu9DLJzOiqmJ9N/QUR/F1jyXZwJbJ2TE67Bim6r/FWxb2pe+/Dm1fVJhXQMQ3ckQ2O7giL9UFcLZgVCKo7YDNf0lQiLSegy2aTljN5ndBKJH7OuSRitcRcacIo4bsdKTpaDMLimZ8RczCBK1QgNc+P1qcxr1Ay+7Qx9wPA2Eg4jmPRDDX215e7RvZ7Zb24akh7JIbAjWYPiF+4VLX4Y8nc0L1EUNgoKyqiaJNAnxQEvXp/gxLO7/KsvIhZ6yF14tSU5rJYrq6RJufMliL6ZgVJJcqluxCIeJrmrCjFRdUeZNsiamUNhXdefI/oFmH0idEH5ycD0ZDtYL+8hlN7qPnQN0ptDljvzARxQGMcSuPgEmkH87Jw4XwLqW0+F/El/1AqBQLOMJxvipOwWdKy01aDY3ExeNXgoHVoTGn3muy50IvEHOkQqWy7+ebLlQmCB9AtWkF7VJG1qUy7yCANdI3DlJYCTgEwXdd703t50m37EC4k7+Iq4J4nPLrrENM43q46A78nAie1eogVpautU0k3CXGREixIaU153Ll/s/P9I5j1lTFZHwoiFK3Xa+W3tV/f+m79bpORQ7nJgwUWDCkIUJWbqkWZNgrJmih9YCWjWshqxIAaKNCFfWn2/EcT5yr2j6QpTTDUsLff84KoAdaeaKagxJsLampyKZSUs/G5KvMoVC0SIcxMrrR/JFssVYbVHm1z5kglSedE66+8qH3HTzqmEm8SEjflUZy9FIq2RHfO9If65bL4nhlnn7EAszRyh5IH1dwU30hTe0+QqOK9vKmoQasv4UvggbToyqIgqW9BOZIMXhOZx7JAXF4iqsovt8hWVKu5JcZuPryvg4uTewfp5OIUh9LbLExLTNNgtrtF4V9+IwtPser45e5dZY20c/sczDlev0Z7Et1lnDIlVzLakzifL7Gq2tLIUq7ya14RZkRoFzt06lSR2tjSAkgLyjMhhRCaafa3auWqG7jbhLfu3Pq2I2Rilc29IVpkQdqIZVFj1SQUCGUr15bgWlQr0XOTDQRp/HAF6nqmKa51zZ3pH06ThLZKtHYLQh5Z9CXLQePfC87ob8YzGyGMTb7dEiFXFaHMvnotj2K64hzajFfnoRubx3abH5V9xt9SigOwrbd5647/dIAmzu1X1FKZvEuMAIAWpzQNUczj+FbDAYT2OK5sGI5SzI7FMDrHaE/W5dcFFSSCdAfty3caCw1o663eAgvNQAEFRKi4O7MRVRLxA0PAHMwtdMG4SHHSapaFAvNociekrcUMd32F8+O4Qmlgy6tFFug875QRThIeBZ9ezjGBBYew/yPXUNh4Ti9RW1B7auqNS1K5izfpd2BtAdN2lRAXYD3FpPrACBL8AOF5R68MzLvfvDjQuIqtHkgvgudei8qDcrwp9dTC508yam6idGu+XduvLXAIWsKo2kekGU0t7hb64l5y1dFW5iSVV61s7Py2imRrFqen8nEQr+BJomXwuIg1Cr3zcChWs0l4dOft2H06Um2WWQauP/eQabeQ9kvMNhxwVVygOrdwROHEVPKzVqpR9qZIxCWSvrAtVhCD14aG7UHDiLZZsTiiC1vqHlrCR0keE4n2tAyClBetLcJ1zpqs/oJt+Kotn6NUJP1G2p7D8C/z6UCOgpWoHj3MdMj2nxD3LxtJuKcVUODNJCEaEW+OpTJoroBLX1BpgBVfGVCnV3fSiNzri3OMSPCD3oUqF2wJf5of9BY01N05XHTd692RAG5LlCf5ZyZslvhEaOYZ8VLYHPWJ4My73IWwWeIToZlH4gtBs8THQ4s42ya0XY/sdFwOR4RZRJKy90/B0VxGChJx6Edi8bYo9pgtJM24wOLoLZEywyHm6NuIiKsW1CVo9NKTlN8kBDn40KwlvkSC7LDQvhrciR/2JlpQ/4o+9lpUHZwLstqt9MEplC0MvcdyT9nOpqXXGcBVHN+tNYO1v3CFfZvVJ/RwYbMJh0EjsgUnQSUK15RgewrCa0dPyYQp6JlF03vQTMESco4JjuEQnN9adJRRTJRxE4bpi6fjbrf87uLu61THu5YcZ65U9H9B0o7fIfdkytWoUnbPbQaGS44DumVqXJTlKJd4Z1Mhi8ZhY3Ljysu/U9/6nTPdqoxFmcZx2NuNCj1G9l4170HkDDXvMGu3i/PJq8RSzGgXX0UeRt4h/G9zTdj8Xp1shJNkgfO0iDsb0Zbp5nmncLWVM+s5w7rt9cDSgy3pe+AwF5iq8+J8PVtorw9Wu93BVFDv7NuReWC1mktMhtV6bnEKrPmufd4RzOYBJVUck2YPr8k5tjzLk6JePYuxiFFM7ml5DlXiCVWAA2/3UpJycVzJPRYknjGC1NwShoEJB5jIi4nt2Du1DpnWEc4YdhsAESJVYyHGVCg6X02VfpSW4Wig1iw/H9DGRWo/UOilt+CqNL36pi9Kg2/BNdkGOG5JGoDLrsg2xtEL0sBcdj22YY5ejtoFXHK2Nf0TJhvgLSvEFkK/DN17L8FTovYEKrAU772KXwbee91o77T12qv41gKlaaow0fC3X+33XX43reZ2n+LXGsmU6XmlUDzZ8JPKw/Cys+psL+PfV4hOq25e1BLYqX3rQ6eXa7wq37EVreLaea7dJdPmwPG+VTTNIDLtpXvqGKx4Rth6u8C9tCZsImXuKzyUDyQVF3hHVtE+Z3dyrfg6I0LSVi/R00EZlYcMI3uxKMzb9iOBoI9mWya7FTpJ4M2GqvTXikb6CL96978D+sj92RbRPEHRVHijU9RMs5LRKTrFS+XplZaqVBeCGwfG2YuXN1dv3pQFWCQiMoJynBiCyVf+NXq/54H1OAno36hQOU6geKZBfCK+XM5ZH+uTJAKFi3oyHs+Za8Nj0sEs2mPGyGzxqEaXBUsccdZYMJ1gPG0zT8HTLiZR4MJJwg8kHopvK3Dqa+p5atwlIyKl9k2Qq6SDDTd0QZnphvJcq01GdhxqX5kV3WqKYn7C7ViKoMgxm1MxHDOPUgjUW511p9/Ycmj2rWpZve+e1t6Vmld1SlYfydJt7eWpbevEBUSl3SuYwAlPiFjNO5Jrssg49OFLWNdQ5ns+aydDU+wQ24wMQWYd7HB0R7RLkjO18rQ1Ot3iMRxso6KBSqSGqrN/6hzIik5QU9D5K5HNB85VBhuIjUdq2ekEBuNms4ppscm0uIbMpcNFHloPfEeboR4aT88INe7YP7n9GecCt1uvn/BK2vWA5qLIzLQ9tXNxT++JC14gQeAteaiee6742tukYwZs1dk1VdJcMpZmm2Jli3bC0wvGYYeZbILAUUdtO+5loLrdYp5AG06mkAKKMNNS3pDysUyWbxIq98YZhpczXUWwF/A/Dnte6dBWwvfkgaBaWFNKvCNyZQewpmzla4A7VemhK5el5DhVRPWMsmfQD5AVcK2EYRHjO3ghHUWOhOB5OAfIN5KYKEwTuRLtZJvJ7woR+oUf0P/H3vc/t5Ebe/6+fwXKVynLdxLX2iT78vybba0T3VvbepZ9m7p3KQqcAUlEM8AYwJCSr+5/v0IDmK8YzgyJoejU6odkLZHoTzeARqPRX9LcxOCUy8SSvaDMxErBi72tlWCrR0WcxS7SupoeLXGaJSY8RhGxwR0Fnlsc8lwdcbJ4rp7BTaY1W4fOlObjKaYKGtWGm6uOC//oc7rx/X3OaHDHLQUhQQMPr6i8R3pUJDMcQckGGH78fbXEB8UUg6K84ZSpWqCcya3ACRapOXtWHPHlcjzsZRw0puVdw+Fblovu8FfEM09din2pf5EkHuhzXkUzlqdBdU1pDf/1rcsi6awkvIq0MZ5gmpI46Fr569uyw64dfvSioBxCqucmi9S8Edhm0jO8WYVLAoHlYut8w4MB3qxQqw7lEFghJ7KNyjbS7kIFuT6TCQaec/sEAxCCakascJFN5ht40DICWCHnpoRV7rBu4nr2piAPq6IfgCTkPuy6APp62N4FAbSDsw6kBzD+yKIpGH9kUT/jmnZ4xjXpfsZNklx4zmHcXtYN9eBawBA/RA0YYMEnxQDrmZWUpMcxyezjcgCjTEMGJ0FIxO8NPD1uh5lbkGdEUgx9rmfqYSIb6T0QqWdZG1l6Kg/Z/ADyNaeC2CIXsnyk38GEwOlJ8RDT2JbSB16GsSJXM6m4IPPgx2k1NMLej5sMwGFbtE2xH4OYjQ6bvoQbftsPwFtNGxsGOHDpCPec7aWVCR4FvXG1I+N/EQlmq67uCDUgAe9eHzrpa22TdyWV2U+1O7UfjiPiglSS3DCLUQ5PpmjxaEF2pp3kZEZZTB5m/+S5YDiZdCkLEnERy+bKNZ5lgIEsjH68E2oIB7OuECoo+9E9rRQ7MrRzNvN1VNoX0esNEVrhdG9IVOmb05n7ZGovH8k5AzVWc0mgUr+vD1UDVUC90QIFBkofjrDRIaCy9fc73jayztYYeyxYTcuM2PazNtfhaEdrc4DfX0OHYPz9NfQkX0PJQ5Tksqt9eCCsULvY0rFty9YYauIRMc9o3FHq56ghkL5C3wduyUrhbyuJGfpgawyi5zYn8LkpjJ3iRz2bz/6vfGQRZatz9F6u4GHt/z0zhcwrwixqhkrKVjP0n/r3zkjHgiBb0Q9xhrTUFREgy0o0dlnILOZbViwmuTb/UmuEXTtivkTP9Yeedyx3scpNUnyKH+aZoFzUG+igoIa3I4ASsiGJhEO1lI3iSOZZdyxYxJnMU6j9OYl9VI7fQz5XNKESPCqzLBoMxLk1MiIiwhRule7vMwSELVVuFyWkqJ651n8vZy/Bgr+cvXxRdr0oVp1rSErTlMQUK6JXD0moVonFTdFUoLFcmprjprYpgqodao2ZHl8v6eJzZs3SlNrKL4yoLRf3+gMrIg1egTJBoLKSeXvteSMGky7oJN/mqbGAcfxo7jkMR/eMbxMSr0hcSuDMCCwmmVp3KLYGzCkesWtP2BVEE8YXgGim9kQY+Stujz69+Ez0jDcIugPj5AKPWhERBvd0wq+vxqlnwSd514XZ9lyugBmF/Hhz07WBJ4zAsblPLOxbajMVtCRTMlVrL3wG5z1OtvhR6o300hyjAjNJi3Q72am8IKV1lNu82x3fy9wbeGiv5JWZU6NIvq1f/BGWkkfQFtw1Y7JMn9vSlVB0UuHo/hytCc5AlbtAPSSVyCOViy6HHviP9VYOa0C0cnmhiktlQbb9xKbu56OpKWWvKdCMkqrdfX+ABeMRfxoeGoX/e5lw2YEkptVk5U/63115gfpvrudjM18Z/jgb1HrS0UTDUwKhTWlM5Z1eqApTZqrxVCtY6RVXqcBVB4V2pBXqQQ5xXNzpAYYDq9bsgVbU1x/efbzzdQbao0mIOTO8a2+PRGYz4bZ10V6JzEU959YnQmYP1zuowVvWGdyMm63RzaqQCd7U9HAn/MSYynOeqyxX0MR+AkZ+NVSQoQL95BFOuYZuE7XbDA4Av6ArAE+Zxr7IlxNAf2NoIKDhqhKHwJ7w6P4oq6aWKIMNYVg10Lvgza83H2/O0ZtP5f/9evPl9m9di6fciOAfCL0RjdOholzG7kebrdYpV79naqBor1lMI6ycQ0QrtwZw2U6X84svy8OK7u3Nl1Y1iiHyAg+ffGxXwHSIZIQTEs991vNAqd2a4twaYdMIM9iblS56wRbd2CdEDWkOoTB7MjBCYt0h4Ur7Ec+jcz/qJxX1CPD1W0bYvWVvEHvZByBI6NQYXNXvuhyZH4i5tveugXMuWn2De4H24Ri2AhxQJOTgBZoR3OwtcWy0GsJQuEmOnxhtkuMBYFP8sN+SDYDWRsKZoMBhUDOe0KjdfGn3M0wTjhfMLxtq3N6GhL565tK2qk0rQE1jTBytO8/9KmbbOYl3nwjDIO+WYEGlR2EWTp/I7zY73CIZqzITjj1542ikCdc/DyJedNLwcd1JoZ+1GntYqrnEGzIzzkI5B9+F9zs9+204z1UAixVQp2yeCb7ydIqv0+4W937kS/49kRvTs23IwxPlTtrde/Bw8qaoLGk2r5ueeXtlPRKAQuHx7pv5hBut+yqIJlvcgpgwuqfaXI5+VwPd41B/okXuyB93laPaNquJ4AnUzHGpl5LPElsPMpwR0TVkn/0geCu6awjH43Ra4fedG2frIbe7IWauVNCrcNlu8BGYlL5zJ3w1Mx3Tj0NL0m9HorSkQqq5vp8cVZhrKlWyw4eyN7EiCg3cUaH2ni3F1Xg3QgP23kbfJlo7FgXffiuq5nKNL49CKKaiFZkWntIip0k8b3UrCU8obYdHhifCuzViKBJYROv5grZc3uEppXmiaJaQB8pWc5zR6VddFM2PtZWsZ3XXyguiC0XOjrG6VZTNPUUCAzPjTTsITGP9bdrxE5HPo4S32vMGJmNqukOftUkmvxqIHTgw4JCIANMA2V/TD+0l4epPM2qmGgLgiA5wRdax/vPgQIY9sBqig7BC/Iicu6egYyA1JFEmiKU5ACgjagbhAt5Yt2AobbCzCUxoJ43vhGcCMY6Cz8Z8jABImVSYKcwIz+WMZ3KeETH33+MD6qk6WRNTcr/IgryMj2bahOMcjzwU5ljmngapQWUMZDIsFMXJjE988NSIEdH93BKE2j15lDPykFERSDH1UNrQYMp6J6UMR2S23mVlhyWVUimndqdk+ULmi1lH9fppiGVYKSJaPVgCE0uwIlLNl1zcz/PJVWZKVwIrMofGQfHcZgWHpdlcID80Rx0TyOoG2RXMWvTicR+eNqK1oz3pXsmD/2ERIxoTpuiSdkYoFXfozWquVKgkdpder1TSQ1fLNhDRMt5RD+oSFgp9shuHUdj7QrEh5hLCryox5iYeq6sZlgnWslmSNtj8HCX0nsCbOWZFuAZ0Hj6vZF/WI9I1n7bAEltZFO1eWkrkZFDUesEGGhW2br5W2VGmLZv5teOvL0Q94uKwnW3IXehxKlC0PF04/5ILhJGkbJUQoGf6ZIBvB75XkaD7Cbadxy9rDf2tRmWuIx3r+L+hmyKl01+vCnpGd6WK7rAhGymj48B/XpNKrqkWtGan0tjUlJ0RaMgeBQ4UDdZvXYMr29kfAMyul1MU7j0RjCRjuAgo4F4Jj0DHaEROT8KcoYRvL4qk8qHRqcDN0SS9L0oaJycoc0A1APjxFvIAQHyLqTpBWTKoc4TOOINkur5sD8vHMXXEOHzi6+kJWRKxoRGUqmexKRis/7HGIt5qaw6yR0Weqd79KL4eT/TBUEu+VN/TvGi8+3H41HOzB3JFcHJ6M0MZomzDk5wpLB6NCrBX7o0tA6W/sV3ThMBv14+ZFon0xFJvsazJi0NtoaKkYbsb7Oui4BpnyaM+PL8w+jBIkMfUi6crIH9u2/irXJTlrdukN5h8j/Rhvv9l3zdR5VORSV8SBN6Kqi3A1lyqmfkwZxcMyjjRbx7PbbkzpKmrBKnvuGhACRkZmtTd5cuXf0D/3ZC7g7Fbg5V0auPixNZBwffEZM+bUSlTvGioYnSLJ/bLg0VD+Ze+mqKPrO0ikOetYR95DgWLYNKqIi+cNytolyRsKSMtN/SOC0QecJol5BzRJfpja1izpPTXsUI/v/yDhnau15VZXNbtMYuyfOakeVdU5br8S+fk/GtdYf+1Lonf7/XrX+W28x1Zrb/b5R4Of7duw1i3pszcKQpS21JaI5lamZ0WyIfSDBpkjGiz6ST5HeuTPllGxp7qp8nIQUf7abI0/Hw/Ufx7HPKnyUnwk/67YnPf4/40mfxez/yTlWbvwd+wAD63ejTUGfXVuTjEI1R4RjT03HS3gdKEUJcQGktoLs2kDYDf8ix+L6/ip/ye/H28wJ7gQ+ZJPwA+9TPM/gf0UyPf98z9/d2jKhO9TulhhVb1EJW3D6hze/2xqyJtV/yf+xn/PsLGtZexvutXSMatVNwB0w3ssVpnDf9iI4LiZG4eW0bAGwjhubQUXKidaT+Q4kcoS76A43pDYxP/i5OkFHprTPs+0MNQ8EZgmhXVSF6CctMo4nqG9JKReaRX+DJPkq5qcPUiElMDtO0V90I4vqXyAaXEfeBdH2/cgI0+MvQrZfmDeV6j7Wh4VXs3lCSCzlowks0zpnalMYSlqYdoPoUk/Qa25Z8vfxo0g08vIFe0O4iM3GADxdQatV9ssKwCNhTzCSalSUJNbwBpjzerVkyB+CET+2QQbQH4PmNxaoB+jDHX5+D1jx/7Aerbo+nHKMjXnEg1S4lYka5UQ4N9jw4SzTAB2OaWpERAs9YsAl6Lt0TY5gOx65QYkw3tvdtYtswSOS5fptvzxIzV5uuoE1Wip1K20Ff43GOCjjszYTmBGbEM7DhtArDxpjxvC9u3hbmVqu890vqn5ogcmemZkiW3zvBmNfcUWjqQH2xTvuDMPaPMHdYvXKuwgStvmAYwzfCm5cT0E0kIW6n1JExgfX2dBnvlyrzkooTt3eumGRZUjuorV2+Rm2szmXee7ocvI0PBNpijrHbMvwCmrn/8GHY+Frn0Fwzfi5sbvwM7zoU2TkyvxRoL3cr4bIFZvKWxWiPo+/fNZKi61oXmUy9m6Mp8XGKVC/MRHkW5NphNnFgZ5idRlHAJU1+P3HMiWdKE1PIA0V5+jHKYViRn+acQAZ1Fl+Qj3XLAZWFybMsGzYrrS0jOMkE3NCHa8gFvebvmRRW6mb75SJfLKLdKNcDwFbr7MSabH/VfL++8iFTQxs0FFD1GEwp5UH/ygwAX4DzjtMP3sDcWGFjvQRi7JRs/GlitE15k9Pi2x6pNXIbftH15FUiCPOlq372qNbp5aKlV5CUI2Udoo1q1B/GEVGS3W2LQGuCYnhpo4j4O3tO/BDdA73o5LZai1AfMQeeYXVJmpMpRVjnEnG8er1aCrHDhnMdJYlROI9S//OrBuQz7umc/1NWPRYOWPG/eN2rb54Bt3SysBSPtIuUx73et+oF13j4QCfNTco1iHo2u8ObRwDtF0Ye+hdPf2MEIETRgYw80AeZ7FEcLBhB2ag9Anzo+HkKj9s4AaJbkEmT6om0MJxzHh6gPbfzrMdzt5sAN/+zy2VglrP9E2Wq+xPpS/kob/eMU8a8V+MXFI8FSoZSyXHX0xH/251NC+meLtUPhPLs8KbSXHrh+3BCj9VRrwrMWUEyLV9L6Aw5kMQ1i56mmwr9gQnD0ZKurY1UdyBN8yM/RtCmULe3c6vQ23rwzQ7RcFF393sar7KNdO+Bc29np7UmuG/0N6J7gVmvuZ2WsEVhUds6Lu5CJATFus5gTCaEgphM48bUUt+YkVMKTCLO2/WV6x0p0JklxV7WiwZHKcTJrmCEnfx0bNLGGt/3sdU+FORitrI9GYohk05Lrs+J3Wsv7lcINYJFahiryrKzBa4UEscrQVCqMqV5EhEUELYjaEpsHbJc0vLNWfTV2hrwp4vqn+UkUk4ywWDrN+/HW+MlSLgiKicI0kecoAzWIojWJ7os7cmUN3w0o8vtEdygrbv+Wv4ZGfhFOojyBi/wC62mpyKIIXKHQ71lSqaR7I6qM6SUNN41SPzh9AIN+vP07Mm0EMZJ52tRKbmIpM11Zinn9yNBvlMV8K8/t98nX9m6zouXFXNmvD52rDp2DhpYi3q17Bs5cWwfh1tbpiyPc4qa66VZEmSBL+vAKPfsvYOsfTZun7t/QhwWMUtoStY74Zj3Z5x2No1bi0S0xnwez3x3xxJfpkpmhS+mpdC1YI+PwPpWaKvTyHuI9vZ2aDxO842Kdr0jWStR9gs2qgSBA8uT71Bv83GLNPyGlv7fCkH05yTjvqtWLTmXfvq9Ye5S5zrYkrrATYm/0MHDQnqhHBdTW1aCeEofooTBLpzQV915E2pAVe3RDCcuIQ4EWuYJbnW89jeRM5kKbd0/LGN8QEfE0paO3RkyWOE+U79VlMA8H7O8rQ97EkC258IIvUlRMX5EfmvDG+H3sGBXHj+tWUkm2qdWLLrutKCKWjbeN4+XZELUe6QoEF1sT/JCkG97RH2Yqr0wzc0Dfefvit9iTIuzps1QVZIZ9nRHCuE3t2AMFNh0SUSAZKBjKZkQILqYRixnapgQaRJStBszVsTBJwuJ+RJTNYsGzrHVqhkFEWcRTCIqyc1cGVFqyAyQ2JUCeqxXfDbDqqqUS4WSLW80lEHqpjfcrLLbagmQxenN7hRYkwrkk1nWibQFBMi5U+TrSnV7pBGATRw86j+wYlfPI/kYfRjjGCp9XWxmcV3tENBosoGOcR0PcxZaB+kmDainEWE1E1NSGqEf4PRM5Y5StnvnRZMEaN1SBZLQjoCabiB4WhKkdZFeTkDWX6S6iURonlAWe6mWeJK4Bz4Ue3lwPFTeFNqpVNs7tu40tvtEaDotVnoKHXZIMC2y3vyeEpVp7I/yWcaM6hzLEEIO6i6B0aFwrHYKuy095sCAUYWbTl2OiiEgpI/G5jZYkSWyqhFqFU4z0vK04WZ4SQaNKTyF09uX66kXd+wxR5mZg+0omdw0a8xRbRUylNcw0h1iiO/O3/+MYu+tYVduAjZO0+KNcwObRprJeKjEVkMry6OajkPznCq8SigK011SlZoB5MvBzQdjGywVf/JO0XBXml/MD+SRsQwVnesWjDRZUo5Tdu8fULtZnkC9Rt8bnO0HIm9urc8OwOaU+3qK/d0xgrUw0Cuffe3vz5UJmJKJLGlUde1lZ5mGs666z2A7qux4P9Jd6Kl+o6oG2qwpPE2x3YaNQaPcscuQHenru6kbxEWsKFnMBnBaRNXkWg7FxrSo2qKQpTbCwDz5esn/QVApBVgnEVGYJfiyNUMUzd9S56iPtQhN+4XbU8fquJEw2tZttfeSq5V8pet4KbiqDi+DMUUhg1n5hsEwLnqKX7SSppoh3VLpCR9YL/gpYTcBmTUyJ1wQi7JzeHfLU2sOXXFiii9t3hjHoNKatK57uhAgFgTRp0mHAeh/1B4awmLeLsedR33nXd149kSO3XAGuKpO9pFbFvcY7loCQ8smemTT6T0SCnYtuiUK39BuZNbahhyEeRXlGzdsUWLX2M2efXr9/sZvV09PM4fiTayyeahEC7djHTC47M9f894k9gtTe0YQUn+HCWkjOUWMOLUnscjKPDVRWTOmlp2qZCTvSZ5e1skOrDJ4RdtCh0EjUq8tAwviDT4KEplTNJF+Ofq0dukD4Uhkq7k2/B3phU3iHrN2VKmNHmOkLeLTWxkbctHOwQpg9wqnUJ4o1bl31QolCDz2VKCpja1FA/bwFQQJTaUYRnKuO66Fv4+29Jd+7LD1m8ciy5oahBLVSIDsfTG4s7004Xkrq7Vfdj/1Wkf4nSOk8bpkYayztQHJNMwhiaPtZOLvQ4rAjgwAlqREA+fW5Fvq3essHiHq8JwMEjOxqur6Cq4peSRxyIA03EmEpeUTBjbWlam0cRlrMfsveeLegMgJ7rhB2o15fGVeFrUflRq+4n2z3G++oeLHjlawqogyr9XRC0qO7YEC7jiDqiG5I49cyX5hbxnNpsklN8vookQG1Ywit7dFBO/fsCIlFWV7KAsloTeI8IabBM4bScibfBcv7InbD7iPvmK/Nd5x+5kwJniRWs2154QkuSAl5jt6+uwUF8umzf1D9d6kwiw0YV9gweURLTEU5lNUzmeBaX1DOcOJxIYJ0IFfHeFKLS5UL/HbTWEQpbwldrdUMffpcgeEdVxCc2BtaA5QkSlYafXnvn157FJXFjesTAEK2qRKuDApGK7ohTNuelO+Kf+pWZqhPoaEB+xU1V+D1lfPGNFfPTgAd6mIvCP5NoH9u9lEbnaP51MlOJqOlnNkJ84Y6oT6DZAyrQAfmQq85KAQUCe7q/UGQEN8iQVZ5goU+FTuHMiJ5Lp2eUBzWsiCS5yIiEsk1z5MY7BJSxIKNkMnXnCs8vUg+N9KWOgVjNjJO/GkAAMmpSVzdoyJnbn9yRuzeRGdYopgsqTH7uqVcXRxdSUw+6cFVbWrZvWYQ/LQiwnoL4VnNOmWIVnjFRgI8VYXXOWitAJAzGmtinVW85Y5YbLVjtySz3ArFmN9pLuEV7yekFz1dravW6E7xCnXC+7XYl93y7divVO6xUYWaiZzBVesUhAHObc5WREIikaIs57m0e65zYMoaV5T6JjYNQ/1SGygmk/NqYEwtpjJy1aoaiE/c4ESC0qltGL0p6iqmW7nprQ2iIAnOdodXt1lXa8GVSkh8dCHotSK7ZnVhMv0sNnQGTFJPH1L34wKbt+ZZV+t2F+2k1uTRCuhhjXMoiQJVzZc79VJF3elVXZsh4w+gAsFZOFT9NyXOJj9CC/+0q8lnyuWdUYYYZrxWZ9DutGI+egwM3zwNuzPhaIcXeNC9yd6CXIEzT0iY+/ndmi5+ntiatu+zx7Eai8tbfaHXMti1Cqjen3vW+6g9bporhuG14KUKvgAOARIpj7vC2/34XNfEoyE8Mw+2L8ZAzYjwe1jQ7rAh91MLHzp8ZRVctrRnwTZniOBoDR9trLAdxzeV/Uts59MsGqc9bXqZdQub0NrfFehECnS8okxJOoMXtM6HYTRkh/a9LI5gvFptxT7uLR473V9nrsDAULOoZDjFD6fD9JoUXsFq+Y3QnJv3rlPkunS9mEOmXj5Cc+uijPW9vfv4xNGavDDXFI+/2jT7Ly33XA49H7T0lpgm+fT+lPpbr725AEPromiFefY7a8zpC7TF3VaU0MfF4BtbSlK5PTXdsCaugocLKK4pCqgCAqnwpgqh3UOd44XcW4WwTlWvtMJs9GHcFlZNKDsMiUOFdfKqyHmS7IJrCg0me7efJLQCkttTUkHNzQYT2jniWWvWQVmNVEr3p2qv2BDRycyW+9O3W5oi6DVfOkcdL5mTVyZ82ZDPLgXR7SXcR3Hcn6bp0py3kLaLHnuuouwkVUVNR+hD5vPbm6J+Ybtc/BhGT1U1VHVCk2OPjuhxju2nPUFM34OesMJqyqmlMPqktLelUUjrNJVGcyK7H6vG2xfGYWnqAM4x4/4aGIMFEHCtvGacPaY8l6UFCnddxBmydQsTgqW6ECQiTCWPF7Dbzn799KVbQAmVqpbAm2ZLic7kOiXpi/OxyqgmPH1LP7Lw3tGEXCxwdF8Gp5fC+fXTl4LdPbgCWR+Znxt9QADh0HO0pkRgEa1phJO5EdX8tFRj1W1c3MQcbGs9FfUcKnrC6L7ul9sg4pLb05RWeSMbLLfOIevy3E9urqLq96NJixqwVXVR23ndF9zmjtxLUk+gNrsl5VeoXhntsTpSnGUkPi2Ob20PcMPthYGI7P9BX51uVRxW52R4ReZQue3oQTJaR+Aiu6J+O1WCrlZEkFh/YpcDDKCPXA//5GL+HfANQHsYR8/e6089M/+UaK2XECtzV6wzwFRZTh4hh0XxXddfU6AESkVAck1Mq9kdA1eUnHe6XSYIPINaj/p/IfqMVwqmU5OUZ9psDQ65rPLB8y5f47SM8LxySTuUlV0JuYNYOcaxeFaWnBGYSVsFqSgj/OIcMd7t9w1ruAop55ryyUjtQ6NsIV8iXAjSK69xoTNbnJ0Mr7fFs8ees5czsqGRwosTOvHfV9yxZVEnQaIE05TEgzh1XC6Se+rT4SPCZd4kPKoWSv09SiZ0lMweQTImmvBUVmyz3aNRPKBrlkQI4+0zfVtsp8wFLCrTErn7AtP9WDNKTJQfJ+qyFMD1jx9dKUnOIMxfS9tEyGn292cc4rBJmVpvY49Nn+OERo+dFStnMk9TXO+gRVVCXqEba1/etj8wtrSlHaJWVLmSGE0ksmUZ/b2+Ju+5NaivVqXWbgGb28bqTbyVjBPDWCAklfrlVmBjsNB4eIf0wUD0oKNQyISQbAqRuIHHoVEhq9ZWwJhxR2H5xtMFDT9DZthRSHJ2z/i2eQM7HEqJoZJbsSamVKu+mSQxe24NGyUo2WjlKPS9xSJq6zGB6UHtWRvfLzTNgqinqZv7oVL805wI/jkC5xZVj/PAJXTduBdmUgYg0Td8954SaMlUW04YJ54hsoP+lBofKFTkUG375ccEpkxYUJUrHAxexbPmSdwhHYtEPrJoAj1nodhz26IxHioBiSaabnvXSh7dk4O6sn9+e2NHkSU9s1a8lsOI/WxqvlLu1389zRlcLfVxAv0b3xpFCBzBgzBlVEGllBm64VLSRWKTiKUVraFzXhRHbzt2uQC/N2H9FeaXOKWt8hPDGM42fxrH7Os4FlCrA2j2AINmrDPqrwHU+nWB6vLff5q9nP00u9RC+Only8tXL6/e/OXV6ze/XL36y5//+POrV5fjQP8KTWGvbxA26K1LzdYOwQxd32z+pIld32x+Lj40hLeMi8Ed/Av+fvppH/iaVA8mQVKuyAkI/BMACSxxy91RRG4ZGC5zbY/tswP/7eeLny4vLy4v/+3ijz/P2HZm/zKLeKs9WQ/mm8+fkCARF7GnVBOxQNH1jWuTyRcKQ1GIDcVIkA0Rsv3Ucn2DEs7vO+/nDTEQlcTzLMnlnI+qF1/2x9mXfSiMvVySyPplsouEbEji6pSfkc+/Xr1wFpGVhZ4086DNGYEWqc1RE7wgSa0PgSnArkf7H5dgCT9bcj5bYDFb8QSz1YyL1eyZlu+z6i9aPraiJrcew9V3d4WX9fAo4imxRc4wQyRdkDgmMYp4VhQ01wM0B4YvrJXKXv34Y5YvEhrJfLmkD4Bj8FqeQ6eTcJbpL3o4+6GFY9NksxdzAivQLjdk48J6EDtPSLhmDKUr0j3SgYViy+aV5/xAYLbq8zRXDFdS+gxCMYxT7qeXKFpjE/Nomt72NaQuoIbq8rCTCnkIeN95vZA8yVW9Hht5IFFuHgB2Q4Kc5mAL50u5ciC7zHl5vOUyu/FMdyHtR1UAyRplc8Y3xDFD3NXjp5q9eCwh61s8zGnoSkHM0tHdxNxXG3+mLMvV3H0opUlCbYL0yAsp7ELHK9SSKYea/XChZ/YVUgKTJb3/ofDhPrO/efaDl8Jn89dCbWaCPzyiH1HCcYwWOMEsghRH8Nr+UJdd4WgtaPonuYOxsaR9U+cgrAlOao84o5eaKwxamDNwAFqMz6WlgF7fXCPCYniSGbikzIzNJIlCqQizBPiyECFlUmlxwW3bs7jKk1lmnLXss72rqX6y4zVmaZdUqnAgDqH118Or2dZ6fALA9otK4UPbrObd1aAORfJ6QwRekQKHtxBUJzapsMrlPOJxd/3szkoPQ6o87CdLKFhvsCHAZnVPvpUrWtE88O8OvQN/QymPc3iQaPpofGrGDY+GK5kudWHA96qLLs/RDrEByJkVjt0VRFkgAxWGeZwU5GtOZLgOjeDPdIOiNWZx0nAG1umTh4jAaGERdAxbp70VVJF52AaMdf6BAvJQaE4CjqfBgeN+6uGuI+4ll/pnfMvFfUgj9jcYr4daOO4suWwQkziCBRjs9fHWvZGY8c/RJaJLJBVNElTQQoxsy8XH1ZqILZUEvdyFNLAGqGpyC8Q1wH40r4GG7i5IMUkUnj8RMISXigj3D6j+qG1FV2fdpk+h96//Pv/0y39++eX28+0uXoIruF+KAW1h+13U11jgeyro3GcE7YvgynRCLeW4MF0FmhGoDSySrhhOgokhpdDCz47qsfK85Odfc9JqVBbgFd6i2GJqGpJxtCA7jz+HqmkjoMPu0FZJWdPgLFoTnJ2jDOeSnGuU52iRy8dziKZ4sVMtyICoPH1uXDqNqVqSlPnxuOzpQJe2vS76RgRHSjyiu4sLm4pg2h/cIQ5UoU5OxXTz8rSR3wLN/P+yDYda/EBTeSz1erBxZ9OxY90jvhZWh57gheelOXTr8JAZ3rKg6uWTGbN/R6uHUNaSwEwW+uTbTo71TW74m06fS9B3cfNSj7gg4Swne7hdX3XTMh+ZBzQOcdHvlAh0s4u2O1gmimpQdSPdWAED0EAztVDulWoElx7WVN8GLH5jvY5F8FwFi7L4BIMhr8HlJ8+XS2MUBULw0Y03AMQE17ZyMpaYJtrU0zR2CiL0ja0FQZOQzumxkdmawIufc3tsbuE3HY4P+9dxro+SCDrc+RFjhaWqv1KOdpf6Bnn6wLkrh6o9bhHlIvX44Ui+owmxzxCtgYuFiTMcUfU4647OP8ydUCT0uNfc5tSgnXH9LZxLQfxpL/vCfCcICY5S26VBUX4xhu4EKNv9HQ/CaFs1DkXZbA3ZUgmNsJPR2qDx/adXBH/jUnXrgCjLzbSk61DXDpiWtzdfEGXofWPUKlmjAcLRLct69xCGLR2OLmzoHrI28XSabfq+XnV19P604KbSyIHgTaOIDwXHiNKXhbn+V0BfxAczLKoPW9hA5mKf4mhdDy8Zra06R9pHb42M2DtIb4XVkc5T8t7I4eTUZVV9rPTNYzIlwpfor5rAwdsVsEJOzYRQYY2E0XvhxVrTfsHkCmowPNiqMjwca5RLxdN566kX7X6rr73T77WR3wLh9hvzkRW2vRBvKYv5VlYuxL+Z33RciLWu/a9/wH++hzsxhLL+Vowi11yoecTZkq5eoSVO5NBbc4kEHXJr9p8KRSgBERsaHRblZsdohLnZl4KKNBwxeWh6S4frsCeoev3bHz/8z3fR5euRUUKvUc7o1xx6AheVqg0nLogcozWWa3efsSfzc4n++uX6ChLEffW/7BjAU09M5MiTs2D5NxLJTdOxOCBQbwS0mMoswY/zvSHatfHLRt8I3/IkIZFqxVwPwGyBACw3E26adrMgFRZGg4XTLZ/NDhAqz2CYJiLTP9mTFXX3Olc8xYpGd+10qLs3nKu7c3R3RSVeJCTW//0esxwnd+ew0O5uwZlz189yyIxLzYwpsIRqOZf93L41TQMpW/nYvcG5hD+Z/zT8fjJPSPo/b7WEi//mWWb+2wjC5C/3SYI8UOULUTvEp8ZFSb5QeiYUgBrlCB5eiD6zLTzthjOPde2QOogQkJZbpLjJzqZsZVOKbN9rGHtFGBE0amrd1pgVDDlLTIx/ufUzwU3PZux+dWH75EWVb/YF1Q9/5CnTsF7++8g0JZC3WxV+eVdSgVyYzc31VV8kuol8PclQasvlc+kiqmttJr2x1d84vyckgwgZZ9v8b87/A37XYd0Ufy8qX0RGQxfNEtGS5+IiIUpBwzJGFYfWuzYrA876Hhto1WUBVRGjMbHZd8U375q1Sw0XZp8ZJgoei69XsaMdTxEpU+Igy0kPMABhU8zme7WhLOTDsgf01S/sJb1cP27sjlIAWSb4A02xIvMYKzxvvZYf8BZejm2iKPiygktT82NKsCIsepzhTahYO/cqb0cuOuATJkmqT0YtJNnewD50JFuTlAicyKAxEuVrYUEAfWM8Jh0wlhzqnk/xWulGrtUFcs2Iuit/pPgBKp3OHSUugkrovS0jX9ZWgAf2kposasG720K52lrJRsiz4FIcKv7EYXULbtDKKmC0CljuDYOy8TBYns5xQjdEHxzMlDMIv8oqY2uzqpypoiJDGUgGaPxgeUbYpKvuQ/dq07Td9hi40niupMIspmw1XTRshUgZEwNiZcSEsC3Kmj7FORcluawV90S1UndQLmMmSERoM7YmBOZSftbn5EgiR3I3MEkmmPFuUJpcByBiZlc+slZeU4AqSGZ0KI0C+ybCQjxCxdbaJioOtkKZd9SwImJDROg6QJ94QlyVuTamDiRQZGY+3bFmCFROt8LQgzseRvqwrQlE32ESguOuTbEhQo4r9TLYaLNDw4V6kdMkRlIJs58NaD+iLVbReiL1B2MTWVHLkihXvQfKkQxVgmDWTITSmEwtI74T3/8PAAD//614dWc="
+	return "eJzsfW2P3Djy3/v+FISD4HaDGXm9e7sI/CKIz947T25nbXi8CYLDQcOW2N28kUgtSU1PL/Lhg+KDpJZISd0tdY/vnDPy32lJVb8qVhWLxafFNXogu9doSbBaIKSoyshr9BfzV0pkImihKGev0f9YIITQW84UpkyihOc5Z/o7tKIkSyXCj5hmeJkRRBnCWYbII2EKqV1BZLRA9rXXC03oGjGcE8M4gv/Uv3p5wr/PG6I/QHyF1IZohEgSllK21j9kfI1yIiVeExmhm8Zb+jMqK1KSKAAIzxPOVnRdCgwiohXNyBV8Bw+xQo84KwmiEpWSpJomVfAn46pJTH+CNlwqy8m+/5lrVns4ruCZfv8eXr6v6HAtcRhX1FWa4zisuAoblkgQVQpGUrTcaRy8ICA+WyO5k4rkiDO03dBkUwNv6E6UjFG29qBRNCd/cDYCjXtzTjSPREjK2TAY+6IzK/jYNP6aMFAMSZHaUGlMOdo33Rf/E0SRCufFC0sUbP01SrFyehDk95IKkr5GSpTuxxUXOVZ775EnnBfgem/KdSkV+v4ntUHff/fqpyv06vvXP/z4+scfoh9++H5YoAoS2hpDJtYNwUEESbhI0RbLWr6WUAqvZT+XN2JJlcBip9812kowhAJt7wURpqEwS/UfSmAmcaLq9kA6JrQYm+hg34DnrxFf/oskztfMH7F58kB2Wy7SfqBVrColEbVPQYAyzFoIiBBc2K8Nm7XgZdHP5Gf4yNIDHhAdISbhNKXwLs4QZSsOnp1gScDQNB8dERGqo6Ij6NDYYFb97jAp8lSHnyCsGpqlE3UYJDztUs84Wx9CHYh0SQOtxsu+NhtFHT6MXBeVZLxM6z7qLfyJCsEfaUpATIVTrLC/27q1T9FK8Bwle59KhNO0DkE4TWP9QuxIApOESMlFsBeDVyP9VeTIth2bJAPe+2uje9tHGKGPXEoKhqv7JImwIIgk31+hdUKuEBcopWuqcMYTglkUxEaZVJglJKYDrnNjX0Q37xwk6ERQjpMNZWQEh+GeqeLR7NfHcbEvxA07q/Ssvo9yktIy7+d+a0hopzqMuU1zaEbVLm50eRWCUl4TLNX1q6QfwpsGIQSEEK17Oyp1SgHpRNXNhRAVguvYSNM2FPvk+qkfSdP07CeA5W+crzNiPC3MXZD1YFf7Sb8zJJ919JQnD0TUnv7O/e0hbp4hqbCCnDTLSKJIatzcPAOflRsuVGx6gNdohTMJZoNZsuHC8buuvLzh5E2RK1j+/qH5SfMz2ycQEdH0tJj4G6O/l6QmiGga9bHL8frEKNy0C03OZacWACQSy5JmCnHWB6URDI5EYvtyIrT99fHK8JJkssNtL5cYyCcGsNxoTRg+ldGCs9Ym+9785SFyA8lAw1C58ISe2jaB7KBlWt6H2eXpbfLeDiu6rTGRpYNcXiPHItlQRRJViglk2COHviHROkJP//2n+Kc/XyEs8itUFMkVymkhv+1C4TIqMqwgpT8NyYc75AhZDAlhissrVC5LpsortKUs5dsAiP0Rz/EYLB0vjxXOabY7mYUhY4UUJN1gdYVSsqSYXaGVIGQp0z5padGBQItx3H+hUkFAu/l4jdNUECmJ7DLIcdLhcJCQjs0Gi3SLBamZQQGgxFm2Q7dv3jYxuDjyUC6JYEQRWUeTvzd/87Ctn1dp8H5OWxOtc9nBbrH+aDAA1a8eHIYKnk7QPTQ0UPBUk154WZU0nZQT0OswAnaywMl0QtUUu8xgBDapBhlPSUCFYzvXcYwMNZTjossJM8aVrn9Nxq5B0s9zyoSlwbciG1BqzXaClM3L19C1EcZUbuvo8tb97aHaLveeUunNiRI0kURFOU/LjCx6ZWkXfM033dqcLW9FIV6NlhzLyX17OLNGIhZg5nKmipkdhuoBixsbNTFscWNcE6HPm0b9U7cGyvEOMa6g/lYIIqEhqtKfLl/skUAZT6D/CcoglFoEKkLeYmZA0J8fAYjgJUuRErTQhUkwl5wmgkuScJbKIIh2BPU5QoDxr+5TUHG6YzinSU25zbJRQfBKV42kawr97MFuU56UuXOICL3JtngndXFUcfQi5cmLFgpJxCNN9sJ4xZhkWCrgDFlqP+/mcM2StJLruvaKqGRDZG0YYHTVAAYTwWVBH0gdGV68cb+98IeH6rl1zwUUvDOCpa7DK9wIBU1xm6z82YJXwCapJrm2sfhJ9ugN/vmItGVByA+kCSbJKGHOg/rRDCCCf281NVPeqPPUMIomkpRkpJpfGIdmBKIGKsOgWd+XfqR9aEMF+Pb/85anD8QN/34t8yUR4CVJWAod+FeYZiRFW6o2CDMDLurFz7iKVxDxno0Mgsgyg6gPM7K6l9D4+sWQZQLZ/tmEsPxWZdYjTz9k6Fx42Xa7+SAP6R3wpIiXKlqEIAuC0xmdE8h/6a7ZlqE1c+ZHejEn7KDteOAX439tUZ6n9/kVPsL1toLO2jFq+l+683mEOLJbvLw9d2V5lgYdUnnApB3alMDgYTHWlgfQvaPyAUnFBUxyaLNdjLNYB6cqDkTFXpHG/c+oTyY4I2m8yjj2veSWyBREJN18eqSSbwmWpbAjnpwympe5rorQdclLiVItKhTqEIZBqdSr1uBXaRMmNyiIgtJCvfrign40WoIWg0EviJXgAicwsQwA7TQTlWMkUlzhLFru6mruaON3woQ+HiHKZ+BuCFSyaMDQNjyBFUtI8ZYwsGjN/LK3HKUtmV6X9/wEA1imUj9SJifPZpvHS0FwsiHtbMdIs+Q8I5gtDgILaydFSa4ao/sNzPNaRuhPG7reXG+xIuL6H9A+/y8nORe7f14XifrToKk58OajqaLWraa2H7eiAwPXM3Rlo6RBZz7E/qEkeDHvfpPzkqmGZDxJyoIa+wdkJwpHWUqenqd0UHXT8E4UUT5jGU1ZF5aGapBEnijrs+qKrLxHR+tGcdf9LzS/MwJgXebu0K0YApTGlMRIrn1MYWawQ3GP38RSVlORPoZmHlBO1Yd80quj5XGdR46lqlaHjbbSAUT7owRh8TVNza6ng/WgMNR8hBklACKjIFLtVGcBSlnbS+pwEHQTqXgR65GQnDynacOhEiWlEISpzMyhQY65heXbBkA9OVJA7tOcGdE/hKZF9EP0/vPnj+/0RAwRjfmjxlwcTMDowYn9YkuW9v3wqsVqUmINUxKSKNguIV+jf7yQMnvxz9CUixPA7yEB9d1D3rEh924q2Y6kjChmbLolgiCZCFw4eYws0cLvN3UzY1U2W7gLam8KZt2cgAnAhX/3hvBhkBtNYD5Hxd6S+a4YTVFaG3EmCHsWiyMcedlqP46xrnV0urigMw9wNn0eq1zZkNdzo0Qq2YfkwdfVToXjgWZc0zf+kfqBOJhxQUQsSeIF05PFD4D6ZMnrbS0m1/Hj0EhnAvEXoH0AAquT2VBY+n4YWy4eoDNalnI3kWXU3QwQrTj0sqdptdxkOvZAtJ99WUDlrkXCH+dGcP5NUzsuO9FOI2IvoF5tjMAF/+40fSsw1M6qBR7ujTai80CJFj7eSVFO1ShvP/52XItkvGf6r8cvR2oBcP3CcRoFAcCmuJkBAAuU9aIwm05nxHGnGaCkKMMgkg3NUkFYfAad8FXFDuoxYgSq2XXUxmUYRgsvKs4YSdrrMk/zoYrkca4014DG5CANgaMgBCx3LIlg0EDZegYob4B+AwqyrIYQPRBSxDijj3NEWgMKWJAUaSaHKCvJuJxVWRmHSk0QUU8gPtqSIeIi/EigBn2wGb949SKojAEfh8eUreMVThTsGnr13XfHqa4pgB2sEwQLEFFOWalIFEb/43NG/6PFL3sEePWsJXgVEMHBlwkXZMmxmMyY7yqKbvh8qEVLhQVUJ+KyCGr2eDe/s9RRWURBCLBGBBD4R0FTwPhkOASGyHvqMMeVxIIUe7uopkJyZ49D+QT0wzAgYM/VJfydkMJ0BWH+KZNxxvnDLEbxjkn0iybe0xC264nrvmEGJG8Nk3FdYsbX63k6w18ClB3ntcAJWZVZtotXlFG5mQfG3yo2qGITVgcMreMEpq5nMZIbGLlb8j2NwgvCYpnxOaLGh4IwBLR7+G+xzvHiFRfzmur/MYx0lznKXGfNuKuie0KKTaPk/pYUm0DBHR7VWxAG6uZ2Mf/YyrlF4e9KAzLdw0eHlaDf/vzxfbTw96sVlKyEuZ0YFl0s2lpv9/ABaPAvQKetIYT8cPyLvaas/tqTMTJSz75CImRxL8bO9A6vaDoWYGtq+BRwwUVJx2L7DYY9R0NzsOw38YbgTG0WbVhHWFuH0jH2xiG7z7K4M3104ozLB0PXTkv1Kc4hgfJisiHJg4xIwautWae23i0u3B78IbZ6c12kTwSaiHlFHInOCvoggKmb4m6vCfaBOBDOpjq8/dYZsrQeJEEOfdbpa7jjW0P3alYbvSYh8GpFkwjGOvFBkSQcnYbBGf3YRfgbwcv1pihVY2KqFyvUr8jZwdoF1gejBSFjXvTO6HkQH6JCyovmrF4vHC3GfHg0+VGAciqLDFZ4+hLCU2E44qhY24ngfgx9q4EmQGHJj4ICZ1jyxYGFpP7lo4fC7UJwKFOyFjh1q+smVpcjPtBoFYZ5Gq1CMaLRKiiXa7QKbrjRinUEy2PPHjGLtdkq3iXRQKYHAWeHVg09+sHp0HR2cKoeIISAQeJ/dlx29WoYls6sSaT//zi4qskDbgDAx7XOrUkTaD+EBBYaT8T9bsO3cKDiFuWY7VCxNkdowkIFDYqvBkXv4Js42bMNJIfzPS7TCEppEy6PlIjLFHWINjkygsUsXIHwAOsyj7msDgc+VdUtYwDK1cHbPQM+h6Qs5gYDpqkX+ZLaPH/72AuKskuAuvm1F5QgOS4KksZFdab0uZB9+vn2zcePP78L4ptyzK5ptXMMxyznjCo+upByxFB1n4M9C9WehTxm1FrX8Lqbn06v4NnMyI3nbz/4baajnhOrCO81vSGutegPy8kl//tfhtjrLGE61qYqOcwWkoDpuOp64zBTmMWOywLOvG83ZefU/JGcP8NiQ33WFRxx5aPtmAcTmaNsq3nOUkhc2IlIdKYgo4yvJ63w/sLXdYG3DaAvTfSBy6lMJkV3S2UyHTwp1aTo7u4+Twdu9omFUwEOOt3hCH+B9TRtko43l2mcrsZ3cuSpIILCWWl7lYgeCDCzBh242UBdwn0v+oIF0Mp+wtzX5dGptAFI9D4smnoZBQNP6xqHQ1h1aDpm5iCIOMmwlNNFu4ov2PQVollG1jjTFBFlSVbCnsA0vUJSpoioJPJiq11lrObDlj4KsrYPzRU98qzMSf/s2zlRGavtQVWnJT42s2KrWPfhK9Yxqy5YGIQ1wFrup/TFGrlzHtxGRy79zqXzmFDOOlfRrtWKlkbz3FYHD4KhEoQMhsMx4Q4I6UDnC6NLMjrZnzTycYGWZfJA1DljYItpMBo2jvacKAq2OPfEQw4nmMP2vSskOFc9YXFXNG5jmaFNgNTNu0XfBoOJ2sZyrXYRZBTEl6TA5vjc5U6fIoz9ikhEKTfxltD1ps0XRHiNfI48Qh1aCZo48hB33FNSqM1EjaA5dgk6VuSJSiWnq6K5jeBSUbhyD6jDhUCMq29eXe/gxPnvrhn/1oulEDTHYhfD/DGjajeV4mFgZLQNWbZdg1ufOev4BgO7ID2GcHiLABxHEgCF2Hbm+k+MGfViEh0PqIIT98sshaOaywJaKeVb5oUyTyoHijCUkU4SK2gZfRiTxK2w2hAxLZ4Cw/57Y8O6XEKlJ44t2lAKzrOTF8L5iFy0U71JXV0BoHl5BXvTk0sZQZZgxzI6MikdXS6rBr1eLH3Z7T7OaaeU6/2+bi55nLbOsKzvBB3NUAF8WO7hcmt4eZlsllg2z854634LrOa9tStlW+dkVJ/Z3cxyxBkZh671dSwWoYAS0MR99eWBq37dZ9HCH2UcMpNcLdptdUCsq9Vnw6qFFp0Y++aLRwZn5OU6bXL/eVeM4goZTNizw853vGvd0T80MtgqbBIoOHiVMguzigWyIAldwTFcEH7sPRkwMSaIpCl0sJShT29uA3JR+RDZ6wEmAl7HTeikkSXew/6saq1PMbNDeZKib3S7feuHaA7/uhTIztFjzkz7Qf9ecoUjgfMLQP705vZIvKX0TUT212wGSz4DuPdPXaywfwM7Yuy5Wrb7/xbhNUR3tXdRNyyqMQJKcNhv/mtAQl70n01zip/Zu7PhtOl62aYfBlUkj6dcX1OjANISYSl5QvWwH+JVwwKiRRuMXWk9Td9mibl+99TObZOmEZy5eTYH+iuU+eDaOJQK2FBvzynbW53S70QA2Th+eHZqDuTva9CaPdLsq03Mo7GfF7WZb+sovK6CK34YfIgbZk/GJTTfPlD4UODLXRw8H/bs0AHJQaaf46fY9IuTBrdb/OSiuybrhhN+ENr4I33+aWyzhnyqgZY95VizcCgqD7sBltW9T9/cQjsFFKUJRGdFVzVgHy5IVZqx67x+BN3+0aGrDR16eShcXQD9nvub4y1NeY2yY+S5QEQLyHIE7vM3A86Pa4agNJfoEKEB9vrA0TZzIWs5yk4u0uW10Y7q5RzqvVL4SYkyUJoqS07yNF4TNZGC6rHEGqYTYcqQpdLPGMSJUp7ICMbxMQy+LjDW9dzloEckCJD1m2FTAtjfc1YJ3oHpSVthaiBuj96wiVsjBJEFVhRnA7KcWgwzQ/H6vgDLFT1SspUHwew1mlkM42Cs+sVLIDUGcSjOszb8eIhFGZeKZvQPXSGJYUVCcLX78WUkuPwVDlNssELAKlwQsolBrMsmk+7lr8OoSz4MjxEwptwY2j7IOVwfCh8JT4p4uY5NCTmdCJe/Pg1LwiCrgjo6ZQmprtKFzeNYXw0gVOj06TVR8YbOMPMI/SAQjs50/PjnxtnjboIh3Dp5krqBbnVF09m6sDqC5SRP4NT01FXL6/uiekNDAz7cni0eA+hnQOj4DQDU4oTLkJMA42LvciGbH47BFR6eHAvMhAwLqlOJGw3MZvfT4bL1Ddc/jgPDi+njwX6l38Yrzurs3g9FbnExW3MBcQQd/1i/gw/C82mnGzXQb2Y0vWDsaeHTTcvorUlpKWDdW32tvzYX2KtEWd2I6BtJkgCux6U+LZImOIZe3PTPE0Gs7UlTfelurbeLFczkcZHRBNf3oKQ8eSCisZbjnf4hsJDDPLQbElurOcyzqLlYY42DCzqao0+nmwqLfyQckP6m3iuCMDPT5VRfsI/wkpfKkv2TRKJkzB7gCHefNE75b4+FHaLqzUW7dQ4YolulVbR6B+l7t6K0Ye1D08PovWcn5QhvzbjcLmzBEpEnkpT2omOw9bYckR+XIBNuCHwHubbeEGgnZ60KweUsp+gsKz/fVqwNZT9XWsQ4TUX4/pYTed98RBX9gNz0D3/e2DbYw2Smf3hrSyEjbSKC5fLxqq2O3jg3Aluzo7JjSbjqFaxkRTNS7/93MkRhgNs5sLkRbo1IO9YGPxK0JIQ584VFw8kGs3U1QtEPKGfRwodW4cDWeywE3i0OAnqTw7YloBgt2nz2r9Q4OOh9Khl0wPqGhEni3QMRjGTHDPFbC02aG3uOWEri7kMxeFDPfagWsaLJg1yMtK4BKKBNTW8cAnvtw+V1pv3UgM+r6ZK+Synss6l1Z8giTdbPGJblPR8jAzR9DQzP5zOwIe52XvT8yvpc2ZPeeOlHB4fdR/8tCM+UnFuPzI/xmVt73zHgCkOA3idUuMmHxTplXLFnHh6oDiZU4Cg/pf/Ql9bfvPwwTbIMG5a86mojGqGNN0lS5mWm+26gK92oEGJaRldVr1+lHi0SPqD9BYZejx4Beb8RjwJdw/MN7gcBOocJfTxCAHNNHoA/HrvoDkCm1a2rzAPMwcM29UuHxs6UFILoKiicE9uZXBhAeyRSfYDoHA6kCX9xHnQc6ufiQoBeEfYFeJHV8xjjvLgfjcMqyxz2587hSsaRoQJ2nHle2qmgs2/Uwb9Q54JWqBrhy/CyhtIHrDc8jXwGR+vYtwesA0oe93eU+B3skJqtpijtvur6Z0H0BLK8Qh8+3KIHmmUSCjqes+/s1nFT6jl5gdZ8u+Xsigz4Ty0zoraOfoVwtsU7ie4r8e8jLzictA5uOhHeG03PFKYTDDNMNbwr6BcRecJ5kRF0r2tn5P5K3xouFPxHSsn9VYfmPbQVPOY8h/9jG/IeWu/eNJ+9KSAkpFiXeWjf0nFiWoquJYwa9wWE3xnZ2vI2vIm7ou3Dt3SQW/tsy/x8hTBD91Dkj63o5kW/uDTvDnJPkNVUHq2glT35WZMnCpf/pH72nkg4wPvnJwqrENMuf6NrbTFWGdIPSdI1w9l06rjT9JCE1lfcC0rbaxeVQ2TaXF/jMWncm2T4vcIU7oOUShD84NUaZYqsiThMawlnSamTL2AAV4O2xJ9vOuh9rW7nix0LDXVG4YYZwVgTs8puNkqoCfaAsDT2TMH1zs+NgNTWB2Gpn5LDocPNOZBoRv1YeKmKUgVx+I3jCCgBPkMxbshDjlNKx1xDUf7Y+KHpTFO6o+lUrgOL4AywDO+IkEhvo4c99uJQT7Kpc+f5JGaDfmP099JhrUGiNX0kDJUFZ4gqGZgnbMI0J/HMhPKmBmb7UQ34ClFYf2JPxboyp2LUC2bcoEO/i1IqSKKynWZIWDukzbimAMYRqjptuEI/YmHBhBPsJgvSa6yMSR5qh49UqLKTiPQmR8fMX2vVREEUgqzLDIs5UOzP8AMWmOW3iy41rL2lx4p3jazGvfCBz/CSZMfOpfg9aECwGxuDgO8AuHNN9reOofQbdA99WIPlwj2C9Yop4gxtlCrk65ewDk3Cnp4HIqKE5y8JW1NGXgqyIoKwhLzEBYWXHoiIBcm5IjEuaPz4Kvr+zy//y8tU34C0uzaTt9dbmpLrxgHBp3UvVaYrp3Jqd/FgnUNDgniwaxd6oNt5fLpPtdfvG0YNRURBTHYB3RlAhZfqdVFJxeFOijOgspxGofLVyubApPvZPlUNJFJHhTCbodhsD3YWeJMpPw4If3IxUisDQILaMH1DtGhzN0vJF0N+3sPWLho/Orn1KgUGsD33I/WWV3sV9FdMIRSVTLWzXMc6ozlVY5ujr04+brG9ZudHIuRkQfjT3Z1t6uOi71HeO8Ekwt5OieqkL2mPIYqCeLurXEYZz6i1LCOh3wZANwhHCx92vYZnqob/DYid1PQ5fgqqcr6GhyNJLGrPmqbn2dTt89Kj5+1OLb06bIyoLRcPiyHj62H3qyFxfMFj4VMaVHzECidkun7bAa1Ia1ZRgP0BPnnKFN8NS3gOGYxtCbu9pJ7eO9SDQwZzHmurkxHqBNOAoiDeVPQnsANefQQyy7FGWGA4ZKkHIxGCi4OVOhqaIQ+DxvGQ7AuzYfI0pBeTw8NLdSaP+VCqNf939BjuBHu2HlMh9JrCRTxmPCT7wmyYPA3pxeTwULbkZWutyXxeE+hn6mMBqhG0/1SA/7yOZzp7cO7TH0kv4j7jIdkXZsPkaUAvJoeHl+qc/hPodf4N/Weqbmh6/+mPqxfxn/GQ7AuzYfI0YIXJbXcXvNjSP7BIm1veqx8D297vvPvdq6+iRXcRn++CggN2wle0FyGf9mql7WDXLVxWCwRukaSJJFgkm4Yifm7+HtDF3jso52mZkcnlbwM8SQV2osecdxi1DtYJDaa9tBHqasCd8Ad0o0WQLU3nYErTHpYwKCATM9Y0EU2jDld9Pm7jy25zDTBqE2g3ZJPZtKcjmXN2w5UQX/XKL14Id5Oang31lfrnCIT1ESB2063WctQPLiUZ6S63mAWe4XQwTH0jbhQ8le58OUNzZYLGDAIMZA2SrHWTeOd75tAytedIW7bDwOx5S5dVbY0/7x7T5KB61L1oi6XFj7o7b2aNUOZCPErk17jxNW58jRtfQNz42uN/7fG/9vhfYo8/1Vnqre/bQ9o+d59nYPKruw478jKDKxMn3Win+Vmqfpb/esxHh8UBZv/rf9/qdaPRgdHUL/WQ5CMAOVBeBTQRWGfZEFxElFF1Mbd5T3CBAMGep4AMwzGpKUSOny4rQ46fjheBcXb5pviVs+sJmsPJcskWqUQZ3ypOikLwhEgZ5RlPHnCWTXcp+s3KEUdAG1b/M6e0RRsGBPIISlVyMRSoetgClbhN5ZhOgbKUJkROFTh1lLY0URvfs8tHqxW5VcYX9QO7YC46IlE6l9oqhsOQrBc8j7TYoRoR8k5N6Q/GBjFMMx2IYv96zCEhjuDa68m8FoKnTWuB7lGOy7OwW/ggjdTShyztQuu2WxhfE2NfzzXYpGNMsi1U4BV7y5/fBJuAC4IfngnijwQ/jIUcPx9Fa9j5OG0Hz4c/P+z6/v1oEYK74yVbz+Fz/xcIf/W6r1731eu6XidL8UgfuZjD8e4s7a++99X3/sN9T/vewocZMuB1EtkVT911ZGEvHPDAv711y6j4kfuieZY6XH1VwBMiBKTDNQe0To4NDKGx4qhGHwHU8elcIDMVE8dAZ0Eza90kRP/Zel/4uKykZ+1CUOX/n73vbW7bRv5/zleB6ZPaPVv9c2n7m5tpZ1I3zeWaOB7buf6e6SASknCmAB5B2lZf/XcWf0iQBEhQouQ08dlzdSRy97OLxWIBLBYhMvxGU1N931OQ1Kdlg8l/59Sg+EPObAB7pRXf5WnHAlBdY3ZUFO0cv4ywhLLVvMBirxOM/3ER/I/J6BYII/0Fgi9sSrOo32wMYsoEyYs5z5NOESuvonoAw+8bSRJ1SRqeWU55TovtRPyuXOQML8HLfMqjmjeS3gz9Vld//AfKyuJ8g7OsXYTDgICKRnPK5v8rSUlmGzGR4Lf6cgJJNmozFes6T3kn45MEtPEEWpNKr9pOt79wm0PFLUjIoQJKnsJaoWai8M2cONSC7VRqXhNrBVgiabF2YoAwakLDgzJpkmQbhEkeL2I7ef5VESeeVHFSxAl6NyZD3G5yI57m57Ysjyz/gZcs92XdCSeryD4QeRce1qn8r2oWbaMzKFKCm15mtJXr27SEVKCmZ11WZ9tXW1UIucHZAJc8TfkDlKSRYae767fhBppDbZS60CZUTxNlDNtxyzJF13hZoOurC5ST/5VEjA/q2+BnmnjnhaFqiF6CADyYWvcPN20ovM7i7VSqRpoeKjgiOF6jjJDc5EfpAXpnxWraMwzlqVZkt6OzfrK65NrUZDf4kW7KzeRkKeslG2peFUFRYJbgPPmV3FPcO0XyIvZ6Gr8/j9rvCpIuo/ZbbUsM9lFAbUIPRZMgoUK6CnSIUlfJrMtQmpuON6QxKDvBKC1DGbnZCIWHYEM0MZuNpg6hdvYtXB448uwkBHLBiBxEymwUhclkcuKaNilOhiSK7ZeimXDn5J6T+H6GM5i/6OHJmfvQ1/EHENXDo2JTDYMqmoQ8ErTGwiSwkMSPc4FZ8kCLtePyh0GHF4xysW3eVGLBpHDDUkzoPUz1ToybQ5yl21M/6uxudVDAlT53x+wELwhLDmsaLiFkBFrDB8sQ7aGzgfAjMAqhZ/8nuttL5c7QLUhxj1OYOwlUsoQsKYMqGQzuMl2lxheb6EXM/FI+uRFNL6Nb2NH+fVBIGGvsYsZGKChmrI/iV+/4MBUT4iG0WJPcDBE8r+KlqMuY52SfiOW2yumpAxUoa5SW4BnqKrHqDhM5gln33Ti64hqzJCXJvsHOinhnMX530njZMWNxv+kkIfbhL/bnr3LrdkagX98PQ5kleA8M+vX9MKjS5Ttj0K/viYFvoBI8Zol4wNnuWJpkpsK0p6V0CI3H5aRLHjOakxHxgJPKAy7ita+4tKGgF9ZWPMVsZS2tvZYfeBbX1Jd1AQZ3cYVdF90qLG6n7HTIbXdoaJHH7B7n+zj5DoVdPHK8SVLKJhzlYOjRRKulUqU3iPRXOd7oC0ggdptFbTxwZmEfpQD7Fjsg2Rj0yGPGdQK/0uHsE1GiE9wqduJq6zQA1WucL6C2qd6OhlwFtU9q68+nKxuS3CidyxLrnWf68AVgtBKcX18gyQKufJSRDeL3EH/RJZFhIa8OT7Tx98lgy8HaHmxwr2OMFHBxjEFOGboUswGFurzyIJpwfdaThtcX8kaQtZwkksSPi5HHYr6K565q2ofaDG7875I8FsZeQY8PNE01bjUtgCM9L9OUx+hnFfLiDWjRL1KclfNl7rzMbxJd/6ZpN65AN+eNXl/4gR2uO73Fouj2psZlpBvOaMHlPzOSU57s2qV8Njyo21Bh4OcCmGhDbrmISiZalbbqkajGLcpNL2qf/sfA9rm1sZj7WyLIwQU1xxjZApzdcDnyadUNeaOfrLJHaRvfrw6u7ZdqB+4z1HjkgqYS0SIXJJe2B3DAKGeCtTN17Zha7oZhnC9gd8sU8FOMZ1GYRvtKgxxjgFcuUcnikgOdiHIDnv7/Pz7ebAVakJQ/nM68chgaTyDKvymWx75vtuKsR6KZnM/MxVagn9SfNEkJ+pv+m5WC+MUTBY7vnkA2dcW+iWIkCoQh6sIFz8/kzeDFmggy0iprwfSsLXnCG84VAHNR7fubGmzkQqzFh0AqcgHetZdbdHednm0kDTFWl6OuWdc8Zl4Qy5yQw0KQHPwA1D134ginmnWTwe6J4jn76PysMvIa6AncuAuZgaDE5BQV65yXq7XZTDBzbL8gFamPQBhwP4wXaEsKJQ86EXhDEBZITQ3xgt+TnlEDPPCTyUGZ5I9Ehu0rsNsYYVZ5/6QoGWfnFdIqcXKV42xNC2Kv8OqPPGu85g1/EqXt4Iz8Fh+3k3WK0naVhpog+b21yu2iN6CeDoW2EAi5IdgwdAp047u9Fh5f6Rv1JVPTRGuc5fxxa7XQP19ewSeeBtLfoneBq/ArX5vVjN0q9ghj+LcuHmorcqprPl8TBndbOvZVDRCT4iJmrvbuvQPJBlqRaXzb04N7QDeHQgfAPgRzVm4mwnCly9cwOSwPsafJxGzf/DrAMi/1uYE9GTuJw6kRcQjCKtFtJki8J3Xzvwud36YIQ/atSjgaMhhdhcV3RtULp2/cGYD6TiXH6oXcRt08vKqLnKATCFS+fEc2G/w4f/fLlzqbJebsnuT62mTJ3hr4nTKWcnV7zkJlC8Rfx4c8IwwtaUpElcypO+IAMtgFzn3bx20P1wOt9ZTLR9lsF1mbYz/XcM593HtvaRtslPE4+q64Oig7SHjz7qhMxtVwizljzvOth7YiR9pbP9vpzUh6BOcTEym5zfDgrdpmOLSOPgE7w0qnSEcjWXnYuAan2lTFbBjPTlPpADRqdj8OixDp7PD6ubl5uwOuw+ppN0xus90XkRl5x2M6LJ5xWOBY4nw6QJGLh8kadhqHl8sYDgLKWUIJZvx4CPKVAAein9GMiH0HzLBxsuxb9m6n4w3y9LKBhbEjsOm2x1RcDAdBhLc+9FDjjGwaCGFmviBias1JZr4A4iDMDtNWkYufEOlfqsGqr/tZTdFc1df9rPZvrOprL6NlzllBWOJl5GouL5vhZrN535HtvLf5QsQNF9nJ2q3jwzHWrmyeE9j1dV+BH3RcqL3som+v3wuoAbmAywafbWJ/m4CVoQvO2LVrYmz4xjhek2Secn5X9izDOMIbr5xOFvMNdaxE78ag+4fN7s+ULuYbspmXontC329CQ8NMvxUZ5j7DGWq7MO41H7eVTMHFcKBJ6nIRg66h3yV4EBimcPosGmotDw3vFaMT7Ko4NQRgSzHdXhqALwU6+XB1hn59/8flGbp8//aXM/Tu5ZvL2zPEc/XXyT3Fp7PZbGiZ+YHQ1bqIAjvbADY1+VYk0QmsKms3LU4lMrU72XhAfSSGYCb8gXnPce4K1BBFJ/XOw6mqAmVwn6GicSQVVvc9WFC1jP6w5ikxJM5kEgB8rHPGbBLVK1oNA1qAlXfOCCvm0EJOXbi79YA6LgxdSQSdfPOTibnO0Lc/VYJ895OCKdvy7z+pyfTXKRUF7FUONaHuWHOaTAe83nBDJ99IZS5pLgpEGRQJickZ+lZ+qraVVEqY4IizIbAgKI3JfNpyCjeKqmxNdPLb9fvL21eXv0qEtcJ/eXnxu/m0Uj3PEWZb9WLdbYJ1T9nRNspMYsYAIl4WR4YEHKuvnJjgIuR5vMZsRaYz0Xo7XHsY6xJ3YIg+XJ3/DI4cOhX89/znD1eoyDETtFnzz4kZUqSKYpJBuBsHDYhmVoANBotQy7GpUzEi5Q/ygFmHEhU6k0i6FsaNw13W70DRA8rUUwM6EQSyuUkyyTqiL70O3DfUajQCYlHxPTOH8kEJjDx0aOkZlpDSStXk5DyhIoMTrJSt1BikxwQ9BMmYEuUk47msa9FuKyjW0ihpJ/HZTWAhHDKqHPxO0rzPeh/lvfnVjHwy9eZrDYkuFStIxKcCEYYXjXIATnD1urUTnCtq7w3UGoI7DKZX7gDZ4fei3JQpBtO1WmjcAnwOaUckOQC4y45N18ggXaFh4xpGAGAgNnOf8dgXsTnVoXEikz2yEeowZuVbv/3muxf1qnxFK3Lh1Y9FLqh7GVVC2GEa7loLpjmgBYnlqSI11pRQjRV25mKS91qY+v1K+pzbi6uqLKdFDqMNuCZIX4mzc60poF2A/8/L1HLJzR9F9p+3twN010VRE4axA+cZbZP2qljmUiVH2OxUjGqTck0WOp5a93knYbtYjiYOp68h5bnqd1gIumIkCVdE38R//51DNTjzZUvtAfBInncL3E/YFTSDGbrhm+oaqowLQRcpQdLsBMI5+cdwbyA4T7eoIPmGMnXASi4TAME4pYQVZ2hBllAMBz7SbSir2iwIYd0qU/XPV6oaqoTaJup9RX0tPR0vBx+LUw6nYmo/7n3hHueUlwItsFVKrQVqFjlfRl9VYj9goXtsEWSmOTHRzpFGNBuozVyOb4zLEkqqt8nYy0l1IB6zRnZX7OUm+UCVW8UPeCttIUB5davODtafag02S6kRJo9Sk1wecNA2XORbiOAKHnXoIFQP0nUkEeg21YKJk2ihCyfLeQZOU0NMMxBnKEtLIefMtbq0d4AFGSdRLASPqTy8AT4YCrHjvKBxmWJjHXDyL17DGQ5AYJiusazhx9wKUHMWg+w0oIWnTFLzBywuzsNbGh6bCkLQti0ZFrhqxTWjOJLiDNyZmkDPIhfd/iXvKeGbUZANihHQ0q4Z6iDYHQ6DNSGqEo5OTx25UOZEZJwJMn1sfBQHpsBrXs0ig6p4Xdt/J7hxVqb+KXLMxJLkAo5i5EV9Xa/xDvpsibwQd6adnX7U6vj2j4pU5ACk3dNwfPIVeshpYSSC6oj1YK1znNDJA2dfFmgBsyTw2El7SQYgnkYO6ugrBHW9ypwgnGWp9O1LmkK5RXMOtWMQ3T/aLX2E6WDV0mHzQbNkfHtxdTrbexrnXhwMlOBaIw+bynWmVE6aw9MsmFzJJagvuESH4jWJ7+RG7BcBCoEpm1cdI0epHYerbx8P7e214zTNI+OCbx8fUQzXXldv9YL87klAfjcO5N+fBOTfx4F88SQgX4wD+f2TgPx+HEg543kCmJKvBCrQSZbzgsc8VeOYywdHLux69Txyod4rGDnc8lEdjWgeJslKzIZhHXYxp456RkDqzzDcHdSFPMxbwuxSo1GsDjVnmmZK5B/6A8Vumohph/ETol45fG02sRxWE0qOEJ0y8uCSKhD40JRuEtjd7hAOOnKhlrFU5MLrMkYPyijMXHtzjYZyFgKVpPOO9JqqjKbXBKfFWkWNM/ReVhWtwTmpIPTh8nf53/OfUcnuGH/wrU2+uXxjHqSMFhSn9E/qdCzwc/P+4vdX19fwtJ4AyUHF8/TbF+9/17QlepRhuCsCbDXFW5KjF5C2g8oMjFV+IlBBRAFTIb1L6aV8+/7DraQs30Pfnr8YWLV9++Li/SVqvWKtWmU5X6Rkc4aW9fV0HlL1zxcXNYGcLOE4xxfopIgzlIviVAb9lxzlvCwITOrWXBRfoBMabzL3nBChtz8M6OwH74stlfyATm5u3p4OqeWH65srWy0/IMrucUqTKqhA56gZQ/hI/TgA/ceeFy/sF8FtybQMnKbbLplGG6EX37yQUY+HeP2TUAE2dc7Z+YtvXnixtNT4Izr55+3t1dc3726vBpX5Y0uZP+6hzJvbmyapioRshKYSAGIjJvZ6L4gKvb5r95jirZT3+/MfZdh5Bkkl9X2i1RteVKZg4AGQmaseN7D+hAtEC1Rwfgf9cUkZFWuPq62IeUGrx2fgpb249xoNoCB2TkSZFv4RoXpxCKajivy0Qbe+SU/CCglv8Qp2vXq15w86PMi8zBQ4L6Mp5FeCKyPTunhYw8XE1nIg7IyVWYByEveQPR3aKiGuToJzb7TrNVx3NlTzQhqLlFnDbGTpogWBsR1kO4OpBMxSi3XnYlMtajOZ16YtPR9cJ6jXfqv3I5cq9faqXiCOXAp129mAPmtdwkq2f9W6vb3bj7ZTW2qnkNYdyba+rpl2UtCGVBOgHpOKS3KZOGwut2OCJgQ2/SBFLWRF2N1uEwEMbUNtaM2rk5wU9WZHAkNOY5/CDN96OzlE8v6ybxMJ3trTVf4L56TKFd0QzKDb6quFyBZyO5xE1YC4lQngMWZmB8yR8pBynKAFTiF9PA9QBYAssydTBXxRZuZzB97IBdqU8OF7d+eBft1m7ElEHxxA+rK/RyuzsTCp6zYt6yKrtXICmt+Xxx5iAQcXimxoYbv7MYIttmp28xG0lySier9BJeWR7VgLhU4urj58/csfaskwxIEbdX1sNqn7t7w528jnzasywthFDY/WnQ80+H1QQ7IUyV9Nz0biOKgz0VRHHQsE+g4YkQuLv7Lf4Vqif0V+r8awVnSlZKp/oZMNfpT/Pm1lFugzHMUahma69EbnVRqpIQTJwd+gEzOqMx7ekwezC/bSAMx29V3eKud194xzxx+mHqraTq+Kod7eXvkqoYKvqC4ja55NDb10zNq875qmUyVtCzSUtLBRW+NtW3cSNT/a/UlKdslT67E2/4Y0/mulVcHt1le9WNR1XxKRottzZ+yGFGueROFOJ5SzItwtw2MYL3iyPQDbDG9lANwQuD1cTtLUOlD5+NraJMc5mTvWKKdQO0zDSuGirpdR/4HaC8MGUbbOsRiDqaJ4yQu05CXbE7QDwJGMtNNShu9/BWf7GCm8b+o8k2J0HQDvgsloIB0KQ+W89VjyX57yO4qt4eRf6hPPiKK/Db7hctRQU4Nx68Arv34RMsjh/hRhmqS6avGe4uohuWYbNbVhCJ03kGsd3eHlna2h3+HfHv3I74K1o9vGpQvDdIQmusIYV8REuSG5TcBNtlfJyEuoLQ9CbkA2qEXO7xoG60c0gAp+f5HU0IUGiF6DqOhNPWSgNb4n6sCNPFMjV3FO9GvydmnXjjHMHY3Qyi1YIaZfxsBlSc9scUBUS1yLtpc/ThJHCeYhBzsOR5tH5AJCk10dfLtVf60edjIqeEbjXXndwsvNOaKTiTzv0YpFelu2weXKvD0oDF8umwNLOJPKcBUNWGDkNW69jwGdoSLiRLBp9umaf0Eei17+pSj4poYBhNQStShyu7c52coV5pk3ihoSvmuv0qFWaL6uFSE59aVEGkjOg3c7u6yX+qymJiuP+sFkFm62McvMFcjpnI67D3TwXihQsOuhFKfHcI12RpM6+8MLA3JFJgLSIOVluCGwDD7fX3zKCpIznNbWK1tYM7B7rWHtaiq3bfTYhYvILoNrj9fYxVJNEoSmW+V2rSjcwlSBHjs0MvLQPUbf28UDwMLvpSSs0RqwXpiW2tLkMIDep0kQoMiFqnoucoHaoT3r0Qe88dhWo8kBFFRDor7VaxtD6prZT4HjrSSMaIJOVIB6OvOCoMKPAOc53u4IgUpLQVTkiNoXnLT55yRLaYy9GHbXwrWi7FaDFw9lYsvi+RCsBecpwWw3ZG9YQuHaNgHJWZoTLAbbO9bQtyg7BzDmkQJ2vwt08ubmOkQSb+wxhXJfVeGGGuOXRJXyCfYHMr6dTR4fSbLOYKjL3LGH0je8DnAOjblVj5yu1lB3FuXg5p4/7SHt0JzJrMzc0QVmjWUH+YFv3UF+OXbhIXRhpsLiHnecErfHEUMLVjBFNDSMOUkiZIkq6cg0jbxk53KbRa/6zPaMnuK0FAXJ52VJk+na/cOHurbVK8gYpLEgOI/Xhh9kFai7kbWEOrNbzJwop+2DmqUph9jaxbT5TquVNl+g7uYLWSsQLDlL5OyPQJPXmYBuCGBypZjBPh5OU3l0e/om0NTlojmZ9RXIbFFwd6RwxpqqWTttsnb3GBvUpnNdeD+oAGDw+86+PE1DM92oZTxtxH2obeRwc7X3irjBQSY0ySNQ3romQ32BHACsb5FTaTr//n+zYaHKvrScY0v1sn0hHmWwaxkiTE4g45IVc0GKuaB/ko9GKNhqqhtKZDgmiMdxmcHZfNj2x/B/Sl6T/KA72gy9MUUloLHOZNSFBFltZM0oGNnkTeX9qiGPaqXio9FIo8PyJbr429/0dqtAC9hEhHHuX/ge30j9Vt9tMMMrv2377+AcFDAAuFUeONS3tO663IgDwKq7DPCoslfbHruqJZaXTKa5gs3RNKW6kOwscgE3O+tzoC2iUL89AFvuu+qc6Wrv3o7OojAHbVDi+9WBdBvHqtYmFKiCMXel77dsoBZnVeEo8znMNhFG35/rE7dSuAfKEv7gtxIYXg4uxkYdTZ1YjFb6zoEsRdLe0UYgVCrFHPynX8XOlI9AJf9OtucqCS3DNLcPb9TpGDL1p5lrr8qIuyq+0UI9Lnt0d7b9FIWfTPM65PCDS6gws5QjQNQ7JRbTRpm5HswGb8yZrqsw1yTg0HYUCHsA8mUbqMUCbXBCTN64RogueaGd+iLnDwJyiuDIgYD8/U2ZFhSORQoKf2JGoPiiTbHgdbcpU1rIh6s6oKQQCBcIirpWZriV5HNyDmEXEQVepFSsG+UfxazvOrxJu/4fZIFuFN0d+72VmhgGKwAa/NrIYDqGshTDjtBjIZ2W4x0f0pCO3GtyI1A70sQfyMIccrctx2r6yIcWdCsiH9IplUtYnG8zOH0AmG/+Mtq9GateA1Xu8MIVSdk8IWlnn8CLdgDlJUwfJG0EtJGkjWKcxjo8gBPm9c7mgrB4vcH5HZSRj9oYOwUi3O3eA0mXgVA9Y88Vuee1rs92rcs9Mvi90ADjd3oFqWOWfX5mVPTQ24VHFhGvGdoOZuYFaGI4B1G/xkYWzoa2onL1uovDr8LwoHFQf4F4XQFkzbkv6GnjPcbQ0gl1bXBmR6hckJwRtUJT7QpVH/p2hqoHrChrOD/XvYFkN69RTwOW29Q8SrDzM/VylzYtUEHGk8aqUM1nFrmNzSDCGd0/o9rS28urNyay7rqjXcYz3U9nPRllO7lVnSyVcFgEsqrNi14UORG8zOMJ3bv2FfL+AwftNgBRLg6JwUe+AyPm2fQAkCSLTvQu35lkKheKzyrtnPbiuif5YnpYEGyjDuk2b1kSOAr0eQOc2z4uyDghdGXxdibKzUQwtAIE0qTPTIkO9W+qVsY2NM65Xj8NAjilpkwbVQh3VNqihMlJi3zPcpj6cD4RbLSmosCrHG+QAiKiNmB9YGM/T10PDDW9PkfdeyTHRievIJt7b5NMuiHxgIJugCDqEKy0kZWtV9y6COB0cfWhuWfaesIltg3FddltP6A+qg0huafyxhDxIQY2E2dUPtgrR+jX/rmojA60fgE3vUj1IYaZ04V00GLGY55XAdRowIFgAR5sCQ8wrGDxxHVbYPCtge3NRe/tgTuJAQrGAmGLrMkLkAE7YrAegtOUxxiWuwi81yuvPEP+lxQ4IUvKqjqqZp+5doYnPO9RCuRSlky+S6y0/oZq+EpEoZ11QKq3fAUj7JJHYX3bYKhq8UW+pvF5jj6vYah/LNvnUjuVqCjGGY6hpj9lHupGAPPkp68d1b3DNdM5Gv9JagWkHKEUynp3S/dRiysSDlbLuBSpqp94xalhLXNCjoIKGIUA8tjl9ICAkQuQAaJSy6JQO/DZgCFXtUrkE2wf6+rrL8fsdG0LdCrxGAH1x6UQnevmE7eG/bFGl438tXEBpscE/hIxZojYe4SZ2iz8kaatpLyT2/xp9ZTrm5v+fmIAP3C5Pds9y/lp6eMPJaY8oxWklwyvyBKXaSG8evEgD0BU7/IDG+ThY6Bs8H95fiQ8kpcXlUGUc14sRRRqLD5DMeRMSOmV7FOwwGsOJY5oSsRWFGSjvVh4NP15hDxuLdUh0PNMzK0hHX8PKMc7Y9hfPUeYaXxwzDEMe5mUFA0J1MMITllY+7G6RIZe2ZbZV2kKOaimeEZV9HhFGIE7cuUGsjlpoLPkGxx0TW++tPi49g4GN3n9k2CPpnv1ewFT6erC45jnif8GaesKYqkGecU4nEXJ81bru0zG4Jb0CrzJolAjdFGr6S1pLoq5hsE6G7w9eykDqjH3YkiwoAnJCdWc4LO25dnIUnwkYCkexGUwbYjoBD7+vd5eGO8UKW021q2uqmqHD0FOcLMm3s4AriWlsfyB2RTcb7dZNW/p5wh1iTr3+PrMvZfnO02pcz+4H8RxO2OcE+y6h2AyU5cMIJ2nV/OHSuy8xJuqzft5yvyLaRlLkjDKqzPckj9c51+PQU40JgVkrpMypwP1b53laSukPx9n0mxXXZlbntukS6rOt9dAHPkHBoYg6XKeUnY3IZjrt1ALJCdwuMUkR7VNxPCn7J6n9ySZOzAeyi8Yni699HkInNHpLQfy3TRR050csAyEO8qSaXkDxQDG0zoPZjmPHqaH66+G8gjVT9thoUBFP2/DlzXLzrg7RVjGDpB6TtZ5TtY5VrKOPCXz187TMXCc2w3+ZvE1x+e1hvW8bTd+2+55X+Z5X+Z5X2bffRlGigee30Wh1uKzFEMvf/ykje9a39nQQ8tAlkULd8YciufRx8fAKD7tBrmFiyWre+J85I7aJrfONjEQnjdBx22C/va8/9m//9lRUB1GPm99/vY57nrWMUDp2P90gTpGcmyN6uNIi63x+FJjDZi8ZN4VHJcp+MzA0KMbiAAPY149Y8IwgyEmNqO+zhnUTKE9fUSTwu8bUC5ajh81QkeOz1qNAWPLKGf3GarQPQIZYbLGdYxuJYWtYWc8+UsuYT/PSJ9npM8z0iPOSD+LPaOPZJekA+vpzpv8H3tf9Nw2jvT5rr8ClZfYdY5u9u7qHuaeMk5yk91kxl/szNRXW1s0REIS1iTBIUA7mr/+qwYaJEgBJCWRsvfbTFy1m1hC/7rRaDQa3Y0RbIwrNvl3q2CGjbUuL5Hd+pJxpcsT35LNt276DOJZrer3Oq5/yzqu/sU2upjLSqgeajG0SMY52fV4k7jaRyfOLMLdP3jiJbXXSjMsiT5TYYcrtvuveA8xMCBlPzuaELnAbPkr8kQ5vOJ8RRQrM57TPYvrooTXLHfzvAHWINREsFNlDxLdxkcGwUBH1A0rPb8/EIyhE4jw9eRXTzZ/v5sZIhc1qmudjwuTdl1Suf0kRPETjR/Een1F3pelPjffVGl6Rer/i7/fn1r4I8p69iFL7OJaZEXKFEuuGklc0zwX6kuVaxKivCK//vr5bzxNWXKJ7C8XPtEc4h0PrRJtlpYhr7B3Oz1o1sEH0VSMMQ3isZ25zoMIqUFDCC+9tpT6/OcBXEXJ4HW95EeiyopNAb0GM1KgfeBH4JtL7n5YM/mkWlLBB2B6WRzyGw8SAfoFGk44atmdwefH3Uyb9WxCMcOEFanYZe28cf/EjfNqmgEncWumTYn+mxfnHg1LvKCeIHTfhn8UeUPFt+tbHPjop/Qi8a2vo3BYKse0skqY5N2ivMlckncNxqZMECnWsiEXsmDx5eKYa5lpMTb3HBZbEFSVnw9WlR8CrEi8dVWTgzJ09gG98MR8VY1fjENrp/9kcZL/rHPD3UMFuQC34cq0vyaiJFX+kIunPLxuqlzGW5ZU/Up60vlHo2zR8Yl4DqfaiQEMOLKhiMdY9sCZciMOfmrdm/jZvOsaU323vU9pJt/OlflzeUq/hAJAQy5ePTHPihzR1nPn9Uz9t8LTzV3oEYFeCRwzN9A2P4ionpBZ4ehIIlJadCG8vGt3Xiy8m3axOIh9x00EZB9vvMS2QqpoHoowdIjsgZvwYYRxs9yfi2eOZ3ZgYkDziw1o3rA8gcePlsvL5/A2OuhO8zvQG2DJWbDW1Hx4r/bRNtI0jjRTE5kAHBArVF7w+dkFGjxA44fmWKYu/fAJeni1Dh/Ajt877lqNbaww4P6KfDF/uWUqiGzoTP1cuPotyHSowHocik2s9LM2cwkNO1/ATYGlZB8WbcDpNxtKkaasDOJM6YqlZ5jbdZWmO0ttUJoWHdhAtq7S6cyaHfHl27UW0qBh8/edCc7dAHlQrbrRTN0ih1ywQsTbSzg2kFuE1VV+C8hO7QyWtiWRWoWOMrYzL89G7+vVWeMtWFiIz2F1kc44gBZcY3/mnmfH0vHmUbOXNd31JDtgX8Y028kdAcwCMnnei6EpHWlu9WBNhomcxObWwy18EjvK8DZXVkFT+70Nyvc2KCPaoHzvgOLpgDKfAoVCmKNkNhQH/d7s43uzj+/NPp6/2YdF8yjSqrVb+pVknG9iBpvEIdnzGU7yRX4zwIKOyPfuC9+7L3zvvvC9+8KM3Rd8Ped9UM7Q4uDDyDe/ztH+wbS4QzD27fjHzHk0/uExC7wWz74VrOSQ4EbTv/9jof/t4TEjmYD7ntAL8I/ZIqQ2Xsxd/bEDJSLLWAaxskVXLF1V9I6Lv/KN0iXZJtz5cB/hQfKtOhzvwH4sA1v18IY9ElkHXYiWhfJI06oPS1BfDwbio2RRdGprBsgPkn4nMsrz/VF75d8v+7E09dC4KlOxkYrKrbM0P+E/Bdan/XWzILtPU0imoAhE/kj+/krK9NU/AovWoe1Xdi9DXdWdKvGw8/0uU4T4ybsQIP2h9Yv+GesBAz8/C+lZGpYWtkmejlw9r9i13Uv1n7Wd7ZfzCHp//e0z+Xh44rKf7yHeR+BxGtYHiRc8CRIOWKERVG+cUS0l0MYl2CS5GJJ1DwUYJdKj2OPb8kQV1x3r5VQ68F6PRrp8jtEDns8wEx/zWGQQgsBXfLSvxMplEIWo1Awwfq3URhwCY81Txea5HfmAQ+9hwQ0kY1lMIRnH2UE+238LbCH175s9RG5FqaJY5Gu++dFk8Xh2FlcpLO8uAL8yerns6pcd7uQ11x3gmFW2b2eC09iDBH6u9ZM/ihSliJmU5OM7W4LdTIKi8qHRLS+gqoD7nKVk8US4Gur6SrC0FOoPemGoLWSZyIkwNBE1HNccWTE/JEbJ7SFFUQ5ILBZ5zmIgLZc41OSwRcFyl5B534TLPcxXRG5FlSZkxTRv0udcaueXiBzyrPF7kiRVCYYohzhBSkSBt6MHMO/L8z2BdTNhsopBneHyG2kRqhTLCtUIAadLcnjxipvHyFaM5bDdlIolAzxsmFpuuZLTQV/tYX+1YeoViUWW0TyR5EJPGgGql4hbY62KK5LwR57o2et6YMRq7Ks4SyI9oplKJciGKfgKqQeGZ4BGMJ5xKdn0rK8ph7QmwzbWPEqyYjHkA5JcqC0oGzxZhOq7hnJvmMwHth97xa4R+FHDM3xXCUFEOjS/cZYsN0xNzmNnTkvbsgvnE5XSTCuwbHZ34JuDIfLw+cRK5mqNKOF7I9iTc7AnW+zBIuNxw57hawAbGFtvKUkQngniBqKdA9hNMKpJ5NGEm3lZlyIDudvu6GY11fZjgJWnEpp/5c/NjWR5Yl+3OooPrlg230alRyc4eqrvExxor3G5j4J40HZyiqC76KUS6AaX9v+LFhtLcgd/4fCiEHmV0W88qzKNem9sveStmV5VilD0qyFgDomF6IcAsR3J2ZMexpLleWP3BmTGHrnZhs8rsfqdU5aJ1hrT8wyKCgFxUhX2nhfsvGWzS5XUG0Tj1pTM/C9XryWxosaxMPxELnQXgCij3/QauWyOLCLfiGTlHljgX979FDyu6FQs+8ar5Qe/hLMvl8OnmM0h0bEGpf8YEpgMi6pz3u+eNiwV4OnkI4+liQKCbGsYk0vF4/7Iw+ib41oQY6I9PVDh5x3UEEIhDoiggeWl27A0Hf3rRkwjEHgCwSfRzla2d45YQzKeEQXNExIfhEsb4qU+tFWjzcsAOrO5YZsnnfEHN5QkFfGDhE0j43Fps8SWPah8d1ynYWo2XACjO1FhRGRATPBxL5Lu+gqtAHcw7Tj5Rd7L4gg24QceUq2lD4IHemOkv4cxdMl4KsJmi6mhjZgLFx44bOycMtQEDxWi/tIZpNiAO0Cl/6hYyZmceu2D4LDVmyXRL7AuHp+4jkXTiMhCYd9YXI0KG2SiZHMIh8oHq1RAAvxpCYtUPI0UlMU2j6CAY2hyibCofMADvAYLO82A6HguWanmkJwZGYQHTmYi4gryC0ZKDWHNIzSLBSGOW4CmCcscgtIjg5wOlBEimllGhso4GZmjxxwySljKjpERIppZRhrdSBnZKM4cUjLmUovJ+ph10GiUuGpwMwlsD9W+gbdQktXJRyQcAu5hII4rCc2JeITgGXsCOJQUtFQ8rlJamgNqjRDjCnaGW8NySTJIE4hFHkO+Lr7qDV/FvF1pRq8HO+kURh838CR5JPmf/l4wwXnxJxp6iTTHELkY08rKOwjwOy/MkSdS73fhnZB50fE8Yd/OQGLMyN5v51UWsW8qmE0wPAIGmY78NsTS6GbmWcilHj/KVstsNTy+dwytyVph+lJ+jjlN6jqCvd8OI2uNwfOjxrDfNyoQQTgwSrlUkzGXV9kRsNwRgpoRGGhIQ+pxu4XYB28mNt5mbzhbzW9Os/B9OnZUwOljLhWFmy8ceekli7f/e7HfoJjHUjUDGwctTXmvxwEdrNIpC1c/wYAmjEUhGF6IUjUJBnYWOWJtQHnRUQnHELks2QacBC/EUxwiHBfpwJ0BOCtJFTeIuzdJPnhPtITeRpPDw3FPhZfJ6aFl8mRYlWTl5Lhg0FOBQVsU8FPl5OjqkQ+B6MW6ovEDWM8cnveo5Dakfr5tZAAuXBUQuhKVSaOwWVR26VaSQboBxvhgp4a/JVw+WKcd/ol3hUeIyNOdUz0EcUJrBiRRW6ogFUmT/Pz57c3jX6y7Qli+4TlbHrgZarEcvo8NCEf/vLUZgQY2Ou/AhNs8A2yg+Td7EIF0HP+IBmyi05C0YGUt1CCDJuyfzcHhXfNKS82Ou5eQi0xeGuaBP312S6ymMEm29LG7bWCiYAHpZcAh2LULvmRLYvX3sqNMP+ENqMIbZm/nVkLoSoq0UszcLF+RWOSSJ3pu8N9gMu5RHe71rc89fWSgWlEm74kS3nHx1AopjIqVMKxi3+y7LUbNqyw8O0hhvvlBAmarNXK1krQy1P089D+a2EgYbUqlmhFqBsvFromrrmdy1WgS4DAK0eTdeYdVQjwAmzE+hdHPWrTmOZdNvuwoR2ckg2+dZkiYZgoka2RJl58G6sKH18kjXPiQHmvRS7ahJXRebGV6oh+tHy3GPHAHACyWvdHQhPMUqmTFum3hfPvrsK1GNMHZOU39GpPssqaED7lJc4hTDod0s6F5x8UxeR6nVcJkW6ZbprNJpfb9ybXPIHkHva93R7BKhCaJuUewtkeJkaYHR5lBnh2BVrlOH64p+rXIlbN/1Jjm1uCG+dI7UuTvajYFb7itg6LAPuxlBalb7UF1H68nbbFQyNB9ZJhIGKdCDt61/VNUZU7T+fy9hsCbkqW68X1tumATTmBX0ad9OL+Rv4bwELcnFzp/RcFoKfGCTPsMvR7g3ohtj1CD0fJrMBOWg3eZHGyBRJbtJz5Pb4FUSXNJUQEwkdIqE3Jh88/tTuId1X5Wzzei11Hi8pGmyyCb+DWW7MfmpuK12exhyeu9nm2oDguRi88/Xbpc73PsHRakcCzHQI1FSkR1TFG+AN71LmM5MtPvHVQPA4FQ2dWKY+URi6womfQEvKYSgkOBaI/HdRICOt+LFxZmxPMIvsoiT3LRFMiv7cwBKiRq/FIR6409IU9bnjJCnewW8kQl2TI379797xqH4Xn7WzxPODzgRWh9qoaqRVLl4CZQsmX0cWe+4B03FTTRxi+GfQqs6boq1ZaVJOF0kwvJZVigjJbpLkIOZxBkx96Bb1xz2Tz5RFHGZMXW4OKA1Pu6aR9t8bRz7hkwvF2O5POjs7s50ZJmb7TK1NnphozdIy25qKTpm65vMpFz+BLP+w2Ad8SwkML7oivCJHA0HNSWkZLct54wafqqGNZcvK31ByMtLNFSgE/JTpJN97+vNoCgGQWrY8/1LaeaEaHXjxnSfFa76nLZK5qiZEWUik20qtZrVj6LnMzZH5DQEg//2nQMmlj75zaj0AcaGW6sE0Y+rJ65bl+vUOot11J+PqnQWFUUukvbkFNHJrqDT3BYbO0jC8YS4/omUFwAuVWKlWsKbiucXOh6DdV4hwvI9UmeTUaOaBx3g66duYf3GoIDgwhnE1XJMlpERckfqWIRZI48o6Q0mAJkFYti90bkb0B29VM38MvA5gU/AF4uJ19tuJM/o1jAAbEoxkBt+3IvA3ifqxccOOACLny8s2+qpJHn1cKjz+03KVXgawAzMV/z2LsVhjZ4i2vLaBHpnqRT3vuPZsLOj47tEMn/bNrKwWQBOiILGrNWnXodtMMrh6W/pUT7wknk5GvOv/3PTzyvvi2DAoEek9FsHS19NytOV0ujhuAm85Lpe5AmbiyX5MbXGRT+4LdLtgYnRjhfao/oi3gG7j+orjbHOxkYg8v8tftwJJxqYsUfrf1rRLrwyRVLNBc+eT6b9nN/Feu5tL6xSraAVZV0vebxVb0KrpqqXVvcaidwGWRLVOrl8yXBQcMqyVFc2eL5Q5k6cllacp0JqIO+9UALH1hR4B2xnErj37azZ6283LUOAHcFO3wVQOLDmcSKSf8O6lq+JgzenJm9Y9bnaB0V3Otr0eUNSll2Z2LNls0EGWqCAMHA7iBDpiDgTBxh9cG5Jssk8p+JN6waOBdvWIh0JuaQ2tm4w4qCM3FX1y9wKavmNq42iVNwuPCx2Vj1CN/ECT2wNKGBt5Qg0vgvZuwbISHdfoX0jusznS/L7jtcDm4Bx6hil7ezbgEOc2N2gwmn8Ky7gcPmmI1hQjbPuzE4fI7aIyZk9Lx7hMPoqO3CO3CzboeYXfg4PvClnMMSNWy6EUYfdQync+fUPamM2yFW81wMvuf6iuX//h940v9//68rkrDCvAUMDfjMRY+iJbRvo2W85YrFqiqhsYC0h/y9vRaJN1fjyDjcQfMUHzULxYgsvyWD/KxcnSMv4Mvbz1f7eQFXdjLTnTfO5R14kK9HXsLtxyxsNVx52RFrS71Rz4at8E44yJOJsZ9jpgwlhO9l0jdT+8jhj01ltveYOofejPwG6egkD8iE5RKTBLkkKX9g6Q7c25VfB/RvSCmqzTbdEQgbPtIUjAKaOCesKtZkJ6qyRkq8NWyk/v3gJERQdhvhfcXLmBGTjlnbAp/1NX/01K1Ffd2yd8FlWTX3FFBtIaM/KlYFvP2VECnbS1Af4PCurBh52kIyzBbaHdL2XqxDY9TstbLZaw0KiNSRkqlyF4SOyXIRlk9M21PpLZEKja4OL0NOEaTMAiuavt2J7C7VTt1rEHuhP/GSJZHibsnziZvnbdOcq9lCfwc6d0DmxGKTWOTIaORm+O19vo+BEUwEGWmlFSIS03OwKMWm9JrUMFetqYAFsPT3/B5c4iNZahdJNbK0eQwNc0McdXHXtxXPiJ7HD0zJ5uJkDG5ttyP86tmwa6pt2P1goSHVc+kG0D5ONeCbz6sZgOBQxYDvPKteuKCXixDMGJpUns3uaWo63URfotatPzvdN481fthlM3iXNkrcQ3dqB0zJZ+z6qZnSt+XLXvzg5rwY8LfO5b71DsDZdM4+PA/PV8NVwku1e5FsyZovgFgngffqYcMXqDDUutOuLzaarYNXtiZJgCTktInDkPYlx8wFtZVAfxBabRtYcma8SHUQr8Wais3ZjCfIkhG6hdlPxaY+ojde8bF2s7eNyLkXqGlmCgy20ouWvRxo2bwYFhqt0oPVq6Bes6noHAK7/GT0W9Tfgek5+LL7GSAbsZ2FS/pHAT9Y2J3C3QG3EibljOA0udHgZEzz82EDauOh7fLWE8szQ9vlcQuabZBe5Tx326PD3w94rlN/3pTxYFMi+Bg0vynddujuV0NN0BGJ3/AHGdZfg0OGojyvOyNpEGjXqRnagKSbpuC6a9LfBPBaUe3kH6krqt3tf3wK9ZGH3/mbNtm+GXZ31B8d21HeKzjEdZDg7vW37hvBwc5oEercSf0UiVjBb1tQ6yHgzne3DMoSb/loykoajWyE5Ze//W2QGfi5b1EazxgGsnGOYNY0X3A38//1iO4q7nLpckqLIp3szustDGYVx1B1cYSwuHiEEOEDaSIqfwxgABf8/CygjSV4o8Ayt0/cQPo42OY38KY//AZCtERU6o1YvxEllPhdFLSEmpuU/6l3FsIg6ZOzPN5dNuz1ceQqwxwcNRzo6zdBZCqe4CJCM4T603yGqy3Z8g3c70n2Ry5eS6Nd8GkOL4jQMuV+f5KQ3+C6Q5ryd0hHJj80icyUbHTFfUk2tIAaiSf9mhWAgVP/Gi6ea6yOagRl98TzRDzNIr232JEl4Riqt3C1ZKTScYpUPDFsXlofe62UtOgCPFj88X7yztGL6xpaUK1tatFJiyxhhYws488uXS1IvEbTqgr+yIqRQkjJV47EIZyMS5FcFAL6MnKakoRt4K0WfYprLdQxq7NpQxqUQ8ChObi6luXKtoyHbStuzafGMQqvKQ8Nop1i1hrMTsjWyTexBTduQLfO4Aita8tBnFZSTXdVdG2GO21BxCJfRzwJCvUEFegkuiD3cC+6YqXc8oLEW5pDtGQLF7V5ME3FxTuTvtq3OBu4CLPWWYN+DMKu6zTmGnMkTAguVxi949KCguKwQuQsV9AJSJfAXMGFrc5jAfDak+YSrISuPKXk5svHz2+//CekuPzy6y+R/WszUE1+4ePRW8V9vCLr0V6+99Ra9eBvGDFA3AxiVfDqJ7hNAdkdsLsfr8dH7+0NK6N2d9MeiCXT3dR/XDuNp7gkv374cNUoLzwQCg8y7phqiIMPRvO6udfeathTIsjUgLbsdAfpKAnsuoJkXIIR5JsK+56R6y2LH/SQrCz1G0fmuZiiFEXTvEK1Gvp6xcQe5VRrhLx/lOTDUUtDx3b3fts/WWMQEUI+cYm5FF+/fnz3WtqeVDBnvqBy14i6/73HT5vvxjSH+S7ZP0XbApMqVzyFHCFSQju7UgeJeWmO+053x4ZKUDJgcdg8krndwtMvoEO6sjynqbZvdbeN97/dkptSKBELp+XBwodynYqnKFbpVKr0AU4l1yJXpUhReQ5VqQI6Xiaz2FvIpVqXaGTrOmib5mtSeD98+nr7M7m9e3v39dY+ElFn+NQ1CGChDVC71EGSYD5U6Qrd/e9jjh02wIDJK7IVTySr4q2mLVNo4pXSDbT5hIMmHJgT8XSoh2BARbmcYQNoUoydAnIogbWiMFqYMSorfLAyp/n+cx9B8CWLH2fA/YWpqsToT+OEfbiObt5+vX2Pb6a09wPrlLfz6YRk7Y9Jb0dR+PM1h8RF80oHOh/Q1QZyHOQVNljSVTRNN1WRM5IIZjYjyCXT73KVO6Om2ihVRh1a0bYeecp5GkoeIc8XKCgrJFj12AsqVMjkEdWAmGCpSCieBdtiZHQFDrF2gK66TVLRO3JcwCHM0GHeC/UIm/0LaHNdQj7JyW+1juhKlErOoH2ehxlp6srODe1qFCb0byxs63PmxM1z+2pRUO4ubxBhiOBF86pkz8kfPqreDngoJp11FuZhvtZnw+hrhT91R/Dp+kiYbZ2HfSeg6X3a7gLVKcneTwzIdCRgN5BwYRKhFc2ZqOQlSVm+UVtrVDQzGk6PfPegR/QxhG7A7zqAgS81NIsZO3gnuqy/DsON8cl8E9WOpWPPYQrBzhSKamD7oDn5YfkDyRjNm5bbepvCUwEEorHxIMT1JaGSrEOV6vBDYT2ynU5dr2N5EIh94mlKNiyHq3OI66VCuUVZerluS6FU2spfHzFXGf3WO1enqxrsX/Z97ZB6HTZLY9iq73xnZYvnc7JlWYLTAt2dw8Q6xtVStYcSKneZucgFQ/xANiXN4RUZrka6j8n8xhdcw/82xhdE9kKN720NzW98xx6GTzO8da83vxkEH7nul7PVRV1FBUFnWbF/GRPZKMGJtuRlmcjJ2DpDiOyjDYyZvI36VPPh9jNGKYz9DKC0CCEreLcYj24A2e/OvQnmA3GINdBkp611HLNC2RY6g9CMq+FF5zPOQ9h0BsEtU3bk006AvtdbB9V0AKJ393M8NSsSk6BkYm3g2oVE6eINZXKeiveuVX16AlgLFOzmwofxiDn/4tjgk2Ybsvyj84gQSNU+9Kgz5AM7ixsGZA4HdiapHQ5OL58zodO0dM+FwzDOZWR6bYzFRy4kVpk7i3dMcswzmJp9mQYzCvzGpIf8/URZlktf7uf4J6pNoG/hE+kRlvEtxg1Psor4hNLe7yeY6lbkfe9BnXbos35DjuGjTiTh+GI4pBZAzqFtF9CMhBfS6ZhzIn5rfkYx5EmVYlkBl8PC0rbNHtxc6wByi3rF8/1KoyMVZlATdEUf9LKNKskmktMAtZkI9Vmxo5fbT2AStUU4eJFZP2pxeJXOMbIIK6cWSMetg7dJ7ENq9RBBVo65rhvi8Ug27NY2zIAFr7ZwjplMI+7McMfphC4wTA4V5cGiMmQs5wH5tHDN9m5bB5h9oW08skDS1QzYmgyr0ejKKs99r6tNjQ3pDCDryMzT5CSIKoDIP7xXWY5cUEMrRmWF2Z6UzlDY+1wPT2P56pKDgsezEJqPJUslYSndnWuqdN7g7IIzrUojXAlzUwvWjZ5MxVLQdawRxiblYiSVQyhAebHOvZrZLoiC5efRtLOsUKlKRrPZycxvBGBeoGOXj1CQyCEEUHm9Q08+97M2nm123HfvP72/e193jTfXJTrztipGOAazNnJuUH785fb9l7ujUUoGNb6zo7x9/+n99fEoZ+2o3KD8evPubXjGsbwaGtB9c8qrf4G/B8qr9e/GlVfbdwUzAW8syrGF1pIpxfON/JH8/ZWU6at/BIqvLWr/sgxI6l5/azB6JuOSFpYP/ZXlwr+SLRqpqtUk0b1qNTLC18II0Zh88y3aKlVEgAWrsSMjfDtT0NLmtMjfVkg1bWNHo1F23KWXKiSzPbLFyJUyQPDOaRLZrBR8WQhjdo5X4eQk/07Nw3XOb0N44W7x1H1pPxPchQsUWOIB7Ie0pXmy/9LplJCQwmhESSmKYlZESGE0osB7P1NCQiiWkh8HaueEMPb1fRQQOI7shwImBtLMCpQ6lgwtAt7V22A5AoWniluVeS5cfFjyueDW71rqDDdZiFwyAp18bbzcyDyAnc6PnSep18I9oV2DnH9qJV27B8W2iNZF5jgINz/fRB9uPgdchBVT1Dapufn55s2Hm8/jHAb88AhHAUgc4Co0HPh35YAw7/F74y/bXDaQ2Xo02Hv1+9nLhX+brdG2m20c7kQUQhzaXwY+Y3Fj3V1rTBjS6zjgTBDi58nla1q34a7T9rmL0JGn4SfKaE5D/Z2PggA13PByXrLLacZjyKYTecLa+VguEmfNdQbzT/IIDNf1kJhp1WR61h342mD8k9SAtJ5F59c9tmgQZPdUwvNYZGAp0dSgXrpKiAYDyr4soP/nGVc3M6eOYAlvvkDgvXbYRTJR3xEBjZRL6DjTSRxtS8Hbev10GfTvJ1oKWyicWUGfEZ5zxSEqfUVWlYJCNc+oUEdtGV6Sj+tOQ/9c5G/+ZKUAWahdwcEA7XRCPpKjabrwP0NStwm2k1EX5Orse2Ql3ZFVJXdXuh69aSuf+974dAaox1bCDK9Naw7vo9v9h3yhXO5vifDnvsiWEAGMtzxNSpbfkwt8ZT1x0/2gCYvxSglXl3D6rNIE3s7tLjL488BYYYSHk5OKJ+jCAN0W9O282pG1SFOoBq5VaU1jqFqmvpmxqmzUTZJHTgklUsQPTJGLu+sbMBgQ9SPwjkNyaUVYQe/9LSv9JUNSOK3utxTqa1mJnTahEZ7wtku2ag1CM3gifxr3qQpuc3QbBa/XOFZ/a7rwC3yLxTOQuyZMYAXkCLYA+12F2HNZi1KWT86ezfcB6eNMdvlxV/Ry4UNZL4OpNoEbM+BUOwBPUja55BqFMIuz1od7630qYSVzj5xc8CVbBuxeY35gPPg+1R1wL3HNGguIS34NN+u2gYb/jSltUngeyYKWLEKM93pJWmvT+RW+p/60DY6J7BD0PGAzukdn4X4ZEL431DGH+FGAVuaNBMkbopy0dAD9l3vPsBdS6OpjaNhHybqCjQVUBylc2XNQlSpTnbViWENME3L/w/1lSAT6zDyjBDTI/2EjPsg/kyE4MPUzzcq+teyCOsz+uXtiVDJ/YsYpoJu2plDAgiXjCJakHJr/gJnW7gvSv/IMo9dMkRHTTAxcAOCL6MfhLH5y8STKByjUT2Hj7frt8KfIyGtcT6/1Sn1t/e/XHeWyAoJeD5HdkRYj5TIglbstI3mVrf6LvavtjRs50t/1KxoOAsuBzLXusofDIgiw6/VujPhFie0L7tOIIntmOuKQXDYpafZw//3wVHeTTbI5Q840R/JesgtkbYlVT1W/VVfXi6WX5hbdJDurwgLQDn+IOI8dI3OtP1oAZpKtFvgkq8rrAWGgtIWrl9yholhVa1UZiTHTrw0HkM+cWVX99w4TTsXzLFovVOWKaYCxteEhhUYfrPtQjRcjkyX60rQKyV6pv9xRTXbAU1F/Z7bTY8rItqC5DYEBNTT4zQ0/OHOf8IYX7S+i3J7tMzsGOOLfj1QRIqpQpJehjlL7fnABQ/se54lI1QjjdlrwJNSVnqwuTS26BhvmH2xqiyAKt4TGwMFYm9ML72/bgL2ux+emnUFEPweWfEVPIgvDxOnNGP0MYnqWBZmIPa29j29/NJaloa6uI/AgIhlMSDucKwt2A/P8SGN5Wo5Al3vTljF5jdIaJG7OleRFMMdYgTDqiq143dFiFBbH+Oxhao/BAVzDvE4/WhzH3KJEd9/u2I8Aoz3hYRwXXVfjXihvr5j+zgBqu7QnYjAvj9NA/AUVdFpt52rmiu4FShkUPK8rosAkwEnB2Y8fPrEky26rvHmIWUCknThBydP0he8B5IzdWXvftCKFZJU0DwJRtkEVdz3eyGOg8e0R1bqmwjsvL5lYspB9ScWDuScT0SHbQX+MLboox5oP+6QUm7rboXLi4DBR3V3BBn41XDAvzC85J1KPbONfcotiFQg4gTjP65PQ4mvL6AZJx+Js8GznoL7QEMO27rMCkwSHsvZUdqFoi4BbA0Q1Inq03DIS5YWqTDuLkMSAZCJYitOAwrWTw3MvT9E++qDGRvPGr0LXcxSk2KGmabxNdQaUkQmTVj1Ea9NlV6r4tS4FSl7jxprqEWXsD3q8v6MrlSWTvkORh6KXvWb++QMTcdL/1mhBvW2m/L5Z5J3M+eYfRYocVM2i6lEWnUKyyks/MJPbLqsJAnVQsHOdafYCeIQxifEfYiOSkGrY6++cIFqAcRPNSyrxpo8xGkoY9rV/zmYeDVWLZCxMVay0WxLU9MnDcs2WVWpIJcnOgcYnL1vfuEnHQuIhIe7qoz57sUiKMLo1pj9cDkLW3yk5rXnrXCU0StNWyWc8ZnQWxXOEw0n4wCLr9vLW2gb1fkmI6BLXo4vblezsso3iDMmUyreqZFkiLi/wBlR/3CNrba1kD2BfU2TtPe2sq5ibFcIeeHHMhdLZLAwaw6U01d0t6vvlc60kUbYufpXJq2yRNtc+A1Pr56jLX4SqwnkmkKYl4WKr/AVWWR4YQ9nm130izHmBK/bQqWMj1b6lGZDWlD0hhR9HN/sLerHqSrHLJAvLaXhVwnbT3BtcDEjtsaxrrGJxYffQlWsbcD2qek5i6qngJTzo0VFX90wrM+w1tyhuyjYiSUSvROtuRchtGn3NegD+dZGl4lceT1TGTbVc8kIGllK8z17Nox6uuKI24xbLPdgctpt/VDdb96Y4AtsCTzPeAdLcHNioZaniLkNGAKBOatBmkuN7DQcYW4dxLWuZZXgy29Zi7JRRn6zeBeyOQCwKHuGspbu85joK2mKJJeAdoHlp0FxIhWVmzmyG+h6oaE3MKfCMnadwxya9ZllsYBxV7ITUtZjpra++/BiuVENZ8+qRhe74i506ChOUlC35fANYc3APFowjuaDCxj5vibCg1kLVG5fceotX4XdkbRHT4KyLyDhYjzGp4KMvsntyzxt6jWPe/M3LexHb2NqO9Np57rSoDI2jTKmn5j5/kl5z5aVbZMuF/kx60pm1VjRh60ph41KgjQhulNY9UQY72socA9PmUd/bLM22LE5H5eNBvEWWJFDu4yIGCjxhhUO+mhu4cZwdu49HCrNMMzD9udtod0JazzHadMDZuGjrXNIRFSYAuTVWqtrsVTGWCyb70Ydk4cIHD0ND96BJOWzWsNiyc13qHiEslO7DJVuLxgnSPLT2CLc5I8AFeHTDV1g+W1nyzXOpew7Tn9Rvv9ipURzvNNJDtv901X5uGkmY1AoMrg5CJLao3W52md4MuHA5lUZY8ZZAO291R0lksi9OIVGR3WMVojHDHPdpuGgMdXMOC95HvwPckpfRei5smviB0FQa8VzYDPUDwam83JmwaeIHQlNJ4jNB08SnQ0O4SyKiGS7mBkeE9mdJ0/un5qi6wBY8wjuEPgkcD5O78aO4WVaExdZZIuV4KWr62iOiC83sVDT73hGU3yUE1wqVZMRbWsFXYREn+k38fq28Be1PcOz1qBo45zxYBTg4i1IXhl6Hci3SlQ5LbzMAcYpP45t84S5coXOz9indVUnrUKV3PVvQp+VU6KhDOoZgeO5gSA4Ygh7BNgkMiSk9NIeeYx7GdPh60nAzrWvKLOaluib0prET0tO5bp+5oPFNOyrt+OA4TLEt3cMpaMd9IXdEyrWoivQu0xEYJjiO6DahcVFesUoixxDTqGkc1r7St4j2YuNqBcjAdaufkOt3ynCrxhdFNn4R1nkm3XdVg+5k0XsaBS3zokpZlroB0W/501eDpR7RXXxL/lBOY/A34GW979pk4XL0v/E0fmfi0jxaFNVO5cLK8QSmW7DYaa8PTD3AD1wJDr7A2JcXc9fThfb2weq3OzgU1HudO+IHVvjgC1b44BGWv2ef9+iP5AWULOOY33mCdZXlVVLXq0/jsIhZzO9Ecw5Z/gQb4MjXvQ3fZMU2kOuw4LFHD1J3SSgGyq+iPC/Kt6Pf1HbotI3Qo9ttBERwmwwxFkUp+AlRaoaTgWqz/HRAzT1gNFA8GiUzzkqif8SkpO/nnJN9gNOmJH0/84zsY5w8IYnEzPOxD3PydMQVcM7RBv0jBhufz6zEHkK3Dk2+V5Ft0P+nkna+V/2XA/leiETrZ3vVX43I8tKXpNGlaWraZ0MXTqeaupevlwPXNMMHFwF5zL1WaaYJz2uU4oiGd2Ppo7YRplnTWdVtqXfR7kGMfz9YRA+rbl7XEliV694v7ZjcI8DZodR2q7hgEIy7ZJoPHB96RdMUIgR9DEVA6ZgvLgNUY10sj6702wcDwuRsqz/JhuKBZJkVKG8Yrav0Vi7KbJHzQgpZegeltjymGOmHxULltqMai2bbBLvVe1IR3tyIcvOLtSP9nf7q/d8G9iPzY11E84iNxuLtXlFO8burw1DTwQEiS8/2rVInXe18dlE5aO+YtbRUI6x6cUBNne8/vX77tinAIhmXEZUMDcmZ/No9R++QbOYP6H+JoqzChK3rZLQj8cFt5w/eF/jqhot6ooWTP2bUY3qYGZJzUu7NH9UuKGGIoxJGe8LsBONom3kMnn4xiRoXQuDueTwW37IIN9wjOuN3yXmxETonyFTSCRU3dHpV3VBeYNtM+Sqj2ldqxfWaorTrB/QfkYwkQOxvjn3e5o5Nwa1Dvyv9ky6HpnNVm+p9d6KVV6qy6kppJ8mKZSvzVLd1ygrySpssGLcQOedF4FeSKz6LHDh8ebpLFH/ps3owkCCwQ20eGV7x3ewQr4MrSZWWgaOt0fEWj+KgGxWN3ERaqAb6RvlDVneCOgSduxKZP3CmMthIbFlUzjucxGDaaNqYZhtMjWvMWBpc/KGX4DvZDHXQeHpGqLqO/cbtz7hCH3PuL0va9IDOijoyU/fUroo7cceN8wKxAchLH7hbhlWZLZxNOjxgs0dXVUkzwVhgu0GNWSraSakXaUYrTEUTDBx1QrfjngeqWS0IqDecVCEFFoUptHzDm2SZvLpJhESwYJnBdRxqW+5k94/7dWYibFrwGwBOJBsEBq+4DLQAC5EGrga4h2567LWJUjKcLFU9E+kzWMFhWsPVGoZ6y/AWh0kYRYZEkVXDMUAuSWJehiKRQdEPtjk4rxA1Ue7ZBpWaWtNEs30pUhUrRS/2ulaCrh6FN0ITaW2nR8twkyeQlebZXZiMlDCryhMOVlaVzzCQ/dE6dqQgx2MMFXy8Hsdq4MI/+ZzufH/IGU3uuGXBudfAwx+RogCqTOZhxJm5yk6/rzb4qJiiV5RXSGZtBcpRXjILk7DYqAqdq4xly+V02MvYa0zLTx2Hb1MuOhhi76hLcSj3L5LHI33OqyhIq43Xvaaxhn9+bbJIBisJr6Kg4FESCuTV+5wrP79uOuxq8pMnhcgopHqhskjVG4FuJh2Edyt/SSA0XXSdb3Bh4d2K9epQjoHlcyD7qHQj7SFUeNydTzGgvlcx+CWv8+hHhGCbbDIX4VHTCAS8jk0Dq1lhw8wxenOwB90RACTnt37nBYkPsnsnBH7Jv+igOkZwFL6YQXDkw+8VHLz9Cw7W+wVHYAOfQXKiu1d0+i3/uwCRPWobIAr+B4XI7huVDd+cxiTTj8sejDJAhlnkFfF7BQ9067F02yablEsRUp/roHyYyUZ6T0zaWdZKl47KQzo/gP9SCUQH0rjL5pF+hxBFuHlSMsQi1qX0SZZxoshVgBgJvvB+nDay1PfjrgDg2bRN0b/GCM8+uP6X/Qi8JraszMYC9uv+rZ+znbwQeeP1xtWPjH9TJCFSg+oYn2EgHu9eHwb5Y7ephpLK9G/1O7UfjyPKCm4luSG5qqInU3gzFUg3JnLpBiKN+UPwz6yCm3TWqYw82CKW3ZlLMBjBYBrGfrwz7hAGZntDsFDuR/e4WnTjQ/aRq6PSoYi+R2nVFd+xIOv6rmU2nPukai+fyDkDmwTPEkhHdPah6qDyuG/0QIH2Xhx+o0NoywZJN7Mq7xndh8tLvBTF4KzLqTsPJztauwT+9Rr6r9fQr/Y1lD9ESSXF3axYhWz46LZleDrJ7lNeLHIRD5T66bxoHDkB94RAugp9H8Hts0kvbq3JgH3QNQbZc520+FwVxt6EW4zms/9BPUKRri7Ye7mih7X/fcaERQIPy3XNUBT1DdjfoGRjpIcFiiJQRT9EiGB0UawQurSisZtCZjGqIJrJhIR7HcYbmnbE2ZI9xy89d+stLFaUzS+RibrIC5EV7QY6x2zkfcPbMGAJv+OJpEO10U2ZMVnlw7FgUZbKaoPik/PYRw39PeyrUiRCkp8ryKPRQIxbI+cFKmSHKz4N6U+FLlWuJyVOSHZuWv+9Cl5RlY3L4NWLputFPetMQ1Kx2fAY0afJlsU8EQgQqW+KZWZJqWqOq9qmjKp2lOswZZfBK0zp+vdoRpL7CEmeW5byEs3NQGjFJWRB3GZecKqspN7J3Qo2MJRJ53WQP1UbjDAM8y1pqUrD6DbN7hMe4/231sC5UljM83L9YhTMOR6xW0/YFqIZ4wtINTMtLMNE6x/RMtxMPoSuZO4g6AGMsyu8jmjo4J5P+e3ZOPcouDRvujBTlk8LzCTkpxubNuNGuPkGyeQ+pX7fUrupoA2bRiiNXu0E53Teh8l9uEVtd/ZKHaNwsYo63U4Obl7wcU9zmw+74/cK9wP42Hll6tSok2/bF38WSplFOJxi04xJC32hS1diQ5NlGN1esDUPc9rKTaAek2VRRWVVDDn0yF2PLUjOOoA4lmUzdi4/MZAoFQipj2jVjFKUu/v+4ENKReaPJIPlOxolhMkO5LGwk5X/jj8P5QXiZ2zj7k5JHwajWk8anm43wYBCrumra0xUZG/A6OatClaYcVYFrjYol+PAwAGRs+5ITXBcXIPAeGB2zR7IcP32w08fr12dgdqaZMwthi2KPjNaPxuSaI9UzYDr1kUHJTLrKPqey23nkhiBrH2A1s2VTMi+ZOd0A++2RldTVSbhXWsfHoQPcGiNm1VlXpWLpJ/Y60OQd4oLU1wYuLBwkwG6TtTuCzgC/I1YgexCpMB+Uy1ngP6D4sGIh6mH7AM7Ki6cZNa0EmVCxRh/gsOHnf/w7urj1QX74e/N/727+vLpL0OTx+DX/oEzF/YjFiIRtTeXqetRZ6sN6tXtmRqp2rdpjKoHxiGCza0DXPbT5c5cMKO8OnPBO1h1r6++0B4sJ+oLHv1AbuWgwmQUJjxeuKznkVr7pIpzA2HXCFPa61a62Au27sY+I2pKc/CF2ZGBcSINW+1H6jeuKagfVdUTwBvgyto/cwE9eG3pG8RB9gGtL+rUOKjAQ7f6XZejWrGx1siA2npQCymnAt2HY68gLaCskHIs2JyHt4+MFhDGwk0q28x9DLRJFY4AuwkfDpuyHtDqSDjy6o6EmmeJiLaDWN3PMF04jh8z9uZOkEHHFAu4C/EQT49kRqMAireVgqN6VjwCs+6clBVHQt6twZrLACKDpnb6RNzvrllbJMFZZ5bs2TKTLHTkjU814eofD/Ip4ptBHi6pBznsPw1a4oWyXMjwDjWV4CyUC3LAOL/Zs97Gy2wDuFkRd5Eu8iJbOTrFj1X3Yewb+R2RG/OLrdjTE+VO3sNr8Hj2EDyQvNu8bn7h9ZX1RAAM8zBbPsZCG74Kzje5Cw435eMtLsN/qIHuabg/0iQ37E87y20EN6uWCh5hmzktd8O14Hmi60Geufi4VvleI2KI5NDar8FkCT+bLvF+aW0m2tvH44Vytg4yHJhf07htQvjFFtly2W/w4ZkV7txJtgoQ8HHHT8MLVctOw2kpClkucD85qTLXQpYJT/0zM4yUO+rMRfuAtadLcXXejcasPRREE9mwoL6W30qUC7kOL0/CCMWvt7NzuqlEEi9EPDujTT880j+TTM7OIiyi9eJGlPNz2lRJKfKEP4h0tQhzMTvDVRQtTrWUtGd118zzshcWVXqK2V1G+cJRJNCzMM60A8881r/OSz8pqkWE18B52aia7tRnbZDRMYNv+Lhev46wPumR5xD3lbYM6R14oKbfYRreFTVjMa2LNjbAR2L959GBDAdgVUxHYaX4EbkwT0GnQKpYsrzgmucIoCkvAwoXcMa6eUOpg52JkyNpfCc8FYhxEnw65mMCQJGiL1IZpjyrUARfouT8wn2Pn450JFtS6+L2Jpc+Hj7rH4/krsNxTscemSHBskqSQXZedExs8rAoRZgE2e0JmfGimJfbLd/KgD/kKIJwCk53wttmvZMTSq+hX5M8ESuUMuczM8urG1ndBKaQ+0mY5aiGVaQzM0OPU1kulllxu6hm3zI3YoVw+wU1X4oXOivYL0/D65Zvz/YZdjuMuutbvrVCWFvhq9R9h1aVKzb10bJz/8q3nbxDJ1MR+2P5JRW/IO8y1vlxQoIOO/+HCYmH1tifzGL983d/AsA/7wsW9JsRDr2AIiWiU+LhzZZdf/7vqzeOIGMnHmffocE5ugfOOyJmMuNIXU0IJk+oT6zKN0Tcq7zQ7Yjob2RZoJ3VBYvCIhZpmIhyq37AS/lijxTqsAnK0lcNgk+6OWaZGdrs5aVJIoVgyHBJ9Q870ZndffzYtUqHwa6Y87pllvnleQPPfa6yv2rETMQ8RSetwUBCwz28Wy38jbOpglGWVuKRky9064lpsyZA1OQVmcHbg4NmHD8WiqEmk+w+yVZHTVFNozNDeVpS4/tsaYeKJtk9S7LVBQt7c7RN9NO7j/949/Fn9vObz7un7YQzScRHaq13Npg5a6Q08pH0Q4GGBg5q0WNeBpX0hIsa1aecxyqfkz/wqCq5TtkjFerGuVGR9RrnOjFGG4+L/bXafQwuazydvNtmzpG86aRs9EAtXQyOVtd0J5SwWEl/WL43+f5m2tSDEy5LXfXhlu+bPrrMvW5e4xGd7oZjsBEbFeVfK6wFOkuTbVOjp0ePQrr0+v9j8GqcVH7Nxw9WYZf98sCW4iXlPvYoXb9+9/bNh8/s05vPH75//+Y6YB9b0vel1bl3kiL/reQ7Fag+kH2no9h1+QidhXfBEnHLKTo8TOs41goJjhdWWYp2ql65ritPImuT6PabjJZF1eoxOpjOV4vhPioGxuFafWadEAjITDQ1k2UYnLm38HpqZGlaFmF0e8xp9YGXS5FglTW+V6TtqkSgDpJDDxp99rV+dviubpnQ+kzVFoNLgnK4iLn/dmuNe9ic9qYP3GEIbyrXrflQlJ90A7ghIOtQrnehoZU1WFpkh8+xU2JkGuov4LoLNiHGNSmJ0DxfVSEz+o/FncDpf7OlePAe9Q/83iKqyoaYfqwUyw2uxAGB3PCCBvO/ML12SGkl0coK1VJYlbMMdVKQq/f66ktrkQ4tSBvyEskzvZ/umF4jkLeXp6wivIwsq4QlWXZb5WT98gfIka7MGAWDCEV6FyZiXoymYxidF1FWJarO6w1XE8w2ynrwVmlW8NOhu+cFZ2Giy5UodKhiY1rh7gecSl6UswJWLHg8ZnDxmwvVHHFWTLpvoWKIVX5BfhT+gGI0qCAQhUjdQEMZFiXYBGHqsIHg19b0tWzSrnzYRWYVy8wNs10ZOYb2SaWGYcA8LJLtYnbYemLUsMuMbcJbzoos29C4pPyeZSmXnf3XSfY+dG3KtlQi2uQLXhRZMatUb1+/v2LEZseOYhUSQa8pJ0m9iK0RHJZNcopz0rUDZ5WPhqDexTVHa9LR4mkMCNQzFL9adoSBHLV3zMnmqracX4KOZT7jDqBtVZpDIe4dqwQLodDgKFCLvtNmttOoPdbp59D1Hj0D+mugUsZjg6r1m79jV3V9NukEhIzckxtnKEmoP9RWGsRB/BOTOa7JqoZ0wcZ48kiCUkS30o9qAS7cmNJMRwDT8+UpKveWFylPpkjhUcF7NTwBXSoi/vSmb5ayJLt/WVeINPFFll0zLM3JNH0oShEnT1DnhKr+bBj46SbyCEAZaoM/QV2mDMDYeZZSo4p974ZajtOpdiq+4penp2QkWIgIJjbckNTlDH9Yh0V8j7sZlYIrqtyOFx2U7mSq94ZaZsvyaxoX4D1MwscemwOQlzxMnt7IiJSJ9C5LqrQMi63aAvTD/J2u6Q6N3K/RZAA3wPU2h0qk4xZ3H8qWvjIqFK6PQLvEgfnf9/XbBD3UZCn7kgrLNbhDkSebAE9aQe5CVdOvclFe9V5AnJUhpt/OcM3zOVCNYx8AiToCv+1+/mhCENDUT7P0ZYqdLRG/OlxbzcqQqgcs1eIM4Z+mRwQqrwJW15evXv2e/YHusPKaaPeINXysFScbLyE8K1QKU1EVaZnV3ZHVvu9I5HRgAZRmSFpf/Daupuxj2ncRyIse2W1Woeu6Kj7a0NfuHhwrK+p9jldr1CWH3thPjbvxAkFT/94jC6bq9SEs2X+8+j2g4RFTe8C02yOI8iow2ryuS+xf/ufg4HQuf1/5Ffa3dUn8eq9fv5Xbzm/6NvH/wC7/l3Xrx7pVPSOeoiJhS2FHogKmQ/vS73QLHDKDRhkjMJueukVSf+8UQx/oT1aQqaf60xTkqKP9iY7N6PP9ieI/4JB/mpJ4P+m/KjEPPe6fppBf65n/ZLW59+Cv/wP//k5llu8Q1FW09hiPUO0Zgc1CkZE61JkiIzBwcArpQRsBv+dZ/FpexU/pE51qVJwM21F2wuk0OProPx2kA07zk4HzfkA/NvJDz9yT4X7Sx6jRCR7SxXFdk0DCevvAH9nbj0PtpYZyVg5/H5mYTqR9198xGYeX04ebxBuRri55IcJkoR5bJsAbCeE5zQdRl/HBiwpaOIRbE6CZF1mdLoCw+lrpPZr6fWCPQN67+kObZacSEZhIZGvlqmtzE3Wf7EuZ89/X3wWQuByIEMI5axENAjSWqeujA8ATGWq12YaN96J3Iq0eVCmEuqdb80/ZejeUPKLe/URJFw0UeqalaDFIfWTUbzEUPcVH317+26gRfHwFmQ58XnRkiI1UU4/qfrVhFFw15geVdoBiNiJJhM4q1seb3lbAfd/BCx3wx4OouznWVJwYRTY3QDfGOMM5+Pabj/sBwm1MHXqDgv9ScVkGG16s+FDdsIPbwXbDBMCSaZZoWVl0Or82CT3UaJPS07FhxPxORHycWDRGJ5aLeM4tWGu8TjpQDXohZQ+9JWdNd5cc7QE67cj4lYRGRAuw4zj2IMYPzXlb2749zMGoI22XQGpoTigRMZxVJDPPUBDGUTX9SHlCXRgGlNm5SM1h/cKk5o6ceY2cu2ShvWNmSYiHqbg0hxAhPBvzYLeuzEh3qmG7wOJPNxxXn+HCrx3k+F0R8cXg6X60AJqDcvpiOtnH/AvMfvb2m49+x+Omklt/0jTvzC0/RlyhbBc8FNG6LcIgenZ+E6bxvYjLNatKkYhfqYCWrrNmfutFwH5Uvy7DUlfKYVkUVUWdKdmE+aHtaCZhT3Ui94xKUHK5VbviMD9GQ6YXydn8yEdAZ11QxDmADmPTvX9O8hcoP7NVywSxkymr0rwQdyLhMOnIW94vYGtDV8O38FvBpcYIsq0Aw+/Y9Tcxv/sGP728diLyW3OvhgKyXSj8ofyjGwSljS3yTKSlXyxEGGuQaPd040ZDs3Xs3DrgIgP6LM3iplgJ/U3fl2dBKjgfi2iO2b57Vi8Lzhe+tWbpq+D8EKWVvWfA+bRGvGzd7dYYtSV1sZoLHxhOhPf4L8Ed0A3W/n8Y5EuJA+asi3nKOaanlKJkHWXWIWZ88+FqVfBVWDvnwyRRW04n1L/59Mij73D3bJO+b60b1ButuvcNw4um9BHLWtd3b/EdmG+KlcO83zXr3SPbF5xLGp9GahZn7TJWQ1q3ITp24J2q2Id+zyw0/yglgnl3DXQBVgd0OvAGEMz3AXRtx6dDSODYOQHNk0qSTq0XZoMSXWXP9k2yHVxh/IOGud0cueCfXT47c6lrxyaMH6EB0TLE+8F3MPrPJintnQW/vnigVSDbiLSyA3BaSL99Ski/1VjlANjLJ4X20gHXjZtitB5rTrQwK8B2UbX2Aw5lMY0S59unIE49Aj4kunwSIl36kol+6dnZyG17km2/O4XyrAtF1fW0aJbbnH/HVsX/sfftzW3cyL7/61OgXHUrcl1qYjm53l3/p1jOhncjS1eUNnvPni0SnAFJrGYGkwGGNP3pTzUe88S8yBmJdracqtgS2fh1A2g0Gv3orJ8XikTFRaErhg7gnni2aweMozv2Z4itkJ71uvEIR2wnWBabatz7WRZrBENrkNldSMWAqGhOjxEoYg/xp66feOmHXRaqd+fl3piTsq0Fh+iqytDLZLUiMUfnnBjr09GiwS6EMDklM8Qqp1O6jnWaWMWbFW6rvV5FciWpmQkAYYCspQHnlDku7cvSr0sita2lxkXYthA7MJNjKCfP3BqcChQTrQzB1w0ONVhEBCpFL4nYEVMJT+5/kITY5H01eoasKeLwX/mTyCMRgQd1rXlvZ8pPFkAhMo8ITH0+QZH00iJ3Q9yn9I6cW8MLp13oL3SH0uK2b/mpkB7yrGDsEsO05GSRBq5QKOEXcujOYd6IcjStQ8ubRqYfjD6Q0TC3s38gKkfHiCdBWSuZiaWharGsfy6/+hsNPbbjE/198nt1t2nRsnSu9Ne7zlWNzumkd9p1T8eZq+ogXNk6NbwYPvgOR50VURSTFf38Hr36p1Sn/3p11gBZHhaSSmZL5CrzxsQ31R1hGgGHRqxTBPQS09NTGslmYLQZGc+xl/RlOmOm61KqG3NswNIa6Yf3pdRUqpf7wT3RnZp0E7zhYpOsSVRJ1H2BzQpAkETy4vvUGvzcu1xrjiH9chIxlquSW4v9RfftTc7aoyHEITJX6tOMnSH2RgsDR+2JYlRAbhqSbg1ij9FDwyydzFQ8eBGBIRsf0Np4WEYMCrRMhLzV2dZTT854EoN597KMQdV/lwUB7b01PLLCiS9sry7Psb+v1fAqvB08cTbwBqtuEnzWdiw0jLvQNHKOH/2TfLJNoV60+b1M7VqV3jb6+4V6Bn2kYQpEbHq6Ah82FvBdekSymmbPtYuhaSF0AZmuY0kAQQ21Fog0fFGELU3T84LUtd+7Au0FRtPuKLDxkGQV7jsKhoaOrIw/BpiVKrrPdUqgQkTDdQskmKvnwsRJ6LUjoqGjOyKMgoiGLgtkUJSeuyygUg/bQWJjAmSJWLNmgHlXLXhQ/B2utKBE6A0Y79c43oEFGXrop9l12qVAuU7AFohJxGKRvY7Up1caAYB7jifHdS9QCWOGElxGfL/QuvT8bjZV0a8f7h4n+hYl2ZjeQroiQQvOAlKMYTMHmeJJssM3kJ8MDiuIr6WhjkfFAsEJBg8u0FWDP8m2fxJGaVr1BSgmnCWxq0oRogWkvi3S8ZoGKnWLT6s5wKA6FFYP68iMXlmKVTHANY/Wk7dH07FiedL6+eqwVO/qZ0zPVR5sHbA8OOAQ4tsv37z4NX52wFqBPSZXqH7a9lQrquwZ8g3SsdxOqwjefZsisMVN2Nj/4c23yX/6EN0iAuktqbZ8bTxZOrKlHJeGp3yX1348Omc29NYngLFUTNmf9ofRMorxP7qiaZHCH0HXtIjgW1E39o2eMQEm2Ilvad9vsvcG2tRGDu++YTm0bWsjgx/efMNC6LCxpRxeemN3ZdQ5s7FQqCc0piExvf0DGhHT26N0zTdgQExvD9Yy34LxML09Rr98FYbD9LaegW/CaDhyE38TBsMR2/jbMBaO28hfiaGQ38oGuq5beNZmITSgXGgauedQ/RNw6WIPCzzJd9KdSMez9kHrn1mdsmM9h7ZI/CErsFt66MyPCeF5Iw0qSZcSzF/FSRjScP3KjiYarG9wHkhEvZrhRhoPx5C2UT/sepRhpTWM6gZ1AyhyOvBUg8aAyoUBDr0LIC/Di6CuhqzznC/yPNFpA7r2c4UcjtdJQEIBVSgjHGP9+mTJoDQcQXGJ4beMoWrimeHfulm57FzlFSpXo2n2KQsWBM2wdPVMjwgSBzQknnoukhcL1aRKK5yU0ndV7RsmAYmpi6hHQkFXlMTo/HF6/boY/CyLnCjCOkmDNxH1WID1OyB8T55dIHfM0UL97r8NYwv7HLg7b1jxu0ksN8+OxU+wVDway0pKezMfqeQfcrxCpL6/P6uP62c6Yt3OBQm3pe8qLtjy36RiC6gfzo/kk4RbGrMQVjza4phClCuv3z2O/BKcQbY6kQU+f44J+Wl2PVEMq1Pqdob+4Rz9DNgrvPTD3eMFj4hLV9TNx5VGWZXhvlft2lrvjRq0w4Q0FF7OzUFzEfgy2Pq6+kOhPbDGvh3o6UVLp1OQVt4szIXkNLVuk8iTxsZU5EIgOA2oj2Odb2Ad9n/BKKkg8wN4lEc+3mcxEIJF5qgzxa91NESrcGvaSHxVEibbQmBV/k8x8CTXc1NTtOW2ghSpQDEOqwHummkoH/KmWqOrLGIdkXIKesHegKEMWG24MfHKEZqnt0GeoD1ste0ydF71ztAHHWDamd6dRojaXwNRR86ZDdcxD8oqdL7vedR23rWdVy8UR5ytANMUQF9S8+Le4IYlEHNuDSl9LvT3hEs7F82IQDP6hTilbWhhCGr9RVAyHN4gwKrVnzm/v7rJZRPbWD09zTwcfzIC7YWmUY7t2ZhJCr1f8nhX3mE7vIriZ4guNZ9hsbaQjKNGRUtyopeT/CGcXpkpvbI0zZAmtbSotZU9tMpgEQn7zlZBDsWw0VVRBlzS73wS+DSgwoGuKEdBalggbCXUKCalrAV6alNYSRqGyrShIfWSIHcDxoZXYh9eR3C4l6dSmyg2OPZGEgWQHksUOdogCrgqQzuPGFO4bi73KGasZNoZvl3bxjt4S97o0FjYQJJXnpV8ViMBu8oRDCIAJ7fclCggIIzq/OhvmQ0MfvM0drliYmww14T4hkZgsOGqn4WFFyAOTVkKkKdqQw4g5dfmWmjf6rR+Kdm9Cj1W0/RaXlVgUzH5bqC44Qhzzlwq3Vg7KkDKlEs3e1W08Ed5t2Rh3vA7gbChOr1WrorlvkA9537SzdetVPGyIUkjL6IIi814QgLqJhddryOZ9Eq3pPRjnizVLeM7rooZqtqpvUQmR3sOoVU9Os17tofE3CjJZIG4uyFeAm4ruGlg2dkEDCL9bKP9mXofWWleqe8Y/cxCEUNrJ7muxI6lnuB0qJhP0IefZ/IEvn+wTwD8ngsM4ScAxvTV8fdohWmckdJ6JooZ6AvKQuxbXIjwnyp/BTNFskuVqTtipjEtkrEjdL0RDrp/yMGw0o2JeQ4rg+KQyYRRgD/TIAns908smjR/lrup1zAIWVfqMVW4MVrTLQnBeKUsd+uy0K1TZq0Krct+razA6bXxxpRXTyOAGnVxEAT7JoA/d4eojVpqNnXSyKS74o6esIQ3cltjkPRhVY6Tf4kuPNrC9tqwHYrJOvFxDKdiLSklku+40ROCybVsMmg44huW+J60S0iaitxDJr8nTODxRfJQqppVK5j0ObuWVKomsVkwsEfjJDT7E8J11FSjc8yRR1bweoSWdi0FfwqLI3crbJWevKqNLbsraF8oyJrE2lsISgxppwwBhZdupDRxyii8WqKZIaY3X0WsTs5bbgbztHasJetGiSNBcFmlFwUJl694byHDc0PXm7w12ijeWJzwftUialBQdfuV8gM2aiycGMrPB+QkhAG6GgYiXNaxEjRMWML1nqslTMPSFaW4iTd4S+q0XEcxgXvSbOSxxZQVTtCqBrZovMU+l0qnsGFgUxRVTC1ZubWlKIiPI955hSjWxSZmQvjEe3YhwFrhdbO6BIMvxYbOJZOUT2rpmroaO9WSBXS7SbYVG7JXVMnnDU5kRW64FrBVo17KqTs4eQozBFbzhtAYybPw9YESD8cWduafNi1hdHYv9DXHodmhr3PHaDYftVTr56lBDkYGbpRg1xXH3Zv0Lcj019AuA+es9J3/WNMnZE3r99nRl3zxQa640M21LPUMpKu90UDpM5dqj0PYwlC8przkwafAYSQUMC/3CtoBn37JfT6E5+rB9nUfqPBQEyWNCK1hQ9bwoeNXVsplRXumbLMQEexupEBKK6yWrHRKtaqLxqfZntpTVzfTbmFw8PxHgY6mQPsryoAEjnxBq30Y7rRD214WezCeL/atH/eW+1r317mpb/u6N8MB/nw6TG9I6hVMWSfe4JzLbXiSXGeuF3XIFKsXo/Msyhju7bUkZQXi1yqwNz0UclID90POck941/MB1s0KUz8Z359SfOvVNxfJ0CatmSwnEp2X5vQ1ZIDV0o3huOh8YwtIwHenphvgAVgVkDYBxVoeEieCKoWqEquMLzd7qJbekHuL705cr2Q7TMsMDuOqsAoKp5bw8cI6eVVkPEl6wZWFJldcLb1RFBDfnZIKKm82OaG1FM8rsy6VVU+l9HSq9ooOER3NbHk6fbulLIJW86WWan/JnLwyYavSEmlSELWED1IcT6dpujyNaLsA7blwo5NUFVoMEhqsOvTw4c60y8jadaRE+jB6qqohZZl4FY4tOqKW5jHaU66Hr0FPaGGV5VRRGG1SOtjSSKV1mkqjPJH1j1X97QvlUFVtaOY4ZPYSzJ0FMOBauQpZuA/gGTO1QOVdF8JKddscyEsXF1AMOBT+/kKewOe/3j/WC8inXBQSeINoBS28NgEJXk/6KqOC8OCW/szCg8jwiyUU0k2D0zPh/Hr/mLJ7AFdS1s/Mzx0cEHLgoedoQ0mMY3dDXezPlajmp6Ua827j9E3fwNbWU1rPIacnlO6rf7kdRFx8d5rSym5kneVWS7Ioz8PkRsOvTZPS0KIuCjuvlmxlR6af7COpF1Cb9ZKyK1SrjA5YHQGG+uanxTEkpWU22IWCiPT/ACmvV8W1RA+SDvRlmsvGIQfL5dAgGTC9sDHKtbFpjEoR0/WaxBDUIluY1FKV0Huuh3+zeP4V8B3gf7O4hXH06gY+9Ur9E/IyI0jRSnNXtDNANfnzIWIIospqicYEqwIlslSETK7xaD67o4N8QbJ8TsNnE6scUK4SiDITTO8qnaMn839cuPSQ+AA+WCJehBGW5C5px7LSlJD73Kqv9ljUT2+gGWIccl0FKe1i93oCbaZqydZpy8POjJjzOYx8MlLLFokkBn/BqSCt8urFL0zDyfA6S589Dpy9JCRb6gqoiXNqprNU/llRp5i4PqYB8Tpxarhc+k+VirY9w2V+8pmb79PlnJU+/Z8omSOjZOypfo28qGjCU1mx6sEpc5hJXEo3r0gMpplgum04C+CtES3lovJg89UOjhoea3qJibKDhXSgAKbf30LqtAo3lmH+oB9UhBywfzjjMg6bZKn1OvYYTrOI+dTNlbU2QtCUHJ4EAS4EzwkqfPIe3Wn7clb9gFVNNAhFkzC6Ir3vZ9zosowm93zDuDiulqWty2vtvLbMZ3keM9gSLuUVvBkOzdhASLJT3AisDxbq+WRwIEC0FwruExKNIRJDuB8aMWTTtBwYRbcXli8sWNLhZ0iR7YUkCZ9CtgsHh5JhyOVWQKwUlGpFLmRgQtK6TMIUMSVbUI4x3Fs0IuesDDXG1DtGN5W+f0grs2GLgH7KFf9UJ4J9jqRzi4r9fOASuobuhaTbBQnc8M17ykBLJrdmtBNPDdIw/pgaXw6ekwO4pjnlgiO2smOSpsywoDKRKOJ5PBvme7wRCd+HLvHGgsLCPBp5SYFnXRoiGNc5K4PiDPpcHrNv4clZUTEeMXhGlmvFOXI/q5qvlIU9NlVaYdq08uwn0F/YTgpQcSQDzmhIhayU4qA7xjmFnDuZRKzKzJhxJmlvzupjEoul35uE7Q1OVzig/v4ghqPtj/2YvfI86IKix2wBBgm+vkMjKzQa1aG6/Mtb543z1rmE8+PtmzeX799c//Tn91c/fbx+/+f/88O79+8v+4H+FXCg6R3CCr12qenaIThE07vtjzDY9G77Lv1QSqaBNyj/YeXOsi9T/t6+PQQ+DNUi75gETJATEPi9BDKwxDV3zyJyzUB3mYPlbkXVsgP/9O7i7eXlxeXlny5+eOeEO0f/xnFZ4PTDfPdwj2ListizlGoiGiia3jloKpv1siW80BIPbSlUT9iSmJdNAART6DP2lETdxECE783hiXjOQnKIPA5mH+KcyGpFXO2XiS58siW+qVN+Th5+vX5tLCItC5g0Fc8P5TICVo2K9PGS+IU+BFAonSCg9r8v5WX41YoxZ4ljZ818HK4dFq+dVyDfV/kflJnJanIDDVPf3RReBvJQL4voImc4RFDEzPOIh1wWpQXN4aWmTFh+YSNE9P7776Nk6VOXJ6sV/SxxpB9umkQQy1z28O4xgy2L8yOQ01O4NGyqbPZ0TuQK1MsN6biwTG5WxPpS4gzXjCFzRWraykLRZfOyc74jMF312Qru6CuGKSl9LkMxAB5Db98gd4NVzCOgvZ297gp1qC4PjaOQzwOMYP5cLTnzE1Gsx0Y+EzdRDwDpF6yQIAnMGWzhPGYrBwinXp7cUuqCZ7wLaTsqA0St8LnFvXio91BDMEa//icomgnC63VM1qZpRxQzwVzmS++yvCjAVfbYawH2fUc+nlpFe8wlyrCk8sIn8CPs+ykb3LHiEW7ZGLNfojpAyV2mJsqQBcmBXVUc2i6gPCibgBqF1AFdUVg5rE4tDBptf3TGxyJF1RXQu+cB9K6bhNKL4RwMVD4qKg9qnYeuUBceJAdURiXOcORg16NmcbTB4ahg1RDEK24KXU4tJhBEgLAQEHKgX7D2Zw05m/D7gOWNkDJPLdULanhqe6jrwPNNMQMq4zeN5a++KZfBSydh/eqx6aLeM5KbCHj2gkS8qkZvU1B50ITD0U75puIQ6yT6jgw0MgGH6cfZw9VPv05nv3y8rucnJ+p9OOf2Iu/PBnn2/z/NZx8/PXTFGxO33Nro2fHef/zw9y54VzSc7zAVZT/FswL+efpp/tvV9OGyD+KyY+JFEL/tghgiGKWQXxLxw/Tmo4TcBbHrM05eEu2HX29nHzsjfXHhSridpQutfebYfXpJxL9ezR7mVx/+1gmvtFZeFO109vDxUxessB6qDxHPChYWw/TTX21oDcrEi866Wg4tkB6vv55bTA6rUwvjOW8xj9cndot5vP6KbjFLloTeBCWhy8KQuOCHyPCfvun/eN3B9Degk6hUENm+YRtQLBSJXKdj0Gw6NC5c65ZqeiAdNWb13nQOBzNFPp2An3WUtJGy+Wrp1zSMEjE3Hwqo71Nd+u6s12yAD/92ZnilYYGURficxPwY2T+CB48TziEGcYJ8tqb6/yzRhe/AbU48/SsofKJDNBMRRBO0E0F+KCS/shRBZJ2hHv41YMU6OQd5LR/2Ueq1VL0D0UKzvZBvLjhNudU/nqCFZHlRfUiHX7BELCSvCyWfufqwpKWezDjaxVQIEuZ6F0Yx2VJmKTa9IsLdOFZBgJN1OEGU3bfqJao4x0Jnh8BnAIN6r4AoBIjC8uww1XMT9oeD+qApGrjpxKyytzO0EGJ/uYB330Uk+PdvajoDD/eac5c55eWUV33eNe9fwwlGPyWDrzsvGBknol+VYIdOEAkisZdLUh1S+oPcGeKtv++DfRNUOyDZgPGsY8vHFjgzoCVTBUvLCVpW+3vZew0ElWkFubnNTpc6Iyc3A3EbgDF7jAb+e7ErYq4XJAgGLb6H9fW9GmfhoCvfz8f96PStokJR+mZZaqN1iBKG1B+HhgNtnau0WAi8MqcpdllioExbeaI+s7gZC5CqaXSDYoJsOcE6Q5LpToNJKTMxgVWVGR9JQTWMPpxA6oZnibCPL1NFRwptBAg6F3WCAhrKg9pTqaxNaGy5rsejkVSLmNRDQEx+TyiksPgMyxRrrD9VzXjNY+UuDp0nELA3OFY9eZAbltbiR2osBMVGwEYMPZMx1oBPhT+Oj0+NYwAZl4Fu6UF1y7IanIJgf1xBalgKqhqpCcuoQitgKYrNjkkKkYtq95/jEance2xOr9x0EciQJl4ngIwF8yc6AjxtmxGOgHy2EW5vb9RPYgfdq83LVd9E9KNz+QOYkyHZQYb3BZho7yFPjazo01maD/VK/+TVmRXOg/ptGoIUxezzHn0vFQRaYh+HriwXLLMlz4qHsJFKNqbdsKiRQt+hbTaAgbAh2BebY8wb02QzDQ2Uh73G+B3XI6CruykioSdrgOe+X8aVx6buyA4nbuHXhy+bx8iYh0aENISeea68iNuu8wZKTHjEwsrDgF1YHZDca3qlWWqSSh7O+C45wzCvxYC363l9Z6VjkVxtSQwHrMGRNrLIN1WqxQamdMLnLvNILbbargldOiYcJktIAZW+8YQjiU3rnmTH1zSneeS/a/SO/B00kEhkcl/Z5s+vHCMNQ96+Yq2slBdgUbBnZVmWd0DdTcQ6lvpPgnS0cPSugBubBJL7ZBlZHp00UR2w1QivOIdr57NTbpAhijY49HziNYxPPrtEUhsWQQ3Z4tjgkCIqDnck/uUIyDJCEQh4EcfBgb320Qd0BumoHupZh9ux+GnIgNDfJL2W0aKhh9NGVMuw0GMuEtUXxkMHn5l8Q0V/gi4RhURaaEqcjoVCsssWH4NWxzvKCXrThNR8fiCgeU2ugXASb42xSbketwmSR3yB5y8EDOGVgAbEenHBZQdsRdOzXJciRTdX/5jff/x/jx9nD7MmXgZXcB9TgrpJfNPoGxzjJxrTuc0IOhTBdazSt1M5wszSgJS9LiUsnK5D7A8mhgBeEzykqVqsPOvw898TkpCBMGRLyqCAABPYiIJBAZqm48+gKtsIRzrDtZLSpsG5uyE4mqAI8kAmIKsJWiZ8P0HU88nrJmAxHxDVPeHUgwJKMyJkeT7HXJF1BxA/e25Ni7c5oOGwv8N7jr6QmCER79Hi4kKX9YsJPDIvEJOjwiTkTTcrT1v+ZaCZN27qCj8cag9iDutBR8COx45ONZhbWvAeypepa5JmMZRJlyEQHuFdOKh6gese3oXtO1p8HmjEhxiHPNUnXxo5hptcLAYa2Hpxs47uQpT0YKaMPtym1/VjqY/MBzQOVQk1PfRd09jmYBnJjS6KRrqyAjqgAaVK3cHhKLKqk7XEwjtgiVkiiDcQlntJLJVIh+HZaqWMooEQ3Bp6HUCMcG3LJkM//csxGjAMf2OrQIAhuHG4bnm0ITHJuT22M/mTGseH/m0/10c2iN1ZYeWg7GIwxOD9kItixq/d/2Elq39lIVLnLikDGa8IzbVBVaVrBlzxYQN2oIizDvyqEDZjujjCLhV7p77S3aGLU7kT0uKYJjO6PDXN8XcVnKuYkEFh/hwTMjhKsEsHRfmoUopGQBkN9sgmMUI3XTiyO6LUH09/e1YGWwr76a0NSt9/eUXwC4Qc1eoAaMgvpyXYDHXtkNMCrYJpiG42X2qHVRpguHGzFtktA8stPdy4ckO3DKuLOI+zTXUosIn3rVn51d+VwI2lkQeCN44iPhZcSARcFubwLz7cpv2kyKIiWTPoVl3sA+xuaHiU7VJL6RC9VVJ9o+qtyi+OGst4Sm6UHE5OXeotIEdew81jNCXCVuivMMDR21Vi3bBxof7CBkCq9N7wYi1ov8HkKtXg8GDzyvB4rG7CBQvmlafelrf6wjv9QRv5gxxYaykrtGdR2PpCvKOhx3Y8dyH+Tf2k5kK8JAL/81/yrzfyTgyHN9LfOUOIb1gs5i4LV3QNYZM+73przpDYjwkrd2Vdf1EZqiha8AtR96jzaKFplBKL9EtBThpmsEJ4rx1elZE8aOr1WAQ6g+E92vz2w6f/+7N7eZXnq4U3+O8KJSH9PSFQ697U0NacmIJsGDq0bMx9Rp/M33H0VygthEPZnKVCV9OQs51JxMpwz5MzZfk34vKt24/dhw3pA82jPPLxfn4wRL02PkLkP/rAfJ+4gsX9MWsgEpaZCc1HCwsy/2E+rF8FEEm60B1oH1UQySpykaXC6OIqESwAx60tI+onxsRighbXlEOZLA/+foPDBPuLiQzZXsykMyeXnlPH8pDVi4EZ1axIF5XuzO0HFgoaJjRc29i9wwmXv1J/Vfzeqyck+KvMNkn/zqJI/V0JQtUCb5ME+UyFLUTtCGlAplc6vJFBMd1LOpll9JlK+tIfQurtsXzGIRUhwDW38PrHgTwUmFXlOXcQrrGEGsAErUlIYuqmO0vTrtDMYUhCP8sckp+Gx8Ut9WBRmh9d8Ii4dEXdHPoW4fZ45EkVwuWbv/Qs+SnlbVaFXd65spr61RTdTa9b0OvI15NMXtVcfsd1fC7Sk0O8ajartmy+MPZESETinG3zX4z9Tf6sxrpJf29CZJGrNLSJbiFoxZL4wicCYlsCFlLBYnjg1RUOudNuA63rLKA8YrtpUiOpRfrNklFiuFD7TDGR8ph+PY/dblRp8z4U8VGWUxCKLgjLYlbfK5DSkK2WVed8bbj69TzHG7grrh9D27GOjCMIn6cBFmQOrvV55bW8Ydu1YLjKaMtHeDAOMlwwmh2TjwUJ3b2Dt+uhkOhXeU0ZTN4d9I8hIScB2AEgJF6fjp5HR6INCUiMfT5ojET2WpgOgL6EzKvLyVsx2UN8jNdKQ7nQY0eH9TR00QjwZ9k1dG5GYvGgErrRLdmzPgUwWvotFvO0r7q5LWSrzYTsWJGbBRfgzwNjNQuu08pKYQyWanlDw/4wwiSYYx+63urqHgOGOmarLEcbzKpsptLuBlkgmURjBwvp4KOuuk/1q41F2fbouNKg6ITAIeRQjhcNmxskDb5Q1m5IVAjbMq1km51zrp/wQqPMPO4Iug0L7kAXe1qOrRkCcyY/7XMyQyIzZDMwS6XAMUHBcDWAiBT8HBqBDD+1mrpsMwKdaZGLY3CYqnzuDG96sKXK3I4WrFkSD91T5575xCS4VjHVIJENW+Yp3sFFByIjXiaQzNCTdzyM4LAtCATuMD7BXt2mgPTD6mVjEKNNk5aehWVCfQ9xIa17A9qOaIeFuxlJ/UnahOfie6GkhO6EA1mxflclKM2akVBK2lUjvhbf/wwAXTr+yg=="
 }
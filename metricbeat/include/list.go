@@ -46,6 +46,7 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/docker/container"
 	_ "github.com/elastic/beats/metricbeat/module/docker/cpu"
 	_ "github.com/elastic/beats/metricbeat/module/docker/diskio"
+	_ "github.com/elastic/beats/metricbeat/module/docker/event"
 	_ "github.com/elastic/beats/metricbeat/module/docker/healthcheck"
 	_ "github.com/elastic/beats/metricbeat/module/docker/image"
 	_ "github.com/elastic/beats/metricbeat/module/docker/info"
@@ -133,8 +134,11 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/rabbitmq/queue"
 	_ "github.com/elastic/beats/metricbeat/module/redis"
 	_ "github.com/elastic/beats/metricbeat/module/redis/info"
+	_ "github.com/elastic/beats/metricbeat/module/redis/key"
 	_ "github.com/elastic/beats/metricbeat/module/redis/keyspace"
+	_ "github.com/elastic/beats/metricbeat/module/redis/slowlog"
 	_ "github.com/elastic/beats/metricbeat/module/system"
+	_ "github.com/elastic/beats/metricbeat/module/system/conntrack"
 	_ "github.com/elastic/beats/metricbeat/module/system/core"
 	_ "github.com/elastic/beats/metricbeat/module/system/cpu"
 	_ "github.com/elastic/beats/metricbeat/module/system/diskio"
@@ -143,11 +147,15 @@ import (
 	_ "github.com/elastic/beats/metricbeat/module/system/load"
 	_ "github.com/elastic/beats/metricbeat/module/system/memory"
 	_ "github.com/elastic/beats/metricbeat/module/system/network"
+	_ "github.com/elastic/beats/metricbeat/module/system/pressure"
 	_ "github.com/elastic/beats/metricbeat/module/system/process"
 	_ "github.com/elastic/beats/metricbeat/module/system/process_summary"
 	_ "github.com/elastic/beats/metricbeat/module/system/raid"
 	_ "github.com/elastic/beats/metricbeat/module/system/socket"
+	_ "github.com/elastic/beats/metricbeat/module/system/socket_summary"
 	_ "github.com/elastic/beats/metricbeat/module/system/uptime"
+	_ "github.com/elastic/beats/metricbeat/module/system/users"
+	_ "github.com/elastic/beats/metricbeat/module/system/vmstat"
 	_ "github.com/elastic/beats/metricbeat/module/traefik"
 	_ "github.com/elastic/beats/metricbeat/module/traefik/health"
 	_ "github.com/elastic/beats/metricbeat/module/uwsgi"
@@ -0,0 +1,86 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package event
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/module/docker"
+)
+
+// attributes are the event attributes that are not container labels.
+var attributes = map[string]bool{
+	"name":     true,
+	"image":    true,
+	"exitCode": true,
+	"signal":   true,
+	"execID":   true,
+}
+
+func eventMapping(event *events.Message, dedot bool) mb.Event {
+	labels := map[string]string{}
+	for k, v := range event.Actor.Attributes {
+		if !attributes[k] {
+			labels[k] = v
+		}
+	}
+
+	container := &docker.Container{
+		ID:     event.Actor.ID,
+		Name:   event.Actor.Attributes["name"],
+		Labels: docker.DeDotLabels(labels, dedot),
+	}
+
+	// Some actions have an argument, like "health_status: healthy" or
+	// "exec_start: sh -c ls".
+	action, argument := event.Action, ""
+	if i := strings.Index(action, ": "); i >= 0 {
+		action, argument = action[:i], action[i+2:]
+	}
+
+	fields := common.MapStr{
+		"type":   event.Type,
+		"action": action,
+	}
+	if argument != "" {
+		fields["argument"] = argument
+	}
+	if image := event.Actor.Attributes["image"]; image != "" {
+		fields["image"] = image
+	}
+	if code, err := strconv.Atoi(event.Actor.Attributes["exitCode"]); err == nil {
+		fields["exit_code"] = code
+	}
+	if signal := event.Actor.Attributes["signal"]; signal != "" {
+		fields["signal"] = signal
+	}
+
+	return mb.Event{
+		Timestamp: time.Unix(0, event.TimeNano).UTC(),
+		ModuleFields: common.MapStr{
+			"container": container.ToMapStr(),
+		},
+		MetricSetFields: fields,
+	}
+}
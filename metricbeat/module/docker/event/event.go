@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/module/docker"
+)
+
+const (
+	initBackoff = 1 * time.Second
+	maxBackoff  = 60 * time.Second
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("docker", "event", New,
+		mb.WithHostParser(docker.HostParser),
+	)
+}
+
+// eventsClient is the part of the Docker client used by the metricset.
+type eventsClient interface {
+	Events(ctx context.Context, options types.EventsOptions) (<-chan events.Message, <-chan error)
+}
+
+// MetricSet subscribes to the Docker events stream and reports one event
+// for each container action, like start, die, oom or health_status.
+type MetricSet struct {
+	mb.BaseMetricSet
+	client   eventsClient
+	dedot    bool
+	lastTime int64 // Time of the last event received in nanoseconds.
+}
+
+// New creates a new instance of the docker event MetricSet.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The docker event metricset is beta")
+
+	config := docker.DefaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	client, err := docker.NewDockerClient(base.HostData().URI, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		client:        client,
+		dedot:         config.DeDot,
+	}, nil
+}
+
+// Run subscribes to the Docker events stream and reports events until the
+// reporter is done. When the stream fails it is reopened with exponential
+// backoff, starting after the last event received so no events are lost.
+func (m *MetricSet) Run(r mb.PushReporterV2) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	backoff := common.NewBackoff(r.Done(), initBackoff, maxBackoff)
+	for {
+		err := m.watch(ctx, r, backoff)
+		if err == nil {
+			return
+		}
+
+		r.Error(errors.Wrap(err, "failed watching docker events"))
+		if !backoff.Wait() {
+			return
+		}
+	}
+}
+
+// watch reports the events of a single subscription to the events stream.
+// It returns nil when the reporter is done, or the error that closed the
+// stream.
+func (m *MetricSet) watch(ctx context.Context, r mb.PushReporterV2, backoff *common.Backoff) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	filter := filters.NewArgs()
+	filter.Add("type", "container")
+
+	options := types.EventsOptions{
+		Filters: filter,
+	}
+	if m.lastTime > 0 {
+		options.Since = fmt.Sprintf("%d.%09d", m.lastTime/int64(time.Second), m.lastTime%int64(time.Second))
+	}
+
+	events, errs := m.client.Events(ctx, options)
+	for {
+		select {
+		case <-r.Done():
+			return nil
+		case err := <-errs:
+			return err
+		case event := <-events:
+			backoff.Reset()
+
+			// Events at the time of the last event received are sent again
+			// when resubscribing.
+			if event.TimeNano <= m.lastTime {
+				continue
+			}
+			m.lastTime = event.TimeNano
+
+			if !r.Event(eventMapping(&event, m.dedot)) {
+				return nil
+			}
+		}
+	}
+}
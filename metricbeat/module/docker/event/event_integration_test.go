@@ -0,0 +1,50 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build integration
+
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/metricbeat/mb"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	ms := mbtest.NewPushMetricSetV2(t, getConfig())
+	events := mbtest.RunPushMetricSetV2(30*time.Second, 1, ms)
+	if len(events) == 0 {
+		t.Fatal("no events received")
+	}
+	if events[0].Error != nil {
+		t.Fatal("received error", events[0].Error)
+	}
+
+	e := mbtest.StandardizeEvent(ms, events[0], mb.AddMetricSetInfo)
+	mbtest.WriteEventToDataJSON(t, e, "")
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "docker",
+		"metricsets": []string{"event"},
+		"hosts":      []string{"unix:///var/run/docker.sock"},
+	}
+}
@@ -0,0 +1,160 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+// fakeClient returns one subscription per call to Events. Each subscription
+// sends its messages and then fails with an error, or blocks if it is the
+// last one.
+type fakeClient struct {
+	sync.Mutex
+	subscriptions [][]events.Message
+	options       []types.EventsOptions
+}
+
+func (c *fakeClient) Events(ctx context.Context, options types.EventsOptions) (<-chan events.Message, <-chan error) {
+	c.Lock()
+	defer c.Unlock()
+
+	messages := make(chan events.Message)
+	errs := make(chan error, 1)
+
+	n := len(c.options)
+	c.options = append(c.options, options)
+	if n >= len(c.subscriptions) {
+		return messages, errs
+	}
+
+	go func(msgs []events.Message, last bool) {
+		for _, msg := range msgs {
+			select {
+			case messages <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if !last {
+			errs <- errors.New("connection lost")
+		}
+	}(c.subscriptions[n], n == len(c.subscriptions)-1)
+
+	return messages, errs
+}
+
+func TestRunReconnects(t *testing.T) {
+	start := events.Message{
+		Type:   "container",
+		Action: "start",
+		Actor: events.Actor{
+			ID: "b1d3e8f4a5c2",
+			Attributes: map[string]string{
+				"name":                       "web",
+				"image":                      "nginx:1.15",
+				"com.docker.compose.service": "web",
+			},
+		},
+		TimeNano: 1529313000000000001,
+	}
+	health := events.Message{
+		Type:   "container",
+		Action: "health_status: unhealthy",
+		Actor: events.Actor{
+			ID:         "b1d3e8f4a5c2",
+			Attributes: map[string]string{"name": "web", "image": "nginx:1.15"},
+		},
+		TimeNano: 1529313005000000000,
+	}
+	die := events.Message{
+		Type:   "container",
+		Action: "die",
+		Actor: events.Actor{
+			ID:         "b1d3e8f4a5c2",
+			Attributes: map[string]string{"name": "web", "image": "nginx:1.15", "exitCode": "137"},
+		},
+		TimeNano: 1529313010000000000,
+	}
+
+	client := &fakeClient{
+		subscriptions: [][]events.Message{
+			{start, health},
+			// The last event is sent again after reconnecting.
+			{health, die},
+		},
+	}
+
+	ms := mbtest.NewPushMetricSetV2(t, getConfig())
+	ms.(*MetricSet).client = client
+
+	reported := mbtest.RunPushMetricSetV2(10*time.Second, 4, ms)
+	if !assert.Len(t, reported, 4) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, common.MapStr{
+		"type":   "container",
+		"action": "start",
+		"image":  "nginx:1.15",
+	}, reported[0].MetricSetFields)
+	assert.Equal(t, common.MapStr{
+		"container": common.MapStr{
+			"id":   "b1d3e8f4a5c2",
+			"name": "web",
+			"labels": common.MapStr{
+				"com_docker_compose_service": "web",
+			},
+		},
+	}, reported[0].ModuleFields)
+	assert.Equal(t, time.Unix(0, start.TimeNano).UTC(), reported[0].Timestamp)
+
+	assert.Equal(t, "health_status", reported[1].MetricSetFields["action"])
+	assert.Equal(t, "unhealthy", reported[1].MetricSetFields["argument"])
+
+	assert.Error(t, reported[2].Error)
+
+	assert.Equal(t, "die", reported[3].MetricSetFields["action"])
+	assert.Equal(t, 137, reported[3].MetricSetFields["exit_code"])
+
+	client.Lock()
+	defer client.Unlock()
+	assert.Equal(t, "", client.options[0].Since)
+	assert.Equal(t, "1529313005.000000000", client.options[1].Since)
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "docker",
+		"metricsets": []string{"event"},
+		"hosts":      []string{"unix:///var/run/docker.sock"},
+	}
+}
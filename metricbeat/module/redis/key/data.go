@@ -0,0 +1,127 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package key
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	rd "github.com/garyburd/redigo/redis"
+)
+
+const (
+	// defaultLimit is the default maximum number of keys reported per
+	// pattern.
+	defaultLimit = 10
+
+	// scanCount is the number of keys requested per SCAN iteration.
+	scanCount = 100
+)
+
+// lengthCommands are the commands used to get the length of each type of
+// key.
+var lengthCommands = map[string]string{
+	"string": "STRLEN",
+	"list":   "LLEN",
+	"set":    "SCARD",
+	"zset":   "ZCARD",
+	"hash":   "HLEN",
+	"stream": "XLEN",
+}
+
+type keyInfo struct {
+	name   string
+	typ    string
+	length int64 // -1 if the type has no length.
+	ttl    int64 // -1 if the key has no expiration.
+}
+
+// fetchKeys returns the keys matching pattern, up to limit keys. Patterns
+// without wildcards are looked up directly, others are matched with SCAN so
+// Redis is not blocked on big keyspaces.
+func fetchKeys(conn rd.Conn, pattern string, limit uint) ([]string, error) {
+	if !strings.ContainsAny(pattern, "*?[") {
+		exists, err := rd.Bool(conn.Do("EXISTS", pattern))
+		if err != nil || !exists {
+			return nil, err
+		}
+		return []string{pattern}, nil
+	}
+
+	var keys []string
+	cursor := "0"
+	for {
+		reply, err := rd.Values(conn.Do("SCAN", cursor, "MATCH", pattern, "COUNT", scanCount))
+		if err != nil {
+			return nil, err
+		}
+
+		var page []string
+		if _, err := rd.Scan(reply, &cursor, &page); err != nil {
+			return nil, errors.Wrap(err, "unexpected SCAN reply")
+		}
+
+		for _, key := range page {
+			if uint(len(keys)) >= limit {
+				return keys, nil
+			}
+			keys = append(keys, key)
+		}
+
+		if cursor == "0" {
+			return keys, nil
+		}
+	}
+}
+
+// fetchKeyInfo returns the type, length and TTL of a key. It returns nil if
+// the key doesn't exist.
+func fetchKeyInfo(conn rd.Conn, key string) (*keyInfo, error) {
+	typ, err := rd.String(conn.Do("TYPE", key))
+	if err != nil {
+		return nil, err
+	}
+	if typ == "none" {
+		return nil, nil
+	}
+
+	ttl, err := rd.Int64(conn.Do("TTL", key))
+	if err != nil {
+		return nil, err
+	}
+	if ttl == -2 {
+		return nil, nil
+	}
+
+	info := &keyInfo{
+		name:   key,
+		typ:    typ,
+		length: -1,
+		ttl:    ttl,
+	}
+
+	if cmd, found := lengthCommands[typ]; found {
+		info.length, err = rd.Int64(conn.Do(cmd, key))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package key
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/redis"
+
+	rd "github.com/garyburd/redigo/redis"
+)
+
+var (
+	debugf = logp.MakeDebug("redis-key")
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("redis", "key", New,
+		mb.WithHostParser(parse.PassThruHostParser),
+	)
+}
+
+// KeyPattern configures the keys to monitor in a keyspace.
+type KeyPattern struct {
+	Keyspace uint   `config:"keyspace"`
+	Pattern  string `config:"pattern" validate:"required"`
+	Limit    uint   `config:"limit"`
+}
+
+// MetricSet for fetching information about Redis keys.
+type MetricSet struct {
+	mb.BaseMetricSet
+	pool     *rd.Pool
+	patterns []KeyPattern
+}
+
+// New creates new instance of MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The redis key metricset is beta")
+
+	// Unpack additional configuration options.
+	config := struct {
+		IdleTimeout time.Duration `config:"idle_timeout"`
+		Network     string        `config:"network"`
+		MaxConn     int           `config:"maxconn" validate:"min=1"`
+		Password    string        `config:"password"`
+		Patterns    []KeyPattern  `config:"key.patterns" validate:"nonzero,required"`
+	}{
+		Network:  "tcp",
+		MaxConn:  10,
+		Password: "",
+	}
+	err := base.Module().UnpackConfig(&config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read configuration for 'key' metricset")
+	}
+
+	for i := range config.Patterns {
+		if config.Patterns[i].Limit == 0 {
+			config.Patterns[i].Limit = defaultLimit
+		}
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		pool: redis.CreatePool(base.Host(), config.Password, config.Network,
+			config.MaxConn, config.IdleTimeout, base.Module().Config().Timeout),
+		patterns: config.Patterns,
+	}, nil
+}
+
+// Fetch fetches the type, length and TTL of the keys matching the configured
+// patterns, one event per key.
+func (m *MetricSet) Fetch() ([]common.MapStr, error) {
+	conn := m.pool.Get()
+	defer conn.Close()
+
+	var events []common.MapStr
+	for _, p := range m.patterns {
+		if _, err := conn.Do("SELECT", p.Keyspace); err != nil {
+			return events, errors.Wrapf(err, "failed to select keyspace %d", p.Keyspace)
+		}
+
+		keys, err := fetchKeys(conn, p.Pattern, p.Limit)
+		if err != nil {
+			return events, errors.Wrapf(err, "failed to list keys for pattern '%s'", p.Pattern)
+		}
+		debugf("Keys in keyspace %d matching '%s': %v", p.Keyspace, p.Pattern, keys)
+
+		for _, key := range keys {
+			info, err := fetchKeyInfo(conn, key)
+			if err != nil {
+				return events, errors.Wrapf(err, "failed to fetch information of key '%s'", key)
+			}
+			if info == nil {
+				// Key was removed in the meantime.
+				continue
+			}
+			events = append(events, eventMapping(p.Keyspace, info))
+		}
+	}
+
+	return events, nil
+}
+
+func eventMapping(keyspace uint, info *keyInfo) common.MapStr {
+	event := common.MapStr{
+		mb.ModuleDataKey: common.MapStr{
+			"keyspace": common.MapStr{
+				"id": fmt.Sprintf("db%d", keyspace),
+			},
+		},
+		"name": info.name,
+		"id":   fmt.Sprintf("%d:%s", keyspace, info.name),
+		"type": info.typ,
+		"expire": common.MapStr{
+			"ttl": info.ttl,
+		},
+	}
+	if info.length >= 0 {
+		event["length"] = info.length
+	}
+	return event
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build integration
+
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/tests/compose"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+	"github.com/elastic/beats/metricbeat/module/redis"
+
+	rd "github.com/garyburd/redigo/redis"
+)
+
+var host = redis.GetRedisEnvHost() + ":" + redis.GetRedisEnvPort()
+
+func TestFetch(t *testing.T) {
+	compose.EnsureUp(t, "redis")
+
+	addEntry(t)
+
+	f := mbtest.NewEventsFetcher(t, getConfig())
+	events, err := f.Fetch()
+	if err != nil {
+		t.Fatal("fetch", err)
+	}
+
+	t.Logf("%s/%s event: %+v", f.Module().Name(), f.Name(), events)
+
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "test-list", events[0]["name"])
+		assert.Equal(t, "list", events[0]["type"])
+		assert.Equal(t, int64(1), events[0]["length"])
+	}
+}
+
+func TestData(t *testing.T) {
+	compose.EnsureUp(t, "redis")
+
+	addEntry(t)
+
+	f := mbtest.NewEventsFetcher(t, getConfig())
+
+	err := mbtest.WriteEvents(f, t)
+	if err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+// addEntry adds a list to redis
+func addEntry(t *testing.T) {
+	c, err := rd.Dial("tcp", host)
+	if err != nil {
+		t.Fatal("connect", err)
+	}
+	defer c.Close()
+
+	_, err = c.Do("DEL", "test-list")
+	if err != nil {
+		t.Fatal("DEL", err)
+	}
+	_, err = c.Do("LPUSH", "test-list", "42")
+	if err != nil {
+		t.Fatal("LPUSH", err)
+	}
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "redis",
+		"metricsets": []string{"key"},
+		"hosts":      []string{host},
+		"key.patterns": []map[string]interface{}{
+			{"pattern": "test-*"},
+		},
+	}
+}
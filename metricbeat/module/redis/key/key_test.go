@@ -0,0 +1,125 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package key
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/metricbeat/mb"
+)
+
+// fakeConn replies to commands with canned replies, indexed by the command
+// and its arguments joined by spaces.
+type fakeConn struct {
+	replies map[string]interface{}
+}
+
+func (c *fakeConn) Close() error { return nil }
+func (c *fakeConn) Err() error   { return nil }
+
+func (c *fakeConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	parts := []string{cmd}
+	for _, arg := range args {
+		parts = append(parts, fmt.Sprint(arg))
+	}
+	reply, found := c.replies[strings.Join(parts, " ")]
+	if !found {
+		return nil, fmt.Errorf("unexpected command %v", parts)
+	}
+	return reply, nil
+}
+
+func (c *fakeConn) Send(string, ...interface{}) error { return nil }
+func (c *fakeConn) Flush() error                      { return nil }
+func (c *fakeConn) Receive() (interface{}, error)     { return nil, nil }
+
+func TestFetchKeys(t *testing.T) {
+	conn := &fakeConn{replies: map[string]interface{}{
+		"EXISTS foo":     int64(1),
+		"EXISTS missing": int64(0),
+		"SCAN 0 MATCH user:* COUNT 100": []interface{}{
+			[]byte("17"),
+			[]interface{}{[]byte("user:1"), []byte("user:2")},
+		},
+		"SCAN 17 MATCH user:* COUNT 100": []interface{}{
+			[]byte("0"),
+			[]interface{}{[]byte("user:3")},
+		},
+	}}
+
+	keys, err := fetchKeys(conn, "foo", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo"}, keys)
+
+	keys, err = fetchKeys(conn, "missing", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	keys, err = fetchKeys(conn, "user:*", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"user:1", "user:2", "user:3"}, keys)
+
+	keys, err = fetchKeys(conn, "user:*", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"user:1"}, keys)
+}
+
+func TestFetchKeyInfo(t *testing.T) {
+	conn := &fakeConn{replies: map[string]interface{}{
+		"TYPE queue":   "list",
+		"TTL queue":    int64(-1),
+		"LLEN queue":   int64(42),
+		"TYPE session": "hash",
+		"TTL session":  int64(3600),
+		"HLEN session": int64(5),
+		"TYPE gone":    "none",
+	}}
+
+	info, err := fetchKeyInfo(conn, "queue")
+	assert.NoError(t, err)
+	assert.Equal(t, &keyInfo{name: "queue", typ: "list", length: 42, ttl: -1}, info)
+
+	info, err = fetchKeyInfo(conn, "session")
+	assert.NoError(t, err)
+	assert.Equal(t, &keyInfo{name: "session", typ: "hash", length: 5, ttl: 3600}, info)
+
+	info, err = fetchKeyInfo(conn, "gone")
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+}
+
+func TestEventMapping(t *testing.T) {
+	event := eventMapping(2, &keyInfo{name: "queue", typ: "list", length: 42, ttl: -1})
+	assert.Equal(t, common.MapStr{
+		mb.ModuleDataKey: common.MapStr{
+			"keyspace": common.MapStr{"id": "db2"},
+		},
+		"name":   "queue",
+		"id":     "2:queue",
+		"type":   "list",
+		"length": int64(42),
+		"expire": common.MapStr{"ttl": int64(-1)},
+	}, event)
+}
@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package slowlog
+
+import (
+	"github.com/pkg/errors"
+
+	rd "github.com/garyburd/redigo/redis"
+)
+
+// slowlogEntry is an entry of the slow log. The reply of SLOWLOG GET
+// contains one array per entry with the following format, client address and
+// name are only available since Redis 4.0:
+//
+//	redis> SLOWLOG GET 1
+//	1) 1) (integer) 13
+//	   2) (integer) 1309448128
+//	   3) (integer) 30
+//	   4) 1) "slowlog"
+//	      2) "get"
+//	      3) "100"
+//	   5) "127.0.0.1:58217"
+//	   6) "worker-123"
+type slowlogEntry struct {
+	id         int64
+	timestamp  int64
+	duration   int64
+	cmd        string
+	key        string
+	args       []string
+	clientAddr string
+	clientName string
+}
+
+// parseSlowlog parses the reply of SLOWLOG GET.
+func parseSlowlog(reply []interface{}) ([]slowlogEntry, error) {
+	entries := make([]slowlogEntry, 0, len(reply))
+	for _, item := range reply {
+		values, err := rd.Values(item, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) < 4 {
+			return nil, errors.Errorf("expected at least 4 values per entry, got %d", len(values))
+		}
+
+		var entry slowlogEntry
+		var args []string
+		rest, err := rd.Scan(values, &entry.id, &entry.timestamp, &entry.duration, &args)
+		if err != nil {
+			return nil, err
+		}
+		if len(rest) >= 2 {
+			if _, err := rd.Scan(rest, &entry.clientAddr, &entry.clientName); err != nil {
+				return nil, err
+			}
+		}
+
+		// This splits up the args into cmd, key, args.
+		if len(args) > 0 {
+			entry.cmd = args[0]
+		}
+		if len(args) > 1 {
+			entry.key = args[1]
+		}
+		if len(args) > 2 {
+			entry.args = args[2:]
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,160 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package slowlog
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/redis"
+
+	rd "github.com/garyburd/redigo/redis"
+)
+
+var (
+	debugf = logp.MakeDebug("redis-slowlog")
+)
+
+func init() {
+	mb.Registry.MustAddMetricSet("redis", "slowlog", New,
+		mb.WithHostParser(parse.PassThruHostParser),
+	)
+}
+
+// MetricSet for fetching the Redis slow log.
+type MetricSet struct {
+	mb.BaseMetricSet
+	pool   *rd.Pool
+	count  int
+	lastID int64 // ID of the last entry reported, -1 if none.
+}
+
+// New creates new instance of MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The redis slowlog metricset is beta")
+
+	// Unpack additional configuration options.
+	config := struct {
+		IdleTimeout time.Duration `config:"idle_timeout"`
+		Network     string        `config:"network"`
+		MaxConn     int           `config:"maxconn" validate:"min=1"`
+		Password    string        `config:"password"`
+		Count       int           `config:"slowlog.count" validate:"min=1"`
+	}{
+		Network:  "tcp",
+		MaxConn:  10,
+		Password: "",
+		Count:    128,
+	}
+	err := base.Module().UnpackConfig(&config)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read configuration for 'slowlog' metricset")
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		pool: redis.CreatePool(base.Host(), config.Password, config.Network,
+			config.MaxConn, config.IdleTimeout, base.Module().Config().Timeout),
+		count:  config.Count,
+		lastID: -1,
+	}, nil
+}
+
+// Fetch reports the slow log entries added since the previous fetch. The
+// slow log is not reset, so it can still be read by other clients.
+func (m *MetricSet) Fetch(r mb.ReporterV2) {
+	conn := m.pool.Get()
+	defer conn.Close()
+
+	reply, err := rd.Values(conn.Do("SLOWLOG", "GET", m.count))
+	if err != nil {
+		r.Error(errors.Wrap(err, "failed to get slow log"))
+		return
+	}
+
+	entries, err := parseSlowlog(reply)
+	if err != nil {
+		r.Error(errors.Wrap(err, "failed to parse slow log"))
+		return
+	}
+
+	for _, entry := range m.newEntries(entries) {
+		r.Event(eventMapping(entry))
+	}
+}
+
+// newEntries returns the entries not reported yet, oldest first, and
+// remembers the newest one. entries must be ordered newest first, as
+// returned by SLOWLOG GET.
+func (m *MetricSet) newEntries(entries []slowlogEntry) []slowlogEntry {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	// IDs start from 0 again after a restart of the server.
+	if entries[0].id < m.lastID {
+		debugf("Slow log IDs went backwards from %d to %d, assuming a server restart", m.lastID, entries[0].id)
+		m.lastID = -1
+	}
+
+	var result []slowlogEntry
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].id > m.lastID {
+			result = append(result, entries[i])
+		}
+	}
+	m.lastID = entries[0].id
+
+	return result
+}
+
+func eventMapping(entry slowlogEntry) mb.Event {
+	fields := common.MapStr{
+		"id": entry.id,
+		"duration": common.MapStr{
+			"us": entry.duration,
+		},
+	}
+	if entry.cmd != "" {
+		fields["cmd"] = entry.cmd
+	}
+	if entry.key != "" {
+		fields["key"] = entry.key
+	}
+	// This could contain confidential data, processors should be used to drop it if needed
+	if len(entry.args) > 0 {
+		fields["args"] = entry.args
+	}
+	if entry.clientAddr != "" {
+		fields.Put("client.address", entry.clientAddr)
+	}
+	if entry.clientName != "" {
+		fields.Put("client.name", entry.clientName)
+	}
+
+	return mb.Event{
+		Timestamp:       time.Unix(entry.timestamp, 0).UTC(),
+		MetricSetFields: fields,
+	}
+}
@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build integration
+
+package slowlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/tests/compose"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+	"github.com/elastic/beats/metricbeat/module/redis"
+
+	rd "github.com/garyburd/redigo/redis"
+)
+
+var host = redis.GetRedisEnvHost() + ":" + redis.GetRedisEnvPort()
+
+func TestFetch(t *testing.T) {
+	compose.EnsureUp(t, "redis")
+
+	addEntry(t)
+
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	events, errs := mbtest.ReportingFetchV2(f)
+	if len(errs) > 0 {
+		t.Fatalf("Expected 0 error, had %d. %v\n", len(errs), errs)
+	}
+	if assert.NotEmpty(t, events) {
+		t.Logf("%s/%s event: %+v", f.Module().Name(), f.Name(), events[0])
+		assert.Contains(t, events[0].MetricSetFields, "cmd")
+	}
+
+	// Entries are only reported once.
+	events, errs = mbtest.ReportingFetchV2(f)
+	assert.Empty(t, errs)
+	assert.Empty(t, events)
+}
+
+func TestData(t *testing.T) {
+	compose.EnsureUp(t, "redis")
+
+	addEntry(t)
+
+	f := mbtest.NewReportingMetricSetV2(t, getConfig())
+	if err := mbtest.WriteEventsReporterV2(f, t, ""); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+// addEntry logs every command in the slow log and runs one.
+func addEntry(t *testing.T) {
+	c, err := rd.Dial("tcp", host)
+	if err != nil {
+		t.Fatal("connect", err)
+	}
+	defer c.Close()
+
+	_, err = c.Do("CONFIG", "SET", "slowlog-log-slower-than", "0")
+	if err != nil {
+		t.Fatal("CONFIG SET", err)
+	}
+	_, err = c.Do("SET", "foo", "bar")
+	if err != nil {
+		t.Fatal("SET", err)
+	}
+}
+
+func getConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"module":     "redis",
+		"metricsets": []string{"slowlog"},
+		"hosts":      []string{host},
+	}
+}
@@ -0,0 +1,116 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package slowlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func entry(id, timestamp, duration int64, args ...string) []interface{} {
+	cmd := make([]interface{}, len(args))
+	for i, arg := range args {
+		cmd[i] = []byte(arg)
+	}
+	return []interface{}{id, timestamp, duration, cmd}
+}
+
+func TestParseSlowlog(t *testing.T) {
+	reply := []interface{}{
+		append(entry(2, 1309448200, 30, "SET", "foo", "bar"), []byte("127.0.0.1:58217"), []byte("worker-1")),
+		entry(1, 1309448128, 15000, "KEYS", "*"),
+		entry(0, 1309448100, 20, "FLUSHALL"),
+	}
+
+	entries, err := parseSlowlog(reply)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, entries, 3) {
+		assert.Equal(t, slowlogEntry{
+			id:         2,
+			timestamp:  1309448200,
+			duration:   30,
+			cmd:        "SET",
+			key:        "foo",
+			args:       []string{"bar"},
+			clientAddr: "127.0.0.1:58217",
+			clientName: "worker-1",
+		}, entries[0])
+		assert.Equal(t, "KEYS", entries[1].cmd)
+		assert.Equal(t, "*", entries[1].key)
+		assert.Nil(t, entries[1].args)
+		assert.Equal(t, "FLUSHALL", entries[2].cmd)
+		assert.Equal(t, "", entries[2].key)
+	}
+
+	_, err = parseSlowlog([]interface{}{[]interface{}{int64(1), int64(2)}})
+	assert.Error(t, err)
+}
+
+func TestNewEntries(t *testing.T) {
+	m := &MetricSet{lastID: -1}
+
+	ids := func(entries []slowlogEntry) []int64 {
+		var result []int64
+		for _, e := range entries {
+			result = append(result, e.id)
+		}
+		return result
+	}
+
+	// First fetch reports everything, oldest first.
+	assert.Equal(t, []int64{3, 4, 5}, ids(m.newEntries([]slowlogEntry{{id: 5}, {id: 4}, {id: 3}})))
+
+	// Only new entries are reported.
+	assert.Equal(t, []int64{6}, ids(m.newEntries([]slowlogEntry{{id: 6}, {id: 5}, {id: 4}})))
+	assert.Empty(t, m.newEntries([]slowlogEntry{{id: 6}, {id: 5}}))
+	assert.Empty(t, m.newEntries(nil))
+
+	// IDs going backwards mean the server was restarted.
+	assert.Equal(t, []int64{0, 1}, ids(m.newEntries([]slowlogEntry{{id: 1}, {id: 0}})))
+	assert.Equal(t, int64(1), m.lastID)
+}
+
+func TestEventMapping(t *testing.T) {
+	event := eventMapping(slowlogEntry{
+		id:         7,
+		timestamp:  1309448128,
+		duration:   30,
+		cmd:        "GET",
+		key:        "foo",
+		clientAddr: "127.0.0.1:58217",
+	})
+
+	assert.Equal(t, int64(1309448128), event.Timestamp.Unix())
+	assert.Equal(t, int64(7), event.MetricSetFields["id"])
+	assert.Equal(t, "GET", event.MetricSetFields["cmd"])
+	assert.Equal(t, "foo", event.MetricSetFields["key"])
+	assert.NotContains(t, event.MetricSetFields, "args")
+
+	us, _ := event.MetricSetFields.GetValue("duration.us")
+	assert.Equal(t, int64(30), us)
+	addr, _ := event.MetricSetFields.GetValue("client.address")
+	assert.Equal(t, "127.0.0.1:58217", addr)
+	_, err := event.MetricSetFields.GetValue("client.name")
+	assert.Error(t, err)
+}
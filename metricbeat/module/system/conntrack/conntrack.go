@@ -0,0 +1,201 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package conntrack
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/system"
+)
+
+// statColumns are the per-CPU counters of /proc/net/stat/nf_conntrack that
+// are reported. Other columns are either deprecated or not counters.
+var statColumns = []string{
+	"found",
+	"invalid",
+	"ignore",
+	"insert",
+	"insert_failed",
+	"drop",
+	"early_drop",
+	"icmp_error",
+	"search_restart",
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("system", "conntrack", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+	)
+}
+
+// MetricSet for fetching netfilter connection tracking metrics.
+type MetricSet struct {
+	mb.BaseMetricSet
+	procPath string
+}
+
+// New creates a new instance of the conntrack metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The system conntrack metricset is beta")
+
+	systemModule, ok := base.Module().(*system.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	config := struct {
+		MountPoint string `config:"conntrack.mount_point"`
+	}{}
+
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	if config.MountPoint == "" {
+		config.MountPoint = systemModule.HostFS
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		procPath:      filepath.Join(config.MountPoint, "/proc"),
+	}, nil
+}
+
+// Fetch fetches the connection tracking table usage and statistics.
+func (m *MetricSet) Fetch() (common.MapStr, error) {
+	sysctlPath := filepath.Join(m.procPath, "sys/net/netfilter")
+
+	entries, err := readUint(filepath.Join(sysctlPath, "nf_conntrack_count"))
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return nil, errors.New("connection tracking is not available, " +
+				"the nf_conntrack kernel module is not loaded")
+		}
+		return nil, err
+	}
+
+	max, err := readUint(filepath.Join(sysctlPath, "nf_conntrack_max"))
+	if err != nil {
+		return nil, err
+	}
+
+	event := common.MapStr{
+		"entries": entries,
+		"max":     max,
+	}
+
+	if max > 0 {
+		event.Put("usage.pct", common.Round(float64(entries)/float64(max), common.DefaultDecimalPlacesCount))
+	}
+
+	if buckets, err := readUint(filepath.Join(sysctlPath, "nf_conntrack_buckets")); err == nil {
+		event["buckets"] = buckets
+	}
+
+	f, err := os.Open(filepath.Join(m.procPath, "net/stat/nf_conntrack"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return event, nil
+		}
+		return nil, errors.Wrap(err, "failed to open conntrack statistics")
+	}
+	defer f.Close()
+
+	stats, err := parseStats(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse conntrack statistics")
+	}
+	event["stats"] = stats
+
+	return event, nil
+}
+
+// readUint reads a file containing a single unsigned integer.
+func readUint(path string) (uint64, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read %v", path)
+	}
+
+	value, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse %v", path)
+	}
+	return value, nil
+}
+
+// parseStats parses /proc/net/stat/nf_conntrack. The first line is a header
+// with the column names, it is followed by one line per CPU with hexadecimal
+// counters. The counters of all CPUs are summed up.
+func parseStats(r io.Reader) (common.MapStr, error) {
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("missing header")
+	}
+	header := strings.Fields(scanner.Text())
+
+	totals := make([]uint64, len(header))
+	for scanner.Scan() {
+		values := strings.Fields(scanner.Text())
+		if len(values) == 0 {
+			continue
+		}
+		if len(values) != len(header) {
+			return nil, errors.Errorf("expected %d columns but got %d", len(header), len(values))
+		}
+
+		for i, value := range values {
+			v, err := strconv.ParseUint(value, 16, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %v", header[i])
+			}
+			totals[i] += v
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	stats := common.MapStr{}
+	for _, column := range statColumns {
+		for i, name := range header {
+			if name == column {
+				stats[column] = totals[i]
+				break
+			}
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package conntrack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata"))
+
+	if err := mbtest.WriteEvent(f, t); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata"))
+	event, err := f.Fetch()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, uint64(1324), event["entries"])
+	assert.Equal(t, uint64(262144), event["max"])
+	assert.Equal(t, uint64(65536), event["buckets"])
+
+	v, _ := event.GetValue("usage.pct")
+	assert.Equal(t, 0.0051, v)
+	v, _ = event.GetValue("stats.drop")
+	assert.Equal(t, uint64(3), v)
+	v, _ = event.GetValue("stats.search_restart")
+	assert.Equal(t, uint64(40), v)
+}
+
+func TestFetchNotLoaded(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata/nonexistent"))
+	_, err := f.Fetch()
+	assert.Error(t, err)
+}
+
+func TestParseStats(t *testing.T) {
+	stats, err := parseStats(strings.NewReader(
+		"entries searched found insert_failed drop\n" +
+			"00000010 00000005 0000000a 00000001 00000002\n" +
+			"00000010 00000005 00000001 00000000 00000003\n"))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// Only the known counters are reported, "entries" and "searched" are not.
+	assert.Equal(t, common.MapStr{
+		"found":         uint64(11),
+		"insert_failed": uint64(1),
+		"drop":          uint64(5),
+	}, stats)
+
+	_, err = parseStats(strings.NewReader("entries found\n00000001\n"))
+	assert.Error(t, err)
+}
+
+func getConfig(mountPoint string) map[string]interface{} {
+	return map[string]interface{}{
+		"module":                "system",
+		"metricsets":            []string{"conntrack"},
+		"conntrack.mount_point": mountPoint,
+	}
+}
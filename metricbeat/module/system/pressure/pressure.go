@@ -0,0 +1,162 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package pressure
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/system"
+)
+
+// resources are the files in /proc/pressure that are reported.
+var resources = []string{"cpu", "memory", "io"}
+
+func init() {
+	mb.Registry.MustAddMetricSet("system", "pressure", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+	)
+}
+
+// MetricSet for fetching Linux pressure stall information.
+type MetricSet struct {
+	mb.BaseMetricSet
+	path string
+}
+
+// New creates a new instance of the pressure metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The system pressure metricset is beta")
+
+	systemModule, ok := base.Module().(*system.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	config := struct {
+		MountPoint string `config:"pressure.mount_point"`
+	}{}
+
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	if config.MountPoint == "" {
+		config.MountPoint = systemModule.HostFS
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		path:          filepath.Join(config.MountPoint, "/proc/pressure"),
+	}, nil
+}
+
+// Fetch fetches the pressure stall information of all resources. Resources
+// not supported by the running kernel are omitted.
+func (m *MetricSet) Fetch() (common.MapStr, error) {
+	event := common.MapStr{}
+	for _, resource := range resources {
+		f, err := os.Open(filepath.Join(m.path, resource))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to open pressure file for %v", resource)
+		}
+
+		stats, err := parsePressure(f)
+		f.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse pressure file for %v", resource)
+		}
+		event[resource] = stats
+	}
+
+	if len(event) == 0 {
+		return nil, errors.Errorf("no pressure stall information found in %v "+
+			"(requires Linux 4.20 or newer with PSI enabled)", m.path)
+	}
+
+	return event, nil
+}
+
+// parsePressure parses the content of a /proc/pressure file. Each line has
+// the format:
+//
+//   some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//
+// The averages are converted from percentages to ratios and the total stall
+// time is reported in microseconds.
+func parsePressure(r io.Reader) (common.MapStr, error) {
+	stats := common.MapStr{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		kind := fields[0]
+		if kind != "some" && kind != "full" {
+			return nil, errors.Errorf("unexpected pressure line type '%v'", kind)
+		}
+
+		line := common.MapStr{}
+		for _, field := range fields[1:] {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 {
+				return nil, errors.Errorf("malformed pressure field '%v'", field)
+			}
+
+			switch key, value := parts[0], parts[1]; key {
+			case "avg10", "avg60", "avg300":
+				v, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse %v", key)
+				}
+				line[key] = common.MapStr{
+					"pct": common.Round(v/100, common.DefaultDecimalPlacesCount),
+				}
+			case "total":
+				v, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse %v", key)
+				}
+				line[key] = common.MapStr{
+					"us": v,
+				}
+			}
+		}
+		stats[kind] = line
+	}
+
+	return stats, scanner.Err()
+}
@@ -0,0 +1,94 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package pressure
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata"))
+
+	if err := mbtest.WriteEvent(f, t); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata"))
+	event, err := f.Fetch()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Len(t, event, 3)
+
+	v, _ := event.GetValue("cpu.some.avg10.pct")
+	assert.Equal(t, 0.0153, v)
+	v, _ = event.GetValue("io.full.total.us")
+	assert.Equal(t, uint64(87654321), v)
+
+	// The kernel does not report full stalls for CPU.
+	_, err = event.GetValue("cpu.full")
+	assert.Error(t, err)
+}
+
+func TestFetchNotSupported(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata/nonexistent"))
+	_, err := f.Fetch()
+	assert.Error(t, err)
+}
+
+func TestParsePressure(t *testing.T) {
+	stats, err := parsePressure(strings.NewReader(
+		"some avg10=12.50 avg60=0.00 avg300=0.00 total=42\n"))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, common.MapStr{
+		"some": common.MapStr{
+			"avg10":  common.MapStr{"pct": 0.125},
+			"avg60":  common.MapStr{"pct": 0.0},
+			"avg300": common.MapStr{"pct": 0.0},
+			"total":  common.MapStr{"us": uint64(42)},
+		},
+	}, stats)
+
+	_, err = parsePressure(strings.NewReader("other avg10=0.00\n"))
+	assert.Error(t, err)
+
+	_, err = parsePressure(strings.NewReader("some avg10\n"))
+	assert.Error(t, err)
+}
+
+func getConfig(mountPoint string) map[string]interface{} {
+	return map[string]interface{}{
+		"module":               "system",
+		"metricsets":           []string{"pressure"},
+		"pressure.mount_point": mountPoint,
+	}
+}
@@ -0,0 +1,292 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package socket_summary
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/system"
+)
+
+// TCP states as reported in /proc/net/tcp, see include/net/tcp_states.h.
+// Unconnected UDP sockets are reported in the close state.
+const (
+	tcpClose  = 0x07
+	tcpListen = 0x0A
+)
+
+var tcpStates = map[uint8]string{
+	0x01: "established",
+	0x02: "syn_sent",
+	0x03: "syn_recv",
+	0x04: "fin_wait1",
+	0x05: "fin_wait2",
+	0x06: "time_wait",
+	0x07: "close",
+	0x08: "close_wait",
+	0x09: "last_ack",
+	0x0A: "listen",
+	0x0B: "closing",
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("system", "socket_summary", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+	)
+}
+
+// MetricSet for fetching a summary of the sockets of the host.
+type MetricSet struct {
+	mb.BaseMetricSet
+	netPath  string
+	pageSize uint64
+}
+
+// New creates a new instance of the socket_summary metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The system socket_summary metricset is beta")
+
+	systemModule, ok := base.Module().(*system.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	config := struct {
+		MountPoint string `config:"socket_summary.mount_point"`
+	}{}
+
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	if config.MountPoint == "" {
+		config.MountPoint = systemModule.HostFS
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		netPath:       filepath.Join(config.MountPoint, "/proc/net"),
+		pageSize:      uint64(os.Getpagesize()),
+	}, nil
+}
+
+// socket is an entry of a /proc/net/{tcp,udp} file.
+type socket struct {
+	localPort uint16
+	state     uint8
+}
+
+// Fetch fetches the socket counts per protocol and TCP state.
+func (m *MetricSet) Fetch() (common.MapStr, error) {
+	tcp4, err := m.readSockets("tcp")
+	if err != nil {
+		return nil, err
+	}
+	tcp6, err := m.readSockets("tcp6")
+	if err != nil {
+		return nil, err
+	}
+	udp4, err := m.readSockets("udp")
+	if err != nil {
+		return nil, err
+	}
+	udp6, err := m.readSockets("udp6")
+	if err != nil {
+		return nil, err
+	}
+
+	tcp := append(tcp4, tcp6...)
+	states := common.MapStr{}
+	for _, name := range tcpStates {
+		states[name] = 0
+	}
+	for _, s := range tcp {
+		if name, found := tcpStates[s.state]; found {
+			states[name] = states[name].(int) + 1
+		}
+	}
+
+	event := common.MapStr{
+		"tcp": common.MapStr{
+			"count":           len(tcp),
+			"ipv4":            common.MapStr{"count": len(tcp4)},
+			"ipv6":            common.MapStr{"count": len(tcp6)},
+			"states":          states,
+			"listening_ports": countPorts(tcp, tcpListen),
+		},
+		"udp": common.MapStr{
+			"count":           len(udp4) + len(udp6),
+			"ipv4":            common.MapStr{"count": len(udp4)},
+			"ipv6":            common.MapStr{"count": len(udp6)},
+			"listening_ports": countPorts(append(udp4, udp6...), tcpClose),
+		},
+	}
+
+	stats, err := m.readSockstat()
+	if err != nil {
+		return nil, err
+	}
+	if v, found := stats["sockets"]["used"]; found {
+		event.Put("all.count", v)
+	}
+	if v, found := stats["TCP"]["orphan"]; found {
+		event.Put("tcp.orphan", v)
+	}
+	if v, found := stats["TCP"]["mem"]; found {
+		event.Put("tcp.memory", v*m.pageSize)
+	}
+	if v, found := stats["UDP"]["mem"]; found {
+		event.Put("udp.memory", v*m.pageSize)
+	}
+
+	return event, nil
+}
+
+// readSockets reads the sockets of the given /proc/net file. A missing file,
+// for example tcp6 when IPv6 is disabled, is treated as no sockets.
+func (m *MetricSet) readSockets(name string) ([]socket, error) {
+	f, err := os.Open(filepath.Join(m.netPath, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to open %v sockets", name)
+	}
+	defer f.Close()
+
+	sockets, err := parseSockets(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %v sockets", name)
+	}
+	return sockets, nil
+}
+
+// readSockstat reads and merges sockstat and sockstat6.
+func (m *MetricSet) readSockstat() (map[string]map[string]uint64, error) {
+	stats := map[string]map[string]uint64{}
+	for _, name := range []string{"sockstat", "sockstat6"} {
+		f, err := os.Open(filepath.Join(m.netPath, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to open %v", name)
+		}
+
+		err = parseSockstat(f, stats)
+		f.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %v", name)
+		}
+	}
+	return stats, nil
+}
+
+// parseSockets parses the content of /proc/net/{tcp,tcp6,udp,udp6}. The
+// first line is a header, every other line describes one socket:
+//
+//	sl  local_address rem_address   st ...
+//	 0: 0100007F:0CEA 00000000:0000 0A ...
+func parseSockets(r io.Reader) ([]socket, error) {
+	var sockets []socket
+
+	scanner := bufio.NewScanner(r)
+	scanner.Scan() // Skip header.
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 4 {
+			return nil, errors.Errorf("malformed line '%v'", scanner.Text())
+		}
+
+		i := strings.LastIndexByte(fields[1], ':')
+		if i < 0 {
+			return nil, errors.Errorf("malformed local address '%v'", fields[1])
+		}
+		port, err := strconv.ParseUint(fields[1][i+1:], 16, 16)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse local port")
+		}
+
+		state, err := strconv.ParseUint(fields[3], 16, 8)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse state")
+		}
+
+		sockets = append(sockets, socket{localPort: uint16(port), state: uint8(state)})
+	}
+
+	return sockets, scanner.Err()
+}
+
+// parseSockstat parses the content of /proc/net/sockstat and adds the
+// values to stats, indexed by protocol and name. Each line has the format:
+//
+//	TCP: inuse 25 orphan 0 tw 3 alloc 31 mem 2
+func parseSockstat(r io.Reader, stats map[string]map[string]uint64) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields)%2 != 1 {
+			return errors.Errorf("malformed line '%v'", scanner.Text())
+		}
+
+		protocol := strings.TrimSuffix(fields[0], ":")
+		values := map[string]uint64{}
+		for i := 1; i < len(fields); i += 2 {
+			v, err := strconv.ParseUint(fields[i+1], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse %v %v", protocol, fields[i])
+			}
+			values[fields[i]] = v
+		}
+		stats[protocol] = values
+	}
+
+	return scanner.Err()
+}
+
+// countPorts returns the number of distinct local ports of the sockets in
+// the given state.
+func countPorts(sockets []socket, state uint8) int {
+	ports := map[uint16]struct{}{}
+	for _, s := range sockets {
+		if s.state == state {
+			ports[s.localPort] = struct{}{}
+		}
+	}
+	return len(ports)
+}
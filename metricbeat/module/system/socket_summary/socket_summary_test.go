@@ -0,0 +1,133 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package socket_summary
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata"))
+
+	if err := mbtest.WriteEvent(f, t); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata"))
+	event, err := f.Fetch()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	pageSize := uint64(os.Getpagesize())
+	expected := common.MapStr{
+		"all": common.MapStr{"count": uint64(412)},
+		"tcp": common.MapStr{
+			"count": 11,
+			"ipv4":  common.MapStr{"count": 8},
+			"ipv6":  common.MapStr{"count": 3},
+			"states": common.MapStr{
+				"established": 3,
+				"syn_sent":    1,
+				"syn_recv":    0,
+				"fin_wait1":   0,
+				"fin_wait2":   0,
+				"time_wait":   1,
+				"close":       0,
+				"close_wait":  1,
+				"last_ack":    0,
+				"listen":      5,
+				"closing":     0,
+			},
+			// 22 is listening on IPv4 and IPv6.
+			"listening_ports": 4,
+			"orphan":          uint64(1),
+			"memory":          3 * pageSize,
+		},
+		"udp": common.MapStr{
+			"count":           4,
+			"ipv4":            common.MapStr{"count": 3},
+			"ipv6":            common.MapStr{"count": 1},
+			"listening_ports": 2,
+			"memory":          2 * pageSize,
+		},
+	}
+	assert.Equal(t, expected, event)
+}
+
+func TestFetchMissingFiles(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata/nonexistent"))
+	event, err := f.Fetch()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	v, _ := event.GetValue("tcp.count")
+	assert.Equal(t, 0, v)
+}
+
+func TestParseSockets(t *testing.T) {
+	sockets, err := parseSockets(strings.NewReader(
+		"  sl  local_address rem_address   st\n" +
+			"   0: 00000000:0016 00000000:0000 0A\n" +
+			"   1: 0F02000A:B0E2 C0A80101:01BB 01\n"))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, []socket{
+		{localPort: 22, state: tcpListen},
+		{localPort: 45282, state: 0x01},
+	}, sockets)
+
+	_, err = parseSockets(strings.NewReader("header\n   0: 00000000\n"))
+	assert.Error(t, err)
+}
+
+func TestParseSockstat(t *testing.T) {
+	stats := map[string]map[string]uint64{}
+	err := parseSockstat(strings.NewReader(
+		"sockets: used 1139\nTCP: inuse 25 orphan 2 tw 3 alloc 31 mem 2\n"), stats)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, map[string]map[string]uint64{
+		"sockets": {"used": 1139},
+		"TCP":     {"inuse": 25, "orphan": 2, "tw": 3, "alloc": 31, "mem": 2},
+	}, stats)
+
+	assert.Error(t, parseSockstat(strings.NewReader("TCP: inuse\n"), stats))
+}
+
+func getConfig(mountPoint string) map[string]interface{} {
+	return map[string]interface{}{
+		"module":                     "system",
+		"metricsets":                 []string{"socket_summary"},
+		"socket_summary.mount_point": mountPoint,
+	}
+}
@@ -0,0 +1,284 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package users
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/system"
+)
+
+const (
+	utmpFile = "/var/run/utmp"
+	wtmpFile = "/var/log/wtmp"
+	btmpFile = "/var/log/btmp"
+)
+
+var debugf = logp.MakeDebug("system.users")
+
+func init() {
+	mb.Registry.MustAddMetricSet("system", "users", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+	)
+}
+
+// MetricSet reports user sessions from utmp and logins, logouts and failed
+// logins from wtmp and btmp.
+type MetricSet struct {
+	mb.BaseMetricSet
+	utmpPath    string
+	wtmp        *logFile
+	btmp        *logFile
+	sessions    map[string]*utmp // Open sessions by terminal.
+	initialized bool
+}
+
+// New creates a new instance of the users metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The system users metricset is beta")
+
+	systemModule, ok := base.Module().(*system.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	config := struct {
+		MountPoint string `config:"users.mount_point"`
+	}{}
+
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	if config.MountPoint == "" {
+		config.MountPoint = systemModule.HostFS
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		utmpPath:      filepath.Join(config.MountPoint, utmpFile),
+		wtmp:          &logFile{path: filepath.Join(config.MountPoint, wtmpFile)},
+		btmp:          &logFile{path: filepath.Join(config.MountPoint, btmpFile)},
+		sessions:      map[string]*utmp{},
+	}, nil
+}
+
+// Fetch reports one event for each active session and one event for each
+// login, logout and failed login recorded since the previous fetch. Records
+// written before the first fetch are not reported.
+func (m *MetricSet) Fetch(r mb.ReporterV2) {
+	active, err := readUtmpFile(m.utmpPath)
+	if err != nil {
+		r.Error(errors.Wrap(err, "failed to read active sessions"))
+		return
+	}
+
+	if !m.initialized {
+		for _, rec := range active {
+			m.sessions[rec.Terminal] = rec
+		}
+		m.wtmp.skipToEnd()
+		m.btmp.skipToEnd()
+		m.initialized = true
+	}
+
+	for _, rec := range active {
+		fields := sessionFields("session", rec)
+		fields["start"] = rec.Timestamp
+		r.Event(mb.Event{
+			MetricSetFields: fields,
+		})
+	}
+
+	err = m.wtmp.read(func(rec *utmp) {
+		switch rec.Type {
+		case userProcess:
+			m.sessions[rec.Terminal] = rec
+			r.Event(mb.Event{
+				Timestamp:       rec.Timestamp,
+				MetricSetFields: sessionFields("login", rec),
+			})
+		case deadProcess:
+			login, found := m.sessions[rec.Terminal]
+			if !found {
+				// The login was not seen, for example because wtmp was
+				// rotated in between.
+				r.Event(mb.Event{
+					Timestamp:       rec.Timestamp,
+					MetricSetFields: sessionFields("logout", rec),
+				})
+				return
+			}
+			delete(m.sessions, rec.Terminal)
+			fields := sessionFields("logout", login)
+			fields["start"] = login.Timestamp
+			r.Event(mb.Event{
+				Timestamp:       rec.Timestamp,
+				MetricSetFields: fields,
+			})
+		case bootTime:
+			// All sessions are gone after a reboot.
+			m.sessions = map[string]*utmp{}
+		}
+	})
+	if err != nil {
+		r.Error(errors.Wrap(err, "failed to read logins"))
+	}
+
+	err = m.btmp.read(func(rec *utmp) {
+		r.Event(mb.Event{
+			Timestamp:       rec.Timestamp,
+			MetricSetFields: sessionFields("failed_login", rec),
+		})
+	})
+	if err != nil {
+		r.Error(errors.Wrap(err, "failed to read failed logins"))
+	}
+}
+
+// sessionFields converts a record into the fields of an event of the given
+// type.
+func sessionFields(typ string, rec *utmp) common.MapStr {
+	fields := common.MapStr{
+		"type": typ,
+		"pid":  rec.Pid,
+	}
+
+	if rec.User != "" {
+		fields["user"] = rec.User
+	}
+	if rec.Terminal != "" {
+		fields["terminal"] = rec.Terminal
+	}
+	if rec.Host != "" {
+		fields.Put("remote.host", rec.Host)
+	}
+	if rec.IP != nil {
+		fields.Put("remote.ip", rec.IP.String())
+	}
+
+	return fields
+}
+
+// readUtmpFile returns the active user sessions in the given utmp file. A
+// missing file is treated as no active sessions.
+func readUtmpFile(path string) ([]*utmp, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var sessions []*utmp
+	for {
+		rec, err := readUtmp(f)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return sessions, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if rec.Type == userProcess {
+			sessions = append(sessions, rec)
+		}
+	}
+}
+
+// logFile keeps track of the records already read from an append-only
+// wtmp or btmp file.
+type logFile struct {
+	path   string
+	inode  uint64
+	offset int64
+}
+
+// skipToEnd marks all records currently in the file as read.
+func (l *logFile) skipToEnd() {
+	info, err := os.Stat(l.path)
+	if err != nil {
+		return
+	}
+	l.inode = inode(info)
+	l.offset = info.Size() - info.Size()%int64(utmpSize)
+}
+
+// read calls handler for each record appended since the last read. The file
+// is read from the beginning if it was rotated or truncated. A missing file
+// is not an error.
+func (l *logFile) read(handler func(*utmp)) error {
+	f, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if ino := inode(info); ino != l.inode || info.Size() < l.offset {
+		debugf("%v was rotated or truncated, reading from the beginning", l.path)
+		l.inode = ino
+		l.offset = 0
+	}
+
+	if _, err := f.Seek(l.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	for {
+		rec, err := readUtmp(f)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// An incomplete record is read again on the next call.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		l.offset += int64(utmpSize)
+		handler(rec)
+	}
+}
+
+func inode(info os.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return stat.Ino
+	}
+	return 0
+}
@@ -0,0 +1,251 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package users
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/metricbeat/mb"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewReportingMetricSetV2(t, getConfig("./_meta/testdata"))
+
+	if err := mbtest.WriteEventsReporterV2(f, t, ""); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	root := copyTestdata(t)
+	defer os.RemoveAll(root)
+
+	f := mbtest.NewReportingMetricSetV2(t, getConfig(root))
+
+	// The first fetch only reports the active sessions.
+	events, errs := mbtest.ReportingFetchV2(f)
+	assert.Empty(t, errs)
+	if !assert.Len(t, events, 2) {
+		t.FailNow()
+	}
+	assert.Equal(t, common.MapStr{
+		"type":     "session",
+		"pid":      2211,
+		"user":     "alice",
+		"terminal": "pts/0",
+		"remote": common.MapStr{
+			"host": "192.168.33.1",
+			"ip":   "192.168.33.1",
+		},
+		"start": time.Unix(1529313000, 123456000).UTC(),
+	}, events[0].MetricSetFields)
+	assert.Equal(t, "bob", events[1].MetricSetFields["user"])
+
+	appendRecords(t, filepath.Join(root, wtmpFile),
+		newRecord(deadProcess, 2211, "pts/0", "", "", 1529315000),
+		newRecord(userProcess, 2801, "pts/0", "dave", "10.0.0.16", 1529315100),
+	)
+	appendRecords(t, filepath.Join(root, btmpFile),
+		newRecord(loginProcess, 2900, "ssh:notty", "root", "203.0.113.8", 1529315200),
+	)
+
+	events, errs = mbtest.ReportingFetchV2(f)
+	assert.Empty(t, errs)
+	if !assert.Len(t, events, 5) {
+		t.FailNow()
+	}
+
+	logout := events[2]
+	assert.Equal(t, time.Unix(1529315000, 0).UTC(), logout.Timestamp)
+	assert.Equal(t, "logout", logout.MetricSetFields["type"])
+	assert.Equal(t, "alice", logout.MetricSetFields["user"])
+	assert.Equal(t, time.Unix(1529313000, 123456000).UTC(), logout.MetricSetFields["start"])
+
+	login := events[3]
+	assert.Equal(t, "login", login.MetricSetFields["type"])
+	assert.Equal(t, "dave", login.MetricSetFields["user"])
+	assert.Equal(t, 2801, login.MetricSetFields["pid"])
+
+	failed := events[4]
+	assert.Equal(t, "failed_login", failed.MetricSetFields["type"])
+	assert.Equal(t, "root", failed.MetricSetFields["user"])
+	ip, _ := failed.MetricSetFields.GetValue("remote.ip")
+	assert.Equal(t, "203.0.113.8", ip)
+
+	// Nothing new was recorded.
+	events, errs = mbtest.ReportingFetchV2(f)
+	assert.Empty(t, errs)
+	assert.Len(t, onlyType(events, "login", "logout", "failed_login"), 0)
+}
+
+func TestFetchRotated(t *testing.T) {
+	root := copyTestdata(t)
+	defer os.RemoveAll(root)
+
+	f := mbtest.NewReportingMetricSetV2(t, getConfig(root))
+	_, errs := mbtest.ReportingFetchV2(f)
+	assert.Empty(t, errs)
+
+	wtmp := filepath.Join(root, wtmpFile)
+	if err := os.Rename(wtmp, wtmp+".1"); err != nil {
+		t.Fatal(err)
+	}
+	appendRecords(t, wtmp,
+		newRecord(userProcess, 2801, "pts/3", "dave", "10.0.0.16", 1529315100),
+	)
+
+	events, errs := mbtest.ReportingFetchV2(f)
+	assert.Empty(t, errs)
+	logins := onlyType(events, "login")
+	if !assert.Len(t, logins, 1) {
+		t.FailNow()
+	}
+	assert.Equal(t, "dave", logins[0].MetricSetFields["user"])
+}
+
+func TestReadIncompleteRecord(t *testing.T) {
+	root := copyTestdata(t)
+	defer os.RemoveAll(root)
+
+	path := filepath.Join(root, btmpFile)
+	l := &logFile{path: path}
+	l.skipToEnd()
+
+	rec := encodeRecord(t, newRecord(loginProcess, 2900, "ssh:notty", "root", "", 1529315200))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(rec[:100])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var read []*utmp
+	if err := l.read(func(u *utmp) { read = append(read, u) }); err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, read, 0)
+
+	_, err = f.Write(rec[100:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.read(func(u *utmp) { read = append(read, u) }); err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, read, 1) {
+		t.FailNow()
+	}
+	assert.Equal(t, "root", read[0].User)
+}
+
+func getConfig(mountPoint string) map[string]interface{} {
+	return map[string]interface{}{
+		"module":            "system",
+		"metricsets":        []string{"users"},
+		"users.mount_point": mountPoint,
+	}
+}
+
+func onlyType(events []mb.Event, types ...string) []mb.Event {
+	var filtered []mb.Event
+	for _, e := range events {
+		for _, typ := range types {
+			if e.MetricSetFields["type"] == typ {
+				filtered = append(filtered, e)
+			}
+		}
+	}
+	return filtered
+}
+
+// copyTestdata copies the test files to a temporary directory, so they can
+// be modified by the tests.
+func copyTestdata(t *testing.T) string {
+	root, err := ioutil.TempDir("", "users")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range []string{utmpFile, wtmpFile, btmpFile} {
+		content, err := ioutil.ReadFile(filepath.Join("_meta/testdata", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dest := filepath.Join(root, file)
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(dest, content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func newRecord(typ int16, pid int32, line, user, host string, sec int32) rawUtmp {
+	rec := rawUtmp{Type: typ, Pid: pid, Sec: sec}
+	copy(rec.Line[:], line)
+	copy(rec.User[:], user)
+	copy(rec.Host[:], host)
+	if ip := net.ParseIP(host).To4(); ip != nil {
+		copy(rec.Addr[:], ip)
+	}
+	return rec
+}
+
+func encodeRecord(t *testing.T, rec rawUtmp) []byte {
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, binary.LittleEndian, rec); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func appendRecords(t *testing.T, path string, recs ...rawUtmp) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	for _, rec := range recs {
+		_, err := f.Write(encodeRecord(t, rec))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package users
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+)
+
+// Record types of utmp entries, see utmp(5).
+const (
+	bootTime     = 2
+	loginProcess = 6
+	userProcess  = 7
+	deadProcess  = 8
+)
+
+// utmpSize is the size of an utmp record on disk.
+var utmpSize = binary.Size(rawUtmp{})
+
+// rawUtmp is the on disk layout of an utmp record as used in utmp, wtmp and
+// btmp on Linux. Timestamps are always 32 bit to keep the format compatible
+// between 32 and 64 bit systems.
+type rawUtmp struct {
+	Type    int16
+	_       [2]byte
+	Pid     int32
+	Line    [32]byte
+	ID      [4]byte
+	User    [32]byte
+	Host    [256]byte
+	Exit    [2]int16
+	Session int32
+	Sec     int32
+	Usec    int32
+	Addr    [16]byte
+	_       [20]byte
+}
+
+// utmp is a decoded utmp record.
+type utmp struct {
+	Type      int
+	Pid       int
+	Terminal  string
+	ID        string
+	User      string
+	Host      string
+	IP        net.IP
+	Session   int
+	Timestamp time.Time
+}
+
+// readUtmp reads the next utmp record from r. It returns io.EOF if there
+// are no more records and io.ErrUnexpectedEOF if the last record is
+// incomplete, which happens when reading while a record is being written.
+func readUtmp(r io.Reader) (*utmp, error) {
+	var raw rawUtmp
+	if err := binary.Read(r, binary.LittleEndian, &raw); err != nil {
+		return nil, err
+	}
+
+	return &utmp{
+		Type:      int(raw.Type),
+		Pid:       int(raw.Pid),
+		Terminal:  cString(raw.Line[:]),
+		ID:        cString(raw.ID[:]),
+		User:      cString(raw.User[:]),
+		Host:      cString(raw.Host[:]),
+		IP:        decodeAddr(raw.Addr),
+		Session:   int(raw.Session),
+		Timestamp: time.Unix(int64(raw.Sec), int64(raw.Usec)*int64(time.Microsecond)).UTC(),
+	}, nil
+}
+
+// cString returns the content of a NUL padded byte array.
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
+
+// decodeAddr decodes the remote address of a record, which is stored in
+// network byte order. IPv4 addresses only use the first four bytes. It
+// returns nil if no address is set.
+func decodeAddr(addr [16]byte) net.IP {
+	if addr == [16]byte{} {
+		return nil
+	}
+
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, addr[:])
+	if bytes.Equal(ip[net.IPv4len:], make([]byte, net.IPv6len-net.IPv4len)) {
+		return ip[:net.IPv4len]
+	}
+	return ip
+}
@@ -0,0 +1,161 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package vmstat
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/metricbeat/mb"
+	"github.com/elastic/beats/metricbeat/mb/parse"
+	"github.com/elastic/beats/metricbeat/module/system"
+)
+
+// counters maps the reported fields to the /proc/vmstat keys they are
+// computed from. Keys ending in "*" are prefixes, the values of all matching
+// keys are summed up (older kernels report some counters per memory zone).
+// Throttling counters share the prefixes but are never included.
+var counters = map[string]string{
+	"page.in":      "pgpgin",
+	"page.out":     "pgpgout",
+	"swap.in":      "pswpin",
+	"swap.out":     "pswpout",
+	"fault.total":  "pgfault",
+	"fault.major":  "pgmajfault",
+	"scan.kswapd":  "pgscan_kswapd*",
+	"scan.direct":  "pgscan_direct*",
+	"steal.kswapd": "pgsteal_kswapd*",
+	"steal.direct": "pgsteal_direct*",
+	"allocstall":   "allocstall*",
+	"oom_kill":     "oom_kill",
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("system", "vmstat", New,
+		mb.WithHostParser(parse.EmptyHostParser),
+	)
+}
+
+// MetricSet for fetching virtual memory statistics.
+type MetricSet struct {
+	mb.BaseMetricSet
+	path string
+}
+
+// New creates a new instance of the vmstat metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The system vmstat metricset is beta")
+
+	systemModule, ok := base.Module().(*system.Module)
+	if !ok {
+		return nil, errors.New("unexpected module type")
+	}
+
+	config := struct {
+		MountPoint string `config:"vmstat.mount_point"`
+	}{}
+
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	if config.MountPoint == "" {
+		config.MountPoint = systemModule.HostFS
+	}
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		path:          filepath.Join(config.MountPoint, "/proc/vmstat"),
+	}, nil
+}
+
+// Fetch fetches the virtual memory statistics.
+func (m *MetricSet) Fetch() (common.MapStr, error) {
+	f, err := os.Open(m.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open vmstat")
+	}
+	defer f.Close()
+
+	values, err := parseVMStat(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse vmstat")
+	}
+
+	event := common.MapStr{}
+	for field, key := range counters {
+		if value, found := sumCounter(values, key); found {
+			event.Put(field, value)
+		}
+	}
+
+	return event, nil
+}
+
+// parseVMStat parses the "key value" lines of /proc/vmstat.
+func parseVMStat(r io.Reader) (map[string]uint64, error) {
+	values := map[string]uint64{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, errors.Errorf("malformed line '%v'", scanner.Text())
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %v", fields[0])
+		}
+		values[fields[0]] = value
+	}
+
+	return values, scanner.Err()
+}
+
+// sumCounter returns the value of the given key, or the sum of all values
+// whose key starts with the given prefix if key ends in "*". found is false
+// if no key matches.
+func sumCounter(values map[string]uint64, key string) (sum uint64, found bool) {
+	if !strings.HasSuffix(key, "*") {
+		sum, found = values[key]
+		return sum, found
+	}
+
+	prefix := strings.TrimSuffix(key, "*")
+	for k, v := range values {
+		if strings.HasPrefix(k, prefix) && !strings.HasSuffix(k, "_throttle") {
+			sum += v
+			found = true
+		}
+	}
+	return sum, found
+}
@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux
+
+package vmstat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	mbtest "github.com/elastic/beats/metricbeat/mb/testing"
+)
+
+func TestData(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata"))
+
+	if err := mbtest.WriteEvent(f, t); err != nil {
+		t.Fatal("write", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	f := mbtest.NewEventFetcher(t, getConfig("./_meta/testdata"))
+	event, err := f.Fetch()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, common.MapStr{
+		"page":       common.MapStr{"in": uint64(10485760), "out": uint64(52428800)},
+		"swap":       common.MapStr{"in": uint64(1200), "out": uint64(3400)},
+		"fault":      common.MapStr{"total": uint64(987654321), "major": uint64(4321)},
+		"scan":       common.MapStr{"kswapd": uint64(987654), "direct": uint64(3456)},
+		"steal":      common.MapStr{"kswapd": uint64(765432), "direct": uint64(2345)},
+		"allocstall": uint64(42),
+		"oom_kill":   uint64(2),
+	}, event)
+}
+
+func TestSumCounter(t *testing.T) {
+	values := map[string]uint64{
+		"pgscan_direct_dma":      1,
+		"pgscan_direct_normal":   2,
+		"pgscan_direct_throttle": 4,
+		"oom_kill":               0,
+	}
+
+	sum, found := sumCounter(values, "pgscan_direct*")
+	assert.True(t, found)
+	assert.Equal(t, uint64(3), sum)
+
+	sum, found = sumCounter(values, "oom_kill")
+	assert.True(t, found)
+	assert.Equal(t, uint64(0), sum)
+
+	_, found = sumCounter(values, "allocstall*")
+	assert.False(t, found)
+}
+
+func getConfig(mountPoint string) map[string]interface{} {
+	return map[string]interface{}{
+		"module":             "system",
+		"metricsets":         []string{"vmstat"},
+		"vmstat.mount_point": mountPoint,
+	}
+}
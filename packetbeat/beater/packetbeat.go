@@ -26,6 +26,7 @@ import (
 
 	"github.com/tsg/gopacket/layers"
 
+	"github.com/elastic/beats/libbeat/api"
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
@@ -35,6 +36,7 @@ import (
 	"github.com/elastic/beats/packetbeat/config"
 	"github.com/elastic/beats/packetbeat/decoder"
 	"github.com/elastic/beats/packetbeat/flows"
+	"github.com/elastic/beats/packetbeat/pcapdump"
 	"github.com/elastic/beats/packetbeat/procs"
 	"github.com/elastic/beats/packetbeat/protos"
 	"github.com/elastic/beats/packetbeat/protos/icmp"
@@ -51,12 +53,17 @@ import (
 type packetbeat struct {
 	config      config.Config
 	cmdLineArgs flags
-	sniff       *sniffer.Sniffer
+	sniffers    []*sniffer.Sniffer
+
+	// dispatcher serializes the access of the workers of all interfaces to
+	// the shared protocol plugins.
+	dispatcher decoder.Dispatcher
 
 	// publisher/pipeline
 	pipeline beat.Pipeline
 	transPub *publish.TransactionPublisher
 	flows    *flows.Flows
+	capture  *pcapdump.Capture
 }
 
 type flags struct {
@@ -128,11 +135,16 @@ func (pb *packetbeat) init(b *beat.Beat) error {
 		b.Publisher,
 		pb.config.IgnoreOutgoing,
 		pb.config.Interfaces.File == "",
+		pb.config.CommunityID.Hasher(),
 	)
 	if err != nil {
 		return err
 	}
 
+	if err := pb.setupPacketCapture(); err != nil {
+		return err
+	}
+
 	logp.Debug("main", "Initializing protocol plugins")
 	err = protos.Protos.Init(false, pb.transPub, cfg.Protocols, cfg.ProtocolsList)
 	if err != nil {
@@ -143,10 +155,10 @@ func (pb *packetbeat) init(b *beat.Beat) error {
 		return err
 	}
 
-	return pb.setupSniffer()
+	return pb.setupSniffers()
 }
 
-func (pb *packetbeat) setupSniffer() error {
+func (pb *packetbeat) setupSniffers() error {
 	config := &pb.config
 
 	icmp, err := pb.icmpConfig()
@@ -154,16 +166,29 @@ func (pb *packetbeat) setupSniffer() error {
 		return err
 	}
 
-	withVlans := config.Interfaces.WithVlans
+	interfaces, err := config.GetInterfaces()
+	if err != nil {
+		return err
+	}
+
 	withICMP := icmp.Enabled()
+	for _, iface := range interfaces {
+		filter := iface.BpfFilter
+		if filter == "" && !config.Flows.IsEnabled() {
+			var tunnels []string
+			if config.Tunnels.IsEnabled() {
+				tunnels = decoder.TunnelsBpfFilter(config.Tunnels)
+			}
+			filter = protos.Protos.BpfFilter(iface.WithVlans, withICMP, tunnels...)
+		}
 
-	filter := config.Interfaces.BpfFilter
-	if filter == "" && !config.Flows.IsEnabled() {
-		filter = protos.Protos.BpfFilter(withVlans, withICMP)
+		sniff, err := sniffer.New(false, filter, pb.createWorker, iface)
+		if err != nil {
+			return err
+		}
+		pb.sniffers = append(pb.sniffers, sniff)
 	}
-
-	pb.sniff, err = sniffer.New(false, filter, pb.createWorker, config.Interfaces)
-	return err
+	return nil
 }
 
 func (pb *packetbeat) setupFlows() error {
@@ -185,7 +210,7 @@ func (pb *packetbeat) setupFlows() error {
 		return err
 	}
 
-	pb.flows, err = flows.NewFlows(client.PublishAll, config.Flows)
+	pb.flows, err = flows.NewFlows(client.PublishAll, config.Flows, config.CommunityID.Hasher())
 	if err != nil {
 		return err
 	}
@@ -193,6 +218,22 @@ func (pb *packetbeat) setupFlows() error {
 	return nil
 }
 
+func (pb *packetbeat) setupPacketCapture() error {
+	var err error
+	pb.capture, err = pcapdump.New(pb.config.PacketCapture)
+	if err != nil || pb.capture == nil {
+		return err
+	}
+
+	if pb.config.PacketCapture.RingBuffer.IsEnabled() {
+		pb.transPub.SetObserver(pb.capture.OnEvent)
+		if err := api.AddHandler("/pcap/dump", pb.capture.HandleDump); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (pb *packetbeat) Run(b *beat.Beat) error {
 	defer func() {
 		if service.ProfileEnabled() {
@@ -215,21 +256,29 @@ func (pb *packetbeat) Run(b *beat.Beat) error {
 	}
 
 	var wg sync.WaitGroup
-	errC := make(chan error, 1)
-
-	// Run the sniffer in background
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-
-		err := pb.sniff.Run()
-		if err != nil {
-			errC <- fmt.Errorf("Sniffer main loop failed: %v", err)
-		}
-	}()
+	errC := make(chan error, len(pb.sniffers))
+
+	// Run the sniffers in background, if one fails all others are stopped
+	for _, sniff := range pb.sniffers {
+		wg.Add(1)
+		go func(sniff *sniffer.Sniffer) {
+			defer wg.Done()
+
+			err := sniff.Run()
+			if err != nil {
+				errC <- fmt.Errorf("Sniffer main loop failed: %v", err)
+				pb.stopSniffers()
+			}
+		}(sniff)
+	}
 
-	logp.Debug("main", "Waiting for the sniffer to finish")
+	logp.Debug("main", "Waiting for the sniffers to finish")
 	wg.Wait()
+	if pb.capture != nil {
+		if err := pb.capture.Close(); err != nil {
+			logp.Err("Failed to close pcap files: %v", err)
+		}
+	}
 	select {
 	default:
 	case err := <-errC:
@@ -242,10 +291,16 @@ func (pb *packetbeat) Run(b *beat.Beat) error {
 // Called by the Beat stop function
 func (pb *packetbeat) Stop() {
 	logp.Info("Packetbeat send stop signal")
-	pb.sniff.Stop()
+	pb.stopSniffers()
 }
 
-func (pb *packetbeat) createWorker(dl layers.LinkType) (sniffer.Worker, error) {
+func (pb *packetbeat) stopSniffers() {
+	for _, sniff := range pb.sniffers {
+		sniff.Stop()
+	}
+}
+
+func (pb *packetbeat) createWorker(device string, dl layers.LinkType) (sniffer.Worker, error) {
 	var icmp4 icmp.ICMPv4Processor
 	var icmp6 icmp.ICMPv6Processor
 	cfg, err := pb.icmpConfig()
@@ -277,11 +332,15 @@ func (pb *packetbeat) createWorker(dl layers.LinkType) (sniffer.Worker, error) {
 		return nil, err
 	}
 
-	worker, err := decoder.New(pb.flows, dl, icmp4, icmp6, tcp, udp)
+	worker, err := decoder.New(pb.flows, device, dl, icmp4, icmp6,
+		pb.dispatcher.TCP(tcp), pb.dispatcher.UDP(udp), pb.config.Defrag, pb.config.Tunnels)
 	if err != nil {
 		return nil, err
 	}
 
+	if pb.capture != nil {
+		return pb.capture.Worker(device, dl, worker), nil
+	}
 	return worker, nil
 }
 
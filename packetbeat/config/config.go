@@ -18,16 +18,24 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/flowhash"
 	"github.com/elastic/beats/libbeat/processors"
 	"github.com/elastic/beats/packetbeat/procs"
 )
 
 type Config struct {
 	Interfaces      InterfacesConfig          `config:"interfaces"`
+	InterfacesList  []InterfacesConfig        `config:"interfaces"`
 	Flows           *Flows                    `config:"flows"`
+	Defrag          *Defrag                   `config:"defrag"`
+	Tunnels         *Tunnels                  `config:"tunnels"`
+	CommunityID     *CommunityID              `config:"community_id"`
+	PacketCapture   *PacketCapture            `config:"packet_capture"`
 	Protocols       map[string]*common.Config `config:"protocols"`
 	ProtocolsList   []*common.Config          `config:"protocols"`
 	Procs           procs.ProcsConfig         `config:"procs"`
@@ -57,6 +65,55 @@ type Flows struct {
 	Processors    processors.PluginConfig `config:"processors"`
 }
 
+// Defrag configures the reassembly of fragmented IPv4 and IPv6 datagrams.
+type Defrag struct {
+	Enabled      *bool         `config:"enabled"`
+	Timeout      time.Duration `config:"timeout"`
+	MaxBytes     int           `config:"max_bytes" validate:"min=0"`
+	MaxDatagrams int           `config:"max_datagrams" validate:"min=0"`
+}
+
+// Tunnels configures the decapsulation of tunneled traffic.
+type Tunnels struct {
+	Enabled     *bool `config:"enabled"`
+	VXLANPorts  []int `config:"vxlan_ports"`
+	GENEVEPorts []int `config:"geneve_ports"`
+}
+
+// CommunityID configures the Community ID flow hash added to flows and
+// transactions.
+type CommunityID struct {
+	Enabled *bool  `config:"enabled"`
+	Seed    uint16 `config:"seed"`
+}
+
+// PacketCapture configures writing captured packets to pcap files.
+type PacketCapture struct {
+	Path       string      `config:"path"`
+	RingBuffer *RingBuffer `config:"ring_buffer"`
+	Rotate     *Rotate     `config:"rotate"`
+}
+
+// RingBuffer configures keeping the most recent packets in memory, so they
+// can be dumped to a pcap file when a transaction matches a condition or
+// when requested through the HTTP endpoint.
+type RingBuffer struct {
+	Enabled     *bool                       `config:"enabled"`
+	MaxBytes    int                         `config:"max_bytes" validate:"min=0"`
+	MaxAge      time.Duration               `config:"max_age" validate:"min=0"`
+	MinInterval time.Duration               `config:"min_interval" validate:"min=0"`
+	When        *processors.ConditionConfig `config:"when"`
+}
+
+// Rotate configures continuously writing all packets to a set of rotated
+// pcap files.
+type Rotate struct {
+	Enabled   *bool         `config:"enabled"`
+	MaxBytes  int           `config:"max_bytes" validate:"min=0"`
+	Interval  time.Duration `config:"interval" validate:"min=0"`
+	KeepFiles int           `config:"keep_files" validate:"min=0"`
+}
+
 type ProtocolCommon struct {
 	Ports              []int         `config:"ports"`
 	SendRequest        bool          `config:"send_request"`
@@ -64,6 +121,73 @@ type ProtocolCommon struct {
 	TransactionTimeout time.Duration `config:"transaction_timeout"`
 }
 
+// GetInterfaces returns the configurations of all interfaces to capture on.
+// The interfaces setting accepts a single interface or a list of interfaces.
+// If packets are read from a file, the file is the only interface.
+func (c *Config) GetInterfaces() ([]InterfacesConfig, error) {
+	if c.Interfaces.File != "" || len(c.InterfacesList) == 0 {
+		return []InterfacesConfig{c.Interfaces}, nil
+	}
+
+	if len(c.InterfacesList) > 1 && c.Interfaces.Dumpfile != "" {
+		return nil, errors.New("dumping packets is not supported when capturing on multiple interfaces")
+	}
+
+	devices := map[string]bool{}
+	interfaces := make([]InterfacesConfig, 0, len(c.InterfacesList))
+	for _, iface := range c.InterfacesList {
+		if iface.File != "" {
+			return nil, errors.New("reading from a file is not supported in a list of interfaces")
+		}
+		if devices[iface.Device] {
+			return nil, fmt.Errorf("interface '%s' is configured more than once", iface.Device)
+		}
+		devices[iface.Device] = true
+
+		// command line options apply to all interfaces
+		iface.OneAtATime = c.Interfaces.OneAtATime
+		iface.Dumpfile = c.Interfaces.Dumpfile
+		interfaces = append(interfaces, iface)
+	}
+	return interfaces, nil
+}
+
 func (f *Flows) IsEnabled() bool {
 	return f != nil && (f.Enabled == nil || *f.Enabled)
 }
+
+func (d *Defrag) IsEnabled() bool {
+	return d == nil || d.Enabled == nil || *d.Enabled
+}
+
+func (t *Tunnels) IsEnabled() bool {
+	return t != nil && (t.Enabled == nil || *t.Enabled)
+}
+
+func (c *CommunityID) IsEnabled() bool {
+	return c == nil || c.Enabled == nil || *c.Enabled
+}
+
+func (p *PacketCapture) IsEnabled() bool {
+	return p != nil && (p.RingBuffer.IsEnabled() || p.Rotate.IsEnabled())
+}
+
+func (r *RingBuffer) IsEnabled() bool {
+	return r != nil && (r.Enabled == nil || *r.Enabled)
+}
+
+func (r *Rotate) IsEnabled() bool {
+	return r != nil && (r.Enabled == nil || *r.Enabled)
+}
+
+// Hasher returns the Community ID hasher, or nil if it is disabled.
+func (c *CommunityID) Hasher() *flowhash.CommunityID {
+	if !c.IsEnabled() {
+		return nil
+	}
+	var seed uint16
+	if c != nil {
+		seed = c.Seed
+	}
+	return flowhash.NewCommunityID(seed)
+}
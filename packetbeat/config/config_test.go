@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/flowhash"
+)
+
+func unpackConfig(t *testing.T, cmdline InterfacesConfig, yaml string) Config {
+	raw, err := common.NewConfigWithYAML([]byte(yaml), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := Config{Interfaces: cmdline}
+	if err := raw.Unpack(&cfg); err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func TestGetInterfacesSingle(t *testing.T) {
+	cfg := unpackConfig(t, InterfacesConfig{}, `
+interfaces:
+  device: eth0
+  snaplen: 1514
+`)
+
+	interfaces, err := cfg.GetInterfaces()
+	assert.NoError(t, err)
+	if assert.Len(t, interfaces, 1) {
+		assert.Equal(t, "eth0", interfaces[0].Device)
+		assert.Equal(t, 1514, interfaces[0].Snaplen)
+	}
+}
+
+func TestGetInterfacesList(t *testing.T) {
+	cfg := unpackConfig(t, InterfacesConfig{OneAtATime: true}, `
+interfaces:
+  - device: eth0
+    bpf_filter: port 80
+  - device: eth1
+    type: af_packet
+    buffer_size_mb: 100
+`)
+
+	interfaces, err := cfg.GetInterfaces()
+	assert.NoError(t, err)
+	if assert.Len(t, interfaces, 2) {
+		assert.Equal(t, "eth0", interfaces[0].Device)
+		assert.Equal(t, "port 80", interfaces[0].BpfFilter)
+		assert.True(t, interfaces[0].OneAtATime)
+
+		assert.Equal(t, "eth1", interfaces[1].Device)
+		assert.Equal(t, "af_packet", interfaces[1].Type)
+		assert.Equal(t, 100, interfaces[1].BufferSizeMb)
+		assert.True(t, interfaces[1].OneAtATime)
+	}
+}
+
+func TestGetInterfacesFile(t *testing.T) {
+	cfg := unpackConfig(t, InterfacesConfig{File: "test.pcap"}, `
+interfaces:
+  - device: eth0
+  - device: eth1
+`)
+
+	interfaces, err := cfg.GetInterfaces()
+	assert.NoError(t, err)
+	if assert.Len(t, interfaces, 1) {
+		assert.Equal(t, "test.pcap", interfaces[0].File)
+	}
+}
+
+func TestGetInterfacesErrors(t *testing.T) {
+	cfg := unpackConfig(t, InterfacesConfig{}, `
+interfaces:
+  - device: eth0
+  - device: eth0
+`)
+	_, err := cfg.GetInterfaces()
+	assert.Error(t, err)
+
+	cfg = unpackConfig(t, InterfacesConfig{Dumpfile: "dump.pcap"}, `
+interfaces:
+  - device: eth0
+  - device: eth1
+`)
+	_, err = cfg.GetInterfaces()
+	assert.Error(t, err)
+
+	cfg = unpackConfig(t, InterfacesConfig{}, `
+interfaces:
+  - device: eth0
+  - file: test.pcap
+`)
+	_, err = cfg.GetInterfaces()
+	assert.Error(t, err)
+}
+
+func TestCommunityIDHasher(t *testing.T) {
+	cfg := unpackConfig(t, InterfacesConfig{}, `interfaces.device: any`)
+	assert.NotNil(t, cfg.CommunityID.Hasher())
+
+	cfg = unpackConfig(t, InterfacesConfig{}, `community_id.enabled: false`)
+	assert.Nil(t, cfg.CommunityID.Hasher())
+
+	cfg = unpackConfig(t, InterfacesConfig{}, `community_id.seed: 123`)
+	assert.Equal(t, flowhash.NewCommunityID(123), cfg.CommunityID.Hasher())
+}
@@ -18,9 +18,12 @@
 package decoder
 
 import (
+	"encoding/binary"
 	"fmt"
 
+	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/packetbeat/config"
 	"github.com/elastic/beats/packetbeat/flows"
 	"github.com/elastic/beats/packetbeat/protos"
 	"github.com/elastic/beats/packetbeat/protos/icmp"
@@ -48,6 +51,10 @@ type Decoder struct {
 	icmp6     layers.ICMPv6
 	tcp       layers.TCP
 	udp       layers.UDP
+	gre       greLayer
+	vxlan     vxlanLayer
+	geneve    geneveLayer
+	mpls      mplsLayer
 	truncated bool
 
 	stD1Q, stIP4, stIP6 multiLayer
@@ -57,6 +64,22 @@ type Decoder struct {
 	tcpProc   tcp.Processor
 	udpProc   udp.Processor
 
+	// defrag is nil if IP defragmentation is disabled.
+	defrag *defragmenter
+
+	// udpTunnels maps UDP ports to the tunnel received on them, it is nil if
+	// tunnels are not decapsulated.
+	udpTunnels map[uint16]gopacket.LayerType
+
+	// Set by process to continue decoding with a different layer type or
+	// payload than the ones of the current layer, like after reassembling a
+	// datagram or for tunnels over UDP.
+	nextLayer   gopacket.LayerType
+	nextPayload []byte
+
+	// iface is the name of the interface packets are captured on.
+	iface string
+
 	flows       *flows.Flows
 	statPackets *flows.Uint
 	statBytes   *flows.Uint
@@ -74,20 +97,28 @@ const (
 // New creates and initializes a new packet decoder.
 func New(
 	f *flows.Flows,
+	iface string,
 	datalink layers.LinkType,
 	icmp4 icmp.ICMPv4Processor,
 	icmp6 icmp.ICMPv6Processor,
 	tcp tcp.Processor,
 	udp udp.Processor,
+	defrag *config.Defrag,
+	tunnels *config.Tunnels,
 ) (*Decoder, error) {
 	d := Decoder{
 		flows:     f,
+		iface:     iface,
 		decoders:  make(map[gopacket.LayerType]gopacket.DecodingLayer),
 		icmp4Proc: icmp4, icmp6Proc: icmp6, tcpProc: tcp, udpProc: udp}
 	d.stD1Q.init(&d.d1q[0], &d.d1q[1])
 	d.stIP4.init(&d.ip4[0], &d.ip4[1])
 	d.stIP6.init(&d.ip6[0], &d.ip6[1])
 
+	if defrag.IsEnabled() {
+		d.defrag = newDefragmenter(defrag)
+	}
+
 	if f != nil {
 		var err error
 		d.statPackets, err = f.NewUint(netPacketsTotalCounter)
@@ -112,6 +143,13 @@ func New(
 	}
 	d.AddLayers(defaultLayerTypes)
 
+	if tunnels.IsEnabled() {
+		d.udpTunnels = udpTunnels(tunnels)
+		d.AddLayers([]gopacket.DecodingLayer{
+			&d.gre, &d.vxlan, &d.geneve, &d.mpls,
+		})
+	}
+
 	debugf("Layer type: %s", datalink.String())
 
 	switch datalink {
@@ -151,17 +189,20 @@ func (d *Decoder) OnPacket(data []byte, ci *gopacket.CaptureInfo) {
 	defer logp.Recover("packet decoding failed")
 
 	d.truncated = false
+	d.nextLayer, d.nextPayload = gopacket.LayerTypeZero, nil
 
 	current := d.linkLayerDecoder
 	currentType := d.linkLayerType
 
 	packet := protos.Packet{Ts: ci.Timestamp}
+	packet.Tuple.Interface = d.iface
 
 	debugf("decode packet data")
 	processed := false
 
 	if d.flowID != nil {
 		d.flowID.Reset(d.flowIDBufferBacking[:0])
+		d.flowID.SetInterface(d.iface)
 
 		// suppress flow stats snapshots while processing packet
 		d.flows.Lock()
@@ -186,6 +227,13 @@ func (d *Decoder) OnPacket(data []byte, ci *gopacket.CaptureInfo) {
 		if processed {
 			break
 		}
+		if d.nextLayer != gopacket.LayerTypeZero {
+			nextType = d.nextLayer
+			if d.nextPayload != nil {
+				data = d.nextPayload
+			}
+			d.nextLayer, d.nextPayload = gopacket.LayerTypeZero, nil
+		}
 
 		// choose next decoding layer
 		next, ok := d.decoders[nextType]
@@ -242,6 +290,10 @@ func (d *Decoder) process(
 		packet.Tuple.DstIP = ip4.DstIP
 		packet.Tuple.IPLength = 4
 
+		if d.defrag != nil && (ip4.Flags&layers.IPv4MoreFragments != 0 || ip4.FragOffset != 0) {
+			return d.onIPv4Fragment(ip4, packet), nil
+		}
+
 	case layers.LayerTypeIPv6:
 		debugf("IPv6 packet")
 		ip6 := &d.ip6[d.stIP6.i]
@@ -255,6 +307,34 @@ func (d *Decoder) process(
 		packet.Tuple.DstIP = ip6.DstIP
 		packet.Tuple.IPLength = 16
 
+		next := ip6.NextHeader
+		if ip6.HopByHop != nil {
+			next = ip6.HopByHop.NextHeader
+		}
+		if d.defrag != nil && next == layers.IPProtocolIPv6Fragment {
+			return d.onIPv6Fragment(ip6, packet), nil
+		}
+
+	case layers.LayerTypeGRE:
+		debugf("GRE packet")
+		d.addTunnel(packet, common.Tunnel{
+			Type:  common.TunnelGRE,
+			ID:    d.gre.Key,
+			HasID: d.gre.KeyPresent,
+		})
+
+	case LayerTypeVXLAN:
+		debugf("VXLAN packet")
+		d.addTunnel(packet, common.Tunnel{Type: common.TunnelVXLAN, ID: d.vxlan.VNI, HasID: true})
+
+	case LayerTypeGENEVE:
+		debugf("GENEVE packet")
+		d.addTunnel(packet, common.Tunnel{Type: common.TunnelGENEVE, ID: d.geneve.VNI, HasID: true})
+
+	case layers.LayerTypeMPLS:
+		debugf("MPLS packet")
+		d.addTunnel(packet, common.Tunnel{Type: common.TunnelMPLS, ID: d.mpls.Label, HasID: true})
+
 	case layers.LayerTypeICMPv4:
 		debugf("ICMPv4 packet")
 		d.onICMPv4(packet)
@@ -266,6 +346,12 @@ func (d *Decoder) process(
 		return true, nil
 
 	case layers.LayerTypeUDP:
+		if tunnel, found := d.udpTunnels[uint16(d.udp.DstPort)]; found {
+			debugf("UDP packet with %v tunnel", tunnel)
+			d.nextLayer = tunnel
+			return false, nil
+		}
+
 		debugf("UDP packet")
 		d.onUDP(packet)
 		return true, nil
@@ -279,6 +365,83 @@ func (d *Decoder) process(
 	return false, nil
 }
 
+// onIPv4Fragment passes a fragment to the defragmenter. It returns true if
+// the packet has been consumed, and false if the datagram is complete and its
+// payload must be decoded.
+func (d *Decoder) onIPv4Fragment(ip4 *layers.IPv4, packet *protos.Packet) bool {
+	if d.truncated {
+		debugf("Ignoring truncated IPv4 fragment")
+		defragInvalid.Inc()
+		return true
+	}
+
+	key := fragmentKey{
+		id:      uint32(ip4.Id),
+		proto:   uint8(ip4.Protocol),
+		version: 4,
+	}
+	copy(key.src[:], ip4.SrcIP)
+	copy(key.dst[:], ip4.DstIP)
+
+	offset := int(ip4.FragOffset) * 8
+	more := ip4.Flags&layers.IPv4MoreFragments != 0
+	payload, complete := d.defrag.add(key, packet.Ts, offset, more, ip4.Payload)
+	if !complete {
+		return true
+	}
+
+	debugf("IPv4 datagram reassembled (%d bytes)", len(payload))
+	d.nextLayer = ip4.Protocol.LayerType()
+	d.nextPayload = payload
+	return false
+}
+
+// onIPv6Fragment is the IPv6 counterpart of onIPv4Fragment, for packets
+// whose IPv6 header is followed by a fragment header.
+func (d *Decoder) onIPv6Fragment(ip6 *layers.IPv6, packet *protos.Packet) bool {
+	// Fragment header: next header, reserved, offset and M flag, identification.
+	hdr := ip6.Payload
+	if d.truncated || len(hdr) < 8 {
+		debugf("Ignoring truncated IPv6 fragment")
+		defragInvalid.Inc()
+		return true
+	}
+
+	proto := layers.IPProtocol(hdr[0])
+	key := fragmentKey{
+		id:      binary.BigEndian.Uint32(hdr[4:8]),
+		proto:   uint8(proto),
+		version: 6,
+	}
+	copy(key.src[:], ip6.SrcIP)
+	copy(key.dst[:], ip6.DstIP)
+
+	offsetFlags := binary.BigEndian.Uint16(hdr[2:4])
+	offset := int(offsetFlags &^ 0x7)
+	more := offsetFlags&0x1 != 0
+	payload, complete := d.defrag.add(key, packet.Ts, offset, more, hdr[8:])
+	if !complete {
+		return true
+	}
+
+	debugf("IPv6 datagram reassembled (%d bytes)", len(payload))
+	d.nextLayer = proto.LayerType()
+	d.nextPayload = payload
+	return false
+}
+
+// addTunnel records the tunnel a packet is encapsulated in. Only the
+// outermost tunnel is kept.
+func (d *Decoder) addTunnel(packet *protos.Packet, tunnel common.Tunnel) {
+	if packet.Tuple.Tunnel.Type != common.TunnelNone {
+		return
+	}
+	packet.Tuple.Tunnel = tunnel
+	if d.flowID != nil {
+		d.flowID.AddTunnel(tunnel)
+	}
+}
+
 func (d *Decoder) onICMPv4(packet *protos.Packet) {
 	if d.icmp4Proc != nil {
 		packet.Payload = d.icmp4.Payload
@@ -197,13 +197,27 @@ func TestDecodePacketData_ipv6Udp(t *testing.T) {
 	assert.NotEqual(t, -1, strings.Index(string(p.Data()), string(udp.pkt.Payload)))
 }
 
+func TestDecodePacketData_interface(t *testing.T) {
+	p := gopacket.NewPacket(ipv6UdpDNS, layers.LinkTypeEthernet, gopacket.Default)
+	udp := &TestUDPProcessor{}
+	d, err := New(nil, "eth1", layers.LinkTypeEthernet, nil, nil, &TestTCPProcessor{}, udp, nil, nil)
+	if err != nil {
+		t.Fatalf("Error creating decoder %v", err)
+	}
+	d.OnPacket(p.Data(), &p.Metadata().CaptureInfo)
+
+	if assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		assert.Equal(t, "eth1", udp.pkt.Tuple.Interface)
+	}
+}
+
 // Creates a new TestDecoder that handles ethernet packets.
 func newTestDecoder(t *testing.T) (*Decoder, *TestTCPProcessor, *TestUDPProcessor) {
 	icmp4Layer := &TestIcmp4Processor{}
 	icmp6Layer := &TestIcmp6Processor{}
 	tcpLayer := &TestTCPProcessor{}
 	udpLayer := &TestUDPProcessor{}
-	d, err := New(nil, layers.LinkTypeEthernet, icmp4Layer, icmp6Layer, tcpLayer, udpLayer)
+	d, err := New(nil, "", layers.LinkTypeEthernet, icmp4Layer, icmp6Layer, tcpLayer, udpLayer, nil, nil)
 	if err != nil {
 		t.Fatalf("Error creating decoder %v", err)
 	}
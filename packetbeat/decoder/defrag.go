@@ -0,0 +1,230 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"container/list"
+	"sort"
+	"time"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/packetbeat/config"
+)
+
+const (
+	defaultDefragTimeout      = 30 * time.Second
+	defaultDefragMaxBytes     = 4 * 1024 * 1024
+	defaultDefragMaxDatagrams = 1024
+
+	// maxDatagramSize is the maximum size of a reassembled payload, jumbograms
+	// are not supported.
+	maxDatagramSize = 65535
+)
+
+var (
+	defragFragments   = monitoring.NewInt(nil, "defrag.fragments")
+	defragReassembled = monitoring.NewInt(nil, "defrag.reassembled")
+	defragExpired     = monitoring.NewInt(nil, "defrag.expired")
+	defragEvicted     = monitoring.NewInt(nil, "defrag.evicted")
+	defragOverlapping = monitoring.NewInt(nil, "defrag.overlapping")
+	defragInvalid     = monitoring.NewInt(nil, "defrag.invalid")
+)
+
+// defragmenter reassembles fragmented IP datagrams. Incomplete datagrams are
+// dropped once the timeout passes, or when the limits on the number of
+// datagrams or the buffered bytes are reached, oldest first.
+//
+// Fragments overlapping with already received fragments drop the whole
+// datagram, as required by RFC 5722 for IPv6. The same is done for IPv4 to
+// avoid reporting data the monitored host might have reassembled differently.
+// Exact duplicates are ignored.
+//
+// Time is driven by the packet timestamps, so reading from a pcap file gives
+// the same results as live capture.
+type defragmenter struct {
+	timeout      time.Duration
+	maxBytes     int
+	maxDatagrams int
+
+	datagrams map[fragmentKey]*list.Element
+	lru       list.List // *datagram, ordered by creation time
+	bytes     int       // bytes buffered by all datagrams
+}
+
+// fragmentKey identifies the fragments of the same datagram.
+type fragmentKey struct {
+	src, dst [16]byte
+	id       uint32
+	proto    uint8
+	version  uint8
+}
+
+type datagram struct {
+	key       fragmentKey
+	fragments []fragment // ordered by offset, never overlapping
+	size      int        // bytes buffered
+	length    int        // length of the datagram, -1 until the last fragment is received
+	expires   time.Time
+}
+
+type fragment struct {
+	offset int
+	data   []byte
+}
+
+func newDefragmenter(cfg *config.Defrag) *defragmenter {
+	d := &defragmenter{
+		timeout:      defaultDefragTimeout,
+		maxBytes:     defaultDefragMaxBytes,
+		maxDatagrams: defaultDefragMaxDatagrams,
+		datagrams:    make(map[fragmentKey]*list.Element),
+	}
+	if cfg != nil {
+		if cfg.Timeout > 0 {
+			d.timeout = cfg.Timeout
+		}
+		if cfg.MaxBytes > 0 {
+			d.maxBytes = cfg.MaxBytes
+		}
+		if cfg.MaxDatagrams > 0 {
+			d.maxDatagrams = cfg.MaxDatagrams
+		}
+	}
+	return d
+}
+
+// add buffers a fragment of the datagram identified by key. data starts at
+// offset bytes of the datagram payload, more is set for all fragments but the
+// last one. It returns the reassembled payload once all fragments have been
+// received. data is copied, so it can be reused by the caller.
+func (d *defragmenter) add(
+	key fragmentKey,
+	ts time.Time,
+	offset int,
+	more bool,
+	data []byte,
+) ([]byte, bool) {
+	defragFragments.Inc()
+	d.expire(ts)
+
+	end := offset + len(data)
+	if end > maxDatagramSize || (more && (len(data) == 0 || len(data)%8 != 0)) {
+		debugf("Invalid fragment (offset=%d, length=%d, more=%v)", offset, len(data), more)
+		defragInvalid.Inc()
+		return nil, false
+	}
+
+	elem, found := d.datagrams[key]
+	if !found {
+		if len(d.datagrams) >= d.maxDatagrams {
+			debugf("Too many fragmented datagrams, dropping the oldest one")
+			defragEvicted.Inc()
+			d.remove(d.lru.Front())
+		}
+		elem = d.lru.PushBack(&datagram{
+			key:     key,
+			length:  -1,
+			expires: ts.Add(d.timeout),
+		})
+		d.datagrams[key] = elem
+	}
+	dg := elem.Value.(*datagram)
+
+	// The end of the datagram must be consistent among all fragments.
+	if last := len(dg.fragments) - 1; !more || dg.length >= 0 {
+		length := dg.length
+		if !more {
+			length = end
+		}
+		if (dg.length >= 0 && dg.length != length) || end > length ||
+			(last >= 0 && dg.fragments[last].end() > length) {
+			debugf("Inconsistent length of fragmented datagram")
+			defragInvalid.Inc()
+			d.remove(elem)
+			return nil, false
+		}
+		dg.length = length
+	}
+
+	i := sort.Search(len(dg.fragments), func(i int) bool {
+		return dg.fragments[i].offset >= offset
+	})
+	if i < len(dg.fragments) && dg.fragments[i].offset == offset && len(dg.fragments[i].data) == len(data) {
+		debugf("Ignoring duplicated fragment")
+		return nil, false
+	}
+	if (i > 0 && dg.fragments[i-1].end() > offset) ||
+		(i < len(dg.fragments) && dg.fragments[i].offset < end) {
+		debugf("Overlapping fragments, dropping datagram")
+		defragOverlapping.Inc()
+		d.remove(elem)
+		return nil, false
+	}
+
+	dg.fragments = append(dg.fragments, fragment{})
+	copy(dg.fragments[i+1:], dg.fragments[i:])
+	dg.fragments[i] = fragment{offset: offset, data: append([]byte(nil), data...)}
+	dg.size += len(data)
+	d.bytes += len(data)
+
+	for d.bytes > d.maxBytes {
+		oldest := d.lru.Front()
+		debugf("Fragments buffer full, dropping the oldest datagram")
+		defragEvicted.Inc()
+		d.remove(oldest)
+		if oldest == elem {
+			return nil, false
+		}
+	}
+
+	// Fragments don't overlap and are inside the datagram, so it is complete
+	// once the buffered size matches its length.
+	if dg.length < 0 || dg.size != dg.length {
+		return nil, false
+	}
+
+	payload := make([]byte, dg.length)
+	for _, f := range dg.fragments {
+		copy(payload[f.offset:], f.data)
+	}
+	d.remove(elem)
+	defragReassembled.Inc()
+	return payload, true
+}
+
+// expire drops the datagrams whose timeout passed before ts.
+func (d *defragmenter) expire(ts time.Time) {
+	for elem := d.lru.Front(); elem != nil; elem = d.lru.Front() {
+		if !ts.After(elem.Value.(*datagram).expires) {
+			return
+		}
+		debugf("Fragmented datagram timed out")
+		defragExpired.Inc()
+		d.remove(elem)
+	}
+}
+
+func (d *defragmenter) remove(elem *list.Element) {
+	dg := d.lru.Remove(elem).(*datagram)
+	delete(d.datagrams, dg.key)
+	d.bytes -= dg.size
+}
+
+func (f *fragment) end() int {
+	return f.offset + len(f.data)
+}
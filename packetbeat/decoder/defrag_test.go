@@ -0,0 +1,281 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package decoder
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+
+	"github.com/elastic/beats/packetbeat/config"
+)
+
+var defragTestKey = fragmentKey{id: 1, proto: 17, version: 4}
+
+func testPayload(n int) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	return data
+}
+
+func TestDefragInOrder(t *testing.T) {
+	d := newDefragmenter(nil)
+	ts := time.Now()
+	data := testPayload(100)
+
+	_, complete := d.add(defragTestKey, ts, 0, true, data[:40])
+	assert.False(t, complete)
+	_, complete = d.add(defragTestKey, ts, 40, true, data[40:80])
+	assert.False(t, complete)
+	payload, complete := d.add(defragTestKey, ts, 80, false, data[80:])
+	assert.True(t, complete)
+	assert.Equal(t, data, payload)
+
+	assert.Empty(t, d.datagrams)
+	assert.Equal(t, 0, d.bytes)
+}
+
+func TestDefragOutOfOrder(t *testing.T) {
+	d := newDefragmenter(nil)
+	ts := time.Now()
+	data := testPayload(100)
+
+	_, complete := d.add(defragTestKey, ts, 80, false, data[80:])
+	assert.False(t, complete)
+	_, complete = d.add(defragTestKey, ts, 0, true, data[:40])
+	assert.False(t, complete)
+
+	// Fragments of other datagrams are kept apart.
+	other := defragTestKey
+	other.id = 2
+	_, complete = d.add(other, ts, 40, true, testPayload(40))
+	assert.False(t, complete)
+
+	payload, complete := d.add(defragTestKey, ts, 40, true, data[40:80])
+	assert.True(t, complete)
+	assert.Equal(t, data, payload)
+	assert.Len(t, d.datagrams, 1)
+}
+
+func TestDefragDuplicates(t *testing.T) {
+	d := newDefragmenter(nil)
+	ts := time.Now()
+	data := testPayload(48)
+
+	d.add(defragTestKey, ts, 0, true, data[:24])
+	_, complete := d.add(defragTestKey, ts, 0, true, data[:24])
+	assert.False(t, complete)
+	payload, complete := d.add(defragTestKey, ts, 24, false, data[24:])
+	assert.True(t, complete)
+	assert.Equal(t, data, payload)
+}
+
+func TestDefragOverlapping(t *testing.T) {
+	d := newDefragmenter(nil)
+	ts := time.Now()
+	data := testPayload(48)
+
+	d.add(defragTestKey, ts, 0, true, data[:24])
+	_, complete := d.add(defragTestKey, ts, 16, false, data[16:])
+	assert.False(t, complete)
+	assert.Empty(t, d.datagrams)
+
+	// Late fragments start a new datagram that is never completed.
+	_, complete = d.add(defragTestKey, ts, 24, false, data[24:])
+	assert.False(t, complete)
+	assert.Len(t, d.datagrams, 1)
+}
+
+func TestDefragInvalid(t *testing.T) {
+	d := newDefragmenter(nil)
+	ts := time.Now()
+
+	// Fragments other than the last one must be multiple of 8 bytes.
+	_, complete := d.add(defragTestKey, ts, 0, true, testPayload(10))
+	assert.False(t, complete)
+	assert.Empty(t, d.datagrams)
+
+	// Too big.
+	_, complete = d.add(defragTestKey, ts, 65528, false, testPayload(16))
+	assert.False(t, complete)
+	assert.Empty(t, d.datagrams)
+
+	// Data after the last fragment.
+	d.add(defragTestKey, ts, 16, false, testPayload(8))
+	_, complete = d.add(defragTestKey, ts, 24, true, testPayload(8))
+	assert.False(t, complete)
+	assert.Empty(t, d.datagrams)
+}
+
+func TestDefragTimeout(t *testing.T) {
+	d := newDefragmenter(&config.Defrag{Timeout: time.Second})
+	ts := time.Now()
+	data := testPayload(48)
+
+	d.add(defragTestKey, ts, 0, true, data[:24])
+	_, complete := d.add(defragTestKey, ts.Add(2*time.Second), 24, false, data[24:])
+	assert.False(t, complete)
+	assert.Len(t, d.datagrams, 1)
+	assert.Equal(t, 24, d.bytes)
+}
+
+func TestDefragLimits(t *testing.T) {
+	ts := time.Now()
+	data := testPayload(48)
+
+	d := newDefragmenter(&config.Defrag{MaxDatagrams: 2})
+	for id := uint32(1); id <= 3; id++ {
+		key := defragTestKey
+		key.id = id
+		d.add(key, ts, 0, true, data[:24])
+	}
+	assert.Len(t, d.datagrams, 2)
+
+	// The first datagram was evicted.
+	_, complete := d.add(defragTestKey, ts, 24, false, data[24:])
+	assert.False(t, complete)
+
+	d = newDefragmenter(&config.Defrag{MaxBytes: 40})
+	d.add(defragTestKey, ts, 0, true, data[:24])
+	other := defragTestKey
+	other.id = 2
+	d.add(other, ts, 0, true, data[:24])
+	assert.Len(t, d.datagrams, 1)
+	assert.Equal(t, 24, d.bytes)
+	_, found := d.datagrams[other]
+	assert.True(t, found)
+}
+
+// ipv4Fragment builds an Ethernet frame with an IPv4 fragment of a UDP
+// datagram from 10.0.0.1 to 10.0.0.2.
+func ipv4Fragment(id uint16, offset int, more bool, payload []byte) []byte {
+	frame := make([]byte, 14+20, 14+20+len(payload))
+	binary.BigEndian.PutUint16(frame[12:14], 0x0800)
+
+	ip := frame[14:]
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:4], uint16(20+len(payload)))
+	binary.BigEndian.PutUint16(ip[4:6], id)
+	flagsOffset := uint16(offset / 8)
+	if more {
+		flagsOffset |= 0x2000
+	}
+	binary.BigEndian.PutUint16(ip[6:8], flagsOffset)
+	ip[8] = 64
+	ip[9] = 17
+	copy(ip[12:16], []byte{10, 0, 0, 1})
+	copy(ip[16:20], []byte{10, 0, 0, 2})
+
+	return append(frame, payload...)
+}
+
+// ipv6Fragment builds an Ethernet frame with an IPv6 fragment of a UDP
+// datagram from 2001:db8::1 to 2001:db8::2.
+func ipv6Fragment(id uint32, offset int, more bool, payload []byte) []byte {
+	frame := make([]byte, 14+40+8, 14+40+8+len(payload))
+	binary.BigEndian.PutUint16(frame[12:14], 0x86dd)
+
+	ip := frame[14:]
+	ip[0] = 0x60
+	binary.BigEndian.PutUint16(ip[4:6], uint16(8+len(payload)))
+	ip[6] = 44
+	ip[7] = 64
+	copy(ip[8:24], []byte{0x20, 0x01, 0x0d, 0xb8, 15: 1})
+	copy(ip[24:40], []byte{0x20, 0x01, 0x0d, 0xb8, 15: 2})
+
+	frag := ip[40:]
+	frag[0] = 17
+	flagsOffset := uint16(offset)
+	if more {
+		flagsOffset |= 1
+	}
+	binary.BigEndian.PutUint16(frag[2:4], flagsOffset)
+	binary.BigEndian.PutUint32(frag[4:8], id)
+
+	return append(frame, payload...)
+}
+
+// udpDatagram builds a UDP datagram from port 53 to port 5353.
+func udpDatagram(payload []byte) []byte {
+	udp := make([]byte, 8, 8+len(payload))
+	binary.BigEndian.PutUint16(udp[0:2], 53)
+	binary.BigEndian.PutUint16(udp[2:4], 5353)
+	binary.BigEndian.PutUint16(udp[4:6], uint16(8+len(payload)))
+	return append(udp, payload...)
+}
+
+func TestDecodeFragmentedIPv4Udp(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	ci := &gopacket.CaptureInfo{Timestamp: time.Now()}
+	payload := testPayload(3000)
+	datagram := udpDatagram(payload)
+
+	d.OnPacket(ipv4Fragment(7, 1480, true, datagram[1480:2960]), ci)
+	d.OnPacket(ipv4Fragment(7, 0, true, datagram[:1480]), ci)
+	assert.Nil(t, udp.pkt)
+
+	d.OnPacket(ipv4Fragment(7, 2960, false, datagram[2960:]), ci)
+	if assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		assert.Equal(t, "10.0.0.1", udp.pkt.Tuple.SrcIP.String())
+		assert.Equal(t, uint16(53), udp.pkt.Tuple.SrcPort)
+		assert.Equal(t, "10.0.0.2", udp.pkt.Tuple.DstIP.String())
+		assert.Equal(t, uint16(5353), udp.pkt.Tuple.DstPort)
+		assert.Equal(t, payload, udp.pkt.Payload)
+	}
+}
+
+func TestDecodeFragmentedIPv6Udp(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	ci := &gopacket.CaptureInfo{Timestamp: time.Now()}
+	payload := testPayload(2000)
+	datagram := udpDatagram(payload)
+
+	d.OnPacket(ipv6Fragment(7, 0, true, datagram[:1232]), ci)
+	assert.Nil(t, udp.pkt)
+
+	d.OnPacket(ipv6Fragment(7, 1232, false, datagram[1232:]), ci)
+	if assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		assert.Equal(t, "2001:db8::1", udp.pkt.Tuple.SrcIP.String())
+		assert.Equal(t, "2001:db8::2", udp.pkt.Tuple.DstIP.String())
+		assert.Equal(t, uint16(5353), udp.pkt.Tuple.DstPort)
+		assert.Equal(t, payload, udp.pkt.Payload)
+	}
+}
+
+func TestDecodeFragmentsDefragDisabled(t *testing.T) {
+	disabled := false
+	udp := &TestUDPProcessor{}
+	d, err := New(nil, "", layers.LinkTypeEthernet, nil, nil, &TestTCPProcessor{}, udp, &config.Defrag{Enabled: &disabled}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ci := &gopacket.CaptureInfo{Timestamp: time.Now()}
+	datagram := udpDatagram(testPayload(100))
+
+	d.OnPacket(ipv4Fragment(7, 0, true, datagram[:64]), ci)
+	d.OnPacket(ipv4Fragment(7, 64, false, datagram[64:]), ci)
+	assert.Nil(t, udp.pkt)
+}
@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"sync"
+
+	"github.com/tsg/gopacket/layers"
+
+	"github.com/elastic/beats/packetbeat/flows"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+	"github.com/elastic/beats/packetbeat/protos/udp"
+)
+
+// Dispatcher serializes the processing of TCP and UDP packets by the decoders
+// of different interfaces. The protocol plugins are shared by all decoders
+// and are not safe for concurrent use. The zero value is ready to use.
+type Dispatcher struct {
+	mu sync.Mutex
+}
+
+type lockedTCPProcessor struct {
+	mu   *sync.Mutex
+	proc tcp.Processor
+}
+
+type lockedUDPProcessor struct {
+	mu   *sync.Mutex
+	proc udp.Processor
+}
+
+// TCP returns a processor passing packets to proc while holding the
+// dispatcher lock.
+func (d *Dispatcher) TCP(proc tcp.Processor) tcp.Processor {
+	return &lockedTCPProcessor{mu: &d.mu, proc: proc}
+}
+
+// UDP returns a processor passing packets to proc while holding the
+// dispatcher lock.
+func (d *Dispatcher) UDP(proc udp.Processor) udp.Processor {
+	return &lockedUDPProcessor{mu: &d.mu, proc: proc}
+}
+
+func (p *lockedTCPProcessor) Process(id *flows.FlowID, hdr *layers.TCP, pkt *protos.Packet) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.proc.Process(id, hdr, pkt)
+}
+
+func (p *lockedUDPProcessor) Process(id *flows.FlowID, pkt *protos.Packet) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.proc.Process(id, pkt)
+}
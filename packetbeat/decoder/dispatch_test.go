@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package decoder
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsg/gopacket/layers"
+
+	"github.com/elastic/beats/packetbeat/flows"
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+// sharedPlugin mimics a protocol plugin shared by the decoders of all
+// interfaces, it is not safe for concurrent use.
+type sharedPlugin struct {
+	packets map[string]int
+}
+
+type sharedTCP struct{ *sharedPlugin }
+
+func (p sharedTCP) Process(id *flows.FlowID, hdr *layers.TCP, pkt *protos.Packet) {
+	p.packets[pkt.Tuple.Interface]++
+}
+
+type sharedUDP struct{ *sharedPlugin }
+
+func (p sharedUDP) Process(id *flows.FlowID, pkt *protos.Packet) {
+	p.packets[pkt.Tuple.Interface]++
+}
+
+func TestDispatcherSerializesWorkers(t *testing.T) {
+	const packets = 1000
+
+	plugin := &sharedPlugin{packets: map[string]int{}}
+	var dispatcher Dispatcher
+
+	var wg sync.WaitGroup
+	for _, iface := range []string{"eth0", "eth1"} {
+		// Flows are disabled, so the decoders don't serialize on the flows table.
+		d, err := New(nil, iface, layers.LinkTypeEthernet, nil, nil,
+			dispatcher.TCP(sharedTCP{plugin}), dispatcher.UDP(sharedUDP{plugin}), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < packets; i++ {
+				decodeFrame(d, ipv4TcpDNS)
+				decodeFrame(d, ethernetFrame(0x0800, innerDNS()))
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, map[string]int{"eth0": 2 * packets, "eth1": 2 * packets}, plugin.packets)
+}
@@ -0,0 +1,252 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+
+	"github.com/elastic/beats/packetbeat/config"
+)
+
+var (
+	LayerTypeVXLAN  = gopacket.RegisterLayerType(1500, gopacket.LayerTypeMetadata{Name: "VXLAN"})
+	LayerTypeGENEVE = gopacket.RegisterLayerType(1501, gopacket.LayerTypeMetadata{Name: "GENEVE"})
+)
+
+var (
+	defaultVXLANPorts  = []int{4789}
+	defaultGENEVEPorts = []int{6081}
+)
+
+// ethernetTypeTransparentBridging is used by GRE and GENEVE to encapsulate
+// Ethernet frames.
+const ethernetTypeTransparentBridging layers.EthernetType = 0x6558
+
+var errTunnelTooShort = errors.New("tunnel header too short")
+
+// udpTunnels returns the layer types of the tunnels received on each UDP port.
+func udpTunnels(cfg *config.Tunnels) map[uint16]gopacket.LayerType {
+	vxlanPorts, genevePorts := defaultVXLANPorts, defaultGENEVEPorts
+	if cfg != nil {
+		if cfg.VXLANPorts != nil {
+			vxlanPorts = cfg.VXLANPorts
+		}
+		if cfg.GENEVEPorts != nil {
+			genevePorts = cfg.GENEVEPorts
+		}
+	}
+
+	ports := map[uint16]gopacket.LayerType{}
+	for _, port := range vxlanPorts {
+		ports[uint16(port)] = LayerTypeVXLAN
+	}
+	for _, port := range genevePorts {
+		ports[uint16(port)] = LayerTypeGENEVE
+	}
+	return ports
+}
+
+// TunnelsBpfFilter returns the BPF filter expressions matching the tunneled
+// traffic decapsulated with the given configuration. The mpls expression
+// changes the decoding offsets of the expressions following it, so it is
+// always the last one.
+func TunnelsBpfFilter(cfg *config.Tunnels) []string {
+	var ports []int
+	for port := range udpTunnels(cfg) {
+		ports = append(ports, int(port))
+	}
+	sort.Ints(ports)
+
+	expressions := []string{"ip proto 47", "ip6 proto 47"}
+	for _, port := range ports {
+		expressions = append(expressions, fmt.Sprintf("udp port %d", port))
+	}
+	return append(expressions, "mpls")
+}
+
+// tunnelPayloadType returns the layer type of the payload of tunnels
+// identifying it with an Ethernet type, like GRE and GENEVE.
+func tunnelPayloadType(protocol layers.EthernetType) gopacket.LayerType {
+	if protocol == ethernetTypeTransparentBridging {
+		return layers.LayerTypeEthernet
+	}
+	return protocol.LayerType()
+}
+
+// greLayer decodes GRE headers (RFC 2784 and RFC 2890). Only version 0 is
+// supported, enhanced GRE as used by PPTP is not decapsulated.
+type greLayer struct {
+	layers.BaseLayer
+	Protocol   layers.EthernetType
+	Version    uint8
+	KeyPresent bool
+	Key        uint32
+}
+
+func (g *greLayer) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if len(data) < 4 {
+		return errTunnelTooShort
+	}
+
+	checksumPresent := data[0]&0x80 != 0
+	g.KeyPresent = data[0]&0x20 != 0
+	seqPresent := data[0]&0x10 != 0
+	g.Version = data[1] & 0x7
+	g.Protocol = layers.EthernetType(binary.BigEndian.Uint16(data[2:4]))
+
+	size := 4
+	if checksumPresent {
+		size += 4
+	}
+	g.Key = 0
+	if g.KeyPresent {
+		if len(data) < size+4 {
+			return errTunnelTooShort
+		}
+		g.Key = binary.BigEndian.Uint32(data[size : size+4])
+		size += 4
+	}
+	if seqPresent {
+		size += 4
+	}
+	if len(data) < size {
+		return errTunnelTooShort
+	}
+
+	g.BaseLayer = layers.BaseLayer{Contents: data[:size], Payload: data[size:]}
+	return nil
+}
+
+func (g *greLayer) CanDecode() gopacket.LayerClass {
+	return layers.LayerTypeGRE
+}
+
+func (g *greLayer) NextLayerType() gopacket.LayerType {
+	if g.Version != 0 {
+		return gopacket.LayerTypePayload
+	}
+	return tunnelPayloadType(g.Protocol)
+}
+
+// vxlanLayer decodes VXLAN headers (RFC 7348), always followed by an
+// Ethernet frame.
+type vxlanLayer struct {
+	layers.BaseLayer
+	VNI uint32
+}
+
+func (v *vxlanLayer) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if len(data) < 8 {
+		return errTunnelTooShort
+	}
+	if data[0]&0x08 == 0 {
+		return errors.New("VXLAN header without a valid VNI")
+	}
+
+	v.VNI = binary.BigEndian.Uint32(data[4:8]) >> 8
+	v.BaseLayer = layers.BaseLayer{Contents: data[:8], Payload: data[8:]}
+	return nil
+}
+
+func (v *vxlanLayer) CanDecode() gopacket.LayerClass {
+	return LayerTypeVXLAN
+}
+
+func (v *vxlanLayer) NextLayerType() gopacket.LayerType {
+	return layers.LayerTypeEthernet
+}
+
+// geneveLayer decodes GENEVE headers (RFC 8926), options are skipped.
+type geneveLayer struct {
+	layers.BaseLayer
+	Protocol layers.EthernetType
+	VNI      uint32
+}
+
+func (g *geneveLayer) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if len(data) < 8 {
+		return errTunnelTooShort
+	}
+	if version := data[0] >> 6; version != 0 {
+		return fmt.Errorf("unsupported GENEVE version %d", version)
+	}
+
+	size := 8 + int(data[0]&0x3f)*4
+	if len(data) < size {
+		return errTunnelTooShort
+	}
+
+	g.Protocol = layers.EthernetType(binary.BigEndian.Uint16(data[2:4]))
+	g.VNI = binary.BigEndian.Uint32(data[4:8]) >> 8
+	g.BaseLayer = layers.BaseLayer{Contents: data[:size], Payload: data[size:]}
+	return nil
+}
+
+func (g *geneveLayer) CanDecode() gopacket.LayerClass {
+	return LayerTypeGENEVE
+}
+
+func (g *geneveLayer) NextLayerType() gopacket.LayerType {
+	return tunnelPayloadType(g.Protocol)
+}
+
+// mplsLayer decodes a MPLS label stack entry. MPLS doesn't identify the
+// payload, so after the bottom of the stack it is guessed from the IP version.
+type mplsLayer struct {
+	layers.BaseLayer
+	Label       uint32
+	StackBottom bool
+}
+
+func (m *mplsLayer) DecodeFromBytes(data []byte, df gopacket.DecodeFeedback) error {
+	if len(data) < 4 {
+		return errTunnelTooShort
+	}
+
+	entry := binary.BigEndian.Uint32(data[:4])
+	m.Label = entry >> 12
+	m.StackBottom = entry&0x100 != 0
+	m.BaseLayer = layers.BaseLayer{Contents: data[:4], Payload: data[4:]}
+	return nil
+}
+
+func (m *mplsLayer) CanDecode() gopacket.LayerClass {
+	return layers.LayerTypeMPLS
+}
+
+func (m *mplsLayer) NextLayerType() gopacket.LayerType {
+	if !m.StackBottom {
+		return layers.LayerTypeMPLS
+	}
+	if len(m.Payload) == 0 {
+		return gopacket.LayerTypePayload
+	}
+	switch m.Payload[0] >> 4 {
+	case 4:
+		return layers.LayerTypeIPv4
+	case 6:
+		return layers.LayerTypeIPv6
+	}
+	return gopacket.LayerTypePayload
+}
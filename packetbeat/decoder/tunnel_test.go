@@ -0,0 +1,193 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package decoder
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/config"
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+func ethernetFrame(etherType uint16, payload []byte) []byte {
+	frame := make([]byte, 14, 14+len(payload))
+	copy(frame[0:6], []byte{0, 0x0c, 0x29, 1, 2, 3})
+	copy(frame[6:12], []byte{0, 0x0c, 0x29, 4, 5, 6})
+	binary.BigEndian.PutUint16(frame[12:14], etherType)
+	return append(frame, payload...)
+}
+
+func ipv4Packet(proto byte, src, dst []byte, payload []byte) []byte {
+	ip := make([]byte, 20, 20+len(payload))
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:4], uint16(20+len(payload)))
+	ip[8] = 64
+	ip[9] = proto
+	copy(ip[12:16], src)
+	copy(ip[16:20], dst)
+	return append(ip, payload...)
+}
+
+func udpPacket(src, dst uint16, payload []byte) []byte {
+	udp := make([]byte, 8, 8+len(payload))
+	binary.BigEndian.PutUint16(udp[0:2], src)
+	binary.BigEndian.PutUint16(udp[2:4], dst)
+	binary.BigEndian.PutUint16(udp[4:6], uint16(8+len(payload)))
+	return append(udp, payload...)
+}
+
+var (
+	outerSrc = []byte{10, 0, 0, 1}
+	outerDst = []byte{10, 0, 0, 2}
+	innerSrc = []byte{192, 168, 0, 1}
+	innerDst = []byte{192, 168, 0, 2}
+)
+
+// innerDNS is an IPv4 packet with a UDP datagram for port 53.
+func innerDNS() []byte {
+	return ipv4Packet(17, innerSrc, innerDst, udpPacket(40000, 53, []byte("query")))
+}
+
+func checkInnerDNS(t *testing.T, udp *TestUDPProcessor, tunnel common.Tunnel) {
+	if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		return
+	}
+	assert.Equal(t, "192.168.0.1", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(40000), udp.pkt.Tuple.SrcPort)
+	assert.Equal(t, "192.168.0.2", udp.pkt.Tuple.DstIP.String())
+	assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+	assert.Equal(t, []byte("query"), udp.pkt.Payload)
+	assert.Equal(t, tunnel, udp.pkt.Tuple.Tunnel)
+}
+
+func decodeFrame(d *Decoder, frame []byte) {
+	d.OnPacket(frame, &gopacket.CaptureInfo{Timestamp: time.Now(), Length: len(frame)})
+}
+
+func newTunnelTestDecoder(t *testing.T) (*Decoder, *TestTCPProcessor, *TestUDPProcessor) {
+	tcp := &TestTCPProcessor{}
+	udp := &TestUDPProcessor{}
+	d, err := New(nil, "", layers.LinkTypeEthernet, nil, nil, tcp, udp, nil, &config.Tunnels{})
+	if err != nil {
+		t.Fatalf("Error creating decoder %v", err)
+	}
+	return d, tcp, udp
+}
+
+func TestDecodeVXLAN(t *testing.T) {
+	vxlan := []byte{0x08, 0, 0, 0, 0, 0x13, 0x89, 0}
+	frame := ethernetFrame(0x0800, ipv4Packet(17, outerSrc, outerDst,
+		udpPacket(55555, 4789, append(vxlan, ethernetFrame(0x0800, innerDNS())...))))
+
+	d, _, udp := newTunnelTestDecoder(t)
+	decodeFrame(d, frame)
+	checkInnerDNS(t, udp, common.Tunnel{Type: common.TunnelVXLAN, ID: 5001, HasID: true})
+}
+
+func TestDecodeGENEVE(t *testing.T) {
+	// One 4 bytes option, followed by an Ethernet frame.
+	geneve := []byte{0x01, 0, 0x65, 0x58, 0, 0, 0x2a, 0, 1, 2, 3, 0}
+	frame := ethernetFrame(0x0800, ipv4Packet(17, outerSrc, outerDst,
+		udpPacket(55555, 6081, append(geneve, ethernetFrame(0x0800, innerDNS())...))))
+
+	d, _, udp := newTunnelTestDecoder(t)
+	decodeFrame(d, frame)
+	checkInnerDNS(t, udp, common.Tunnel{Type: common.TunnelGENEVE, ID: 42, HasID: true})
+}
+
+func TestDecodeGRE(t *testing.T) {
+	// GRE with key and sequence number, carrying IPv4.
+	gre := []byte{0x30, 0, 0x08, 0, 0, 0, 0, 7, 0, 0, 0, 1}
+	frame := ethernetFrame(0x0800, ipv4Packet(47, outerSrc, outerDst, append(gre, innerDNS()...)))
+
+	d, _, udp := newTunnelTestDecoder(t)
+	decodeFrame(d, frame)
+	checkInnerDNS(t, udp, common.Tunnel{Type: common.TunnelGRE, ID: 7, HasID: true})
+
+	// GRE without key, carrying an Ethernet frame.
+	gre = []byte{0, 0, 0x65, 0x58}
+	frame = ethernetFrame(0x0800, ipv4Packet(47, outerSrc, outerDst,
+		append(gre, ethernetFrame(0x0800, innerDNS())...)))
+
+	d, _, udp = newTunnelTestDecoder(t)
+	decodeFrame(d, frame)
+	checkInnerDNS(t, udp, common.Tunnel{Type: common.TunnelGRE})
+}
+
+func TestDecodeMPLS(t *testing.T) {
+	// Two labels, 100 and 200.
+	labels := []byte{0, 0x06, 0x40, 64, 0, 0x0c, 0x81, 64}
+	frame := ethernetFrame(0x8847, append(labels, innerDNS()...))
+
+	d, _, udp := newTunnelTestDecoder(t)
+	decodeFrame(d, frame)
+	checkInnerDNS(t, udp, common.Tunnel{Type: common.TunnelMPLS, ID: 100, HasID: true})
+}
+
+func TestDecodeTunnelsDisabled(t *testing.T) {
+	vxlan := []byte{0x08, 0, 0, 0, 0, 0x13, 0x89, 0}
+	frame := ethernetFrame(0x0800, ipv4Packet(17, outerSrc, outerDst,
+		udpPacket(55555, 4789, append(vxlan, ethernetFrame(0x0800, innerDNS())...))))
+
+	disabled := false
+	for _, tunnels := range []*config.Tunnels{nil, {Enabled: &disabled}} {
+		udp := &TestUDPProcessor{}
+		d, err := New(nil, "", layers.LinkTypeEthernet, nil, nil, &TestTCPProcessor{}, udp,
+			nil, tunnels)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decodeFrame(d, frame)
+
+		// The outer UDP datagram is passed as is.
+		if assert.NotNil(t, udp.pkt) {
+			assert.Equal(t, uint16(4789), udp.pkt.Tuple.DstPort)
+			assert.Equal(t, common.TunnelNone, udp.pkt.Tuple.Tunnel.Type)
+		}
+	}
+}
+
+func TestTunnelsBpfFilter(t *testing.T) {
+	assert.Equal(t,
+		[]string{"ip proto 47", "ip6 proto 47", "udp port 4789", "udp port 6081", "mpls"},
+		TunnelsBpfFilter(nil))
+	assert.Equal(t,
+		[]string{"ip proto 47", "ip6 proto 47", "udp port 4789", "udp port 8472", "mpls"},
+		TunnelsBpfFilter(&config.Tunnels{VXLANPorts: []int{8472, 4789}, GENEVEPorts: []int{}}))
+
+	tunnels := TunnelsBpfFilter(&config.Tunnels{GENEVEPorts: []int{}})
+	assert.Equal(t,
+		"ip proto 47 or ip6 proto 47 or udp port 4789 or mpls",
+		protos.Protos.BpfFilter(false, false, tunnels...))
+
+	// The mpls keyword changes the decoding offsets of the following
+	// expressions, only the VLAN encapsulated expressions use it.
+	assert.Equal(t,
+		"ip proto 47 or ip6 proto 47 or udp port 4789 or ether proto 0x8847 or "+
+			"(vlan and (ip proto 47 or ip6 proto 47 or udp port 4789 or mpls))",
+		protos.Protos.BpfFilter(true, false, tunnels...))
+}
@@ -22,6 +22,8 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"net"
+
+	"github.com/elastic/beats/libbeat/common"
 )
 
 type FlowID struct {
@@ -33,6 +35,9 @@ type rawFlowID struct {
 	flowID []byte
 	flowIDMeta
 	dir flowDirection
+
+	// type and code of the ICMP message, not part of the flow id
+	icmpType, icmpCode uint8
 }
 
 type flowIDMeta struct {
@@ -51,10 +56,15 @@ type flowIDMeta struct {
 	offUDP        uint8
 	offTCP        uint8
 	offID         uint8
+	offTunnel     uint8
 
 	cntEth  uint8
 	cntVlan uint8
 	cntIP   uint8
+
+	// interface the packets were captured on. It is not serialized, but
+	// separates flows with the same addresses seen on different interfaces.
+	iface string
 }
 
 type FlowIDFlag uint16
@@ -72,6 +82,7 @@ const (
 	UDPFlow
 	TCPFlow
 	ConnectionID
+	TunnelFlow
 )
 
 const (
@@ -90,13 +101,15 @@ const (
 	SizeTCPFlowID    = 2 * SizePortNumber // source + dest port
 	SizeUDPFlowID    = 2 * SizePortNumber // source + dest port
 	SizeConnectionID = 8                  // 64bit internal connection id
+	SizeTunnelFlowID = 1 + 1 + 4          // tunnel type + has id + tunnel id
 
 	SizeFlowIDMax int = SizeEthFlowID +
 		2*(SizeVlanFlowID+SizeIPv4FlowID+SizeIPv6FlowID) +
 		SizeICMPFlowID +
 		SizeTCPFlowID +
 		SizeUDPFlowID +
-		SizeConnectionID
+		SizeConnectionID +
+		SizeTunnelFlowID
 )
 
 const offUnset uint8 = 0xff
@@ -116,6 +129,7 @@ var flowIDEmptyMeta = flowIDMeta{
 	offUDP:        offUnset,
 	offTCP:        offUnset,
 	offID:         offUnset,
+	offTunnel:     offUnset,
 
 	cntEth:  0,
 	cntVlan: 0,
@@ -150,9 +164,15 @@ func (f *FlowID) Reset(buf []byte) {
 	f.flowID = buf
 	f.flowIDMeta = flowIDEmptyMeta
 	f.dir = flowDirUnset
+	f.icmpType, f.icmpCode = 0, 0
 	f.flow.stats = nil
 }
 
+// SetInterface records the name of the interface the packet was captured on.
+func (f *FlowID) SetInterface(name string) {
+	f.iface = name
+}
+
 func (f *FlowID) AddEth(src, dst net.HardwareAddr) {
 	debugf("flowid: add eth")
 	f.addID(&f.offEth, EthFlow, src, dst, flowDirUnset)
@@ -220,6 +240,12 @@ func (f *FlowID) AddICMPv6Response(id uint16) {
 	f.addID(&f.offICMPv6, ICMPv6Flow, tmp[:], nil, flowDirReversed)
 }
 
+// SetICMPMessage records the type and code of an ICMP message. A flow keeps
+// the ones of the first message seen.
+func (f *FlowID) SetICMPMessage(typ, code uint8) {
+	f.icmpType, f.icmpCode = typ, code
+}
+
 func (f *FlowID) AddUDP(src, dst uint16) {
 	debugf("flowid: add udp")
 
@@ -240,6 +266,19 @@ func (f *FlowID) AddConnectionID(id uint64) {
 	f.addID(&f.offID, ConnectionID, tmp[:], nil, flowDirUnset)
 }
 
+// AddTunnel adds the outermost tunnel the packet was encapsulated in.
+func (f *FlowID) AddTunnel(t common.Tunnel) {
+	debugf("flowid: add tunnel")
+
+	var tmp [SizeTunnelFlowID]byte
+	tmp[0] = byte(t.Type)
+	if t.HasID {
+		tmp[1] = 1
+	}
+	binary.LittleEndian.PutUint32(tmp[2:], t.ID)
+	f.addID(&f.offTunnel, TunnelFlow, tmp[:], nil, flowDirUnset)
+}
+
 func (f *FlowID) addMultLayerID(
 	off, outerOff *uint8,
 	flag, outerFlag FlowIDFlag,
@@ -333,7 +372,7 @@ func (f rawFlowID) clone() rawFlowID {
 }
 
 func FlowIDsEqual(f1, f2 *FlowID) bool {
-	return f1.flags == f2.flags && bytes.Equal(f1.flowID, f2.flowID)
+	return f1.flags == f2.flags && f1.iface == f2.iface && bytes.Equal(f1.flowID, f2.flowID)
 }
 
 func (f *rawFlowID) Flags() FlowIDFlag {
@@ -364,6 +403,8 @@ func (f *rawFlowID) Get(i FlowIDFlag) []byte {
 		return f.UDP()
 	case TCPFlow:
 		return f.TCP()
+	case TunnelFlow:
+		return f.Tunnel()
 	default:
 		return nil
 	}
@@ -392,6 +433,10 @@ func (f *rawFlowID) Serialize() []byte {
 		f.cntVlan,
 		f.cntIP,
 	})
+	// only present for tunneled flows, to not change the ID of other flows
+	if f.flags&TunnelFlow != 0 {
+		enc.Write([]byte{f.offTunnel})
+	}
 	enc.Write(f.flowID)
 	enc.Close()
 
@@ -474,6 +519,30 @@ func (f *rawFlowID) ConnectionID() []byte {
 	return f.extractID(f.offID, SizeConnectionID)
 }
 
+func (f *rawFlowID) Tunnel() []byte {
+	return f.extractID(f.offTunnel, SizeTunnelFlowID)
+}
+
+func (f *rawFlowID) TunnelInfo() (common.Tunnel, bool) {
+	raw := f.Tunnel()
+	if raw == nil {
+		return common.Tunnel{}, false
+	}
+	return common.Tunnel{
+		Type:  common.TunnelType(raw[0]),
+		HasID: raw[1] != 0,
+		ID:    binary.LittleEndian.Uint32(raw[2:]),
+	}, true
+}
+
+func (f *rawFlowID) ICMPMessage() (typ, code uint8) {
+	return f.icmpType, f.icmpCode
+}
+
+func (f *rawFlowID) Interface() string {
+	return f.iface
+}
+
 func (f *rawFlowID) extractID(off, sz uint8) []byte {
 	if off == offUnset {
 		return nil
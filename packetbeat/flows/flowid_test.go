@@ -24,8 +24,12 @@ import (
 	"encoding/binary"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/flowhash"
 )
 
 type applyAddr func(f *FlowID)
@@ -297,3 +301,82 @@ func TestSimilarWithOffsets(t *testing.T) {
 	assert.Equal(t, id1.flags, id2.flags)
 	assert.NotEqual(t, id1.flowIDMeta, id2.flowIDMeta)
 }
+
+func TestFlowIDTunnel(t *testing.T) {
+	ip1 := []byte{127, 0, 0, 1}
+	ip2 := []byte{128, 0, 1, 2}
+	tunnel := common.Tunnel{Type: common.TunnelVXLAN, ID: 5001, HasID: true}
+
+	plain := newFlowID()
+	addIP(ip1, ip2)(plain)
+
+	tunneled := newFlowID()
+	addIP(ip1, ip2)(tunneled)
+	tunneled.AddTunnel(tunnel)
+
+	other := newFlowID()
+	addIP(ip1, ip2)(other)
+	other.AddTunnel(common.Tunnel{Type: common.TunnelVXLAN, ID: 5002, HasID: true})
+
+	assert.False(t, FlowIDsEqual(plain, tunneled))
+	assert.False(t, FlowIDsEqual(tunneled, other))
+	assert.NotEqual(t, plain.Serialize(), tunneled.Serialize())
+
+	_, ok := plain.TunnelInfo()
+	assert.False(t, ok)
+
+	info, ok := tunneled.TunnelInfo()
+	assert.True(t, ok)
+	assert.Equal(t, tunnel, info)
+
+	event := createEvent(time.Now(), &biFlow{id: tunneled.rawFlowID}, false, nil, nil, nil)
+	assert.Equal(t, common.MapStr{"type": "vxlan", "id": uint32(5001)}, event.Fields["tunnel"])
+}
+
+func TestFlowIDInterface(t *testing.T) {
+	ip1 := []byte{127, 0, 0, 1}
+	ip2 := []byte{128, 0, 1, 2}
+
+	eth0 := newFlowID()
+	eth0.SetInterface("eth0")
+	addIP(ip1, ip2)(eth0)
+
+	eth1 := newFlowID()
+	eth1.SetInterface("eth1")
+	addIP(ip1, ip2)(eth1)
+
+	assert.False(t, FlowIDsEqual(eth0, eth1))
+	assert.Equal(t, eth0.Serialize(), eth1.Serialize())
+
+	table := &flowMetaTable{table: make(map[flowIDMeta]*flowTable)}
+	table.get(eth0, &counterReg{})
+	table.get(eth1, &counterReg{})
+	assert.Len(t, table.table, 2)
+
+	event := createEvent(time.Now(), &biFlow{id: eth1.rawFlowID}, false, nil, nil, nil)
+	assert.Equal(t, common.MapStr{"name": "eth1"}, event.Fields["interface"])
+}
+
+func TestFlowCommunityID(t *testing.T) {
+	hasher := flowhash.NewCommunityID(0)
+
+	tcp := newFlowID()
+	tcp.AddIPv4(net.ParseIP("66.35.250.204").To4(), net.ParseIP("128.232.110.120").To4())
+	tcp.AddTCP(80, 34855)
+	flow, ok := communityIDFlow(&tcp.rawFlowID)
+	assert.True(t, ok)
+	assert.Equal(t, "1:LQU9qZlK+B5F3KDmev6m5PMibrg=", hasher.Hash(flow))
+
+	icmp := newFlowID()
+	icmp.AddIPv4(net.ParseIP("192.168.0.89").To4(), net.ParseIP("192.168.0.1").To4())
+	icmp.AddICMPv4Request(1)
+	icmp.SetICMPMessage(8, 0)
+	flow, ok = communityIDFlow(&icmp.rawFlowID)
+	assert.True(t, ok)
+	assert.Equal(t, "1:X0snYXpgwiv9TZtqg64sgzUn6Dk=", hasher.Hash(flow))
+
+	ipOnly := newFlowID()
+	ipOnly.AddIPv4(net.ParseIP("192.168.0.89").To4(), net.ParseIP("192.168.0.1").To4())
+	_, ok = communityIDFlow(&ipOnly.rawFlowID)
+	assert.False(t, ok)
+}
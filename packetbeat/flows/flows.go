@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common/flowhash"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/packetbeat/config"
 )
@@ -41,7 +42,9 @@ const (
 	defaultPeriod  = 10 * time.Second
 )
 
-func NewFlows(pub Reporter, config *config.Flows) (*Flows, error) {
+// NewFlows creates the flows table and the worker reporting flows. The
+// Community ID is not added to the flows if communityID is nil.
+func NewFlows(pub Reporter, config *config.Flows, communityID *flowhash.CommunityID) (*Flows, error) {
 	duration := func(s string, d time.Duration) (time.Duration, error) {
 		if s == "" {
 			return d, nil
@@ -67,7 +70,7 @@ func NewFlows(pub Reporter, config *config.Flows) (*Flows, error) {
 
 	counter := &counterReg{}
 
-	worker, err := newFlowsWorker(pub, table, counter, timeout, period)
+	worker, err := newFlowsWorker(pub, table, counter, timeout, period, communityID)
 	if err != nil {
 		logp.Err("failed to configure flows processing intervals: %v", err)
 		return nil, err
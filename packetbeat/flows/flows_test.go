@@ -50,7 +50,7 @@ func TestFlowsCounting(t *testing.T) {
 	port1 := []byte{0, 1}
 	port2 := []byte{0, 2}
 
-	module, err := NewFlows(nil, &config.Flows{})
+	module, err := NewFlows(nil, &config.Flows{}, nil)
 	assert.NoError(t, err)
 
 	uint1, err := module.NewUint("uint1")
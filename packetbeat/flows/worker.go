@@ -26,13 +26,15 @@ import (
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/flowhash"
 )
 
 type flowsProcessor struct {
-	spool    spool
-	table    *flowMetaTable
-	counters *counterReg
-	timeout  time.Duration
+	spool       spool
+	table       *flowMetaTable
+	counters    *counterReg
+	timeout     time.Duration
+	communityID *flowhash.CommunityID
 }
 
 var (
@@ -45,6 +47,7 @@ func newFlowsWorker(
 	table *flowMetaTable,
 	counters *counterReg,
 	timeout, period time.Duration,
+	communityID *flowhash.CommunityID,
 ) (*worker, error) {
 	oneSecond := 1 * time.Second
 
@@ -81,9 +84,10 @@ func newFlowsWorker(
 
 	defaultBatchSize := 1024
 	processor := &flowsProcessor{
-		table:    table,
-		counters: counters,
-		timeout:  timeout,
+		table:       table,
+		counters:    counters,
+		timeout:     timeout,
+		communityID: communityID,
 	}
 	processor.spool.init(pub, defaultBatchSize)
 
@@ -193,6 +197,11 @@ func (fw *flowsProcessor) report(
 	intNames, uintNames, floatNames []string,
 ) {
 	event := createEvent(ts, flow, isOver, intNames, uintNames, floatNames)
+	if fw.communityID != nil {
+		if f, ok := communityIDFlow(&flow.id); ok {
+			event.Fields["community_id"] = fw.communityID.Hash(f)
+		}
+	}
 
 	debugf("add event: %v", event)
 	fw.spool.publish(event)
@@ -229,6 +238,16 @@ func createEvent(
 		fields["vlan"] = binary.LittleEndian.Uint16(vlan)
 	}
 
+	// add capture interface
+	if iface := f.id.Interface(); iface != "" {
+		fields["interface"] = common.MapStr{"name": iface}
+	}
+
+	// add tunnel
+	if tunnel, ok := f.id.TunnelInfo(); ok {
+		fields["tunnel"] = tunnel.Fields()
+	}
+
 	// add icmp
 	if icmp := f.id.ICMPv4(); icmp != nil {
 		fields["icmp_id"] = binary.LittleEndian.Uint16(icmp)
@@ -290,6 +309,40 @@ func createEvent(
 	}
 }
 
+// communityIDFlow returns the innermost addresses and the transport of a flow,
+// as needed to compute its Community ID.
+func communityIDFlow(id *rawFlowID) (flowhash.Flow, bool) {
+	var flow flowhash.Flow
+
+	if src, dst, ok := id.IPv4Addr(); ok {
+		flow.SourceIP, flow.DestinationIP = net.IP(src), net.IP(dst)
+	} else if src, dst, ok := id.IPv6Addr(); ok {
+		flow.SourceIP, flow.DestinationIP = net.IP(src), net.IP(dst)
+	} else {
+		return flow, false
+	}
+
+	if src, dst, ok := id.TCPAddr(); ok {
+		flow.Protocol = flowhash.TCP
+		flow.SourcePort = binary.LittleEndian.Uint16(src)
+		flow.DestinationPort = binary.LittleEndian.Uint16(dst)
+	} else if src, dst, ok := id.UDPAddr(); ok {
+		flow.Protocol = flowhash.UDP
+		flow.SourcePort = binary.LittleEndian.Uint16(src)
+		flow.DestinationPort = binary.LittleEndian.Uint16(dst)
+	} else if id.ICMPv4() != nil {
+		flow.Protocol = flowhash.ICMP
+		flow.ICMP.Type, flow.ICMP.Code = id.ICMPMessage()
+	} else if id.ICMPv6() != nil {
+		flow.Protocol = flowhash.ICMPv6
+		flow.ICMP.Type, flow.ICMP.Code = id.ICMPMessage()
+	} else {
+		return flow, false
+	}
+
+	return flow, true
+}
+
 func encodeStats(
 	stats *flowStats,
 	ints, uints, floats []string,
@@ -31,5 +31,5 @@ func init() {
 
 // Asset returns asset data
 func Asset() string {
-	return "eJzsvW1z47ayIPw9vwLl51TFU48kz1vm7p3as7s+tifxPR6PY3tukrPnlgYiIQnXJMAAoGVla//7FhovBEmQEi3NS+p6PiSWRHY3Go3uRnej8d0Y3ZH1WzQjWH2HkKIqI2/R38ynlMhE0EJRzt6i//EdQgidcKYwZRIlPM85g/fQnJIslQjfY5rhWUYQZQhnGSL3hCmk1gWRk++QfeztdwBojBjOiUE80X/Ct1Gc+t/tksALiM+RWhKgEEnCUsoW8EXGFygnUuIFkRN0HjwFr1HpQUmiNIH694SzOV2UAmt0aE4zMtLf6x+xQvc4K/WbqJQkBZhU6Y+MqxAYvIKWXCqLyT5/ywFVjY6R/g2++qQ/fvJwOIy4m65Jm2kO42bGedqwRIKoUjCSotkaUPGCaDRsgeRaKpIjztBqSZNlRXjAO1EyRtkiQo2iOfmDsy2ocU9+TmruiZCUs83E2AedWIE4w+QvCNOkkBSpJZVGlCd10T34X3ooUuG8OLBAtay/RSlWjg+C/F5SQdK3SInSfTnnIseq9hx5wHmhl95xuSilQi/fqCV6+fzFmxF68fLtqx/e/vBq8urVy+24CyShlRFkYpehXiCCJFykaIVlNb7GoBReyH4sx2JGlcBiDc8abiVYqwKQ94IIM1GYpfBBCcwkTlQ1H4ZPDcRGO9T4yGf/SRK31syHqfnljqxXXKT9hHpdVUoiqjWlFZRB1qCACMFFjYCF4GXRj+RMv+Q0YGIwavnFaUr1szhDlM25XtkJlqC/AI+cOGGwWtEBdNRYZea/dzQp8qCCLzvIqkizcCYtBAlP29AzzhZDoGsgbdAaVgt0fc62gm7ExJqoJONlWtmoE/0RFYLf05ToYSqcYoXjZuu9/RXNBc8NJP+q1HNVqSCcplN4YOpA6icTIiUXnVZMPzqBtyYObHNhk2TD6r0MzFudwgm64lJSLbhgkyTCgmiAI7RIyAhxgVK6oApnPCGYTTppo0wqzBIypRuWzrl9EJ2fOpK0EUE5TpaUNZduDMNmy+RxhHZ9Oyz2gWkgZ57P6uUkJykt837s7w0IELFhyK2bQzOq1tPA5HkKSjkmWKrxi2SDIg0AIbCItLJ2VBpyqKzMXI/IgW70s+pJsb+MH7YXPfuKpuVHzhcZMSutG7sgi42m9hqe2TQ+u9BTntzB+rEr/dR9jgA3vyGpsNLqN8tIom02LHPzm16zcsmFmhoL8BbNcSb1pGGWLLlw+MZ+lX9XV8puyJ4sFLUPXXrc2gQiJjTdTSd+ZPT3klQAEU1jWt2jy2PmYxDGUC4AnPNOLQHakZiVNFOIsz5SAmXwSEpOPE4Nqw9Xhmckky1sNV8C9fsTG2g5B04YPF5otTBXIvuT+RQBcq6dgUBQtZVrqZ5KNvX3GyXT4h4ml7vPyU92W9GejT1JulEQESHHIllSRRJVij2MoQYOHZLJYoIe/tub6ZvXI4RFPkJFkYxQTgv5rE0Kl5Miw0q79LtR8uEGOUCWhoQwxeUIlbOSqXKEVpSlfNVBRH3H83gaLJwojjnOabbeGYUBYwcpSLrEaoRSMqOYjdBcEDKTad9oadEiofZVD/YLKpVWaOdXY5ymgkhJZBtBjpPdBunQLLFIV1iQCtkIlbLEWbZG749PQhqcHrkrZ0QwooistMnfw+8iaKvfvRtc92kroCjUJf1msXppowKqEY0GqaGCp3swDwEHCp4a3RZFVe6qmhqYNLyoapUFTvY3qApiG5nege2VgxpiBwu3Na7bITLQUI6LNibMGFcQ/9obugBkHOc+HZYAb1LzXfrQ7sFli+I1cN0+GiK3wUbafY5CvV0SSRrxDWQ0zIyXJrxJ2D0VnOWEqdDFdyMJAkB6qzrP+Ao8xwQX2uKmkw6tIom49w73lnFh844JSsHfKXwdkNDYyCQZJUxNd8VFGVXUhgv70Ol3aDIw2J3xBU1w5l5+3Oj2g3XbcdINYTON7PwKWQM4ZPbM+z6EypUmxi3s+PB3J6Zn2EPoEQRnG6k5r6G3mF2aI1xLVCKITT2sR2ZDDYvIw0lc+BMC64IuKMOZZUkw3Mr9eccF+un29moEuxIbQzDZjYA75EEJXG2ycS2w6mFpOGhJcErESHshKZnjMlPo06/jd1yssEhJqv/6NKn04UeWaQTVUPQIUyo14HSEqEI4W+G1REusRw6hsBGEmaneO6lkSSpbATkWP/+fYEiMM8MvywXZnL3TbaRpQXh8CrUY/Uj4+RVEfDXEILNgXp4M9o8ynmAVc+wXhE8LTlloB33c5/9kejg/vBihTFP2r/93O+NRjcChdeSHrHSCg25rM+XTfzWQnGVrROdozUstBJQRhGsPLJUq5Nujo9VqNSEZloomk4QfLUqakiPCjux3kuhd2lGRlQvK5FGOpSLiqJSULcaULYhUY5iYyVLl2f82g7hybut/IJCYghYkoybOVpmnPZDRJuAcvrHM9O4zMu/9hzaDQDq64AupsFzGRa3gQm1WXRleE4FeI/10EMVLzGLan/aCF7cjyT+qCVE84RkkRX24I6RBKzzGFZIFSeic6qWuliTIFSUFiJeUZU58MKCKtqZFg8wqKNxHYRD3DZXqYU33GXX4fn3z88UIXZOUSoi2X398/0z//0D7Mgdhbkd/4dVKI/lXo3JPU7sPK6kBDnMNtiIhKtC7odrW+xAkI1huIQWSz5XeoLs3QtuvfR74f9v0TgIgVLrsp4mN5jlViKZaPDCSJMdM0aQKqzgvHARlCqnZyhU/eKd94zP95UHcId/GHQcHmypJsnmXa30gFRZqqmhOHpe6/u23334bv38/Pj29/emnt+/fv725meQ0y+g/muvz5fMXP4yfvxi/fH374vXb52/ePv9h8vxfXvxjiyVKc+t+zKmQChU4uSPK6xAYpnYFZoQwJAlpSsGBVtl/mjHmXCokSKK9Myv0JB085rl28jb4lyylCdYbRDq3tQFU78Wlcp8Y4AHFDPD07xAdGlX1BB6cIFo56d01okwRkZNUL1FDqlT6T+0DNOnM+GqLLKQiQuM3Ep2iGdY84UxLPiNGYedEYbsCWFo5tTVs9xnekKA6Z4wImIJ/vzi+9M4uGC3KECNqxcWdnY4meF4qIqabkdyQhGtvdSiu+g6Sl8Jv5bYuU7gSvCBCUVJtbwBOmDvorESohUN73McbA/L98YkfFJaIWnmDaGRtJWv59aKdlEJo6QPRawdJto3wVhN5fnX/2o1yMDk1mBtJmz7OSz94/XzyLy9+GKHxv7yePH/x4mBnL50WUzPiT+EOD96o/HQklaC1Mg8UVq7A3h8rqsqUwJrKOFuYT5IUWDjeYTB2OMIQsx62nbHWqnjUxNVAbilTjs5vZvo8QZsnsQbSTOh+J5EW928eseTefKEld//msbP2xs/am30tuvs339Ky23beYgtv+PTtsvC+oUkMSPoGFl+wO9w0iWa6YIPIynxGxP5sLlTVtCcm8DY2EPcBEjVoRdXSyZXi+gVFma1t1p5dTrAsBcnDkByKOCQhbYyoqfWQpoor7/TWaW2UM24gF0REw3Kc5HPnhX3XScRsrcjnJQEwfNdwAzUTd3cCw6nYpyd4GsD9ltzBcLz/hXxCPexvwjTt4hEOnbu6kh4gW9+uW7hpHr9dp/ALLrxvyKkAYr6ZxbebX/jFl983NI8BSV99CW7vGoZG+Nv3D0P5Uty5i0/+4bb+YYiXJnmxObp68v4K0dTHHeGzibAG8x0UKdmI60bA5iwjztDtyVUYqaWpz35ALqWV/bgN8nC7JkFqhRKtXEjdlaaChMfTokmBjcH01ZKoJWmlN7VSoGzGS5aiQ5JTZRedKe94VjFNaMXXfq6qHXg2Qf9uTv/YfBNl9q0JuuSuwsIvkMIeGZqaI0OhM09Z8IGXqhFgVliVG84AwqlSuliijNyTzL4SSaca7bjCa72kE54XpSJQ4OEhmUO2KSkISyXizCX9IGk8QjM7nYLIMlO28iMnmIUWkzLzvlZVVdoQIHSkYjey6MPfgw9nwcFA/fnGlOY0vz4xpTXm6xpLc6KWfMOqubXZQ8zSo3siZkfmpShTq0odKJahsuYl2Rchi3r449ntCF19uNH//XhrymUkR5w9M2U+Nz9fhECQRo0Ob84uzk5uRx7kx6vT49uzETo9uzjT/6+gtDKvtfxEX2rblpe5N0yGF0gJl48gcyIkUjwyag9PE/7x+gIVWC1RWWhhM4ZWKiQzLJfo8OiZAeBT+3TuX6MSfToqJRHy6MWnUQ2qp6565pMBpPWN1pZy1HpQrQs9tGxdmxYFJ0/hwETdZ2BcoTnNMlsfgbOsxgF7fr7GZj3QR2grjRZ41FRSfVx2bKoXimm5qbGgejYcqH70jqzHZplLxYV7ulq95q070swR/l4Ssa7FOLY69qsHCa9CGwK0LHOsB4hTc/IXkrvhMKl2QDTP/azNqkmTXK8m7bll9I6gTz+e3SIrKlNTC/Q/NbF/VdotNFBttQitHVVvwjELTJtfqKIDiNqECMM4C6856QLn9Qr74DBwDze0iBBw8QTOiSJC1qdZW1MsTAGDVhXarOiBBs/X5v52Kehcja+vTppvV2+YcakKe2MwjFenBbqOaNp2DgbUFThacEDf2vOw/sydqvBFjyQ8/Cz1tAfmQhFRCOKLKgVegSxbiGH1njW1S5IV8zIzjrHg5Swjcsm5MscyrVMj8KpyZq7hQ7M+sO22OPzhagRaOio3LDcHSoGeNf2Ut4uNJeskBEuzAbB2eEWD81WHuCgyandGpjCJs2xt9eqMMizWFXwPnpcV5wUpBJGEqdr2Ki4ggsiCM0n2PlID9msPteYIhxucwB9+H3yNDgPvWD4b4hmH0JEgmSmg4rGiprjEGY4puk2vkZU2X0nGkzuobdFqUHF+5/y/jCjSV02lPTeSUOk9ZwQVNxJCErJePgs7p/ompSinXWRq2CdXHwdT1YXL7OvoFj1GGju1piygS65C70fSP0jTuWnLo9VsKCNsoZYj2EO7vY/5zuE5v0KB8tN7MlOXHeOm+cIVQNnEQ3vUes/w+GEbcfpzjTtlMhCs1psbKrxA3vAd0R6W9U2Uq3O0df7g+aEFvSes0hIVHKvAvIviq2mvP75Hh4LgbKx9iHHOGVVcULZ4BnunBFd7PZxJjpb43neR0O8b9GPFx5YQvQcpmWM6NJE5vbwJvTWP21VJplQm/J6I9aaVnAjuV3IsurAXFrvgVSP6oLg25ERq75TKpRlCTdgM8wcops7hZBynex2LVuXQSwsGocFDh6mmWHhI24oHNa17cnynBVGbRcoQV0tScSbRDr62liuSZY/mSMrzRzLlnPUMwuy9ILgW55wHc/rhfYN75wwpInKvmH55hS7xPV0Ywb+luXYPj6/O49vNlM7nRBCWEDQjaqU9iU8pz0/MRF0AjjOWftJbZf9i64kbhQX4+dYdwPnvReAAHL//+apl6fWXrlg+sSWbrp9R3IJbqGjQwRNBimw93rFJENAKkKBTkJ4BzIxvPkKS5jTDQn+5VKqIY/RR/dfPX7cj0OaVRkukR5yavNUeI3kosiBMD1RGot5JhqUcR84Rb8+Wd5hmGo2N1ADECCbz815RnZ9G8JCHZInZPpuFOIg9yMZ7aBJlQdk+UdUPXmjmmPn4JqptpaWk9230M84zgtl26M/npv1fyiGEkwiCVTX0o99LUsYYkDaOyu2E22/cHNjN+MlDkpX7G72ngFWQURduXCo+Ton2t/eDPQBokBqPpWTQgzF2Wn68wrStLR6FPDimCREkLQXGvfX7K7PsYlqEM1nm2vPCW67k85QwRefUOovWLQAgI+3R0RScYddC0qbutDAwksXkkGRUO20NCoYqmFvPhLFeVAtGUggPW8Rjb6kcPqTwIqrswLEfJ7xk7fkZRk+19fABIMsWkJGRPaIOkzYj6A8ieM0b1P8YWWXrcUqSDAuSmhdjStpP5H4Jd2DBPcGdC0rwUlG2GN+RHfuy2GCbAxiEY1F9+eDkbu+rJ+XEhMHJQ0EShXByx/gqI+nCRi3mQSwvTlbGk1qCdc/LWhKWVsJkF3e4u1jiesVDUbpthlqSPFaoMh8bLbUb0adG97ljtp2Kj87HJC9UW0p2wQYQI8hAWnf0yNxiNbtk6pSfrJZxqO7ul9xHEFHNQbRqZ1c+V4leG7EgPhThz2MVgtxTXspsjTxWIytU1oDpvT6DbZY/F97Wh2WmaLGrn3BcrSQPsW8lYbEoXRiyjnZI05UPrgIg6ILqIYPzZRgjee5MpJygExNr5/MarHssNE9rabAanzBLseJiR8mu5tcDdLowtppye8xtN6TX1nfy4LyRjBsavXHcg9/8/vz9WRVS6PKd9a7qCHZE3bQQlvC0Xry2Kz0OZIQDNn63WTQf39vPmUGDyiaXIKHZ50HlsV3ysM0TZ+OCCEklMOHwBZwvD795+SxWDCYoFzSi1bd3O9yIHagReq6X5r9GJVBA+oByFtuUDhrwcRDZDeBWij629bbbfb4jalu9qLgNTSge3SYVVMSrDx8lURU8H7wJD+OjiCu8Tx47Y9XLX988fD9D9uBiqHbXYg7LurDVBm0sEC/clY0nemOvfWLoI05j3hUuiv2hCZMegM017sJSYpYKHEQIT9x3rTCh/wXdvz56NSxgGGJCm0+YnAcJ86oCryKgChGkVfrHAuoOP4Z57jgRLUJaKLetFm1blm6Mm7E2E019FIRUtHt41imJlIq2iIlcatCshWsjnmdV1/822rYURzG/00BAeNcQQuW2k5OotYhtopZKEJzvihsdGzy2QNAA1YsHdnXYdb2SUGRYzZNTilCADNs+9yL61VbwoEWJBWaKwE7Oev7VY42kphk1DiGbEMOv3RzgTeEaPPpj5i7OsOVihoaUSq1PSr0NNdsmnKgSZ+0rAZokmUTqTnJ4DGU2CyKqSghf/15L0854unZ/mzk8xPYPKlFGc2rLFV7+8Ob93xBl9v1nk+hKDovGtmFmu0bg5wubojVBokB0oFzALfaoe1IrWUHDlVZdN6IvpbWs8Fp4IyfjpemIDM2XkPQXyMDa+V7ax5+U3JOSe1Jyn0/JxU+WmHr4x638U6IwzWTgqvlrmAzYoUu64cs/anpr6qjM2nGJxvj5qnstxziwDReC1m3bDD+kh5X5tIMmtEmkWqRdN4WpSgtoHMj+anIaVHbMWp3AnCjcS1wX01rUnfC84NCHae7mynUdj5PQz8GQyDuybvbNjhPbLVRRkj+wbO25hueKCLiq6seMz3A2hfCOnOod0siVogMZdle5iWrVanr55UkOau430ttlCHei98rcVVSvnra1teYLkGZvSnJbaRE8vpnyhGfTZpotTn3PUmuR3rPcEp6VOZNIEntfhz2R5Wr4MNQZp2Xi7onrW4rhSIo7sp5a6J93MFd/96OgLCUPJjmrmRhVdg0y8YKyxRTauO1bYsxhlAq+OQxtakXNcRHTXnLJyyzV7oU7qPjzx7Pr347Ofj07+Xh7Zkp49XBLB87GGZSg5J4E4paaOQ2Ok5k8OpVmPrutTY9eGmTkbJLBy1lQMeN1Dgw6aPEXESbvViZLkuNpq3inTttW1vC2Yori2rkMQXf7UtsZx04Ct2Fgi9S2iLtergaPlpF7nt2TtN8ibjA2g+mCakzzzQzOg+rdo59WPaOWvn6y+qzJfmgytmJrglrZlX1SFC4DiWmK8HxuNK1Biw4JrY7VasJHJgyrEY/QvGSQf4euvHixEGShNYmG+GwTm8WC7G9UAE2rVaOqDt59vDy5Pf9weQBtgo9//PH67Mfj27ODUZWF9QnRfkJZ/SqKHZlPPMuO6uzqJwKLTo9hMBEfGHGNEaDXMk6WnhdmKR9iCWEY/SEyjVXyixS4ntivE/UozXd1fXZ1fH22q85zxE1pF1MGM66l9xwO646cn/ZPoiC/T/e3DYgs5Cri8LQd+LokP20H6tQ/bQeetgO7bwdQI9b/ebWpv8rNVftaKqNbAvPvSbE+KdYnxYqeFOs3rlijKQ1ZFgUXquXPd9T4oc11fi1W1No16a0wXINRFrZllblX3dPhhNDUgtvjli7nlfDcHIvEtbwYZujDld743VQbiOhocamWWnpaDe7Q9omcWFGyLVy3XWFkA4/p3GPGXv8F5SRZYkZlrodRypZ+i9uW2qG4lkT2y2ssNGZt37yELgJYylqQ7Py4opkLLaOlJB0ZshUWWvHFs+Nb1gJAIwmL28Ebmep3niSlMIeNfjG/gL6Hiw3BQkeJql+dMWiyoSEaKko4U9CQzGOX+4VMLNAnSELovb2sozpIDFXUiJoI4/XZj+c3t2fXkHr8Gkm/lhI1xWn9qb8N4c4tUd8GBfxQfwrHtjQd+k+SKHpPoDQ0EmFEc55lfFXNQ+PmQEZWR4Lk/B7uXEp7xhL0XN4fE+H+Vlr0BE7qLSTrWLfJe8dRarBfLFht5Tq1WdygrYlBhNwB1Dasp5D1U8j6KWT9FLL+jCHrDutfaxgZ0rLR+lfukeuf4C4JA0e8aiZ026g2atZoYYbs+9CQIbRkuN40DWCxke3NCZtK5g73k8KIcM5F1Zwkx2sLb6gv0ej5UOfNtgWBwajs2GOVlZ005DKGZbBPUWPhowjZh2NVUaKCey4HkWEt6+6W2plo1xrCtNVov7iNWRYEp9OEM3MqKmkW+m7LqxadbQMdILFNbi39QUhCCbpYmEOe4bKIMBU1TjbQeNoKDS4V6wuqaKdMNjf3ONO7Ajj6BG5ue6AbyAcAn512QeAMjCV/RQRBd4yvXBsnMwrYfYWJpyVO3Ulcd4HioaQsAbVXMn9DcTVXVaWFn8xnG+cPdlZfav6WGG4gDY7Ep5GuiV3EzjKe3DVbG3zWCVstuSTNE/yISi/3ECZJlhA0Gip8K0EVmXZoSPSolX9qHbuaWw4bfo3LLnSaa/eu3MTtFCs8tSzqJbB9SribwHMFDa9dihXYbJcFlgjLO9vqC3IFegXYvWytDUCM2n3vJkDb+92Dq3bGFBobWxduA0l73UnsgR6pcrXHDH57AZXMqLVaX6YYJazMp5r2UpC9ubVbGg/yUBBBodUZRpYGvS8DPUqSsuqju5VKcqzf6zSHUcJhU4zFAhTK/rg6cLfQTbY7+JmysCvs6eVN67Dn6eXNeNAJz5TVG0lv0xFupz5kepJOL2/cNSPVMWTkuwbZYFoh+ELg3MjegjAi3I3fNYAmlQBwA2BUooQXtGpwG/F0qzMp08bGYwv6qzMopqEdeFf25nrbyJwyqI33PciDbdikAZO6FAyFqLFjARd0QRnEuF33H7G2CSUYHGVmeDVwseNjvpsDJHPaPIAc5QSXaskFVVjt3BbsGLgEoXDDFnNOyqUngjirzVRQ2NI6CtatPkzQbJHnmDKzyF12yvrisuMMvRmYIEkJx8Sm3vf7HMNzd39YdPdul2BTSTBG73sa2mtQWdUIdouhpETSnfvZbJgnm08wV6PIMLOkOCpKIUvSuOvIjNhzIFtP0HU3O9wdMZ3D9dmpqfZrPrNMOjJ9C1wmaQoXIgQapAbSk9c5gGRJkjvKFtOUSj3vX2i+AFc4YTUoWtNiONQJDeNqvcmbudn6cJQoGdwYNe085bqv8UD+Svlr/3948bLZQbrI1rAnbKg/c4it52Bur753Gt5eZpM0Ejdek15+OLu+/nAd7XpltFEj/rnBqoTKbUY0F/RMUGhRO6+izaYVjb/fgHE2LgRlbY85WWKBE7iD4XBGMr5Cr15CrHnG7wl68fKN6ZqrtZDeqQWPY0Gqg4yNDS6WiMgEF8Tcwo9ePHdnHyU6/Ofp6emzCfobTu7MfSsQp0zR7yWHkKUg7uWGAcQzOUIJFoJC7zmYQWmS1BllBM0JSc37CWf3RNgUzz/VCP1TjGq9dfW/f7Ja9i46favVarLgfJGRScJjjdn8NDa2me3ssN0sCpJwkcrG5MVwHx8fH/cgbCbRWxhNRpzPh2E9v+zBSVSWTouslFPOekdLIMthgkfF2ITErOgektuL02dIQ0GcERMVzvCMNE5j1+7fgZDZ7cXp//8CvOSDOeeTGRaTBc8wW0y4WEwOtKU4CL9o+k8E+Qq5lCgi8qB97+3Fqa3SgPtDMEMkn5E0JeBFuQB5DaABpp9eKlW8PTqCLn6JLOdz+gAUxPiLc/yHnj0+Ke9ivWGYXG3VtapPWzKEhcDr8CpBjFIKOwWsfUM4xWq2GoAPSeKu9rFne2frll/V6XJYmltFYI/x+sMYkb0jycmuHU3l0H1KmZxY5J+MyguTKQ3yehVtU7Uq7nKJtn4sJAUVRIBejU6w/aNDXzhitlUXIGSNkbcpihLy/tdu9NsrD23kdiAipk48D1S2vWCYhu1MEXGPM02CbR0f3AnRnKYcr9GMoAQny4Z9mpG51jo0jHWnVCZYQLP3fxDB3bUHOcGs8pyAE+aduk/OVYVqEl8DnXxo+KybPABNgk0wV7EUM/KJrVSBG++MzqLSvQGX5tXA2RQdyBrsF92khzD97Lbpt9swSj6zvqoCI37j5xSWuziq7n4AY/sp/kraqiLAa6wm0I4HQZy5gP9ZcaMsyUptoppZ17qLx9mcLkrh/ffqcqx+Fn0jGjMg6AtozcubfhK+rub0HVK/2IqrerI+cslVJH+lJVcRsGHJtR78UkuuQvyNLLmAoK+15AISvpUl9+SwBLz4szotvFCTdlexlkidaVGyz0Vl5eD5QRx42m44uyHWFbaSD292LCWRWqRvzk46BkIe1FT0hanOHhRhKak6adpCrqYarIb1t+PTfz+7vukYXJkWU0n/GHbLj21czcX3En08vUIFXmccp+YOt0PKTMDuWdW7VO+ngxzWT7e37YuN9JfDslgWKnpsi1KNcU/dSVsj2bqDaV+Hv9qVr1FUkevhfZFScBsrh0tDcqtRJvGHsCCtPIWb6vHH6/MWKs0yd/DMKSs4QkOUex1YAQWJvlonbGTuEl+Ko08P49VqNdawxqXIoA84ST/F2zz2tT7cy1GhNl+PUY6L0L2CseBClYKktYbhsctvm9B+ATNvh2EaSxau26L3NWYZgSCwe6xq4RersLUkgCOhZ6HWJsynIEEprX09qCRaAFQ7PoTA6clzLOMzoOc0yv7GbVwtzjYrVMPFsl1Tzdha27LzZt9ii1ShtggHbB0ZAtR5XVmIpVgKLAfhMW90YrrkCs15yZrlPn++pdJOX5t/O62VFjRoZLrbWmnBnK2/6FrxJQ3WutIkD63r+cn7tnU1s6R/GtYL3MJGg0pFtnDHGv1bgbBYE9eCS0lnGZka+9Jcuq8bn9/ENIjRLXZSWzpiQ5f249gV8vG7q2NYVazRfZ9vFd7Ybk7CdcIefDtjCNuor059+5nY1VmN4396JMPcGdwujlnoj2RZ4GpXyy4nOey2ald2m69ay8/9kMY93I7FF2BAgxagW0nNWtmtprC92XN0eLiIauOTu/vNjUNpSpnslfkH5pr0gxqsub8+fTzDkqQjdKBV4IE5n0oelPuaC3RgayPNj1DADZ9rANuEbVgyGWV74IfAK6PwfaKaC1/LaX+Q6MPlxW+9qxee2+PsOJJMTtjiqYJn/rnaBfmdOVp0IIkyp8EWREVyr42L8BEvEqga1QYVdr3mTCYUq8VxN0Jshm/9y3cPPDsLzudpakDmqmH41Z4HN4zUAxYCNfs3upK9gPGIzlssstdnD7UXu4sExFSqS2fC2kO3YD9e/v3ywy+XByN0cMFxejCqQT24UVwQ/aO5BE7/dcJLpojQf+oNtv7/TYZnJ0pkAOX644nAq0w/0YSFlYTHyyQhEv58h6l+Cw4flmp5MNhG/NdkUnNQMYkGB5bkRcbXCDPnTUq4CJMIAsds6hJu5TYGJ6fmYFnNlbAaD0q3DiWpA/vkGD3xE2i2Np8Cg+CxxO658u/B6bFp/azeI2fflSA2juw1daXXBocxzuoBxwk2q9moxN2JbeoRf8AQ4NvjsN1s+9pkhMwwjvwgH2wwIYBiM0O+LimOKfj3vdNggLpdrtFgUDg9hyOy9rq1ylTVAMLOuWkEe63yn3oMZhruZ2VyR3ZNZVootgNUGFGwo2s3BWox06jG3deqVlclzqqKzlq1r+dNdWy6BuGwNR2dmq5Gd7PE9jFcDOJrdt59ERtldeoHkGnm+Y6s27yFzPn29GVUQlsgDas2yVJbf22cIRmyjT+7d3I8p1zHn5AUdEjnLtTVxyTI6tuAy45zWSX3bX4AQpURK1u7rKbu1tgyaDcIf1s03Mmk/ZkUKzyyN/FAlp9KlFPojdC/mfjyw6xrpM88Tqva4gN8pJRpB+jNa2TTM2640nSfsukJN5sbxc1OxNeg0CmQ7VYE5DM3yYhJMb5FzYc3Gb61IqZToFPOlmSzjYed9JwI0Xu04Vum0LAwJVnkIM+wZZaYvRSiLBEQfTpKif0LAfyN7hZlVNHITfH7o8NisJbLp1eHm6p7ImZc7na7bxWW8/1grCo68OAPnMbpoUXg1bTRUWcHtySIteBVcDO4s1kH2gOQaDKZHECO+SATJUr0Ltl812tZDcGmaGTaLDR6lDti6k9MVQoFpf69zPAM6Z2zpAv2/RYMTIlUe6FGA4JAE2c7koRLxXMeOfA5aE4NVQ4WyqH5nDF7hiT3kycJkQetcbUr7y6yAwPWhNqKvUiFWTpbHxz+9fmzETqQGV8dHP71hf4bOkNJSe/JweFfXz4buRCdli97wnbeQODVGATlTOy2h1vNbr6PmbtWwAmA1lzIoaZzr2S5rWuMrGH2kjwUiua7bgSgYgwuzl674jtfc1e35y3O1ukMAZ/P61P/3189Ryle22sva9hsX0HbMeeA8ZWJvZFMEkTrO057jnkmeVYqgj4y+tCi+fDVy/GM9jJOZoQU08j+b6DO0mCQJKYbNGUop4ngjg6nZ7/PRDlNTPTRvNKvN0J3bU8WtLG/gzZk7jd/1r6HX4zDcdAWNYMOo96YCiQlQE8gC9P1cm0szUitcSu+6aqNXeXAZif995JGog+7jEL1xKaotI2UlCCQowFFDDT0hijs9hDLacno7iGfk+MbdJjwvMCCjDFLx3KFi2e1fg5+Ffdu5L4cQYZtEIcC5XNyfGNylqgsUqwabUS21eLg7+xr/6OBUalo4rx0fzIaneFkiQhTYm26gAfnA6L1MrZG50CTa10xgNmbnGkXfzw2y+q0gtfurmjEuQw+E8/ZgqezMBGvvzmddZTBmF//1lFtqpFL4gZv8x1JxiWxisYcTjflS1aVWohoRUVwGTEMY0kXSyJsFzmf7kfocB6eh/0E5ZifgMmfXNHzp2fmrmEtdg6D7XyLJVqRLOsq26k4ggYVDjR7VPZMkTWkjq6qCZtrz2/ida5hZS1w4c+xNyWunowJ7UJ4YLVJ9bzMshOeZeY8y+Wg8/em17h/2SYxtnkK1KgpaE2wIqwWYNW+C5yUhye9o9IAUU/5lbabZ8oVOpw888JVQ9Bzpto973HPOfdFugHmGRbG2+kalTuM3WK0iXPd8ps7Wuyga2+IbSpSxc1SnthdoOKI51ShsWnPD32UXJGgaQnhng280zKDB/XItcke19BB6bGRpeCoQpmpRu+LrtFew6s72paqHCPHDzQv867Bz0jQyyJO0rX9fW9Ry4oAz9Iii3DEzcg7wfPt8PyyJMLvCJNSSHcXhPGxqPQw29ial/n39hP5t5sPl5VkwHEZn/qQsVlGtWJ5cNWsWoIuBloNcUEQMXVOcoRwBl1AzQmtvJQK5VglS1Of5FHX4Jvp9EfMavLavsnnytY6epzuTfQXIHKE/sJFSsRM/7WkTI3QX8hDkWFqb3b4i2S4kEuu2rw0IvUO1P4NXLOyrZ6PshbuLJehJfRjsyrbi1Sb4zFaKpMQZz6cXfTcp/U+3NialUazU0dLXcs6hejubjdbkBcRYR/Ipr+12VRvDWYkTYuLAe2UUfUOt24kgo4WGVGkTZZ5Ym9EWYRGUgsi5lzkzUYt7+C2jdqFQ0HXHuv5jpAkpo3mRwPyg9u/SU8Arp039b7De8xKnLWHavTF+QCf0WqYwGNv5g4/XE2vz64ufnMXuuh1bNt/BtfhrHCYS3P0OsNq3V+/toqk09Gq0fuBJddXJ90F2KjHM3ugnWzQMH2xWnDcrOJCxF9PcJY94uTX1Qm8aY56gVvj4rfRPUGRrR+HxJiHrbC0MuadzLFA4fkIoMEhqxB20I9dw+kAP5WqU5/0QNPCG6mr7jhcP51n+L5bb2k8jdt/zAsxKREknZQDWz86ISHie2nuPqDpSA8h0U6p1telWo5LRh+6MC52wQjL7zEoe2XIgzdRNL1ndojkMExS4XyY93wsZlQJDJe8+hPixJKEcpwsKSNw2Ng1zOzCbZ/ddCg9LG31A7fvBtvutfw9Czfd65ufL7q23Pq3Ycc7HXg0rE2pbO5hh8fS3NZW0+w7G0Jzsvh2tjrFqEQ0/ejuCJkKvtoltlsjzMW6NXZTIjovs85ttqehBjBovsBX/tB1hqUCNUk6VC5lkohGa+TNZJ9f3pxd37rOqNtRTdNYpy5GVnrzAFSQVNMeIRJ6Jlfxlm2pvDm7ODvZTGUw534rVQPH58417mlNqGlsyMQXpRBmvYe+AVsw8Oz5yixb17NWgsGqPKjAPfle9hyfMoW+eygmC64FCaNJsII68bbPPm2FRbvI0diVw2b1JpuHDZ0v37UbOl++u0H3r49eDTurZ+CiHY/qbeayps7nFFxM1shXhKU5ZVxMd8YDYDZjU7gX3P1rdPLh/dWHj5enQS9nhWO5mVbVdI8QaNgVPAjtmT4zlLW+D3wFR0sNlja4Mfe038+tU9DwdJ3kFYu6xb7iUi0E6Tbb1QPDbLdDNEwYH6FtANGu2mYfPoNTzleLfTkNUR3YctWqGQp03bb9X7ZSd1Es3WlCA1OSeyLqxUubgbqXBhwANs1469+9O749vmh8d3V8eX7ymXyE+TfvI+xEYdNHsLpEkJSGduxaf+5QI/DbMA3iwKNBGsSQ2TrYsVWmsV4pByRXPjZDOLYDj7b/Gp5EqyP7nCk0g8l3frVzqZaCzlUwmbfwxfj66qRjRqsHhvkoHtOweW11wtkwoyaWopY8NeGqoM1Nz1T6jcppXX3MaUYa/XFMAi0AW9pcn4R0k1ZkXnXVg6kf1JKIFZUWxPlp0ATDzZpG1XFQVE8dTQYId7iXD2fNwNFGMyXzsMne+emFGXE0oveY9dWu8mkQo+fIBmSp9KXb1UzVIG6xAN39XsN0JkhKuPAqON0b1OqZ7el1qy4L9eftRXsfYNfaxcDrXVQ2XHMuMUvlEt+RacLzIiNq1ysLfrG3YsBUX9wgRhZcUeOe+mvXKrPk8zKSSGmfqcEDZw661Zku8IQlYl1AQrWzlUWZ7zoKKxp6AAFhhniLwDYYR4Ug95SX0j3YRRLgmRrt1CJuUJWMJa6LMKNgSpYSkUGmxmpE0CxwTxGvV8Ue0NS0WwiHe34K540VTe6Iqn42nxF5UIR1jNbcSjFNiFDmVDWZ+iz4/mTL3tphjKbLsavqUgzFw2g3QVRJktXH7Qov7BsBwd2jWpIsazcGHNJwqr0l7hODDRxBTtXW++romfJb6Nk6DB67K+PhugbFW9C0N1Iyw7O0FCZZSWPSHQ7KXvJC0mlCi2VX46lmadsWg7uw5W0WbDgGKiF9DxRyMMRzV2XgiW2CuyEE+gfKt0dHq9VqQjHDEy4WR6aAHrLORyqT48rENz5OHpYqz/6/+pfjjrZfAVt4DuXvlQ7YG4vCKsAAjV32NZZZeiI3OW/FGA19rMGOadr4ZNgS54JXFvEhb7wGGcrp9LoLIGnxvqdp5VaYEQ69g9hokan+MKXmtuX4JXV967NFsBPaJZcKnMP2ZXQ+RVDA1Y5gGzK8JmLqm/gElnNXgtpCg4K1FdAwBhq87uhfbz33CNsFODXm4jORT9hCLZ3Kc3bLYByZGmIjIubSdYZIXqi1rSKNQtQ2I73XZkASf28VKBUAGo3OGQH6E5oFKHiwnWm20JznypXfQGWrVFVZrlNy9XpNOMSq3WSSxvitAvJaPYusxAFXuywO1NJ4g7NfvhmYSJZUEY+pMTy/ewPd62StBW62ftSgWuZizwNs2YmthtmC5bXw9mP8ItagWTJs/m2yBjsq45jtbpF/aQGRNESH6nr36yrWj9Le18wYL1lia6NwQ8X6Yy6doo+M+AfzgY6zFV7LpjLeahOxUbvWRnZSvdjhKpgCzlpBzCSiqwcr68i92jXKfv3h+b/amEDVmLxDGwiKs2kkPDtg/cNqr5gBlSwabDuXFqJmXE1Nr/oo3kYpYgvpqWa77XUf7D2COaGmyQFcSNhDA56rjqFvRQK83kEBnPmrRbtC5OYOsOkdWU9xtuCCqmW+XxXswTYscH2yDB0aS9skm608ur45HqHTm2Pt5ZydnN4cbx5SozYPbS28N/QPH1UMSYvLr7t38ouysCXujooOKnGmiGBw3nNqnPUYjRv3ZTclNFBGxxU4dAmB4djMdtAi8GqXdQ4NKsNFxtDV2ft2wLQ2SWWsG/SWttgNOrg/0ijZ5mjrMDbZYTgKKmKmdJB1OzFgmj1vm9i4WGBG/9jLRutDAMueKdoKL86mJaM72/OPjJrj0ZTVwPdQAcaRJbH79wehvrJwtBYSZKHHbwmxs9lDQ8LznLMpi/W3H0jGJaQ9BGy97cEmVw5d2f+N65BKWXbYnY1r4owpqtZueyVL7eixFNnrzp+WxtPS+NMsja5ox2fxyt1+88krf/LKn7zy2mievPInrxw9eeWPRvnkevzpXI8YQU9e+dPSeFoaWzjl02SJafvQRW9noZMlHFqYIyVKqbzVtl75VrUxn4eCrapzcEaEubxsx2aVsRuVXeoUkABQc6/yPZw+gC8FSQi9j1ZuzilbEFEIyiLNnnp3S++CNyt/JSjS2npn9J/41ePU5r8dvwKELmVSUdShITuXzBLL5a5rJZ6u0j6WpjMgDrA1JUjSXq1aP6L9GegzuS7jFMN1dllSZtCEYUmA4Ml3/y8AAP//vqeefg=="
+	return "eJzsfXtzG7ey5//6FCj9c+W6JGU7js8eV93dVSTFUcWSFYnOSc6eWxQ4A5JYzQATAEOK2drvvtV4DWYGw7cf2asb1z0iOdP9Q6PRaDQajaM+eiTLd2hMsDpCSFGVkXfoB/MpJTIRtFCUs3fovx8hhNA5ZwpTJlHC85wz/R6aUJKlEuE5phkeZwRRhnCWITInTCG1LIgcHCH72LsjTaiPGM6JYTyAP/W3UZ7wbzgj+gXEJ0jNiEaIJGEpZVP9RcanKCdS4imRA3QVPKVfo9KTkkQBQPg94WxCp6XA0EQ0oRnpwXvwI1ZojrOSICpRKUmqaVIFHxlXITH9CppxqSwn+/yQa1Y1HD34TT//AA8/eDpct7gb16AtNMdxveA8NiyRIKoUjKRovNQ4eEGg+WyK5FIqkiPO0GJGk1kFPJCdKBmjbBpBo2hO/uRsAzTuyc+JZk6EpJytB2MfdGoFL5vOnxIGgiEpUjMqjSoP6qp7/D+hKVLhvDi2REHX36EUKycHQf4oqSDpO6RE6b6ccJFjVXuOPOG8gKF3Vk5LqdDrt2qGXr989baHXr1+9933777/bvDdd6/XN8hDQgujyMQOQxgggiRcpGiBZdW+RqMUnsrVXM7EmCqBxVI/a6SVYDAFWt8LIkxHYZbqD0pgJnGiqv5A2iY0GBvrYJ+A398hPv7fJHFjzXwYmV8eyXLBRboaqLdVpSSiGlNgoAyzBgIiBBf2bcNmKnhZrGZyCS9ZesADrCPYJJymFJ7FGaJswmFkJ1gSUDTNR1tEhCqr6Ag6NNaY+e8dJkWeKvPTCauCZukMWgwSnrapZ5xNt6EORNqkgVbwcKzPNqIOLw7cFJVkvEyrOeocPqJC8DlNCTRT4RQrHJ+2ru2vaCJ4jpLaqxLhNK1MEE7TkX5g5EgCk4RIyUXnLAaPDvRbA0e2ObBJsmb03gTTWx3hAN1yKSkorp6TJMKCIJK87qFpQnqIC5TSKVU44wnBbNCJjTKpMEvIiK4ZOlf2QXR14SDBJIJynMwoIxtwWD8zeR7hvL4ZF/vAKNAzL2f1epCTlJb5au7XhoQeVNsxt24OzahajoIpzyMoZZ9gqfqvktUQzgJCCAghWs12VGqXAtwJP811ISoE17aRpk0o9pf+02okoerZVwDLe86nGTEjrZu7INO1U+2dfmZd++xAT3nySEQ10i/c5whx8xuSCivwSbOMJIqkZpib32DMyhkXamRmgHdogjMJaoNZMuPC8ev7UR4M8rDJHlZ8fghfCV+zcwIRA5ruZxM/MfpHSSqCiKaDVexyPN3TCod6ock579QCAEdiXNJMIc5WQQmMwY5I7FxOhNa/VbwyPCaZbHGr+RJr/Ik1WK60JAwfr7QwWCuV/cl8ihC5AmcgUFQuIqan0k0gu1YzLe/t9HL/PvnJLivavXEgTYd2RZUci2RGFUlUKQ7Qhho5dEIG0wF6+m9vR2/f9BAWeQ8VRdJDOS3kizYULgdFhhW49Psh+XiPHCGLISFMcdlD5bhkquyhBWUpX3SAqK94dsdg6UR5THBOs+XeLAwZ20hB0hlWPZSSMcWshyaCkLFMV7WWFi0ItNiM+wcqFRi0q9s+TlNBpCSyzSDHSYvDVo10bGZYpAssSMUMAgAlzrIluj47DzE4O/JYjolgRBFZWZOfw+8ibKvfvRtc92kropUvu3ZarF5aa4CqR7c2QwVPDzA9BBIoeKpJH0VZlTQ9KCeg12IE7GSBk8M1qqLYZgYrsINKkPGUdIhw08l1M0aGGspx0eaEGeNKx78Oxi4gGed5SIcl4OvJdgi1YnsAly3K19C1FsZEbivrcu4+R6kOZ0S6eImjCIErjPCYlya8SdicCs5yiPYGLr5rSRAAgqXqJOMLHYJKcAEzbhquqkO5SCLm3uHeMC5s3jFBKf03xO9ICKGxkEkySpga7cuLMqooVmvZwTs0IdsxyviUJjhzL+/WusNw3bSdtFjP7OoW2QkwJtGu9jVCqFwBGDew483fH8yKZm+DRxCcrUVzVWNvObttjoA1hAYwzOdPS9iCoNIMIk/HDlapqXFBp5ThzIokaK5rAUI/coF+Gg5ve2jChYshAOmadMiTErhaZONaYNXTAjpoRnBKRA+8kJRMcJkp9PBb/0cuFlikJIW/HqyE4N8nlhEpg6ZAC1MqYUsp7SGqEM4WeCnRDEPLdSisp8PMFNZOKpl5/wNp1A++/x90kxhnRl5WCrLZexebaNOU8HgXghq9J/zqVkd8QR+CnQXDcbC1f5TxBNdC5/5lwkcFpyycB33c5/9koIzfv+qhDJD9/f8GD3WonRsIpgWOrYMfitIpDhrWespv/9VIcpYtEZ2gJS9RSiYU9n1qD8yUKuS709PFYjEgGZaKJoOEn05LmpJTwk7td5LAKu20yMopZfI0x1IRcVpKyqZ9yqZEqr7umMFM5dn/Mo24dW7rf8JeE0YFLUgGCMw22+FgtAFc6W+sML37jAz8/4RpUENHH/hUKixncVUruFBHK3sNeizDSyLQGwRPu/6yLA9qvfSLm0Hyj8J4UzzhGWx8VuGOEANsrDKukCxIQieUpNrkVAqvkgIMAZayzIkPBnhVL9OiAbMKCq9CGMR9AzTopGb7wIz10PXy/pcPPXRHUip1tP3u0/UL+N9j8GWOw70d+EIO4pt/NZQH6tpDzJJAcD2U0DXYCEJUofdjtUKRa9wEyQiWG2iB5BMFC3T3huPqfB79v2zaJV8gQqXb/YQXwbmGLIEU1AMjSXLMFE2qsEoNpyoZI9lRzawHc8IK4LxUROQQvDI0NMICJ49ESbQgsD3EElzIMtNOKWU9MMKYLQdr5ptg9Oy4AgG5WlBu8PcQ7G7wCXqYCvLQQw/zpwwz+AO2oefkAaT1kBeZDP2BFdHEzbcpAzQ0JUyBhRHvtLh+/e3D2Q1wfn95c/nrJfr15kqbHvT+7hKWSvBTc5q4vv1wb9atA3QDRosobdTgFcNGogVVM1gaPZJlo78pU0RMqsDAVl3OiFpw8VgRaWpldHEVROa7unzDxa63uETNXm4u/HAwV9CxRFM6Jz7j5SElsMh4AIFC/geY2RopeEgQyTOwNZomNTTNi7rNfgYBj5OylDz5TqrR0pkRgmCdPOQGjfVmTbJNrdcSnuclg90+r4kxGXn5HL969+GXT3//45/Zz//+w/c/fvfzRU7mb/Pvb6/pWEz/4/hopdxAZueOI+y/6i6dYTlzUox0+QBd6a00+FmG3Qm6OeZqhlIqiH5FAhms37LOM2yc5UXpzCq8jxZ4CUL8JyGPnhY8fF8KmmCFq4AlEJIjnWNSxRSOfwTQl/DlcTyysElcAUgjqiTJJl0xgmOpsFAjyHrZLQfn999//71/fd2/uBj+9NO76+t39/eDnGYZ/WezW1+/fPV9/+Wr/us3w1dv3r18++7l94OXf3v1z/XdqWhu11ETKqSyKuedIdfBaEwIQ5KQ5jRxDL7nX6aNelYSBHYv3OxN0q3bPIHV6mq2VywFXSQSpjatgDAEQFbuE3ODY2Lowe9697dXJUZ5coKAlyURBqMEsytJwdfQJPQGM9GLmSbOjC8qu9CJVBEB/DUtmqIxBm+YM9B8ZsalDt3bEcBSv8iqc5tnmK1jxaxj8CvMbpaM8b4p85OItXqNxmivYrSeyT1JOCy7t+VVYyZ5KbafCW8FZCwqSqo4jaYTboJ2TXT1fZ0OBvDv3pC8Pjv3jcISUatvOlWoNpJBf71qJ6UQoIvQ14OjFghabIah6sir2/kb18qt4dRoroU22i3ccPzm5eBvr77vof7f3gxevnp1vFkTV4QbaDEyLX4IQ1Xa0lQBBySVoDVH0Ee9fFowVlSVKdEzHXiN5pMkBRZOdhDCynMcEYgZD5v2WGtU7NRxNZIb6pTD+c10nwe0vhNrJE2HHrYTaTF/u1mDakPu7RcacvO3u/baW99rbw816OZvv6VhN3+7+8Dbvvv2GXjfUCcGkL6BwReEudZ1ogZrgpiszMdEbD7i1nUTeG/tbeTQ21gD7qPe4NYre6dXikMHKcrsIQ3w7HKCZSlIHu4txBySEBsjamQ9pJHiyju9KwMea+DCvyHQcpLkEys2edQJYrxU5PNC0ByOGm4gCPGoq1s2dgLDrjikJ3gR0P2W3MGwvVtjqhFei++bcSpoMYJmfxNT02aNiXuE2/ZdjeQ2uuXAfjM96AGt68fPPi/t7BR+wYH3rXmG38zg288v/OLD79t0Dr/6ENzcNQwn4W/fPwz1S3HnLj77h5v6hyFfmuTF+ujq+fUt7Li6uKP+bCKsQX87klXEdS1hcygbZ2h4fhtGamnqdz+UwKy9+zEMdmj23QQJd3vaeyG1pvk9nlWbAmuD6YsZUTMi2sxhNTbmJUvRCcmpsoMOtsiJeOEJcQGGr/1clQT1YoB+hdwtv3FO9T4WLxVs17lUMT9ACnv2caQTvmrOPK0Mah+41sUBq75Srm422LwZnc5QRuYks684cxm03lhH2BVT3G2X6Uw1T0mjQykpCEslnKK32Qt2A3xsu1MQCXlwJoUtJ5iFMybsWcL7YKr4pEZhsKpPV4jo48/Bh8vghDPI6V73Xevrc52sYb+uiTQnasbXjJqhTYPALD2dEzE+NS9FhVqlHIIs7Q6mp2RfhN5EJ+8vhz10+/Ee/v+nodm6lBxx9qKn/eH7Xz6ERCDjYoxO7i8/XJ4Pe57kp9uLs+FlD11cfrgcXoZUGmZCkNr+xIq2ujxZ94ZJVdFQgrYiQSZw1ljxSKs9PRDQp7sPqMBqhsoCKW4nWqmQzGAP+OT0hSFgvQSd1+FeoxI9nMJpd3n66qF31Nw71+iqZx4MIdhyAmspe60H1bKAFOBsWesWBQmZWkwNnwGyuCY0y2yiF5QACSVgC4HUxAwNXaXZK+QOrzY1aqWUnZjcUDIZr6A3NRFUz4YNhUcfybJvhrlUXLinPTX7Vjvt44+SiOWKzIEVjdSvwqSG0azMsUlb0LDMBnbYTKrQgmZZ0GvjqtMkh9EETlxGHwl6eH85RFZVRiap8X8A2P9Q4BYaqjbtjdZqbjTpmAEG069OoNAU0WIGGUgBvbo8wD3M5VFHVYMV0gDjB2mVmgBRRMh6N8NsCtlc0HlgKmBagYYGz3t68N5wJuhE9e9uz5tvV2+YNGdVcW90LuPVsacO6Ne2Lo0hdasdLV1pxM7nYSKtOx5mvQGJSFjFQcLA8nT1NnUhiHIOucAL7T7Y1NwwDdlOtTOSFZMyAzJICV6OMyJnnAOFKqVD4EXlzNzpD7WWRd0Wxz8cjRpLR+aGleaWWgC9Brri58XGkLVUIXSsIyV2Hl7Q4KDoCS6KjNqVkcmwhI19a1fHlEFtE0/fk+dlJXlBCkEkYaq2vIoriCCy4EySg7fUkP3aTa05wuECJ/CHr4Ov0UngHcsX23jGIXXI2NTrPsWbk0BXrpCTGCTSrJY9zGoLmL6SjCePOrcFjh8ozh+d/5cRRWKMKwKFIAmV3nNGOqtI6oigN0PByqkGNSnKURdMoH1++2lrVF289KprRFmMV10kjZVaUxcg1y70fiT9s1Z4C55t66O1bCgjbKpmPZ89CU+b7xyfq9swKRDWZOaASUya9QQou/HQbjWsGXZvtlGnv1a7UyYDxWq9uUIMXt/wIwEPy/omyuV42gNLMLNgm9zprURFh8q6Y+mPBdx9ukYncECqDz5EP+eMKg77zC/02inxOUkI4UxyNMNzXw4HeBr2fcX7FohN27RC1zmfFzf3noit0+bfhXTvlMqEz4lYrhvJieB+JMeiCwcRsQteNaIPikOyOZHgnVI5M+LzZOAFI/wtDFNnczKO04O2BUw5rC1NI4A8lNZrqYWntKl6UK0hKMePEH9kEnKNEYc4hicFRzR0pHdBsmxniaQ831EoV2xFI8CLgT1lCIhFJefJXHy8bkjviiFIV/SG6R/foRs8p1Oj+EOag3t4dnvl/QdPC3imdDIhgkCxpzFRC3CaHlKeQ8EVwtQHzeOSpQ+w4PYvtp64hzTch8odwPkfReAAnF3/ctua6eFLd+onsSmbrjBbfAa3VOMh2vCF8CVBimzZ37PamcaqKSGgBD2AmfHNe0jSnGZYwJdwmi3O0Uf137x8045Am1cCr7BrrbgG5xA8RvJUZEGYXqMctHkmGZayT9MWx83F8iOmGWiXjdRoihFO5ueDsrq6iPAhT8kMs0NWPXIUVzDr739k5tKSsnURI0ozwczHN0MQBZaSztvsx5xnBLPN2F9N4NhFD6UcjoihRBCsqqaf/lGSMiaAtHHmdy/e1lVA2JFdz588JVl5uNZ7BKyijLp441LxfkpgFXAY7gFBw9R4LCWDGTACgPH+AlN1GObBeXMdQQItMO6tX1+ZYRcBknAGBzNFX+ENR/KVP4cVugWaSA88OppqZ9jGFNzWHSgjI1kEQUoyCk5bA8G2BmbohdCHQTWFsrQQHraM+36mcvyg/mkEjnXs+wkvmdoTT7X0sFSlOzCldaRna23oThsT9CcRvOYNwj9GFtmyn5Ikw3AeTL8oI7h9Rx4WuCNrzx11DSjBSwhC9R/Jcj9baoNtjmBwCi9kx3gfJ48tTvuOnpTr+jl6CoaEQZw8Mr7ISDq1UYtJEMuLw4I9++zgwPywloSllTLZwR2uLmY47HuEitItM9SM5BHMdNI3Vmo/0BfG9rl6AZ2Gj076JC/U8qDcNMUIM62t++mjjeKXdpXsD6HKahiH5m4O2YsRJIJYs7OvnKtTUzZiQZw6VOexCkHmlJcyWyLP1UwEweLBbvZippdZdm83gjwvM0WLff2Es2okeYpejyNcsZiWLgy5e/Wojy4DICjn7Clr58tEIGFXxHqmcoDOTaydT2q05liATGvbYDU5YZZixcWyhXjH/vUEnS2MMKW5Pea2H9M76zt5ck5vYrbXxgAO4DdfX11fOnLdvjOsqk71iqgbC2EJT+vJa/vicSQjErDxu/WquXuRUjcNGlZ2cwlCRrHJ13VWP4+tkrfie8NZv4BiB1J3yskrXSgj/Ob1iwiCQlAuqFru4Xa4FjtSPfQShubfI9wSLvT2AeUstijdqsFnQWQ3oBtUGxh0Lvf5nqxt9qLihiBSPMKLPBVUxLMPd9Koip4P3oRVRULW1j4fVMaW5mr5+lsQ9uPrmuzJxVjtb8UcFyhJA/gjXHRMfF8xnsPCHmIoQE0X1m3xwUVxODbhpgdNq+BggqXELBU4iBCeu+9aYUL/C5q/Of1uu4BhyCkeNayxugo2zKsMvApAFSJIq+2fteHHcJ87DgKhzjbb18OJrclp9czSzXE9V0cu5N6FIERhq920fu8w6lEwkdtZ3Eb1oJPxJKuuL0FovRZHOf8IRPSO3RK02Pq9aCJqta6brKUSBIc1oHfijc4MH5sgaIjCUNWRImy9bNjiSCWq+skZRVhIaFH5F9FvNoMHTUssMFOEpJXnXz3W2NSEmVOvDyrKJsTwW7cEeLFv68+YuwHIposZpCmFjOppCctQWLYQhBNV4sw1uxuS2UDeSw/PdDWIKRFVJoQLrNe3acc8Xbq/TR+eYPsHlKWgObXpCq+/f3v9A8RxzPtBheuupLFNhFkDDYPn/JcPdovWBIkC1YHe9aYxMgs4LThaZ0I6zUfdNn4xq2WV19KzERAlygRqIcEggGQZfxOWHjv/Ji37ZyP3bOSejdznM3JHRzHwJh9+t5F/QRSmmQxcNX+fnCG77ZBu+PI7dW/NHJVZOy7RaD9fdI/lmAQ2kUJQg3KT5od4WJmPOjCtVakWtLumMlXbAsAD2V9hLtTWJ95rdYBQJqmD92qhtdCd87zgkF/CJ66v3PUJcQirJRiCfCTL5gUA2ypVFPJHiI47qeGJgquPiELvMz7G2UiHd+QIVkg9l4quYdhVpSPZhVo1tnO/BuQg534t3q6JcC+8t+b+tnr2tM2tNV9o02htoCC5zbQIHl8v6YRno+Y229ZDbZvhlvCszBlkCtuLh8ZLt/8AG5ngZReCp2VC0vVDMWxJ8UiWI0v98zbm9mffCjgn9QQJSUgLUW4AE08pm47gfNfBNRycuJC+9jNtrqhOULR1cme8zFJYQ7mDir98urz7/fTyt8vzT8NLmDQhdExZ6cjZOIMSlMxJoG5w36HXP+gmu49OpXH4B0ddYlhhl9Y1vdZku8ng9SzImPE2Rzc6KPGnumHJZEZyPGol72xm2FudYYUCOVp10t2+1GaTYyfATQTYgtpWcVeU2vCBInpzXfB0sBLVik7dCZfOxjTfjPV5UFg9+m6FHrX4Bke7ziaHwaQ5bA6otbtySEThMJCYpghPJsbSGrbohNDqWC0Ah6ug4POyID00KZnO1IW6twhPp4JMYRcNKDbiA81WKSymREUf2aVVmhqYVWOqjn/8dHM+vPp4cwzAjs/ev7+7fH82vDzuVbuwfkN0NdBGmeH9YM6IF9lpXVyrQWAxlYcC8ZERVxgB7C/ByczLQlNDJ1jqMAx8iHSjA1UIqItW29g/gOW7vbu8Pbu73NfmOXDVafm9Bdeye46HdUcgt9O9GIMkyB+jwy0DIgO5ijg8LweelwPPy4Hn5cD/X8uBUBQQDP281tRZUQvLo4wuCZ4N67NhfTasz4b1r2FYj2IykGUBVcpa/nxHjt8GeX4tUQRZnmYprK/BKAtbskrqOhYeh1NCc7rBHre0ywI4Nk70viiu7Ythhj7ewsLvvlpARFuLS7itS9k8n6NNJ4+u5lS7dhqsqwojG3xM5R7T9vovKCcQnqAyh2aU9U3o7rnFNUcfYWv8htCqjmm0JWwKLFJ1FQEsZS1IdnVWYeZwySPEAjp2yBZYgOGTR5tDqgGC8CSkwDrejh5cypQtEU+SUpjDRv8wv+gNZnOhDlGDOKj61RlbdbYuiIaKUs7amnnm9n51uonGBzeJ0Lm9rMMfh9U9ImHTF8I/d5fvr+6Hl3dgVPlm/X3YTb+WESXzWEryhtHErVhD91ZjWdhjW2DM4U841TEnOjU0EmFEE55lfFH1g60F6VSFkcWpIDnXdy7BPdOdbQlqLu/ckpYQ9UXUtOjm2ighudEkuAFLIPvFgtVWr1O7ixuUNTGMbFe18XRr9kZKtkn3tAA/h6yfQ9bPIev/QiHrjtm/VjByndnrcI9c/QR3SRhYFJ/sBU5qPduomaOFGbLv64IM4UyG7Q/2FU2L9WxtTmBjl5nkKSEaVg/lXFTFSXK8tDPj4Ggzi+sE06j5sP2ENHT1GkyZCdv2dorj4KgTQy6nR9urSgcKJ/VdgBzCsaqQuIlmaxh2Zt1/pnZTNJ+EZTXc4+uVJAQFZRxHcDRan4pKmom+m8pqgwk6YGKL3PJJMyShBJ1OiSBpfVgMjta0wZSi7cC1Uuk3AF4FVcApk83FPYYja+DWWje33dA18DWBz44dDmbRBFv4+mZeOMjqyjhpEGb1FW48zXDqTuK6CxRPJIUaPZihkvmr1qu+qg7v+s4Mz9nFBGBXVl+q/2Z4Dj8FR+LTsM1rwI6hEl+ztMHnAOs7bDHjkoRwc3vDpNN76EKczHRyzrbKtxBUkVGHhdxt5F9Yx67mlsPfmpcd6DQH965sLuub8CBcP7Ii6mDedUq4G+AVbKhi5rZYtZjtsIDwk3y0pb6AuR4Bdi1bKwMQQ3vo1YSe//zqwUpxgqkubGxduMHRl1tJHACPVLk64A5+ewCVDIY1q9dliiGBBGOQZSmI/HxwmsZHqxkU5RBUlzrDyGKAdZkuNkSS0r+9mUlyoj9YK5rXZW/XxVhMtUE5nFS3XC10w3YHP9NZUszfBKc+L346v52/aR35NF/3tzrq6WnHPbsNznkarrG6iU2PzvEMHq0Pri5Z11AM63Va4a7vk4cnmj68QHKm02HGy+D4CwS8qS58W/hbiEMwJnI6ql9ttjGQGRbpAotWCNDGY08ekhn88hA7vG8RHq3zrFtMQeJ+YQfD18UhXTER92a7C0L+lkJsOu1q/3o4sditLylxcXV//vHXy7ujKJ4ZL+TRhv5JC0ToiWR4ifBUxwdrS2CQlJoJXk5ngzgEW7xyZxT2fUQyXID3aZzPQCHGZIpZLWaMkz9KKqm7ll4QRhY4cx5sB87Y1vLGffaP4KYSC6vyIjB6GAuO0wTDPUdcoAddDBY+NEdQZBTRIgqKFqvwDINyZVe33SOJtkZSiMAVH9sdQ403FPdMoUN8UTPF63iWq/GYvZkDobHbCk/KkrV7dLADNuZcSSVwgU4e5GpIemQcElEw1NDJw3QjgUSCg1tZG3+7kJUEbKHYcGCULchnNKEZ2ZszUEJAaRU7A69BKm7Xo0xSAhGs1BhVuy3dtKcxox5isAav9bsD0qiXGsVy5sKMlljPX7GgJxx9Yh6jm7OfB0edOGQ5hhuwcizDknFr1C4qFUMJASWne63CWU3uUL2JiNbP2zE2RPzFRBbHphBSJkdGTeWeOC5u7u0AkqgKYyi+GQ4YIxHtXzcCokj8eNu4I1IOWSGjQwEw5DTtgL8LcZUrg5p+ahtZS7Znt3h6Tcu4paIwVRxIUW6Gt3soinVJ9Sx6IBEFs4an3vRXO/FUKEYZwZLo2x1GkoS++lovLQpLkzPV1m0nVUB7MK9aV24FODfD+4pKh5OUhaQnAZvk0Y3DX7ozsgIeZVSqvSUE85BH4hKktu/CHD+NYJE5shPJCO632Btcjp9oXub1tQdQDkAhnEBEXq4AZ73tw6kVEArVp4dKpmgWoiJw/ZCL5jGygOiJTsxbiXMMV7qz6ddDagGshTonLOVipBPCNhkbW1h+Q1pris2uZBM6LW1NCD7ZTCHdUuVg0OplvNz/DSs5VqwG6ErX27CZulAqWSJcuyIdNjWiBKmqbrfCDF3CGo4R1QpBmBy/GXnq61KKHRtNuvrogtZmSychvdA7Wue+ro4DhGEJZ8FWe7CO+3NY4jks8RyWeA5LPIclnsMSz2GJ57DEc1jiOSzxHJZ4Dks8hyWewxLPYYn/OmEJl/zCwiuRL27u22kvN/db5rz4s8BtD7jpxLp3InkqDZVeIXznKhX6DvNA8NUyztqtQvCpwHBxOlZoShgR5rbmWd026YIvOkE/JEbhzueCVovCSJq3aw8vRo2s+w3wV1Wm4V2bWmwkbw/GPFKmC0P6C/iDSEd9vaBP1Zg6b3D5L/HRIi7olDJ9wNNdfSWWdmDpxlFmHMwauaqpkQCPrhjXloHOohjAOVi4RAOrve/EO9NSArJWLKZIsDubGxwytKtEOIrNkEOwbF1CphqOpTuabRPRZSO/rd4wQZJS10geeY/rczRvYeM2lt3cpcjbc9QQ7vT8LfYa1eAW5A2akhLZSJ4/fD9Z+5hSQRIbrLP9pTgqSiFLgup1P4y6ewlkywG66xaHixV0NtcfzR5BUu9nbavHbLsAZi9J02qCbZU0R9XJ8c4GJDOSPELFgZRK8PS/UH9pXmGH1ahAwAzriub6tsTa9NwsTFBvjhIlg8Pp6Sgij8O2Rx/ehgZMqJAKff/qtS3VYIHqaKc+EFGj6Co4R5rgIK+0987CgxtVwjSSxi3pzcfLu7uPd20u3ho11tMrpNBcNY8JaCb0BIXbra9seBF+0rNy5WcwzvqFoKydLp7MsMCJ3mA7GZOML9B3r3W4dMznBL16/faFruMCVgiOKQSPg6viq3jXFBaBN0RkggtYIsK28auXrvC3RCf/uri4eDFAP+DkEckM6zrkMFv9UXIoZwB07cuhRBEa4rHsoQQLQWEbwPSgdZMyygiaEJKa9xPO5kTY883/Uj30L9GrXSwN//2L1Y6uR7tvsVgMppxPMzJIeD5Y0Y2N3ZeWsriTEoIkXKSy0Xkx3mdnZ2crGDYrSLQ46geA5VZcr25W8CQqS0dFVsoRZytbS3RVSrCSihd9fVLFqe4JGX64eIGACuKMmCORGR6TxlUEP0IquJGFVkEE7/37K5jy0fGE88EYi8GUZ5hNB1xMB8cwUxyHX9Tp6dHjykOlBK4yD+6uHn64sCVKJoLn4GSQfExSyCxLeOFOh9YIwlRjnobbuN+dnuorLBNZTib0SSOIyRfn+E/oPT4oHyP6hJlcbHRl2wo7ccYQFgIv3fiHRmKUUp3+jcE31LnoppCk5geLR/jRDioYtvWjgtUM0Y25VQFpF68/3P+TvBQJ8bprW1M5dA8pkwPL/MFE0wdHnfBWGtpWQJK7g/S2eFIIBRVEgFxltIPtHx32woHZ1FxoJWu0vI0oCuT6t272mxsPmOT2AHF10w1CqawLQlsxIM6hJxExx1kQ73BeTRuPPl89JijByawxP43JBKwODQ96plQmWKQwk/4T7jfWSy2pj5JVnpOWhHmnRhBusvasBvEx0CmHhs+6RhDwtBXW2Jkv13KzwgXIvqYZlGIwbxSkuSVud0OqQIzr9JCm7902frsMo+Qz26vqVJBf+DmDpe1v0zIbBVuN+CtZqwqAt1hNoh0PanWGo/J46dSNsiQrYYpqlhyoAW2F1251VGVMsFotom/EYgaAvoDVvLlfDeHrWk5/PfAXG3HVhcQ7DrkK8lcachWANUOu9eCXGnIV429kyAWAvtaQCyB8K0Pu2WEJZPFXdVp4oQbtK/Vq8AHOJaiSfS6qK8cvj+PEU75trOtqAuF8Wy+3OuoLORASAl/3l+cdDSFPaiRWhakunxRhYK5cUEtHqtpmsGrWD2cXv17e3Xc0rkyL5sbveiNub23n4t8k+nRxiwq8zDiGw55/EnQCR/IgYPeiurgX1tPBHtZPw+FtaxMLvtxuF8tSjW9jbXBuGzi6jXNbyrW+hdK1FWbf8d9HWhJ5po0xxiPkoxMI6oNl5cB0gxOCUMsq/UDClAcJ19aiDOIPQZytuU/hurr/6e6qxQpE5qouO2MFRKB8tH1di1hX4/KlamyxLH2Lv9v4Uhw9PPUXi0UfaPVLkdkt0odBVDCr7v1suU271Mlty/UM5bhw05CzeAkuIJyeWkC2M71D5ZSg3gj47x86FmGbAfO+pQQC8b4G3LkPF1u6x6r7K+s+hfnPQgAB6ZCpDeQ2tiC1UVr6YmgS7s/Aqh0fgv8SnudYxnsA+vRowxzGmmSb5dnCwTI4ivKyGjPSOkHZNMp3p04dVvoIdhS61ffdw7lV1EvL9sGJOEDeouhagn7gKSUSWT02d48+TP+khTlTnpIJ1Ot80F3lcktNFKNF00SWna5FZg5nt446Gm5l3LFxs41FakxNLU5D16Md2yi1qenNyzfxDi9mAsut+Jg3OjndQIIwL1k6iDO0I+wvYE/ae/wHMCgtahKMyH4GpUVzvPyiBsUJKsrsr2VRbFM+p0mxfhpN8tBPuzq/bvtpps3wE9rKW7O043anaXPcSxs49vYRJ0MNLHYXdsGlpOOMjIyn0rRvbxqf3x61wDjbbjX/aL3C1MCeoVmZY6ZLOMJQhJhM7mA72t1cG+v29V76sCJrT3910m6Y9e1ow8tR2lZvP4+4LPEVfHcUmLvKYFmspL6jyIJFW7U8esSTRxyMu5/hc2vg6W+3WyE5wvFBt8ESSfPcaY2ECzp6JMutRFQ3p6BjZ7dXIKLmKGlVPIPndPzFXlxBQ5NhdjsfcqJmPPUhwyjitr3ZrGMbRgjg6Ey18bKGu61PCRdwWKurEtg6vsHrUAZM81Qc5Vjprc5Qn/k6JC79dtuBGiTUXl2g0EWwzOwsF+GpeEETuQtD82aNiyAT4/tEGBVYKB3p2ppZWycrWnH2vXoWsXJo21XBm7coeMrmAp3BUcSV5QJct/7Ldhv10N61/ziTZU6EOeO8sVj1SbRmWtUu49zkegUFrPUGLYVEJ4X+hN1br1JGn3s2cN903nyB5eqYhN5oND0AEQsv5K727CK/0BZV7KMdePNxOPpweXZxeTf68ePd6PbsbngF9+W4ySAnuY4XB/PBtf2qNSW4H9L4tNAxKwQc4hNDl013blXzZPqOA8nh8HQRhc0MqBKpLZqJU2l3DSWYQST9eExhO+u4RmvCBTLf98dYkrSHjqHA0zGMQ718cF9DopGtN2p+1EWR9ecawTawNf4TZKvtLw+BF2aJ5PPfuPD1Ue0PEn28+fD7Cij2uf3ReCFYinbNYPm4jZjgOZC0l1hn6hc6lkSZS4GnREVSukxPVqLnBQwlE1nUVS8hbmJz4OO8ayQtehkVmfflDiCzy+DOC0Cjda5qhmPm1s3tKvf2gqLQlLiTAIHgEZ1YZa9oUwlbCzGfZuXiYX+V0Bt5dEITP16pbAzYTzc/33z8x81xDx1/4Dg97tWoHt8rLgj8eEEyovRf57CpTAT8ecUmHP73PsPjcyUy+PvD3adzgRcZEW1aWEl45L5MoEYD/PkjpvAWqBtcnXW8Sg2eheSF1GxUTKN1DJnkRcbhcIrz0WFqXsyIILp0fShP5PQ2RienetYMHQ8rPzjjnS3RiSR1Yg9O0APfgSbo+BBMCJ7Li1Udr2fsUf3+ix17351ssF6AG+xNW+lYo5OYZKHBccBaMgNjEvcH25CRN02GfrOaQktsXxtGKAwT1dnTC10DRLNYL5CvC8UJBf9xcAyGqF3NWQsG844eb34lWk1VNYI6Wukk5Zqzclb+S7dBvzyYj0tIE9qzDZaKvVU1XGDb1lkkq4RpTOP+YxXMVYmz6qCIz4iqRRuqNVCNwkmrOzotXQ33IZaYwY6U7XefG09ZHf0WME0/P5JlW7Y6BXZzfFAbAOZQoFWLokiY/cFh1jkWblZcJbSDw/GSmnCB1Iyg/8feFfc2biv5//0piNwCuwsk3m23d+g9XA/IS7JA3qWJL8723f3l0BId8ypLXpGKN/fpH4YcUqRESpbtbF8Bt0UR29LMj0NyZsgZDl0o5B1fmOBQl5BUsiDuvu/Zl3XOIKYdqOBewMoqzmam+m4Nnq4yjUgLpncbBMtT5c9AwhJEt+BYOCyIIZS24uq+sS7h/xHNxHH7fdqJqi3cwB1HGbiE//aTDW1hc+uKRq5f2DvcsCP+CIRGgWw3I9S5xr4xojcT/0KaD/fghsfhbsPcFrtByHoZr1bSC1Z2JxT8MyPUIkxZJmkfwB4gCgOcRc+TUu0+fUgZ/kUU/V53i+dccpq9Ig7kgJbLZm0NN1XPrJwXgsuXPcFqIMXCV0UnlvyJ0TgdWEq6mTVuqdzDLallABtp5r6Z+ubnE/AABBmPxycqknWSlRVJYCtBf9dpWbXwdHbvrJm/vIv8MK1VkSJcKfW3IqNziHeqkhhvtxBgCkWFDoEGCKmNpiLfExKtZAEXP+/XpxqVoUVW4LSh2dNSMj9ZSIR9A6MgTOkWff+QbwpJaO9FSJqn85eTd798fH9KTkRWbE7e/fID/K1uWxVQwuDk3S8/vj81W3QwvrBwx6LBwKoxsKK4d9shrWa90F36zk4+IwlFNBiI29Z0HhQWeiVBWMPsJfu2hiT8PYGBvwOjhcMtlvoQok3l9+15S7I+zoCXZbv+Pz59JCl9EXjS2eWGd3XjLZQnebHRG5Qsg8Kh/ooTy6PMRZFVkpEvOf/Wwvzu049nc94pOJExtp5VYk/JKTKQ2q4uPOM5WfGkLAwOo2ffZmU1U3qVlfqVbr2BY+4QuybGgjbWd+o6LPObLeHTIa+8CFeA7sn79wFNdWKzLJWeIEiTbKhwM6QNS+9VaEt7f9McYjK5dv1O+teKM3nQVji7DtbcmgnOBV5OKktGbQ0thaFegkWxJlTMqpzvv+VzcT4l75JitaYlO6N5eiY2dP3eKxNlZ3HXgPyOgLTY1F6a2mO6OJ/qmCWp1in1vWrSry2xAcrfOdT6B4hxIXlivHQzu8bkCgLsLJflCyg379ihUaUeWcxqPQG46Iopmp3BmVhmzmBX0WoFq91N8o5xGWwkvsifinTuBuLhm8t5Kw6vxaZ//WskRQuYCyzkIky8I8kKwVDRyKX91ahSpEg2vKyD0RBmY2TJnyDcqCtx2HA/Ie8WbpmNR3XK41EJ+dGcpXp8T+gaVJEsLAcFFS5TJRuWZbF8sloiwxIHmnkVHV10vfBajtpB3VJcMlFloFd4bi+B9zYuMJu6HXjygzF2BESq8xvUiyrLLoos08dkb/3snY4mQPfAuySxL2MQY5unYA7hORmouIROOS60wHcBxaDo1Y5Kg4Qf8qsEngYuJHk3fm/ttMcgGq8/tc9b3ouisGd/HM5zqnoj3ipT46UlaL2d91BMf+frPXTtlGGtsnrfLC0SXAXKghQrLskZHBkopZeYrbOPzLOOd1pl6kFoOZjsM4+dOtGkx5LJ39evNEpqxVp7r17do71OEi5LbeXVSOPnrNbYEUj3+PuekEIArEjX2UubvemRz2Wx2o7P3yHCbKhC/TY1pjlecgqDzdBsc1Pdsh2bc/K36d2tbYc+hWtDHyLUy0YK6msd20a1pIojQUwYtvOZznOCaldZBrLaqBNhq0pIzN6EaUcta4++LLyT61a4avAJ1nAmJ5j4bnmaN8kbBfKUvFFJhfOXU/JmyXN5St6wb+uM8lzV2CJvRE7XYlnItiz1kPoM2ldMGUz4otxDtBlfcSlcS2jbhirbPC/GEcPuYzGyFxHhq9xAK30uvAwcimZFQauPaRssp6PQYUgsi4ZLkB/aIhMDxfTXtpj8iqOqE5WW1qSNMqrfKdCNhFVmqvJs2rD0EwcDhQz1SF2zEvaI/XPp2spwJ+28yJGIKgaInu8pEUwXj/6iviB3Zv0mLADqlbGwvsOvNK9o1m6q1hfXA3xG1DCOx24HJA7Qu8ns/mpy87+Y3qPmMV6pr0eCva/evmnxGsOK7q+BWa6TqKPl4b3Lk/vJBQm7nT2e2TceFQPQNC6Te910LYWAv57QLNvhQPnkQr2pT5Art8bs3wZ4qHX0bkzUq9txaUXMo8JBour5AKHBW1YubbxuCCYE0ImQnwlZ7kANDGjgkE2ACRTMmS0y+hzXW4Da1kXFGaleCJBLIKhT8XSwWGCQMCg7UAlWEp5CGjhJwCEGfV3J5VmV828xjk/7cFTTbxeWYivyehcNsg4MIzGMk5B0tR7UvPNyzmUJLK8v0QKCntUyJiuaLCEJGWrlmDrcMd74bF+tG2NYay5vheHjLLtfxNfMXXS/TP/7Jrbkht8iC+7YGhbJhxVrTE9yET4bMGQvzSxtATNabW0MRHg5WxdHgD3EgOypKsrJ0llZbMSgrg+vuRUws9cN3HUe7aLKostsi8EjWC8BAJnp8gyuiQW1yiIql+eClY0jSP2wr2+nV/cPKNAtUfMUQXkEc7aBxYNCASVWik0AZF6tZs5+y7Yop1c3Vxf9KJ0+t0spj1yxwDFq/dAIxsaY+K4IgXcXvgFLMNAbZbHR0xbBKS+KOh6U4568tXehvOZ5pTq/zTYTDnGpGRTli1Z4KBdwka0FD3FDvZkv3Hsibj+374m4/Twlzz99+EQGKUxNd5i6jO3WdkkZ0NmYgtmT1ZokINIVz4tytjcfRaafm6Sd5J5/Ihd3v07uvtxe1vElImkoNtPKmu4YBAC1pgfvq0ihKmvb/N7xFQwWjxYYXDHYz/URNDxdM/LWT77FnhRCPpUsbrbrB4bZbsNo2GDcQdusnw6gbQ7hMxjlPHk6lNMQ1IEeAFA9dQ85um4cnmcRFj3qLsgF34nCFuyZlX7yUj9R89KAahC6xr/5DP+ekc/nD+c3jecm57fXF6/kIyz+6X2ExSF9BNQlJUu5a8fu4XNEjajfhmkQQ36YBtEwWwc7too04k4g+sAKss0ngUBWaAUerCraVCb9veUze80QmuZkAmnGLgjuVpOZXreLyUyvB9b8E3wd7b0t6llMryeu+h739DvYTH85slXQGVTbBc2ys+tLs/ZJOc2Kp6b5IHMGGjRyuj4R7Ou4NXn6HRxgL8Aq5YmZfLXxupiyr/GCDIqlLpSxS6P1mxFmgbPw17e/XT9ctWHMS5ony10Q6DfrsoZG/rJYrwohyW+cxlsPuMdVyXdh/OX+um43BLJ62KRcrDP60rx9dWt++D7eDjOAse/Kbs1P0idHqlu2VBYHEedD0cnidUTZw3QHMbYtRESoNWu8ltBc8WwvywvcImYzZdpw1dZHIofirQtzeL0BdRFpIuPSgb3Zmbp8fBeOXwQrz87hbeRgpnDc0W5ddbU1syneVd5gZGqMQMxHijakAAZIHTL3gA7S1oFRgQmeK55l3GRXzpncMJa7krAxR9VJHpnHH37+SO41JF0d7oefP5EpRBKKnEzgjkUmxGOdXqWCcTRHjeyHNh/NU9C2R5ufouI1TFbrWGQCYTq02ja7JQ6w0O0XmwbaZeNrmK7ubzEDaTsVbsejIAORroMMmu0Ikp9eTsiKpdxUy1676TzY0FPID6V5I4sg3GoPmO7PpuLbRgxBrALHR+PEdZNtUuS5zvk5IFO8U6qmba5N7QCi5BrgEe+eIIJW9zSSC7o7wwXUqCAxRC4dyODVECLPlaJVyotRJzqojRYhE1RTndCUFw0UifqftGhVEk7C+DNLiZdkHYSE+3CHFJqDDKn3Cu/+YfLh/LdJN1a9MBOj1gO7Q9US04TdX8WpNfn3D3V9deglMe5GCRs7Xkm1vUGaIqlq0gks0V8kvxOICNrgDkqnV9STi1+/fPj540engFm4GR06ZuemtLWLga96oke0+jrUA0L6z1HgCesGWHaESlnyORwAcdGe6pymBXmEI8slS54fT0cBWuo/9QycDXw8JY/wrPpbeQY8h8XwM3t0mm+abCz/qE+5eu2aXgfLTYc0qGHU2I/s1Eker+bRfpTQgsMNNwbEeBQZjT82x2FnCequDm6BgnN8RY6EBsK6+68IqrJ45mCiaRaENlBYdvQ7dC04MQ5jeD1vyBfO0Sk6OkVHp+joFB2doqNT9Ic6RRjMkMuSL6QTz3hQX5zdTy4i4an6gWEJF5ZTWG03dW70tqAOsYIoIWETN+whzlFvRIquuJSJLV1f+rHQBc9Y4w6hU1PU2JCt8OCSUGdnICpr47D+FLmDUpobLpDE9aVzB4YJQUE90EjVS9gP5AnbXhRuYqLba5oOnIpL2aK+fQ4Q3egWj0cHCha2jyw3wEAfYXY5ry+BqHvKo7hFNPFbwtbNitj9IUVA4UURazrROKLDa3u8ZtZlbjD44WYam2s302GBRJmJwTNsSfNULOnvbAaneeEcRbpfcsffl8wWjX24mZKcPRWS61wbCNfOYce3jrHbDV/jky6p23tEhzZVcWtdB57lSfmyhp5aRS9pqFb7tgKHBjTAAabBIwM9fSkcW37mRSXMgzFISlqz3QKRQXAxYDBoGanylJWZOnaCGlFpFnKnFtgevROe6trRbnMhzluSE8mh2l/9s/5MGNzZF2mtLpw/S1gJRyrgwOcMN4UPObYEhjmoTVdieHxTsYeh4qTuQxUKwbJF8BQpvuEAjrdqybKsfXmiO9NCs80l1M7v6xoGPRKxqhaz/YzCv3HyAecvbia8agbZcLGEY01uUzCnrlitqlxJgaQVhIC0DsRxMR4FGyWqNSwPWDpL+HoZu3eqeU5/i8bd4Fl9JOu2gcP1cVmmEBZQ8RKP9bpgDRnzz5Qxdcei+MuHD5vNZsxpTsdF+fRBhyHVEboPMhNntYlvfBx/W8pV9i/+l2c/9YqlWMERS1HrgIOJyC1p4LBBRwgjW1pkiEfsKBigfgZkz3ja+KTFEpaCVRbhJjcnT6vJ0EA17xxKMLyfeVq7FXoSNxsWmoheByktoqJNM56neMqn9Xj3/GwBNoN2WQgJpMUoCgCqFiDXWUZfWDkz83bmWM59AbUHjTu3HAxnCoPVHd3zbRxvFk7AmTYXrwSf5U9yaXxc5IgGSu20mSGigt6QGrZaw638qiRGkCLYjPQZzIBgZq5opaKIukvyuqFqAP0JzYK5jsSWCu7WnDprQ5oyHUJa4iioeiqiaYbKo+Ams8B1dFhVDCnUBpzn7ohTUh2PgvLSB4OtwTms3LS1IaLikuHp5lbz7OpN6V4z1lrk5i87NaplLg7cwJad2KqZLVqm2QPa+F2sQbP+yXbWYE9lHLLdLfi3SIilLjvi691RFOL3UKxf8MotmudFBQmXSlXQhoq1NbuM7IO0/P4g59mGvoimMnZGSsPddnzyUd9I8Vp2Ub8YcRWUzvNP945H7bEyCvUCqr5RSPR9Aav/+deP/457AkYBRmaKYCWn2SyQa97dzR47mC6OFN+CcwxkMYs2wjov5Cx4z6bm26ir0GJ6CasH/b679nD6BO/BeqYZTzsw0IVk5e4Q1OsRBKqAIRMR5utqnvEErvub0eypKLlcroI4dlbBlmzDAjsY3wrEAfO5qcwILuXJ/fT8lFxOz8HLubq4nJ73N6lRaGD7wTuFMgPoTbjQggxh4UBlVbLvKkKvl9+KGkUEJc0kK6Ek1TNT6wAx2la3+5Kp1KXK5LwmR6C+lgj2bARLSTe7SsjetlUzAaM8ufq13oAMsRRV6DLoLW2xabStH2SUbLO1w+ywqmvpnW/bRhwtdOpCofIFz3lFuRXlE835/x9koXXn0OoLr7t8aQaFIve2519yLlUJNZ575DtQKOOYe5GFnVhPkA5ooZI9QfsRCPZmB4akWK0OlN4AUw4gwNIbq7SZ2i61/XdH5igEiAtRsXK3OXGVSy5hDqjllajA0ctTglnux6lxnBp/mqkxaqLB3Q7nqVHf/NjaKzfrzaNXfvTKj1750Ss/euVHr/zolR+98qNXfvTKj16545U3wbSd8lmypDwf9VlLD8cFvALbibKEQq/GaqNXvlVuzOsgwN36bgQ0gyp7wESM+juiw4k5zzVIk+1nKg9D6FQxUYNMeQ+Q7PmCX5pM+AC0Bc+fWLkuee7llIf1lofss/MmioMLN0lrPGqrKOerGsP/0U/e9zH2LQh/O/+kGJqQSY0ooiEbX9cQllS41S/6uiiIpu1vGp8TcDrgIC1s2RxBgndqVb/e7Cvg07Eu7RTLgiQ0Syq4+J/IJSNLKpbj0T8GAB2gztk="
 }
@@ -28,15 +28,18 @@ import (
 	_ "github.com/elastic/beats/packetbeat/protos/amqp"
 	_ "github.com/elastic/beats/packetbeat/protos/applayer"
 	_ "github.com/elastic/beats/packetbeat/protos/cassandra"
+	_ "github.com/elastic/beats/packetbeat/protos/dhcpv4"
 	_ "github.com/elastic/beats/packetbeat/protos/dns"
 	_ "github.com/elastic/beats/packetbeat/protos/http"
 	_ "github.com/elastic/beats/packetbeat/protos/icmp"
+	_ "github.com/elastic/beats/packetbeat/protos/kafka"
 	_ "github.com/elastic/beats/packetbeat/protos/memcache"
 	_ "github.com/elastic/beats/packetbeat/protos/mongodb"
 	_ "github.com/elastic/beats/packetbeat/protos/mysql"
 	_ "github.com/elastic/beats/packetbeat/protos/nfs"
 	_ "github.com/elastic/beats/packetbeat/protos/pgsql"
 	_ "github.com/elastic/beats/packetbeat/protos/redis"
+	_ "github.com/elastic/beats/packetbeat/protos/sip"
 	_ "github.com/elastic/beats/packetbeat/protos/tcp"
 	_ "github.com/elastic/beats/packetbeat/protos/thrift"
 	_ "github.com/elastic/beats/packetbeat/protos/tls"
@@ -0,0 +1,345 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package pcapdump writes captured packets to pcap files. It keeps a ring
+// buffer of recent packets per interface, dumped when a transaction matches a
+// condition or on request, and continuously writes rotated pcap files.
+package pcapdump
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+	"github.com/elastic/beats/libbeat/paths"
+	"github.com/elastic/beats/libbeat/processors"
+	"github.com/elastic/beats/packetbeat/config"
+)
+
+const (
+	defaultPath = "pcap"
+
+	defaultRingMaxBytes    = 10 * 1024 * 1024
+	defaultRingMaxAge      = time.Minute
+	defaultRingMinInterval = time.Minute
+
+	defaultRotateMaxBytes  = 100 * 1024 * 1024
+	defaultRotateKeepFiles = 10
+)
+
+var (
+	dumpsWritten = monitoring.NewInt(nil, "pcapdump.dumps")
+	dumpsSkipped = monitoring.NewInt(nil, "pcapdump.skipped")
+	writeErrors  = monitoring.NewInt(nil, "pcapdump.write_errors")
+)
+
+var debugf = logp.MakeDebug("pcapdump")
+
+// Worker receives the packets of a sniffer. It matches sniffer.Worker.
+type Worker interface {
+	OnPacket(data []byte, ci *gopacket.CaptureInfo)
+}
+
+// Capture writes the packets of all interfaces to pcap files.
+type Capture struct {
+	path        string
+	ring        *config.RingBuffer
+	rotate      *config.Rotate
+	condition   *processors.Condition
+	minInterval time.Duration
+
+	mutex       sync.Mutex
+	interfaces  []*captureWorker
+	lastTrigger time.Time
+	dumping     bool
+}
+
+type captureWorker struct {
+	next     Worker
+	prefix   string
+	linkType layers.LinkType
+
+	ring *ringBuffer
+
+	mutex   sync.Mutex
+	rotator *rotatingWriter
+}
+
+// New creates a Capture from the configuration. It returns nil if neither
+// the ring buffer nor the rotated files are enabled.
+func New(cfg *config.PacketCapture) (*Capture, error) {
+	if !cfg.IsEnabled() {
+		return nil, nil
+	}
+
+	path := cfg.Path
+	if path == "" {
+		path = defaultPath
+	}
+	c := &Capture{
+		path: paths.Resolve(paths.Data, path),
+	}
+
+	if cfg.RingBuffer.IsEnabled() {
+		ring := *cfg.RingBuffer
+		if ring.MaxBytes == 0 {
+			ring.MaxBytes = defaultRingMaxBytes
+		}
+		if ring.MaxAge == 0 {
+			ring.MaxAge = defaultRingMaxAge
+		}
+		c.minInterval = ring.MinInterval
+		if c.minInterval == 0 {
+			c.minInterval = defaultRingMinInterval
+		}
+		if ring.When != nil {
+			condition, err := processors.NewCondition(ring.When)
+			if err != nil {
+				return nil, err
+			}
+			c.condition = condition
+		}
+		c.ring = &ring
+	}
+
+	if cfg.Rotate.IsEnabled() {
+		rotate := *cfg.Rotate
+		if rotate.MaxBytes == 0 {
+			rotate.MaxBytes = defaultRotateMaxBytes
+		}
+		if rotate.KeepFiles == 0 {
+			rotate.KeepFiles = defaultRotateKeepFiles
+		}
+		c.rotate = &rotate
+	}
+
+	if err := os.MkdirAll(c.path, 0750); err != nil {
+		return nil, err
+	}
+	logp.Info("Writing pcap files to %s", c.path)
+	return c, nil
+}
+
+// Worker wraps the worker of a sniffer, recording all packets before
+// forwarding them. The device name is empty if packets are read from a file.
+func (c *Capture) Worker(device string, linkType layers.LinkType, next Worker) Worker {
+	w := &captureWorker{
+		next:     next,
+		prefix:   filePrefix(device),
+		linkType: linkType,
+	}
+	if c.ring != nil {
+		w.ring = newRingBuffer(c.ring.MaxBytes, c.ring.MaxAge)
+	}
+	if c.rotate != nil {
+		w.rotator = &rotatingWriter{
+			dir:       c.path,
+			prefix:    w.prefix,
+			linkType:  linkType,
+			maxBytes:  c.rotate.MaxBytes,
+			interval:  c.rotate.Interval,
+			keepFiles: c.rotate.KeepFiles,
+		}
+	}
+
+	c.mutex.Lock()
+	c.interfaces = append(c.interfaces, w)
+	c.mutex.Unlock()
+	return w
+}
+
+func (w *captureWorker) OnPacket(data []byte, ci *gopacket.CaptureInfo) {
+	if w.ring != nil {
+		w.ring.add(*ci, data)
+	}
+	if w.rotator != nil {
+		w.mutex.Lock()
+		if err := w.rotator.write(*ci, data); err != nil {
+			writeErrors.Inc()
+			debugf("Failed to write packet to pcap file: %v", err)
+		}
+		w.mutex.Unlock()
+	}
+	w.next.OnPacket(data, ci)
+}
+
+func (w *captureWorker) close() error {
+	if w.rotator == nil {
+		return nil
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.rotator.close()
+}
+
+// OnEvent dumps the ring buffers in the background if the transaction event
+// matches the configured condition. Dumps are triggered at most once per
+// minimum interval.
+func (c *Capture) OnEvent(event *beat.Event) {
+	if c.condition == nil || !c.condition.Check(event.Fields) {
+		return
+	}
+
+	c.mutex.Lock()
+	now := time.Now()
+	if c.dumping || now.Sub(c.lastTrigger) < c.minInterval {
+		c.mutex.Unlock()
+		dumpsSkipped.Inc()
+		return
+	}
+	c.lastTrigger = now
+	c.dumping = true
+	c.mutex.Unlock()
+
+	go func() {
+		defer func() {
+			c.mutex.Lock()
+			c.dumping = false
+			c.mutex.Unlock()
+		}()
+
+		files, err := c.Dump()
+		if err != nil {
+			logp.Err("Failed to dump packets: %v", err)
+			return
+		}
+		logp.Info("Transaction matched the pcap dump condition, packets written to %v", files)
+	}()
+}
+
+func (c *Capture) isDumping() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.dumping
+}
+
+// Dump writes the ring buffer of each interface to a new pcap file and
+// returns the paths of the files written. Interfaces without buffered
+// packets are skipped.
+func (c *Capture) Dump() ([]string, error) {
+	if c.ring == nil {
+		return nil, errors.New("the packet ring buffer is disabled")
+	}
+
+	c.mutex.Lock()
+	interfaces := c.interfaces
+	c.mutex.Unlock()
+
+	var files []string
+	for _, w := range interfaces {
+		packets := w.ring.snapshot()
+		if len(packets) == 0 {
+			continue
+		}
+
+		path, err := c.dump(w, packets)
+		if err != nil {
+			return files, err
+		}
+		dumpsWritten.Inc()
+		files = append(files, path)
+	}
+	return files, nil
+}
+
+func (c *Capture) dump(w *captureWorker, packets []packet) (string, error) {
+	f, err := createFile(c.path, w.prefix+"-dump", time.Now())
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	buf := bufio.NewWriter(f)
+	writer, err := NewWriter(buf, w.linkType)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range packets {
+		if err := writer.WritePacket(p.ci, p.data); err != nil {
+			return "", err
+		}
+	}
+	if err := buf.Flush(); err != nil {
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
+
+// Close flushes and closes the rotated pcap files.
+func (c *Capture) Close() error {
+	c.mutex.Lock()
+	interfaces := c.interfaces
+	c.mutex.Unlock()
+
+	var firstErr error
+	for _, w := range interfaces {
+		if err := w.close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// HandleDump is an HTTP handler dumping the ring buffers on POST requests.
+// It responds with the paths of the files written.
+func (c *Capture) HandleDump(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	files, err := c.Dump()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if files == nil {
+		files = []string{}
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(map[string]interface{}{"files": files})
+}
+
+// filePrefix derives the file name prefix from the device name. Characters
+// other than letters and digits are replaced, so the prefix is safe to use
+// in file names and glob patterns.
+func filePrefix(device string) string {
+	if device == "" {
+		device = "file"
+	}
+
+	name := []byte(device)
+	for i, b := range name {
+		isAlnum := (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+		if !isAlnum {
+			name[i] = '_'
+		}
+	}
+	return "packetbeat-" + string(name)
+}
@@ -0,0 +1,297 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package pcapdump
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/config"
+)
+
+type countingWorker struct {
+	packets int
+}
+
+func (w *countingWorker) OnPacket(data []byte, ci *gopacket.CaptureInfo) {
+	w.packets++
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "pcapdump")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return dir
+}
+
+func pcapFiles(t *testing.T, dir, pattern string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, pattern))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return files
+}
+
+func TestRingBufferMaxBytes(t *testing.T) {
+	ts := time.Now()
+	r := newRingBuffer(3*recordSize(make([]byte, 100)), 0)
+	for i := 0; i < 5; i++ {
+		p := testPacket(ts.Add(time.Duration(i)*time.Millisecond), 100)
+		r.add(p.ci, p.data)
+	}
+
+	packets := r.snapshot()
+	if !assert.Len(t, packets, 3) {
+		t.FailNow()
+	}
+	assert.True(t, ts.Add(2*time.Millisecond).Equal(packets[0].ci.Timestamp))
+	assert.Equal(t, 3*recordSize(make([]byte, 100)), r.bytes)
+
+	// packets larger than the whole buffer are not kept
+	p := testPacket(ts.Add(time.Second), 1000)
+	r.add(p.ci, p.data)
+	assert.Len(t, r.snapshot(), 3)
+}
+
+func TestRingBufferMaxAge(t *testing.T) {
+	ts := time.Now()
+	r := newRingBuffer(0, 10*time.Second)
+	for _, offset := range []int{0, 5, 12, 20} {
+		p := testPacket(ts.Add(time.Duration(offset)*time.Second), 10)
+		r.add(p.ci, p.data)
+	}
+
+	packets := r.snapshot()
+	if !assert.Len(t, packets, 2) {
+		t.FailNow()
+	}
+	assert.True(t, ts.Add(12*time.Second).Equal(packets[0].ci.Timestamp))
+}
+
+func TestRingBufferCopiesData(t *testing.T) {
+	r := newRingBuffer(0, 0)
+	p := testPacket(time.Now(), 4)
+	r.add(p.ci, p.data)
+	p.data[0] = 0xff
+
+	assert.Equal(t, byte(0), r.snapshot()[0].data[0])
+}
+
+func TestRotatingWriter(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	size := recordSize(make([]byte, 100))
+	r := &rotatingWriter{
+		dir:       dir,
+		prefix:    "packetbeat-eth0",
+		linkType:  layers.LinkTypeEthernet,
+		maxBytes:  24 + 2*size,
+		keepFiles: 2,
+	}
+
+	ts := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 7; i++ {
+		p := testPacket(ts.Add(time.Duration(i)*time.Second), 100)
+		if !assert.NoError(t, r.write(p.ci, p.data)) {
+			t.FailNow()
+		}
+	}
+	if !assert.NoError(t, r.close()) {
+		t.FailNow()
+	}
+
+	// 4 files were written, the oldest 2 were removed
+	files := pcapFiles(t, dir, "*.pcap")
+	if !assert.Len(t, files, 2) {
+		t.FailNow()
+	}
+	assert.Equal(t, filepath.Join(dir, "packetbeat-eth0-20180601T120004.000000Z.pcap"), files[0])
+
+	_, packets := readPcapFile(t, files[0])
+	assert.Len(t, packets, 2)
+	_, packets = readPcapFile(t, files[1])
+	if !assert.Len(t, packets, 1) {
+		t.FailNow()
+	}
+	assert.True(t, ts.Add(6*time.Second).Equal(packets[0].ci.Timestamp))
+}
+
+func TestRotatingWriterInterval(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	r := &rotatingWriter{
+		dir:      dir,
+		prefix:   "packetbeat-eth0",
+		linkType: layers.LinkTypeEthernet,
+		interval: time.Minute,
+	}
+
+	ts := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, offset := range []int{0, 30, 59, 60, 90} {
+		p := testPacket(ts.Add(time.Duration(offset)*time.Second), 10)
+		if !assert.NoError(t, r.write(p.ci, p.data)) {
+			t.FailNow()
+		}
+	}
+	if !assert.NoError(t, r.close()) {
+		t.FailNow()
+	}
+
+	files := pcapFiles(t, dir, "*.pcap")
+	if !assert.Len(t, files, 2) {
+		t.FailNow()
+	}
+	_, packets := readPcapFile(t, files[0])
+	assert.Len(t, packets, 3)
+	_, packets = readPcapFile(t, files[1])
+	assert.Len(t, packets, 2)
+}
+
+func TestCaptureRotate(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	c, err := New(&config.PacketCapture{Path: dir, Rotate: &config.Rotate{}})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	next := &countingWorker{}
+	w := c.Worker("eth0", layers.LinkTypeEthernet, next)
+	for i := 0; i < 3; i++ {
+		p := testPacket(time.Now(), 60)
+		w.OnPacket(p.data, &p.ci)
+	}
+	if !assert.NoError(t, c.Close()) {
+		t.FailNow()
+	}
+	assert.Equal(t, 3, next.packets)
+
+	files := pcapFiles(t, dir, "packetbeat-eth0-*.pcap")
+	if !assert.Len(t, files, 1) {
+		t.FailNow()
+	}
+	_, packets := readPcapFile(t, files[0])
+	assert.Len(t, packets, 3)
+
+	_, err = c.Dump()
+	assert.Error(t, err, "dumping requires the ring buffer")
+}
+
+func TestCaptureDumpOnEvent(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	cfg, err := common.NewConfigFrom(map[string]interface{}{
+		"path":                    dir,
+		"ring_buffer.when.equals": map[string]interface{}{"status": "Error"},
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	var captureConfig config.PacketCapture
+	if !assert.NoError(t, cfg.Unpack(&captureConfig)) {
+		t.FailNow()
+	}
+
+	c, err := New(&captureConfig)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	w := c.Worker("eth0", layers.LinkTypeLinuxSLL, &countingWorker{})
+	for i := 0; i < 3; i++ {
+		p := testPacket(time.Now(), 60)
+		w.OnPacket(p.data, &p.ci)
+	}
+
+	c.OnEvent(&beat.Event{Fields: common.MapStr{"status": "OK"}})
+	c.OnEvent(&beat.Event{Fields: common.MapStr{"status": "Error"}})
+
+	var files []string
+	for i := 0; i < 100 && len(files) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+		files = pcapFiles(t, dir, "packetbeat-eth0-dump-*.pcap")
+	}
+	if !assert.Len(t, files, 1) {
+		t.FailNow()
+	}
+
+	// wait for the dump to complete, further matches within the minimum
+	// interval are ignored
+	for i := 0; i < 100 && c.isDumping(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.OnEvent(&beat.Event{Fields: common.MapStr{"status": "Error"}})
+	time.Sleep(10 * time.Millisecond)
+	assert.Len(t, pcapFiles(t, dir, "*.pcap"), 1)
+
+	linkType, packets := readPcapFile(t, files[0])
+	assert.Equal(t, layers.LinkTypeLinuxSLL, linkType)
+	assert.Len(t, packets, 3)
+}
+
+func TestCaptureHandleDump(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	c, err := New(&config.PacketCapture{Path: dir, RingBuffer: &config.RingBuffer{}})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	w := c.Worker("", layers.LinkTypeEthernet, &countingWorker{})
+	p := testPacket(time.Now(), 60)
+	w.OnPacket(p.data, &p.ci)
+
+	rec := httptest.NewRecorder()
+	c.HandleDump(rec, httptest.NewRequest("GET", "/pcap/dump", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	c.HandleDump(rec, httptest.NewRequest("POST", "/pcap/dump", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	files := pcapFiles(t, dir, "packetbeat-file-dump-*.pcap")
+	if !assert.Len(t, files, 1) {
+		t.FailNow()
+	}
+	assert.JSONEq(t, `{"files": ["`+files[0]+`"]}`, rec.Body.String())
+}
+
+func TestFilePrefix(t *testing.T) {
+	assert.Equal(t, "packetbeat-file", filePrefix(""))
+	assert.Equal(t, "packetbeat-eth0_100", filePrefix("eth0.100"))
+	assert.Equal(t, "packetbeat-_Device_NPF__1_", filePrefix(`\Device\NPF_{1}`))
+}
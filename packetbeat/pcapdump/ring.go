@@ -0,0 +1,101 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pcapdump
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tsg/gopacket"
+)
+
+type packet struct {
+	ci   gopacket.CaptureInfo
+	data []byte
+}
+
+// ringBuffer keeps the most recent packets of an interface. Packets are
+// dropped oldest first once the buffered bytes exceed maxBytes, or once they
+// are older than maxAge compared to the newest packet.
+//
+// Time is driven by the packet timestamps, so reading from a pcap file gives
+// the same results as live capture.
+type ringBuffer struct {
+	mutex    sync.Mutex
+	maxBytes int
+	maxAge   time.Duration
+
+	packets []packet
+	bytes   int
+}
+
+func newRingBuffer(maxBytes int, maxAge time.Duration) *ringBuffer {
+	return &ringBuffer{maxBytes: maxBytes, maxAge: maxAge}
+}
+
+// add stores a copy of the packet data, as the sniffer reuses its buffers.
+func (r *ringBuffer) add(ci gopacket.CaptureInfo, data []byte) {
+	size := recordSize(data)
+	if r.maxBytes > 0 && size > r.maxBytes {
+		return
+	}
+
+	buf := make([]byte, len(data))
+	copy(buf, data)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.packets = append(r.packets, packet{ci: ci, data: buf})
+	r.bytes += size
+	r.evict(ci.Timestamp)
+}
+
+func (r *ringBuffer) evict(now time.Time) {
+	n := 0
+	for ; n < len(r.packets); n++ {
+		p := &r.packets[n]
+		tooLarge := r.maxBytes > 0 && r.bytes > r.maxBytes
+		tooOld := r.maxAge > 0 && now.Sub(p.ci.Timestamp) > r.maxAge
+		if !tooLarge && !tooOld {
+			break
+		}
+		r.bytes -= recordSize(p.data)
+	}
+	if n == 0 {
+		return
+	}
+
+	// Clear the references to the dropped packets, so the garbage collector
+	// can reclaim them before the backing array is reallocated.
+	for i := 0; i < n; i++ {
+		r.packets[i] = packet{}
+	}
+	r.packets = r.packets[n:]
+}
+
+// snapshot returns the buffered packets, oldest first. The returned packets
+// must not be modified.
+func (r *ringBuffer) snapshot() []packet {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	packets := make([]packet, len(r.packets))
+	copy(packets, r.packets)
+	return packets
+}
@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pcapdump
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// rotatingWriter continuously writes packets to pcap files in a directory.
+// A new file is started once the current one exceeds maxBytes or, if an
+// interval is set, once the interval passed since its first packet. Only
+// the newest keepFiles files with the writer's prefix are kept.
+type rotatingWriter struct {
+	dir       string
+	prefix    string
+	linkType  layers.LinkType
+	maxBytes  int
+	interval  time.Duration
+	keepFiles int
+
+	file    *os.File
+	buf     *bufio.Writer
+	writer  *Writer
+	size    int
+	started time.Time
+}
+
+func (r *rotatingWriter) write(ci gopacket.CaptureInfo, data []byte) error {
+	if r.file == nil || r.shouldRotate(ci.Timestamp, data) {
+		if err := r.rotate(ci.Timestamp); err != nil {
+			return err
+		}
+	}
+
+	if err := r.writer.WritePacket(ci, data); err != nil {
+		return err
+	}
+	r.size += recordSize(data)
+	return nil
+}
+
+func (r *rotatingWriter) shouldRotate(ts time.Time, data []byte) bool {
+	if r.maxBytes > 0 && r.size+recordSize(data) > r.maxBytes {
+		return true
+	}
+	return r.interval > 0 && ts.Sub(r.started) >= r.interval
+}
+
+func (r *rotatingWriter) rotate(ts time.Time) error {
+	if err := r.close(); err != nil {
+		logp.Err("Failed to close pcap file: %v", err)
+	}
+
+	var err error
+	r.file, err = createFile(r.dir, r.prefix, ts)
+	if err != nil {
+		return err
+	}
+
+	r.buf = bufio.NewWriter(r.file)
+	r.writer, err = NewWriter(r.buf, r.linkType)
+	if err != nil {
+		r.close()
+		return err
+	}
+	r.size = 24
+	r.started = ts
+	debugf("Started pcap file %s", r.file.Name())
+
+	r.removeOldFiles()
+	return nil
+}
+
+// removeOldFiles deletes the oldest files with the writer's prefix, including
+// files written before a restart. The file names sort by time.
+func (r *rotatingWriter) removeOldFiles() {
+	if r.keepFiles <= 0 {
+		return
+	}
+
+	files, err := filepath.Glob(filepath.Join(r.dir, globPattern(r.prefix)))
+	if err != nil || len(files) <= r.keepFiles {
+		return
+	}
+
+	sort.Strings(files)
+	for _, path := range files[:len(files)-r.keepFiles] {
+		if err := os.Remove(path); err != nil {
+			logp.Err("Failed to remove old pcap file %s: %v", path, err)
+		}
+	}
+}
+
+func (r *rotatingWriter) close() error {
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.buf.Flush()
+	if cerr := r.file.Close(); err == nil {
+		err = cerr
+	}
+	r.file, r.buf, r.writer = nil, nil, nil
+	return err
+}
+
+// createFile exclusively creates a new file named after the prefix and the
+// timestamp. A counter is added if a file for the same timestamp exists.
+func createFile(dir, prefix string, ts time.Time) (*os.File, error) {
+	name := prefix + "-" + ts.UTC().Format("20060102T150405.000000Z")
+	for i := 0; ; i++ {
+		path := filepath.Join(dir, name+".pcap")
+		if i > 0 {
+			path = filepath.Join(dir, fmt.Sprintf("%s-%d.pcap", name, i))
+		}
+
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if os.IsExist(err) {
+			continue
+		}
+		return f, err
+	}
+}
+
+func globPattern(prefix string) string {
+	return prefix + "-[0-9]*.pcap"
+}
@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pcapdump
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+)
+
+const (
+	pcapMagic        = 0xa1b2c3d4
+	pcapVersionMajor = 2
+	pcapVersionMinor = 4
+
+	// maxSnaplen is the snapshot length announced in the file header. It is
+	// the largest snapshot length libpcap accepts, so packets captured with
+	// any configured snaplen fit.
+	maxSnaplen = 262144
+)
+
+var errPacketTooLarge = errors.New("packet exceeds the maximum snapshot length")
+
+// Writer writes packets in the libpcap file format with microsecond
+// timestamps in little-endian byte order.
+type Writer struct {
+	w   io.Writer
+	buf [24]byte
+}
+
+// NewWriter writes the pcap file header to w and returns a Writer for
+// appending packets of the given link type.
+func NewWriter(w io.Writer, linkType layers.LinkType) (*Writer, error) {
+	pw := &Writer{w: w}
+
+	hdr := pw.buf[:24]
+	binary.LittleEndian.PutUint32(hdr[0:], pcapMagic)
+	binary.LittleEndian.PutUint16(hdr[4:], pcapVersionMajor)
+	binary.LittleEndian.PutUint16(hdr[6:], pcapVersionMinor)
+	binary.LittleEndian.PutUint32(hdr[8:], 0)  // thiszone
+	binary.LittleEndian.PutUint32(hdr[12:], 0) // sigfigs
+	binary.LittleEndian.PutUint32(hdr[16:], maxSnaplen)
+	binary.LittleEndian.PutUint32(hdr[20:], uint32(linkType))
+	if _, err := w.Write(hdr); err != nil {
+		return nil, err
+	}
+	return pw, nil
+}
+
+// WritePacket appends a packet record to the file. The original length is
+// taken from ci, so truncated packets are recorded as such.
+func (pw *Writer) WritePacket(ci gopacket.CaptureInfo, data []byte) error {
+	if len(data) > maxSnaplen {
+		return errPacketTooLarge
+	}
+
+	length := ci.Length
+	if length < len(data) {
+		length = len(data)
+	}
+
+	ts := ci.Timestamp
+	hdr := pw.buf[:16]
+	binary.LittleEndian.PutUint32(hdr[0:], uint32(ts.Unix()))
+	binary.LittleEndian.PutUint32(hdr[4:], uint32(ts.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(hdr[8:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(hdr[12:], uint32(length))
+	if _, err := pw.w.Write(hdr); err != nil {
+		return err
+	}
+	_, err := pw.w.Write(data)
+	return err
+}
+
+// recordSize returns the number of bytes a packet takes up in a pcap file.
+func recordSize(data []byte) int {
+	return 16 + len(data)
+}
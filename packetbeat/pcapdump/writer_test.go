@@ -0,0 +1,133 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package pcapdump
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tsg/gopacket"
+	"github.com/tsg/gopacket/layers"
+)
+
+// readPcap parses a pcap file written by Writer.
+func readPcap(t *testing.T, data []byte) (layers.LinkType, []packet) {
+	if !assert.True(t, len(data) >= 24, "missing file header") {
+		t.FailNow()
+	}
+	if !assert.Equal(t, uint32(pcapMagic), binary.LittleEndian.Uint32(data[0:])) {
+		t.FailNow()
+	}
+	if !assert.Equal(t, uint16(pcapVersionMajor), binary.LittleEndian.Uint16(data[4:])) {
+		t.FailNow()
+	}
+	if !assert.Equal(t, uint16(pcapVersionMinor), binary.LittleEndian.Uint16(data[6:])) {
+		t.FailNow()
+	}
+	linkType := layers.LinkType(binary.LittleEndian.Uint32(data[20:]))
+
+	var packets []packet
+	for data = data[24:]; len(data) > 0; {
+		if !assert.True(t, len(data) >= 16, "truncated record header") {
+			t.FailNow()
+		}
+		sec := binary.LittleEndian.Uint32(data[0:])
+		usec := binary.LittleEndian.Uint32(data[4:])
+		capLen := int(binary.LittleEndian.Uint32(data[8:]))
+		origLen := int(binary.LittleEndian.Uint32(data[12:]))
+		if !assert.True(t, len(data) >= 16+capLen, "truncated record") {
+			t.FailNow()
+		}
+
+		packets = append(packets, packet{
+			ci: gopacket.CaptureInfo{
+				Timestamp:     time.Unix(int64(sec), int64(usec)*1000),
+				CaptureLength: capLen,
+				Length:        origLen,
+			},
+			data: data[16 : 16+capLen],
+		})
+		data = data[16+capLen:]
+	}
+	return linkType, packets
+}
+
+func readPcapFile(t *testing.T, path string) (layers.LinkType, []packet) {
+	data, err := ioutil.ReadFile(path)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return readPcap(t, data)
+}
+
+func testPacket(ts time.Time, n int) packet {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	return packet{
+		ci:   gopacket.CaptureInfo{Timestamp: ts, CaptureLength: n, Length: n},
+		data: data,
+	}
+}
+
+func TestWriter(t *testing.T) {
+	ts := time.Date(2018, 6, 1, 12, 0, 0, 123456000, time.UTC)
+	truncated := testPacket(ts.Add(time.Second), 10)
+	truncated.ci.Length = 1500
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, layers.LinkTypeEthernet)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	for _, p := range []packet{testPacket(ts, 60), truncated} {
+		if !assert.NoError(t, w.WritePacket(p.ci, p.data)) {
+			t.FailNow()
+		}
+	}
+
+	linkType, packets := readPcap(t, buf.Bytes())
+	assert.Equal(t, layers.LinkTypeEthernet, linkType)
+	if !assert.Len(t, packets, 2) {
+		t.FailNow()
+	}
+
+	assert.True(t, ts.Equal(packets[0].ci.Timestamp))
+	assert.Equal(t, testPacket(ts, 60).data, packets[0].data)
+	assert.Equal(t, 60, packets[0].ci.Length)
+
+	assert.Equal(t, 10, packets[1].ci.CaptureLength)
+	assert.Equal(t, 1500, packets[1].ci.Length)
+}
+
+func TestWriterPacketTooLarge(t *testing.T) {
+	w, err := NewWriter(ioutil.Discard, layers.LinkTypeEthernet)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	p := testPacket(time.Now(), maxSnaplen+1)
+	assert.Equal(t, errPacketTooLarge, w.WritePacket(p.ci, p.data))
+}
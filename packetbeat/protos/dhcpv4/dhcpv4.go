@@ -0,0 +1,391 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package dhcpv4 provides support for parsing DHCPv4 (RFC 2131) messages
+// and reporting requests and their replies as transactions. Messages are
+// paired using their transaction ID and client hardware address.
+package dhcpv4
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+type dhcpv4Plugin struct {
+	// Configuration data.
+	ports        []int
+	sendRequest  bool
+	sendResponse bool
+
+	// Cache of DHCP transactions waiting for a reply, keyed by
+	// transactionKey.
+	transactions       *common.Cache
+	transactionTimeout time.Duration
+
+	results protos.Reporter // Channel where results are pushed.
+}
+
+var (
+	debugf = logp.MakeDebug("dhcpv4")
+)
+
+var (
+	unmatchedRequests = monitoring.NewInt(nil, "dhcpv4.unmatched_requests")
+	unmatchedReplies  = monitoring.NewInt(nil, "dhcpv4.unmatched_replies")
+)
+
+// Notes added to transactions.
+const (
+	noReply       = "No reply to this request was received"
+	orphanedReply = "Reply received without an associated request"
+)
+
+// transactionKey pairs a request with its reply.
+type transactionKey struct {
+	transactionID uint32
+	clientMAC     string
+}
+
+func (k transactionKey) String() string {
+	return fmt.Sprintf("xid[0x%08x] chaddr[%s]", k.transactionID, k.clientMAC)
+}
+
+type packet struct {
+	ts           time.Time
+	tuple        common.IPPortTuple
+	cmdlineTuple *common.CmdlineTuple
+	size         int
+	raw          []byte
+	msg          *message
+}
+
+type transaction struct {
+	ts    time.Time // Time when the request was received.
+	key   transactionKey
+	src   common.Endpoint
+	dst   common.Endpoint
+	notes []string
+
+	request *packet
+	reply   *packet
+}
+
+func init() {
+	protos.Register("dhcpv4", New)
+}
+
+// New creates and initializes a new DHCPv4 protocol analyzer instance.
+func New(
+	testMode bool,
+	results protos.Reporter,
+	cfg *common.Config,
+) (protos.Plugin, error) {
+	p := &dhcpv4Plugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (dhcp *dhcpv4Plugin) init(results protos.Reporter, config *dhcpv4Config) error {
+	dhcp.setFromConfig(config)
+	dhcp.transactions = common.NewCacheWithRemovalListener(
+		dhcp.transactionTimeout,
+		protos.DefaultTransactionHashSize,
+		func(k common.Key, v common.Value) {
+			trans, ok := v.(*transaction)
+			if !ok {
+				logp.Err("Expired value is not a *transaction.")
+				return
+			}
+			dhcp.expireTransaction(trans)
+		})
+	dhcp.transactions.StartJanitor(dhcp.transactionTimeout)
+
+	dhcp.results = results
+
+	return nil
+}
+
+func (dhcp *dhcpv4Plugin) setFromConfig(config *dhcpv4Config) {
+	dhcp.ports = config.Ports
+	dhcp.sendRequest = config.SendRequest
+	dhcp.sendResponse = config.SendResponse
+	dhcp.transactionTimeout = config.TransactionTimeout
+}
+
+func (dhcp *dhcpv4Plugin) GetPorts() []int {
+	return dhcp.ports
+}
+
+func (dhcp *dhcpv4Plugin) ParseUDP(pkt *protos.Packet) {
+	defer logp.Recover("DHCPv4 ParseUDP")
+
+	debugf("Parsing packet addressed with %s of length %d.",
+		pkt.Tuple.String(), len(pkt.Payload))
+
+	msg, err := decodeMessage(pkt.Payload)
+	if err != nil {
+		debugf("%v", err)
+		return
+	}
+
+	p := &packet{
+		ts:           pkt.Ts,
+		tuple:        pkt.Tuple,
+		cmdlineTuple: procs.ProcWatcher.FindProcessesTuple(&pkt.Tuple),
+		size:         len(pkt.Payload),
+		msg:          msg,
+	}
+	if dhcp.sendRequest || dhcp.sendResponse {
+		p.raw = append([]byte(nil), pkt.Payload...)
+	}
+
+	if msg.isRequest() {
+		dhcp.receivedRequest(p)
+	} else {
+		dhcp.receivedReply(p)
+	}
+}
+
+func keyOf(msg *message) transactionKey {
+	return transactionKey{
+		transactionID: msg.transactionID,
+		clientMAC:     string(msg.clientMAC),
+	}
+}
+
+func newTransaction(p *packet, tuple common.BaseTuple, cmd common.CmdlineTuple) *transaction {
+	t := &transaction{
+		ts:  p.ts,
+		key: keyOf(p.msg),
+	}
+	t.src, t.dst = common.MakeEndpointPair(tuple, &cmd)
+	return t
+}
+
+func (dhcp *dhcpv4Plugin) receivedRequest(p *packet) {
+	key := keyOf(p.msg)
+	debugf("Processing %s request. %s", p.msg.messageType, key)
+
+	t := newTransaction(p, p.tuple.BaseTuple, *p.cmdlineTuple)
+	t.request = p
+	if !p.msg.messageType.expectsReply() {
+		dhcp.publishTransaction(t)
+		return
+	}
+
+	if prev := dhcp.transactions.Put(key, t); prev != nil {
+		// The client retransmitted the request before getting a reply.
+		prev := prev.(*transaction)
+		prev.notes = append(prev.notes, noReply)
+		dhcp.publishTransaction(prev)
+		unmatchedRequests.Add(1)
+	}
+}
+
+func (dhcp *dhcpv4Plugin) receivedReply(p *packet) {
+	key := keyOf(p.msg)
+	debugf("Processing %s reply. %s", p.msg.messageType, key)
+
+	v := dhcp.transactions.Delete(key)
+	if v == nil {
+		tuple := p.tuple.BaseTuple
+		tuple.SrcIP, tuple.DstIP = tuple.DstIP, tuple.SrcIP
+		tuple.SrcPort, tuple.DstPort = tuple.DstPort, tuple.SrcPort
+		t := newTransaction(p, tuple, p.cmdlineTuple.Reverse())
+		t.notes = append(t.notes, orphanedReply)
+		t.reply = p
+		debugf("%s %s", orphanedReply, key)
+		unmatchedReplies.Add(1)
+		dhcp.publishTransaction(t)
+		return
+	}
+
+	t := v.(*transaction)
+	t.reply = p
+	dhcp.publishTransaction(t)
+}
+
+func (dhcp *dhcpv4Plugin) expireTransaction(t *transaction) {
+	t.notes = append(t.notes, noReply)
+	debugf("%s %s", noReply, t.key)
+	dhcp.publishTransaction(t)
+	unmatchedRequests.Add(1)
+}
+
+func (dhcp *dhcpv4Plugin) publishTransaction(t *transaction) {
+	if dhcp.results == nil {
+		return
+	}
+
+	debugf("Publishing transaction. %s", t.key)
+
+	fields := common.MapStr{}
+	fields["type"] = "dhcpv4"
+	fields["transport"] = "udp"
+	fields["src"] = &t.src
+	fields["dst"] = &t.dst
+	fields["status"] = common.ERROR_STATUS
+	if len(t.notes) == 1 {
+		fields["notes"] = t.notes[0]
+	} else if len(t.notes) > 1 {
+		fields["notes"] = strings.Join(t.notes, " ")
+	}
+
+	dhcpEvent := common.MapStr{
+		"transaction_id": fmt.Sprintf("0x%08x", t.key.transactionID),
+		"client_mac":     net.HardwareAddr(t.key.clientMAC).String(),
+	}
+	fields["dhcpv4"] = dhcpEvent
+
+	if requ := t.request; requ != nil {
+		fields["method"] = requ.msg.messageType.String()
+		fields["bytes_in"] = requ.size
+		dhcpEvent["request"] = requ.msg.toMapStr()
+		if !requ.msg.messageType.expectsReply() {
+			fields["status"] = common.OK_STATUS
+		}
+		if dhcp.sendRequest {
+			fields["request"] = fmt.Sprintf("%x", requ.raw)
+		}
+	}
+
+	if reply := t.reply; reply != nil {
+		if t.request == nil {
+			fields["method"] = reply.msg.messageType.String()
+		}
+		fields["bytes_out"] = reply.size
+		dhcpEvent["reply"] = reply.msg.toMapStr()
+		if reply.msg.messageType != msgNak && len(t.notes) == 0 {
+			fields["status"] = common.OK_STATUS
+		}
+		if t.request != nil {
+			fields["responsetime"] = int32(reply.ts.Sub(t.ts).Nanoseconds() / 1e6)
+		}
+		if dhcp.sendResponse {
+			fields["response"] = fmt.Sprintf("%x", reply.raw)
+		}
+	}
+
+	dhcp.results(beat.Event{
+		Timestamp: t.ts,
+		Fields:    fields,
+	})
+}
+
+func (m *message) toMapStr() common.MapStr {
+	fields := common.MapStr{
+		"message_type": m.messageType.String(),
+		"hops":         m.hops,
+		"seconds":      m.seconds,
+		"flags":        "unicast",
+	}
+	if m.broadcast {
+		fields["flags"] = "broadcast"
+	}
+	putIP(fields, "client_ip", m.clientIP)
+	putIP(fields, "assigned_ip", m.assignedIP)
+	putIP(fields, "server_ip", m.serverIP)
+	putIP(fields, "relay_ip", m.relayIP)
+	if m.serverName != "" {
+		fields["server_name"] = m.serverName
+	}
+	if m.bootFileName != "" {
+		fields["boot_file_name"] = m.bootFileName
+	}
+	if opts := m.options.toMapStr(); len(opts) > 0 {
+		fields["option"] = opts
+	}
+	return fields
+}
+
+func (o *options) toMapStr() common.MapStr {
+	fields := common.MapStr{}
+	putIP(fields, "subnet_mask", o.subnetMask)
+	putIPs(fields, "router", o.routers)
+	putIPs(fields, "dns_servers", o.dnsServers)
+	putString(fields, "hostname", o.hostname)
+	putString(fields, "domain_name", o.domainName)
+	putIP(fields, "broadcast_address", o.broadcastAddress)
+	putIPs(fields, "ntp_servers", o.ntpServers)
+	putIP(fields, "requested_ip_address", o.requestedIP)
+	putUint32(fields, "ip_address_lease_time_sec", o.leaseTime)
+	putIP(fields, "server_identifier", o.serverIdentifier)
+	if len(o.parameterRequestList) > 0 {
+		params := make([]int, len(o.parameterRequestList))
+		for i, p := range o.parameterRequestList {
+			params[i] = int(p)
+		}
+		fields["parameter_request_list"] = params
+	}
+	putString(fields, "message", o.message)
+	if o.maxMessageSize > 0 {
+		fields["max_dhcp_message_size"] = o.maxMessageSize
+	}
+	putUint32(fields, "renewal_time_sec", o.renewalTime)
+	putUint32(fields, "rebinding_time_sec", o.rebindingTime)
+	putString(fields, "vendor_class_identifier", o.vendorClassIdentifier)
+	putString(fields, "client_identifier", o.clientIdentifier)
+	return fields
+}
+
+func putIP(m common.MapStr, key string, ip net.IP) {
+	if ip != nil && !ip.IsUnspecified() {
+		m[key] = ip.String()
+	}
+}
+
+func putIPs(m common.MapStr, key string, ips []net.IP) {
+	if len(ips) == 0 {
+		return
+	}
+	list := make([]string, len(ips))
+	for i, ip := range ips {
+		list[i] = ip.String()
+	}
+	m[key] = list
+}
+
+func putString(m common.MapStr, key, value string) {
+	if value != "" {
+		m[key] = value
+	}
+}
+
+func putUint32(m common.MapStr, key string, value uint32) {
+	if value > 0 {
+		m[key] = value
+	}
+}
@@ -0,0 +1,216 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package dhcpv4
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+var (
+	clientRequest = common.NewIPPortTuple(4, net.IPv4zero, 68, net.IPv4bcast, 67)
+	serverReply   = common.NewIPPortTuple(4, net.ParseIP("192.168.0.1"), 67, net.IPv4bcast, 68)
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	e.events = append(e.events, event)
+}
+
+func newDHCPv4(store *eventStore, settings map[string]interface{}) *dhcpv4Plugin {
+	cfg, _ := common.NewConfigFrom(settings)
+	dhcp, err := New(false, store.publish, cfg)
+	if err != nil {
+		panic(err)
+	}
+	return dhcp.(*dhcpv4Plugin)
+}
+
+func newPacket(t common.IPPortTuple, ts time.Time, payload []byte) *protos.Packet {
+	return &protos.Packet{
+		Ts:      ts,
+		Tuple:   t,
+		Payload: payload,
+	}
+}
+
+var (
+	discover = testMessage{
+		op:          opBootRequest,
+		xid:         0x3d1d,
+		broadcast:   true,
+		messageType: msgDiscover,
+		options: [][]byte{
+			option(optHostname, []byte("laptop")...),
+		},
+	}.bytes()
+	offer = testMessage{
+		op:          opBootReply,
+		xid:         0x3d1d,
+		assignedIP:  "192.168.0.10",
+		messageType: msgOffer,
+		options: [][]byte{
+			option(optRouter, 192, 168, 0, 1),
+			option(optLeaseTime, 0, 0, 0x0e, 0x10),
+		},
+	}.bytes()
+	nak = testMessage{
+		op:          opBootReply,
+		xid:         0x3d1e,
+		messageType: msgNak,
+		options: [][]byte{
+			option(optMessage, []byte("wrong network")...),
+		},
+	}.bytes()
+)
+
+func TestDHCPv4_requestReply(t *testing.T) {
+	var store eventStore
+	dhcp := newDHCPv4(&store, nil)
+
+	ts := time.Now()
+	dhcp.ParseUDP(newPacket(clientRequest, ts, discover))
+	dhcp.ParseUDP(newPacket(serverReply, ts.Add(25*time.Millisecond), offer))
+
+	if !assert.Len(t, store.events, 1) {
+		return
+	}
+	fields := store.events[0].Fields
+	assert.Equal(t, "dhcpv4", fields["type"])
+	assert.Equal(t, common.OK_STATUS, fields["status"])
+	assert.Equal(t, "DISCOVER", fields["method"])
+	assert.Equal(t, int32(25), fields["responsetime"])
+	assert.Equal(t, len(discover), fields["bytes_in"])
+	assert.Equal(t, len(offer), fields["bytes_out"])
+	assert.Equal(t, common.MapStr{
+		"transaction_id": "0x00003d1d",
+		"client_mac":     "00:0b:82:01:fc:42",
+		"request": common.MapStr{
+			"message_type": "DISCOVER",
+			"hops":         uint8(0),
+			"seconds":      uint16(0),
+			"flags":        "broadcast",
+			"option": common.MapStr{
+				"hostname": "laptop",
+			},
+		},
+		"reply": common.MapStr{
+			"message_type": "OFFER",
+			"hops":         uint8(0),
+			"seconds":      uint16(0),
+			"flags":        "unicast",
+			"assigned_ip":  "192.168.0.10",
+			"option": common.MapStr{
+				"router":                    []string{"192.168.0.1"},
+				"ip_address_lease_time_sec": uint32(3600),
+			},
+		},
+	}, fields["dhcpv4"])
+}
+
+func TestDHCPv4_nak(t *testing.T) {
+	var store eventStore
+	dhcp := newDHCPv4(&store, nil)
+
+	request := testMessage{op: opBootRequest, xid: 0x3d1e, messageType: msgRequest}.bytes()
+	dhcp.ParseUDP(newPacket(clientRequest, time.Now(), request))
+	dhcp.ParseUDP(newPacket(serverReply, time.Now(), nak))
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, common.ERROR_STATUS, fields["status"])
+		message, _ := fields.GetValue("dhcpv4.reply.option.message")
+		assert.Equal(t, "wrong network", message)
+	}
+}
+
+func TestDHCPv4_release(t *testing.T) {
+	var store eventStore
+	dhcp := newDHCPv4(&store, map[string]interface{}{"send_request": true})
+
+	release := testMessage{op: opBootRequest, xid: 1, clientIP: "192.168.0.10", messageType: msgRelease}.bytes()
+	dhcp.ParseUDP(newPacket(clientRequest, time.Now(), release))
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, common.OK_STATUS, fields["status"])
+		assert.Equal(t, "RELEASE", fields["method"])
+		assert.NotContains(t, fields, "responsetime")
+		assert.NotEmpty(t, fields["request"])
+		clientIP, _ := fields.GetValue("dhcpv4.request.client_ip")
+		assert.Equal(t, "192.168.0.10", clientIP)
+	}
+	assert.Equal(t, 0, dhcp.transactions.Size())
+}
+
+func TestDHCPv4_orphanedReply(t *testing.T) {
+	var store eventStore
+	dhcp := newDHCPv4(&store, nil)
+
+	dhcp.ParseUDP(newPacket(serverReply, time.Now(), offer))
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, orphanedReply, fields["notes"])
+		assert.Equal(t, common.ERROR_STATUS, fields["status"])
+		assert.Equal(t, "OFFER", fields["method"])
+		assert.Equal(t, "192.168.0.1", fields["dst"].(*common.Endpoint).IP)
+	}
+}
+
+func TestDHCPv4_noReply(t *testing.T) {
+	var store eventStore
+	dhcp := newDHCPv4(&store, map[string]interface{}{"transaction_timeout": "10ms"})
+
+	dhcp.ParseUDP(newPacket(clientRequest, time.Now(), discover))
+	time.Sleep(20 * time.Millisecond)
+	dhcp.transactions.CleanUp()
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, noReply, fields["notes"])
+		assert.Equal(t, common.ERROR_STATUS, fields["status"])
+	}
+}
+
+func TestDHCPv4_retransmission(t *testing.T) {
+	var store eventStore
+	dhcp := newDHCPv4(&store, nil)
+
+	ts := time.Now()
+	dhcp.ParseUDP(newPacket(clientRequest, ts, discover))
+	dhcp.ParseUDP(newPacket(clientRequest, ts.Add(4*time.Second), discover))
+	dhcp.ParseUDP(newPacket(serverReply, ts.Add(4010*time.Millisecond), offer))
+
+	if assert.Len(t, store.events, 2) {
+		assert.Equal(t, noReply, store.events[0].Fields["notes"])
+		assert.Equal(t, int32(10), store.events[1].Fields["responsetime"])
+	}
+}
@@ -0,0 +1,320 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dhcpv4
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+var (
+	errTooShort       = errors.New("DHCPv4 message is too short")
+	errInvalidOpCode  = errors.New("invalid BOOTP op code")
+	errNoMagicCookie  = errors.New("DHCP magic cookie not found")
+	errInvalidOption  = errors.New("invalid DHCP option")
+	errNoMessageType  = errors.New("DHCP message type option not found")
+	errInvalidHWLen   = errors.New("invalid hardware address length")
+	errUnexpectedType = errors.New("unexpected DHCP message type")
+)
+
+// BOOTP operation codes (RFC 2131).
+const (
+	opBootRequest = 1
+	opBootReply   = 2
+)
+
+// Size of the fixed part of the message, before the magic cookie.
+const headerSize = 236
+
+var magicCookie = []byte{99, 130, 83, 99}
+
+// DHCP message types (RFC 2132 section 9.6).
+type messageType uint8
+
+const (
+	msgDiscover messageType = 1 + iota
+	msgOffer
+	msgRequest
+	msgDecline
+	msgAck
+	msgNak
+	msgRelease
+	msgInform
+)
+
+var messageTypeNames = map[messageType]string{
+	msgDiscover: "DISCOVER",
+	msgOffer:    "OFFER",
+	msgRequest:  "REQUEST",
+	msgDecline:  "DECLINE",
+	msgAck:      "ACK",
+	msgNak:      "NAK",
+	msgRelease:  "RELEASE",
+	msgInform:   "INFORM",
+}
+
+func (t messageType) String() string {
+	if name, found := messageTypeNames[t]; found {
+		return name
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", uint8(t))
+}
+
+// expectsReply returns true for the request types that are answered by a
+// server. DECLINE and RELEASE messages are not acknowledged.
+func (t messageType) expectsReply() bool {
+	return t != msgDecline && t != msgRelease
+}
+
+// DHCP option codes (RFC 2132).
+const (
+	optPad                   = 0
+	optSubnetMask            = 1
+	optRouter                = 3
+	optDNSServers            = 6
+	optHostname              = 12
+	optDomainName            = 15
+	optBroadcastAddress      = 28
+	optNTPServers            = 42
+	optRequestedIPAddress    = 50
+	optLeaseTime             = 51
+	optMessageType           = 53
+	optServerIdentifier      = 54
+	optParameterRequestList  = 55
+	optMessage               = 56
+	optMaxMessageSize        = 57
+	optRenewalTime           = 58
+	optRebindingTime         = 59
+	optVendorClassIdentifier = 60
+	optClientIdentifier      = 61
+	optEnd                   = 255
+)
+
+// message is a decoded DHCPv4 message.
+type message struct {
+	opCode        uint8
+	hops          uint8
+	transactionID uint32
+	seconds       uint16
+	broadcast     bool
+	clientIP      net.IP // ciaddr
+	assignedIP    net.IP // yiaddr
+	serverIP      net.IP // siaddr
+	relayIP       net.IP // giaddr
+	clientMAC     net.HardwareAddr
+	serverName    string
+	bootFileName  string
+
+	messageType messageType
+	options     options
+}
+
+// options contains the decoded DHCP options that are reported.
+type options struct {
+	subnetMask            net.IP
+	routers               []net.IP
+	dnsServers            []net.IP
+	hostname              string
+	domainName            string
+	broadcastAddress      net.IP
+	ntpServers            []net.IP
+	requestedIP           net.IP
+	leaseTime             uint32
+	serverIdentifier      net.IP
+	parameterRequestList  []uint8
+	message               string
+	maxMessageSize        uint16
+	renewalTime           uint32
+	rebindingTime         uint32
+	vendorClassIdentifier string
+	clientIdentifier      string
+}
+
+// decodeMessage decodes a DHCPv4 message from a UDP payload.
+func decodeMessage(data []byte) (*message, error) {
+	if len(data) < headerSize+len(magicCookie) {
+		return nil, errTooShort
+	}
+	// The decoded values refer to the data, which is reused by the sniffer.
+	data = append([]byte(nil), data...)
+
+	m := &message{
+		opCode:        data[0],
+		hops:          data[3],
+		transactionID: binary.BigEndian.Uint32(data[4:8]),
+		seconds:       binary.BigEndian.Uint16(data[8:10]),
+		broadcast:     data[10]&0x80 != 0,
+		clientIP:      net.IP(data[12:16]),
+		assignedIP:    net.IP(data[16:20]),
+		serverIP:      net.IP(data[20:24]),
+		relayIP:       net.IP(data[24:28]),
+		serverName:    cString(data[44:108]),
+		bootFileName:  cString(data[108:236]),
+	}
+	if m.opCode != opBootRequest && m.opCode != opBootReply {
+		return nil, errInvalidOpCode
+	}
+
+	hwLen := int(data[2])
+	if hwLen > 16 {
+		return nil, errInvalidHWLen
+	}
+	m.clientMAC = net.HardwareAddr(data[28 : 28+hwLen])
+
+	if string(data[headerSize:headerSize+len(magicCookie)]) != string(magicCookie) {
+		return nil, errNoMagicCookie
+	}
+	if err := m.decodeOptions(data[headerSize+len(magicCookie):]); err != nil {
+		return nil, err
+	}
+	if m.messageType == 0 {
+		return nil, errNoMessageType
+	}
+
+	isRequest := m.opCode == opBootRequest
+	switch m.messageType {
+	case msgOffer, msgAck, msgNak:
+		if isRequest {
+			return nil, errUnexpectedType
+		}
+	default:
+		if !isRequest {
+			return nil, errUnexpectedType
+		}
+	}
+	return m, nil
+}
+
+func (m *message) isRequest() bool {
+	return m.opCode == opBootRequest
+}
+
+func (m *message) decodeOptions(data []byte) error {
+	opts := &m.options
+	for len(data) > 0 {
+		code := data[0]
+		if code == optEnd {
+			return nil
+		}
+		if code == optPad {
+			data = data[1:]
+			continue
+		}
+		if len(data) < 2 || len(data) < 2+int(data[1]) {
+			return errInvalidOption
+		}
+		value := data[2 : 2+int(data[1])]
+		data = data[2+len(value):]
+
+		var err error
+		switch code {
+		case optMessageType:
+			if len(value) != 1 {
+				return errInvalidOption
+			}
+			m.messageType = messageType(value[0])
+		case optSubnetMask:
+			opts.subnetMask, err = decodeIP(value)
+		case optRouter:
+			opts.routers, err = decodeIPs(value)
+		case optDNSServers:
+			opts.dnsServers, err = decodeIPs(value)
+		case optHostname:
+			opts.hostname = string(value)
+		case optDomainName:
+			opts.domainName = string(value)
+		case optBroadcastAddress:
+			opts.broadcastAddress, err = decodeIP(value)
+		case optNTPServers:
+			opts.ntpServers, err = decodeIPs(value)
+		case optRequestedIPAddress:
+			opts.requestedIP, err = decodeIP(value)
+		case optLeaseTime:
+			opts.leaseTime, err = decodeUint32(value)
+		case optServerIdentifier:
+			opts.serverIdentifier, err = decodeIP(value)
+		case optParameterRequestList:
+			opts.parameterRequestList = value
+		case optMessage:
+			opts.message = string(value)
+		case optMaxMessageSize:
+			if len(value) != 2 {
+				return errInvalidOption
+			}
+			opts.maxMessageSize = binary.BigEndian.Uint16(value)
+		case optRenewalTime:
+			opts.renewalTime, err = decodeUint32(value)
+		case optRebindingTime:
+			opts.rebindingTime, err = decodeUint32(value)
+		case optVendorClassIdentifier:
+			opts.vendorClassIdentifier = string(value)
+		case optClientIdentifier:
+			opts.clientIdentifier = decodeClientIdentifier(value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeIP(value []byte) (net.IP, error) {
+	if len(value) != net.IPv4len {
+		return nil, errInvalidOption
+	}
+	return net.IP(value), nil
+}
+
+func decodeIPs(value []byte) ([]net.IP, error) {
+	if len(value) == 0 || len(value)%net.IPv4len != 0 {
+		return nil, errInvalidOption
+	}
+	ips := make([]net.IP, 0, len(value)/net.IPv4len)
+	for i := 0; i < len(value); i += net.IPv4len {
+		ips = append(ips, net.IP(value[i:i+net.IPv4len]))
+	}
+	return ips, nil
+}
+
+func decodeUint32(value []byte) (uint32, error) {
+	if len(value) != 4 {
+		return 0, errInvalidOption
+	}
+	return binary.BigEndian.Uint32(value), nil
+}
+
+// decodeClientIdentifier formats a client identifier. Identifiers made of
+// an Ethernet hardware type and address are formatted as MAC addresses.
+func decodeClientIdentifier(value []byte) string {
+	if len(value) == 7 && value[0] == 1 {
+		return net.HardwareAddr(value[1:]).String()
+	}
+	return hex.EncodeToString(value)
+}
+
+// cString returns the contents of a NUL terminated field.
+func cString(b []byte) string {
+	if i := strings.IndexByte(string(b), 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
@@ -0,0 +1,173 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package dhcpv4
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var clientMAC = net.HardwareAddr{0x00, 0x0b, 0x82, 0x01, 0xfc, 0x42}
+
+// testMessage builds a DHCPv4 message with the given fixed fields and
+// options, adding the message type option and the end option.
+type testMessage struct {
+	op          uint8
+	xid         uint32
+	broadcast   bool
+	clientIP    string
+	assignedIP  string
+	serverIP    string
+	relayIP     string
+	serverName  string
+	messageType messageType
+	options     [][]byte
+}
+
+func (tm testMessage) bytes() []byte {
+	data := make([]byte, headerSize)
+	data[0] = tm.op
+	data[1] = 1 // Ethernet
+	data[2] = byte(len(clientMAC))
+	binary.BigEndian.PutUint32(data[4:8], tm.xid)
+	if tm.broadcast {
+		data[10] = 0x80
+	}
+	for offset, ip := range map[int]string{12: tm.clientIP, 16: tm.assignedIP, 20: tm.serverIP, 24: tm.relayIP} {
+		if ip != "" {
+			copy(data[offset:offset+4], net.ParseIP(ip).To4())
+		}
+	}
+	copy(data[28:], clientMAC)
+	copy(data[44:108], tm.serverName)
+
+	data = append(data, magicCookie...)
+	data = append(data, optMessageType, 1, byte(tm.messageType))
+	for _, opt := range tm.options {
+		data = append(data, opt...)
+	}
+	return append(data, optEnd, 0, 0, 0)
+}
+
+func option(code uint8, value ...byte) []byte {
+	return append([]byte{code, byte(len(value))}, value...)
+}
+
+func TestDecodeMessage_discover(t *testing.T) {
+	data := testMessage{
+		op:          opBootRequest,
+		xid:         0x3d1d,
+		broadcast:   true,
+		messageType: msgDiscover,
+		options: [][]byte{
+			option(optClientIdentifier, 1, 0x00, 0x0b, 0x82, 0x01, 0xfc, 0x42),
+			option(optRequestedIPAddress, 0, 0, 0, 0),
+			option(optParameterRequestList, 1, 3, 6, 42),
+			option(optHostname, []byte("laptop")...),
+			option(optVendorClassIdentifier, []byte("MSFT 5.0")...),
+			{optPad, optPad},
+		},
+	}.bytes()
+
+	msg, err := decodeMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, msg.isRequest())
+	assert.Equal(t, msgDiscover, msg.messageType)
+	assert.Equal(t, uint32(0x3d1d), msg.transactionID)
+	assert.True(t, msg.broadcast)
+	assert.Equal(t, clientMAC, msg.clientMAC)
+	assert.Equal(t, "00:0b:82:01:fc:42", msg.options.clientIdentifier)
+	assert.Equal(t, []uint8{1, 3, 6, 42}, msg.options.parameterRequestList)
+	assert.Equal(t, "laptop", msg.options.hostname)
+	assert.Equal(t, "MSFT 5.0", msg.options.vendorClassIdentifier)
+}
+
+func TestDecodeMessage_offer(t *testing.T) {
+	data := testMessage{
+		op:          opBootReply,
+		xid:         0x3d1d,
+		assignedIP:  "192.168.0.10",
+		serverIP:    "192.168.0.1",
+		serverName:  "dhcp-server",
+		messageType: msgOffer,
+		options: [][]byte{
+			option(optSubnetMask, 255, 255, 255, 0),
+			option(optRouter, 192, 168, 0, 1),
+			option(optDNSServers, 192, 168, 0, 1, 8, 8, 8, 8),
+			option(optLeaseTime, 0, 0, 0x0e, 0x10),
+			option(optServerIdentifier, 192, 168, 0, 1),
+		},
+	}.bytes()
+
+	msg, err := decodeMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.False(t, msg.isRequest())
+	assert.Equal(t, msgOffer, msg.messageType)
+	assert.Equal(t, "192.168.0.10", msg.assignedIP.String())
+	assert.Equal(t, "dhcp-server", msg.serverName)
+	assert.Equal(t, "255.255.255.0", msg.options.subnetMask.String())
+	assert.Equal(t, []net.IP{net.IP{192, 168, 0, 1}}, msg.options.routers)
+	assert.Equal(t, []net.IP{net.IP{192, 168, 0, 1}, net.IP{8, 8, 8, 8}}, msg.options.dnsServers)
+	assert.Equal(t, uint32(3600), msg.options.leaseTime)
+}
+
+func TestDecodeMessage_invalid(t *testing.T) {
+	valid := testMessage{op: opBootRequest, messageType: msgRequest}.bytes()
+
+	noCookie := append([]byte(nil), valid...)
+	noCookie[headerSize] = 0
+
+	badOp := append([]byte(nil), valid...)
+	badOp[0] = 3
+
+	truncatedOption := testMessage{op: opBootRequest, messageType: msgRequest}.bytes()
+	truncatedOption = append(truncatedOption[:len(truncatedOption)-4], optHostname, 10, 'a')
+
+	badIP := testMessage{op: opBootRequest, messageType: msgRequest, options: [][]byte{
+		option(optRequestedIPAddress, 1, 2, 3),
+	}}.bytes()
+
+	wrongDirection := testMessage{op: opBootRequest, messageType: msgAck}.bytes()
+
+	for name, testCase := range map[string]struct {
+		data []byte
+		err  error
+	}{
+		"too short":         {valid[:100], errTooShort},
+		"missing cookie":    {noCookie, errNoMagicCookie},
+		"invalid op":        {badOp, errInvalidOpCode},
+		"truncated option":  {truncatedOption, errInvalidOption},
+		"invalid IP option": {badIP, errInvalidOption},
+		"unexpected type":   {wrongDirection, errUnexpectedType},
+		"missing type":      {valid[:headerSize+len(magicCookie)], errNoMessageType},
+	} {
+		_, err := decodeMessage(testCase.data)
+		assert.Equal(t, testCase.err, err, name)
+	}
+}
@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+var errBodyTooLarge = errors.New("decoded body exceeds max_message_size")
+
+// decodeBody reverts the content codings listed in the Content-Encoding
+// header of the message, so the body can be captured in its original form.
+// The decoded body is limited to http.maxMessageSize bytes. If the body
+// cannot be decoded, it is left untouched and a note is added to the message.
+func (http *httpPlugin) decodeBody(m *message) {
+	if !m.saveBody || len(m.body) == 0 || len(m.contentEncoding) == 0 {
+		return
+	}
+
+	body, err := decodeContent(m.body, m.contentEncoding, http.maxMessageSize)
+	if err != nil {
+		if isDebug {
+			debugf("Failed to decode %s body: %v", m.contentEncoding, err)
+		}
+		m.notes = append(m.notes, fmt.Sprintf("Failed to decode %s body: %v", m.contentEncoding, err))
+		return
+	}
+	m.body = body
+}
+
+// decodeContent applies the decoders for a Content-Encoding header value.
+// Codings are listed in the order they were applied, so they are reverted
+// starting from the last one.
+func decodeContent(body []byte, encoding []byte, maxSize int) ([]byte, error) {
+	codings := bytes.Split(encoding, []byte(","))
+	for i := len(codings) - 1; i >= 0; i-- {
+		coding := string(bytes.ToLower(trim(codings[i])))
+
+		var r io.Reader
+		var err error
+		switch coding {
+		case "identity", "":
+			continue
+		case "gzip", "x-gzip":
+			r, err = gzip.NewReader(bytes.NewReader(body))
+		case "deflate":
+			// Deflate is supposed to be wrapped in the zlib format, but some
+			// implementations send the raw deflate stream.
+			r, err = zlib.NewReader(bytes.NewReader(body))
+			if err != nil {
+				r, err = flate.NewReader(bytes.NewReader(body)), nil
+			}
+		default:
+			return nil, fmt.Errorf("unsupported content coding '%s'", coding)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		body, err = ioutil.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > maxSize {
+			return nil, errBodyTooLarge
+		}
+	}
+	return body, nil
+}
@@ -417,6 +417,7 @@ func (http *httpPlugin) handleHTTP(
 	m.direction = dir
 	m.cmdlineTuple = procs.ProcWatcher.FindProcessesTuple(tcptuple.IPPort())
 	http.hideHeaders(m)
+	http.decodeBody(m)
 
 	if m.isRequest {
 		if isDebug {
@@ -622,6 +623,9 @@ func (http *httpPlugin) collectHeaders(m *message) interface{} {
 func (http *httpPlugin) setBody(result common.MapStr, m *message) {
 	if m.sendBody && len(m.body) > 0 {
 		result["body"] = string(m.body)
+		if len(m.contentEncoding) > 0 {
+			result["content_encoding"] = string(m.contentEncoding)
+		}
 	}
 }
 
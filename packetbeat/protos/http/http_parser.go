@@ -56,6 +56,7 @@ type message struct {
 	contentLength    int
 	contentType      common.NetString
 	transferEncoding common.NetString
+	contentEncoding  common.NetString
 	isChunked        bool
 	headers          map[string]common.NetString
 	size             uint64
@@ -105,6 +106,7 @@ var (
 	nameContentLength    = []byte("content-length")
 	nameContentType      = []byte("content-type")
 	nameTransferEncoding = []byte("transfer-encoding")
+	nameContentEncoding  = []byte("content-encoding")
 	nameConnection       = []byte("connection")
 )
 
@@ -367,6 +369,8 @@ func (parser *parser) parseHeader(m *message, data []byte) (bool, bool, int) {
 				m.contentType = headerVal
 			} else if bytes.Equal(headerName, nameTransferEncoding) {
 				m.isChunked = bytes.Equal(common.NetString(headerVal), transferEncodingChunked)
+			} else if bytes.Equal(headerName, nameContentEncoding) {
+				m.contentEncoding = headerVal
 			} else if bytes.Equal(headerName, nameConnection) {
 				m.connection = headerVal
 			}
@@ -21,6 +21,9 @@ package http
 
 import (
 	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
 	"fmt"
 	"net"
 	"regexp"
@@ -1423,6 +1426,87 @@ func TestHttp_includeBodies(t *testing.T) {
 	}
 }
 
+func TestHttp_decodeBody(t *testing.T) {
+	body := "compressed response body"
+	gzipped := func(s string) []byte {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		w.Write([]byte(s))
+		w.Close()
+		return buf.Bytes()
+	}
+	zlibbed := func(s string) []byte {
+		var buf bytes.Buffer
+		w := zlib.NewWriter(&buf)
+		w.Write([]byte(s))
+		w.Close()
+		return buf.Bytes()
+	}
+	deflated := func(s string) []byte {
+		var buf bytes.Buffer
+		w, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+		w.Write([]byte(s))
+		w.Close()
+		return buf.Bytes()
+	}
+
+	for _, testCase := range []struct {
+		name     string
+		encoding string
+		payload  []byte
+		maxSize  int
+		expected string
+		noted    bool
+	}{
+		{"gzip", "gzip", gzipped(body), 0, body, false},
+		{"x-gzip", "x-gzip", gzipped(body), 0, body, false},
+		{"zlib deflate", "deflate", zlibbed(body), 0, body, false},
+		{"raw deflate", "deflate", deflated(body), 0, body, false},
+		{"multiple codings", "deflate, gzip", gzipped(string(zlibbed(body))), 0, body, false},
+		{"identity", "identity", []byte(body), 0, body, false},
+		{"unsupported", "br", []byte(body), 0, body, true},
+		{"corrupt", "gzip", []byte(body), 0, body, true},
+		{"too large", "gzip", gzipped(body), len(body) - 1, string(gzipped(body)), true},
+	} {
+		var store eventStore
+		http := httpModForTests(&store)
+		config := defaultConfig
+		config.IncludeResponseBodyFor = []string{"text/plain"}
+		if testCase.maxSize > 0 {
+			config.MaxMessageSize = testCase.maxSize
+		}
+		http.setFromConfig(&config)
+
+		req := "GET / HTTP/1.1\r\n" +
+			"\r\n"
+		resp := fmt.Sprintf("HTTP/1.1 200 OK\r\n"+
+			"Content-Type: text/plain\r\n"+
+			"Content-Encoding: %s\r\n"+
+			"Content-Length: %d\r\n"+
+			"\r\n", testCase.encoding, len(testCase.payload)) + string(testCase.payload)
+
+		tcptuple := testCreateTCPTuple()
+		packet := protos.Packet{Payload: []byte(req)}
+		private := protos.ProtocolData(&httpConnectionData{})
+		private = http.Parse(&packet, tcptuple, 0, private)
+
+		packet.Payload = []byte(resp)
+		private = http.Parse(&packet, tcptuple, 1, private)
+		http.ReceivedFin(tcptuple, 1, private)
+
+		trans := expectTransaction(t, &store)
+		if !assert.NotNil(t, trans, testCase.name) {
+			continue
+		}
+		contents, _ := trans.GetValue("http.response.body")
+		assert.Equal(t, testCase.expected, contents, testCase.name)
+		encoding, _ := trans.GetValue("http.response.content_encoding")
+		assert.Equal(t, testCase.encoding, encoding, testCase.name)
+		hasNotes, _ := trans.HasKey("notes")
+		assert.Equal(t, testCase.noted, hasNotes, testCase.name)
+	}
+}
+
 func benchmarkHTTPMessage(b *testing.B, data []byte) {
 	http := httpModForTests(nil)
 	parser := newParser(&http.parserConfig)
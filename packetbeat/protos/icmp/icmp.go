@@ -147,11 +147,13 @@ func (icmp *icmpPlugin) ProcessICMPv4(
 	if isRequest(tuple, msg) {
 		if flowID != nil {
 			flowID.AddICMPv4Request(id)
+			flowID.SetICMPMessage(typ, code)
 		}
 		icmp.processRequest(tuple, msg)
 	} else {
 		if flowID != nil {
 			flowID.AddICMPv4Response(id)
+			flowID.SetICMPMessage(typ, code)
 		}
 		icmp.processResponse(tuple, msg)
 	}
@@ -182,11 +184,13 @@ func (icmp *icmpPlugin) ProcessICMPv6(
 	if isRequest(tuple, msg) {
 		if flowID != nil {
 			flowID.AddICMPv6Request(id)
+			flowID.SetICMPMessage(typ, code)
 		}
 		icmp.processRequest(tuple, msg)
 	} else {
 		if flowID != nil {
 			flowID.AddICMPv6Response(id)
+			flowID.SetICMPMessage(typ, code)
 		}
 		icmp.processResponse(tuple, msg)
 	}
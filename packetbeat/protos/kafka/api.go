@@ -0,0 +1,520 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"fmt"
+)
+
+// apiKey identifies the Kafka API of a request.
+type apiKey int16
+
+const (
+	apiProduce         apiKey = 0
+	apiFetch           apiKey = 1
+	apiListOffsets     apiKey = 2
+	apiMetadata        apiKey = 3
+	apiOffsetCommit    apiKey = 8
+	apiOffsetFetch     apiKey = 9
+	apiFindCoordinator apiKey = 10
+	apiJoinGroup       apiKey = 11
+	apiHeartbeat       apiKey = 12
+	apiLeaveGroup      apiKey = 13
+	apiSyncGroup       apiKey = 14
+	apiAPIVersions     apiKey = 18
+)
+
+var apiNames = []string{
+	"Produce",
+	"Fetch",
+	"ListOffsets",
+	"Metadata",
+	"LeaderAndIsr",
+	"StopReplica",
+	"UpdateMetadata",
+	"ControlledShutdown",
+	"OffsetCommit",
+	"OffsetFetch",
+	"FindCoordinator",
+	"JoinGroup",
+	"Heartbeat",
+	"LeaveGroup",
+	"SyncGroup",
+	"DescribeGroups",
+	"ListGroups",
+	"SaslHandshake",
+	"ApiVersions",
+	"CreateTopics",
+	"DeleteTopics",
+	"DeleteRecords",
+	"InitProducerId",
+	"OffsetForLeaderEpoch",
+	"AddPartitionsToTxn",
+	"AddOffsetsToTxn",
+	"EndTxn",
+	"WriteTxnMarkers",
+	"TxnOffsetCommit",
+	"DescribeAcls",
+	"CreateAcls",
+	"DeleteAcls",
+	"DescribeConfigs",
+	"AlterConfigs",
+	"AlterReplicaLogDirs",
+	"DescribeLogDirs",
+	"SaslAuthenticate",
+	"CreatePartitions",
+	"CreateDelegationToken",
+	"RenewDelegationToken",
+	"ExpireDelegationToken",
+	"DescribeDelegationToken",
+	"DeleteGroups",
+	"ElectLeaders",
+	"IncrementalAlterConfigs",
+	"AlterPartitionReassignments",
+	"ListPartitionReassignments",
+	"OffsetDelete",
+}
+
+func (k apiKey) valid() bool {
+	return k >= 0 && int(k) < len(apiNames)
+}
+
+func (k apiKey) String() string {
+	if k.valid() {
+		return apiNames[k]
+	}
+	return fmt.Sprintf("Unknown(%d)", int16(k))
+}
+
+var errorNames = []string{
+	"NONE",
+	"OFFSET_OUT_OF_RANGE",
+	"CORRUPT_MESSAGE",
+	"UNKNOWN_TOPIC_OR_PARTITION",
+	"INVALID_FETCH_SIZE",
+	"LEADER_NOT_AVAILABLE",
+	"NOT_LEADER_FOR_PARTITION",
+	"REQUEST_TIMED_OUT",
+	"BROKER_NOT_AVAILABLE",
+	"REPLICA_NOT_AVAILABLE",
+	"MESSAGE_TOO_LARGE",
+	"STALE_CONTROLLER_EPOCH",
+	"OFFSET_METADATA_TOO_LARGE",
+	"NETWORK_EXCEPTION",
+	"COORDINATOR_LOAD_IN_PROGRESS",
+	"COORDINATOR_NOT_AVAILABLE",
+	"NOT_COORDINATOR",
+	"INVALID_TOPIC_EXCEPTION",
+	"RECORD_LIST_TOO_LARGE",
+	"NOT_ENOUGH_REPLICAS",
+	"NOT_ENOUGH_REPLICAS_AFTER_APPEND",
+	"INVALID_REQUIRED_ACKS",
+	"ILLEGAL_GENERATION",
+	"INCONSISTENT_GROUP_PROTOCOL",
+	"INVALID_GROUP_ID",
+	"UNKNOWN_MEMBER_ID",
+	"INVALID_SESSION_TIMEOUT",
+	"REBALANCE_IN_PROGRESS",
+	"INVALID_COMMIT_OFFSET_SIZE",
+	"TOPIC_AUTHORIZATION_FAILED",
+	"GROUP_AUTHORIZATION_FAILED",
+	"CLUSTER_AUTHORIZATION_FAILED",
+	"INVALID_TIMESTAMP",
+	"UNSUPPORTED_SASL_MECHANISM",
+	"ILLEGAL_SASL_STATE",
+	"UNSUPPORTED_VERSION",
+	"TOPIC_ALREADY_EXISTS",
+	"INVALID_PARTITIONS",
+	"INVALID_REPLICATION_FACTOR",
+	"INVALID_REPLICA_ASSIGNMENT",
+	"INVALID_CONFIG",
+	"NOT_CONTROLLER",
+	"INVALID_REQUEST",
+	"UNSUPPORTED_FOR_MESSAGE_FORMAT",
+	"POLICY_VIOLATION",
+	"OUT_OF_ORDER_SEQUENCE_NUMBER",
+	"DUPLICATE_SEQUENCE_NUMBER",
+	"INVALID_PRODUCER_EPOCH",
+	"INVALID_TXN_STATE",
+	"INVALID_PRODUCER_ID_MAPPING",
+	"INVALID_TRANSACTION_TIMEOUT",
+	"CONCURRENT_TRANSACTIONS",
+	"TRANSACTION_COORDINATOR_FENCED",
+	"TRANSACTIONAL_ID_AUTHORIZATION_FAILED",
+	"SECURITY_DISABLED",
+	"OPERATION_NOT_ATTEMPTED",
+	"KAFKA_STORAGE_ERROR",
+	"LOG_DIR_NOT_FOUND",
+	"SASL_AUTHENTICATION_FAILED",
+	"UNKNOWN_PRODUCER_ID",
+	"REASSIGNMENT_IN_PROGRESS",
+}
+
+func errorName(code int16) string {
+	if code == -1 {
+		return "UNKNOWN_SERVER_ERROR"
+	}
+	if code >= 0 && int(code) < len(errorNames) {
+		return errorNames[code]
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", code)
+}
+
+// requestInfo contains the details decoded from the body of a request.
+type requestInfo struct {
+	topics     []string
+	partitions []string // Formatted as topic-partition.
+	groupID    string
+
+	// noResponse is set for produce requests that don't require an
+	// acknowledgment.
+	noResponse bool
+}
+
+func (info *requestInfo) addTopic(topic string) {
+	info.topics = append(info.topics, topic)
+}
+
+func (info *requestInfo) addPartition(topic string, partition int32) {
+	info.partitions = append(info.partitions, fmt.Sprintf("%s-%d", topic, partition))
+}
+
+// api describes how to decode the bodies of the requests and responses of
+// an API. Only the versions up to maxVersion are decoded, later versions
+// use the flexible encoding introduced with KIP-482.
+type api struct {
+	maxVersion     int16
+	decodeRequest  func(d *decoder, version int16, info *requestInfo)
+	decodeResponse func(d *decoder, version int16, errs *errorCode)
+}
+
+// errorCode keeps the first error code that is not NONE.
+type errorCode int16
+
+func (e *errorCode) set(code int16) {
+	if *e == 0 {
+		*e = errorCode(code)
+	}
+}
+
+var apis = map[apiKey]api{
+	apiProduce:         {8, decodeProduceRequest, decodeProduceResponse},
+	apiFetch:           {11, decodeFetchRequest, decodeFetchResponse},
+	apiListOffsets:     {5, decodeListOffsetsRequest, decodeListOffsetsResponse},
+	apiMetadata:        {8, decodeMetadataRequest, decodeMetadataResponse},
+	apiOffsetCommit:    {7, decodeOffsetCommitRequest, decodeOffsetCommitResponse},
+	apiOffsetFetch:     {5, decodeOffsetFetchRequest, decodeOffsetFetchResponse},
+	apiFindCoordinator: {2, decodeFindCoordinatorRequest, decodeGroupResponse(1)},
+	apiJoinGroup:       {5, decodeGroupRequest, decodeGroupResponse(2)},
+	apiHeartbeat:       {3, decodeGroupRequest, decodeGroupResponse(1)},
+	apiLeaveGroup:      {3, decodeGroupRequest, decodeGroupResponse(1)},
+	apiSyncGroup:       {3, decodeGroupRequest, decodeGroupResponse(1)},
+	apiAPIVersions:     {2, nil, decodeAPIVersionsResponse},
+}
+
+// decodeTopicPartitions decodes an array of topics, each one with an array
+// of partitions. The fields following the partition index are decoded by
+// the partition function.
+func decodeTopicPartitions(d *decoder, info *requestInfo, partition func()) {
+	d.array(func() {
+		topic := d.string()
+		info.addTopic(topic)
+		d.array(func() {
+			info.addPartition(topic, d.int32())
+			partition()
+		})
+	})
+}
+
+func decodeProduceRequest(d *decoder, version int16, info *requestInfo) {
+	if version >= 3 {
+		d.string() // transactional_id
+	}
+	acks := d.int16()
+	info.noResponse = d.err == nil && acks == 0
+	d.int32() // timeout_ms
+	decodeTopicPartitions(d, info, func() {
+		d.skipBytes() // records
+	})
+}
+
+func decodeProduceResponse(d *decoder, version int16, errs *errorCode) {
+	d.array(func() {
+		d.string() // name
+		d.array(func() {
+			d.int32() // index
+			errs.set(d.int16())
+			d.int64() // base_offset
+			if version >= 2 {
+				d.int64() // log_append_time_ms
+			}
+			if version >= 5 {
+				d.int64() // log_start_offset
+			}
+			if version >= 8 {
+				d.array(func() {
+					d.int32()  // batch_index
+					d.string() // batch_index_error_message
+				})
+				d.string() // error_message
+			}
+		})
+	})
+}
+
+func decodeFetchRequest(d *decoder, version int16, info *requestInfo) {
+	d.int32() // replica_id
+	d.int32() // max_wait_ms
+	d.int32() // min_bytes
+	if version >= 3 {
+		d.int32() // max_bytes
+	}
+	if version >= 4 {
+		d.int8() // isolation_level
+	}
+	if version >= 7 {
+		d.int32() // session_id
+		d.int32() // session_epoch
+	}
+	decodeTopicPartitions(d, info, func() {
+		if version >= 9 {
+			d.int32() // current_leader_epoch
+		}
+		d.int64() // fetch_offset
+		if version >= 5 {
+			d.int64() // log_start_offset
+		}
+		d.int32() // partition_max_bytes
+	})
+}
+
+func decodeFetchResponse(d *decoder, version int16, errs *errorCode) {
+	if version >= 1 {
+		d.int32() // throttle_time_ms
+	}
+	if version >= 7 {
+		errs.set(d.int16())
+		d.int32() // session_id
+	}
+	d.array(func() {
+		d.string() // topic
+		d.array(func() {
+			d.int32() // partition_index
+			errs.set(d.int16())
+			d.int64() // high_watermark
+			if version >= 4 {
+				d.int64() // last_stable_offset
+			}
+			if version >= 5 {
+				d.int64() // log_start_offset
+			}
+			if version >= 4 {
+				d.array(func() {
+					d.int64() // producer_id
+					d.int64() // first_offset
+				})
+			}
+			if version >= 11 {
+				d.int32() // preferred_read_replica
+			}
+			d.skipBytes() // records
+		})
+	})
+}
+
+func decodeListOffsetsRequest(d *decoder, version int16, info *requestInfo) {
+	d.int32() // replica_id
+	if version >= 2 {
+		d.int8() // isolation_level
+	}
+	decodeTopicPartitions(d, info, func() {
+		if version >= 4 {
+			d.int32() // current_leader_epoch
+		}
+		d.int64() // timestamp
+		if version == 0 {
+			d.int32() // max_num_offsets
+		}
+	})
+}
+
+func decodeListOffsetsResponse(d *decoder, version int16, errs *errorCode) {
+	if version >= 2 {
+		d.int32() // throttle_time_ms
+	}
+	d.array(func() {
+		d.string() // name
+		d.array(func() {
+			d.int32() // partition_index
+			errs.set(d.int16())
+			if version == 0 {
+				d.array(func() { d.int64() }) // old_style_offsets
+				return
+			}
+			d.int64() // timestamp
+			d.int64() // offset
+			if version >= 4 {
+				d.int32() // leader_epoch
+			}
+		})
+	})
+}
+
+func decodeMetadataRequest(d *decoder, version int16, info *requestInfo) {
+	d.array(func() {
+		info.addTopic(d.string())
+	})
+}
+
+func decodeMetadataResponse(d *decoder, version int16, errs *errorCode) {
+	if version >= 3 {
+		d.int32() // throttle_time_ms
+	}
+	d.array(func() {
+		d.int32()  // node_id
+		d.string() // host
+		d.int32()  // port
+		if version >= 1 {
+			d.string() // rack
+		}
+	})
+	if version >= 2 {
+		d.string() // cluster_id
+	}
+	if version >= 1 {
+		d.int32() // controller_id
+	}
+	skipInt32Array := func() {
+		d.array(func() { d.int32() })
+	}
+	d.array(func() {
+		errs.set(d.int16())
+		d.string() // name
+		if version >= 1 {
+			d.int8() // is_internal
+		}
+		d.array(func() {
+			errs.set(d.int16())
+			d.int32() // partition_index
+			d.int32() // leader_id
+			if version >= 7 {
+				d.int32() // leader_epoch
+			}
+			skipInt32Array() // replica_nodes
+			skipInt32Array() // isr_nodes
+			if version >= 5 {
+				skipInt32Array() // offline_replicas
+			}
+		})
+		if version >= 8 {
+			d.int32() // topic_authorized_operations
+		}
+	})
+}
+
+func decodeOffsetCommitRequest(d *decoder, version int16, info *requestInfo) {
+	info.groupID = d.string()
+	if version >= 1 {
+		d.int32()  // generation_id
+		d.string() // member_id
+	}
+	if version >= 7 {
+		d.string() // group_instance_id
+	}
+	if version >= 2 && version <= 4 {
+		d.int64() // retention_time_ms
+	}
+	decodeTopicPartitions(d, info, func() {
+		d.int64() // committed_offset
+		if version >= 6 {
+			d.int32() // committed_leader_epoch
+		}
+		if version == 1 {
+			d.int64() // commit_timestamp
+		}
+		d.string() // committed_metadata
+	})
+}
+
+func decodeOffsetCommitResponse(d *decoder, version int16, errs *errorCode) {
+	if version >= 3 {
+		d.int32() // throttle_time_ms
+	}
+	d.array(func() {
+		d.string() // name
+		d.array(func() {
+			d.int32() // partition_index
+			errs.set(d.int16())
+		})
+	})
+}
+
+func decodeOffsetFetchRequest(d *decoder, version int16, info *requestInfo) {
+	info.groupID = d.string()
+	decodeTopicPartitions(d, info, func() {})
+}
+
+func decodeOffsetFetchResponse(d *decoder, version int16, errs *errorCode) {
+	if version >= 3 {
+		d.int32() // throttle_time_ms
+	}
+	d.array(func() {
+		d.string() // name
+		d.array(func() {
+			d.int32() // partition_index
+			d.int64() // committed_offset
+			if version >= 5 {
+				d.int32() // committed_leader_epoch
+			}
+			d.string() // metadata
+			errs.set(d.int16())
+		})
+	})
+	if version >= 2 {
+		errs.set(d.int16())
+	}
+}
+
+func decodeFindCoordinatorRequest(d *decoder, version int16, info *requestInfo) {
+	key := d.string()
+	if version == 0 || d.int8() == 0 {
+		// The key is a group ID, and not a transactional ID.
+		info.groupID = key
+	}
+}
+
+// decodeGroupRequest decodes the requests of the group membership APIs,
+// which start with the group ID.
+func decodeGroupRequest(d *decoder, version int16, info *requestInfo) {
+	info.groupID = d.string()
+}
+
+// decodeGroupResponse returns a decoder for responses made of an error
+// code, preceded by the throttle time since the given version.
+func decodeGroupResponse(throttleVersion int16) func(*decoder, int16, *errorCode) {
+	return func(d *decoder, version int16, errs *errorCode) {
+		if version >= throttleVersion {
+			d.int32() // throttle_time_ms
+		}
+		errs.set(d.int16())
+	}
+}
+
+func decodeAPIVersionsResponse(d *decoder, version int16, errs *errorCode) {
+	errs.set(d.int16())
+}
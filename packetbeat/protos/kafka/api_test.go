@@ -0,0 +1,210 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package kafka
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// encoder builds Kafka messages for the tests.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) int8(v int8) *encoder {
+	e.buf = append(e.buf, byte(v))
+	return e
+}
+
+func (e *encoder) int16(v int16) *encoder {
+	e.buf = append(e.buf, 0, 0)
+	binary.BigEndian.PutUint16(e.buf[len(e.buf)-2:], uint16(v))
+	return e
+}
+
+func (e *encoder) int32(v int32) *encoder {
+	e.buf = append(e.buf, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(e.buf[len(e.buf)-4:], uint32(v))
+	return e
+}
+
+func (e *encoder) int64(v int64) *encoder {
+	e.buf = append(e.buf, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint64(e.buf[len(e.buf)-8:], uint64(v))
+	return e
+}
+
+func (e *encoder) string(s string) *encoder {
+	e.int16(int16(len(s)))
+	e.buf = append(e.buf, s...)
+	return e
+}
+
+func (e *encoder) bytes(b []byte) *encoder {
+	e.int32(int32(len(b)))
+	e.buf = append(e.buf, b...)
+	return e
+}
+
+// message prefixes the encoded data with its size.
+func (e *encoder) message() []byte {
+	return append((&encoder{}).int32(int32(len(e.buf))).buf, e.buf...)
+}
+
+func requestHeader(key apiKey, version int16, correlationID int32) *encoder {
+	return (&encoder{}).int16(int16(key)).int16(version).int32(correlationID).string("producer-1")
+}
+
+func TestDecoder(t *testing.T) {
+	data := (&encoder{}).int8(-1).int16(2).int32(3).int64(4).string("abc").string("").bytes([]byte{1, 2}).buf
+	d := newDecoder(data)
+
+	assert.Equal(t, int8(-1), d.int8())
+	assert.Equal(t, int16(2), d.int16())
+	assert.Equal(t, int32(3), d.int32())
+	assert.Equal(t, int64(4), d.int64())
+	assert.Equal(t, "abc", d.string())
+	assert.Equal(t, "", d.string())
+	d.skipBytes()
+	assert.NoError(t, d.err)
+	assert.Empty(t, d.data)
+
+	assert.Equal(t, int32(0), d.int32())
+	assert.Equal(t, errShortRead, d.err)
+}
+
+func TestDecodeRequest_produce(t *testing.T) {
+	for _, version := range []int16{0, 3, 8} {
+		e := requestHeader(apiProduce, version, 7)
+		if version >= 3 {
+			e.string("")
+		}
+		e.int16(1).int32(30000)
+		e.int32(2)
+		e.string("orders").int32(2)
+		e.int32(0).bytes([]byte("records"))
+		e.int32(1).bytes(nil)
+		e.string("payments").int32(1)
+		e.int32(4).bytes([]byte("records"))
+
+		msg := &message{}
+		err := msg.decodeRequest(e.buf)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equal(t, apiProduce, msg.apiKey)
+		assert.Equal(t, version, msg.apiVersion)
+		assert.Equal(t, int32(7), msg.correlationID)
+		assert.Equal(t, "producer-1", msg.clientID)
+		assert.Equal(t, []string{"orders", "payments"}, msg.info.topics)
+		assert.Equal(t, []string{"orders-0", "orders-1", "payments-4"}, msg.info.partitions)
+		assert.False(t, msg.info.noResponse)
+	}
+}
+
+func TestDecodeRequest_fetch(t *testing.T) {
+	e := requestHeader(apiFetch, 10, 1)
+	e.int32(-1).int32(500).int32(1).int32(52428800).int8(0).int32(0).int32(-1)
+	e.int32(1).string("orders").int32(1)
+	e.int32(3).int32(-1).int64(100).int64(0).int32(1048576)
+
+	msg := &message{}
+	if assert.NoError(t, msg.decodeRequest(e.buf)) {
+		assert.Equal(t, []string{"orders-3"}, msg.info.partitions)
+	}
+}
+
+func TestDecodeRequest_group(t *testing.T) {
+	e := requestHeader(apiOffsetCommit, 2, 1)
+	e.string("billing").int32(5).string("member-1").int64(-1)
+	e.int32(1).string("orders").int32(1)
+	e.int32(0).int64(42).string("")
+
+	msg := &message{}
+	if assert.NoError(t, msg.decodeRequest(e.buf)) {
+		assert.Equal(t, "billing", msg.info.groupID)
+		assert.Equal(t, []string{"orders-0"}, msg.info.partitions)
+	}
+
+	e = requestHeader(apiJoinGroup, 5, 2).string("billing")
+	msg = &message{}
+	if assert.NoError(t, msg.decodeRequest(e.buf)) {
+		assert.Equal(t, "billing", msg.info.groupID)
+	}
+}
+
+func TestDecodeRequest_invalid(t *testing.T) {
+	msg := &message{}
+	assert.Error(t, msg.decodeRequest([]byte{0, 1}))
+
+	e := requestHeader(apiKey(1000), 0, 1)
+	assert.Error(t, msg.decodeRequest(e.buf))
+}
+
+func TestDecodeRequest_flexibleVersion(t *testing.T) {
+	// The body of flexible versions is not decoded.
+	e := requestHeader(apiProduce, 9, 1).int8(0).int8(0).int16(0)
+	msg := &message{}
+	if assert.NoError(t, msg.decodeRequest(e.buf)) {
+		assert.Empty(t, msg.info.topics)
+		assert.False(t, msg.info.noResponse)
+	}
+}
+
+func TestDecodeResponse(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		key     apiKey
+		version int16
+		body    *encoder
+		err     errorCode
+	}{
+		"produce": {apiProduce, 5,
+			(&encoder{}).int32(1).string("orders").int32(2).
+				int32(0).int16(0).int64(1).int64(-1).int64(0).
+				int32(1).int16(6).int64(-1).int64(-1).int64(-1).
+				int32(0), 6},
+		"fetch": {apiFetch, 4,
+			(&encoder{}).int32(0).int32(1).string("orders").int32(1).
+				int32(0).int16(1).int64(10).int64(10).int32(-1).bytes(nil), 1},
+		"metadata": {apiMetadata, 1,
+			(&encoder{}).int32(1).int32(1).string("broker-1").int32(9092).string("").
+				int32(1).
+				int32(1).int16(3).string("missing").int8(0).int32(0), 3},
+		"offset fetch": {apiOffsetFetch, 3,
+			(&encoder{}).int32(0).int32(0).int16(16), 16},
+		"join group": {apiJoinGroup, 2,
+			(&encoder{}).int32(0).int16(27), 27},
+		"heartbeat": {apiHeartbeat, 0,
+			(&encoder{}).int16(0), 0},
+	} {
+		var code errorCode
+		apis[testCase.key].decodeResponse(newDecoder(testCase.body.buf), testCase.version, &code)
+		assert.Equal(t, testCase.err, code, name)
+	}
+}
+
+func TestErrorName(t *testing.T) {
+	assert.Equal(t, "UNKNOWN_SERVER_ERROR", errorName(-1))
+	assert.Equal(t, "NOT_LEADER_FOR_PARTITION", errorName(6))
+	assert.Equal(t, "UNKNOWN(1000)", errorName(1000))
+}
@@ -0,0 +1,445 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package kafka provides support for parsing the Kafka wire protocol and
+// reporting requests and their responses as transactions. Responses are
+// correlated to requests by their correlation ID on each connection.
+package kafka
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/applayer"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+type kafkaPlugin struct {
+	// Configuration data.
+	ports []int
+
+	// Cache of requests waiting for a response, keyed by transactionKey.
+	transactions       *common.Cache
+	transactionTimeout time.Duration
+
+	results protos.Reporter // Channel where results are pushed.
+}
+
+var (
+	debugf = logp.MakeDebug("kafka")
+)
+
+var (
+	unmatchedRequests  = monitoring.NewInt(nil, "kafka.unmatched_requests")
+	unmatchedResponses = monitoring.NewInt(nil, "kafka.unmatched_responses")
+)
+
+const (
+	// Messages larger than this are considered invalid, the stream is
+	// not Kafka or it is out of sync.
+	maxMessageSize = 1 << 30
+
+	// Only the beginning of larger messages is buffered and decoded.
+	maxBufferedSize = tcp.TCPMaxDataInStream
+
+	// Sizes of the request header up to the client ID, and of the
+	// response header.
+	minRequestSize  = 2 + 2 + 4 + 2
+	minResponseSize = 4
+)
+
+const (
+	noResponse = "No response to this request was received"
+	truncated  = "Message was too large to be fully decoded"
+)
+
+// transactionKey identifies a request in a connection.
+type transactionKey struct {
+	conn          common.HashableTCPTuple
+	correlationID int32
+}
+
+// message is a Kafka request or response.
+type message struct {
+	ts            time.Time
+	tuple         common.IPPortTuple
+	cmdlineTuple  *common.CmdlineTuple
+	size          int
+	truncated     bool
+	correlationID int32
+
+	// Request header and body.
+	apiKey     apiKey
+	apiVersion int16
+	clientID   string
+	info       requestInfo
+
+	// Response body, decoded once the request is known.
+	body []byte
+}
+
+type transaction struct {
+	applayer.Transaction
+	request *message
+
+	response  *message
+	errorCode errorCode
+}
+
+// stream contains the data from one side of a TCP connection.
+type stream struct {
+	data []byte
+
+	// skip is the number of bytes of a message too large to be buffered
+	// that have not been received yet.
+	skip int
+}
+
+type connection struct {
+	streams [2]*stream
+}
+
+func init() {
+	protos.Register("kafka", New)
+}
+
+// New creates and initializes a new Kafka protocol analyzer instance.
+func New(
+	testMode bool,
+	results protos.Reporter,
+	cfg *common.Config,
+) (protos.Plugin, error) {
+	p := &kafkaPlugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (kafka *kafkaPlugin) init(results protos.Reporter, config *kafkaConfig) error {
+	kafka.setFromConfig(config)
+	kafka.transactions = common.NewCacheWithRemovalListener(
+		kafka.transactionTimeout,
+		protos.DefaultTransactionHashSize,
+		func(k common.Key, v common.Value) {
+			trans, ok := v.(*transaction)
+			if !ok {
+				logp.Err("Expired value is not a *transaction.")
+				return
+			}
+			kafka.expireTransaction(trans)
+		})
+	kafka.transactions.StartJanitor(kafka.transactionTimeout)
+
+	kafka.results = results
+
+	return nil
+}
+
+func (kafka *kafkaPlugin) setFromConfig(config *kafkaConfig) {
+	kafka.ports = config.Ports
+	kafka.transactionTimeout = config.TransactionTimeout
+}
+
+func (kafka *kafkaPlugin) GetPorts() []int {
+	return kafka.ports
+}
+
+func (kafka *kafkaPlugin) ConnectionTimeout() time.Duration {
+	return kafka.transactionTimeout
+}
+
+// isRequest returns true for packets sent to one of the configured ports.
+func (kafka *kafkaPlugin) isRequest(pkt *protos.Packet) bool {
+	for _, port := range kafka.ports {
+		if uint16(port) == pkt.Tuple.DstPort {
+			return true
+		}
+	}
+	return false
+}
+
+func (kafka *kafkaPlugin) Parse(
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	defer logp.Recover("Kafka Parse")
+
+	debugf("Parsing packet addressed with %s of length %d.",
+		pkt.Tuple.String(), len(pkt.Payload))
+
+	conn := ensureConnection(private)
+	st := conn.streams[dir]
+	if st == nil {
+		st = &stream{}
+		conn.streams[dir] = st
+	}
+
+	payload := pkt.Payload
+	if st.skip > 0 {
+		n := st.skip
+		if n > len(payload) {
+			n = len(payload)
+		}
+		st.skip -= n
+		payload = payload[n:]
+	}
+	st.data = append(st.data, payload...)
+
+	isRequest := kafka.isRequest(pkt)
+	for len(st.data) >= 4 {
+		size := int(int32(binary.BigEndian.Uint32(st.data)))
+		minSize := minResponseSize
+		if isRequest {
+			minSize = minRequestSize
+		}
+		if size < minSize || size > maxMessageSize {
+			debugf("Invalid message size %d, dropping Kafka stream %s", size, tcptuple)
+			conn.streams[dir] = nil
+			return conn
+		}
+
+		total := 4 + size
+		data := st.data
+		if total <= len(data) {
+			data = data[:total]
+			st.data = st.data[total:]
+		} else if len(data) >= maxBufferedSize {
+			st.skip = total - len(data)
+			st.data = nil
+		} else {
+			break
+		}
+
+		msg := &message{
+			ts:           pkt.Ts,
+			tuple:        pkt.Tuple,
+			cmdlineTuple: procs.ProcWatcher.FindProcessesTuple(tcptuple.IPPort()),
+			size:         total,
+			truncated:    len(data) < total,
+		}
+		if isRequest {
+			if err := msg.decodeRequest(data[4:]); err != nil {
+				debugf("%v, dropping Kafka stream %s", err, tcptuple)
+				conn.streams[dir] = nil
+				return conn
+			}
+			kafka.receivedRequest(tcptuple, msg)
+		} else {
+			d := newDecoder(data[4:])
+			msg.correlationID = d.int32()
+			msg.body = d.data
+			kafka.receivedResponse(tcptuple, msg)
+		}
+	}
+
+	return conn
+}
+
+func ensureConnection(private protos.ProtocolData) *connection {
+	if private == nil {
+		return &connection{}
+	}
+
+	conn, ok := private.(*connection)
+	if !ok {
+		logp.Warn("Kafka connection data type error, create new one")
+		return &connection{}
+	}
+	if conn == nil {
+		logp.Warn("Unexpected: Kafka connection data not set, create new one")
+		return &connection{}
+	}
+
+	return conn
+}
+
+// ReceivedFin is a no-op, Kafka messages are delimited by their size.
+func (kafka *kafkaPlugin) ReceivedFin(
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	return private
+}
+
+// GapInStream ignores gaps in the part of a message that is not buffered,
+// and drops the stream otherwise as the message boundaries are lost.
+func (kafka *kafkaPlugin) GapInStream(
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	nbytes int,
+	private protos.ProtocolData,
+) (priv protos.ProtocolData, drop bool) {
+	conn, ok := private.(*connection)
+	if !ok || conn == nil || conn.streams[dir] == nil {
+		return private, false
+	}
+
+	st := conn.streams[dir]
+	if st.skip >= nbytes {
+		st.skip -= nbytes
+		return conn, false
+	}
+
+	conn.streams[dir] = nil
+	return conn, true
+}
+
+// decodeRequest decodes the request header and, for the supported API
+// versions, the body of the request.
+func (m *message) decodeRequest(data []byte) error {
+	d := newDecoder(data)
+	m.apiKey = apiKey(d.int16())
+	m.apiVersion = d.int16()
+	m.correlationID = d.int32()
+	m.clientID = d.string()
+	if d.err != nil {
+		return d.err
+	}
+	if !m.apiKey.valid() || m.apiVersion < 0 {
+		return fmt.Errorf("invalid request header with API key %d and version %d", m.apiKey, m.apiVersion)
+	}
+
+	if api, found := apis[m.apiKey]; found && api.decodeRequest != nil && m.apiVersion <= api.maxVersion {
+		// Errors are ignored, as truncated messages are decoded as far
+		// as possible.
+		api.decodeRequest(d, m.apiVersion, &m.info)
+	}
+	return nil
+}
+
+func (kafka *kafkaPlugin) receivedRequest(tcptuple *common.TCPTuple, msg *message) {
+	debugf("Received %s request with correlation ID %d", msg.apiKey, msg.correlationID)
+
+	t := &transaction{request: msg}
+	t.Init("kafka", msg.tuple, applayer.TransportTCP, applayer.NetOriginalDirection,
+		msg.ts, msg.cmdlineTuple, nil)
+
+	if msg.info.noResponse {
+		kafka.publishTransaction(t)
+		return
+	}
+	key := transactionKey{conn: tcptuple.Hashable(), correlationID: msg.correlationID}
+	kafka.transactions.Put(key, t)
+}
+
+func (kafka *kafkaPlugin) receivedResponse(tcptuple *common.TCPTuple, msg *message) {
+	debugf("Received response with correlation ID %d", msg.correlationID)
+
+	key := transactionKey{conn: tcptuple.Hashable(), correlationID: msg.correlationID}
+	v := kafka.transactions.Delete(key)
+	if v == nil {
+		// The body can't be decoded without the request.
+		debugf("Response received without an associated request")
+		unmatchedResponses.Add(1)
+		return
+	}
+
+	t := v.(*transaction)
+	t.response = msg
+	if api, found := apis[t.request.apiKey]; found && t.request.apiVersion <= api.maxVersion {
+		api.decodeResponse(newDecoder(msg.body), t.request.apiVersion, &t.errorCode)
+	}
+	kafka.publishTransaction(t)
+}
+
+func (kafka *kafkaPlugin) expireTransaction(t *transaction) {
+	t.Notes = append(t.Notes, noResponse)
+	debugf("%s, correlation ID %d", noResponse, t.request.correlationID)
+	kafka.publishTransaction(t)
+	unmatchedRequests.Add(1)
+}
+
+func (kafka *kafkaPlugin) publishTransaction(t *transaction) {
+	if kafka.results == nil {
+		return
+	}
+
+	requ, resp := t.request, t.response
+	if requ.truncated || (resp != nil && resp.truncated) {
+		t.Notes = append(t.Notes, truncated)
+	}
+
+	t.Status = common.OK_STATUS
+	t.BytesIn = uint64(requ.size)
+	t.ResponseTime = -1
+	if resp != nil {
+		t.BytesOut = uint64(resp.size)
+		t.ResponseTime = int32(resp.ts.Sub(t.Ts.Ts).Nanoseconds() / 1e6)
+		if t.errorCode != 0 {
+			t.Status = common.ERROR_STATUS
+		}
+	} else if !requ.info.noResponse {
+		t.Status = common.ERROR_STATUS
+	}
+
+	event := beat.Event{Fields: common.MapStr{}}
+	if err := t.Event(&event); err != nil {
+		logp.Warn("error filling generic transaction fields: %v", err)
+		return
+	}
+
+	fields := event.Fields
+	fields["method"] = requ.apiKey.String()
+	fields["query"] = requ.apiKey.String()
+
+	kafkaEvent := common.MapStr{
+		"api_key":        int16(requ.apiKey),
+		"api_version":    requ.apiVersion,
+		"correlation_id": requ.correlationID,
+	}
+	fields["kafka"] = kafkaEvent
+	if requ.clientID != "" {
+		kafkaEvent["client_id"] = requ.clientID
+	}
+	if len(requ.info.topics) > 0 {
+		kafkaEvent["topics"] = requ.info.topics
+		fields["query"] = fmt.Sprintf("%s %s", requ.apiKey, strings.Join(requ.info.topics, ","))
+	}
+	if len(requ.info.partitions) > 0 {
+		kafkaEvent["partitions"] = requ.info.partitions
+	}
+	if requ.info.groupID != "" {
+		kafkaEvent["group_id"] = requ.info.groupID
+	}
+
+	if resp != nil && t.errorCode != 0 {
+		kafkaEvent["error_code"] = int16(t.errorCode)
+		kafkaEvent["error"] = errorName(int16(t.errorCode))
+	}
+
+	kafka.results(event)
+}
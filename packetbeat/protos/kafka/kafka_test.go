@@ -0,0 +1,206 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package kafka
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+var (
+	clientIP = net.ParseIP("10.0.0.1")
+	serverIP = net.ParseIP("10.0.0.2")
+
+	forward = common.NewIPPortTuple(4, clientIP, 34000, serverIP, 9092)
+	reverse = common.NewIPPortTuple(4, serverIP, 9092, clientIP, 34000)
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	e.events = append(e.events, event)
+}
+
+func newKafka(store *eventStore, settings map[string]interface{}) *kafkaPlugin {
+	config := map[string]interface{}{"ports": []int{9092}}
+	for k, v := range settings {
+		config[k] = v
+	}
+	cfg, _ := common.NewConfigFrom(config)
+	kafka, err := New(false, store.publish, cfg)
+	if err != nil {
+		panic(err)
+	}
+	return kafka.(*kafkaPlugin)
+}
+
+func newPacket(t common.IPPortTuple, ts time.Time, payload []byte) *protos.Packet {
+	return &protos.Packet{
+		Ts:      ts,
+		Tuple:   t,
+		Payload: payload,
+	}
+}
+
+func produceRequest(correlationID int32, acks int16) []byte {
+	return requestHeader(apiProduce, 2, correlationID).
+		int16(acks).int32(30000).
+		int32(1).string("orders").int32(1).
+		int32(0).bytes([]byte("records")).
+		message()
+}
+
+func produceResponse(correlationID int32, errorCode int16) []byte {
+	return (&encoder{}).int32(correlationID).
+		int32(1).string("orders").int32(1).
+		int32(0).int16(errorCode).int64(1).int64(-1).
+		int32(0).
+		message()
+}
+
+func TestKafka_pipelinedRequests(t *testing.T) {
+	var store eventStore
+	kafka := newKafka(&store, nil)
+	tcptuple := common.TCPTupleFromIPPort(&forward, 1)
+
+	ts := time.Now()
+	requests := append(produceRequest(1, 1), produceRequest(2, -1)...)
+	responses := append(produceResponse(2, 0), produceResponse(1, 10)...)
+
+	var private protos.ProtocolData
+	private = kafka.Parse(newPacket(forward, ts, requests[:10]), &tcptuple, 0, private)
+	private = kafka.Parse(newPacket(forward, ts, requests[10:]), &tcptuple, 0, private)
+	private = kafka.Parse(newPacket(reverse, ts.Add(15*time.Millisecond), responses), &tcptuple, 1, private)
+
+	if !assert.Len(t, store.events, 2) {
+		return
+	}
+
+	fields := store.events[0].Fields
+	assert.Equal(t, "kafka", fields["type"])
+	assert.Equal(t, common.OK_STATUS, fields["status"])
+	assert.Equal(t, "Produce", fields["method"])
+	assert.Equal(t, "Produce orders", fields["query"])
+	assert.Equal(t, int32(15), fields["responsetime"])
+	assert.Equal(t, uint64(len(produceRequest(2, -1))), fields["bytes_in"])
+	assert.Equal(t, uint64(len(produceResponse(2, 0))), fields["bytes_out"])
+	assert.Equal(t, common.MapStr{
+		"api_key":        int16(0),
+		"api_version":    int16(2),
+		"correlation_id": int32(2),
+		"client_id":      "producer-1",
+		"topics":         []string{"orders"},
+		"partitions":     []string{"orders-0"},
+	}, fields["kafka"])
+
+	fields = store.events[1].Fields
+	assert.Equal(t, common.ERROR_STATUS, fields["status"])
+	code, _ := fields.GetValue("kafka.error_code")
+	assert.Equal(t, int16(10), code)
+	name, _ := fields.GetValue("kafka.error")
+	assert.Equal(t, "MESSAGE_TOO_LARGE", name)
+}
+
+func TestKafka_produceWithoutAcks(t *testing.T) {
+	var store eventStore
+	kafka := newKafka(&store, nil)
+	tcptuple := common.TCPTupleFromIPPort(&forward, 1)
+
+	kafka.Parse(newPacket(forward, time.Now(), produceRequest(1, 0)), &tcptuple, 0, nil)
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, common.OK_STATUS, fields["status"])
+		assert.Equal(t, int32(-1), fields["responsetime"])
+	}
+	assert.Equal(t, 0, kafka.transactions.Size())
+}
+
+func TestKafka_noResponse(t *testing.T) {
+	var store eventStore
+	kafka := newKafka(&store, map[string]interface{}{"transaction_timeout": "10ms"})
+	tcptuple := common.TCPTupleFromIPPort(&forward, 1)
+
+	kafka.Parse(newPacket(forward, time.Now(), produceRequest(1, 1)), &tcptuple, 0, nil)
+	time.Sleep(20 * time.Millisecond)
+	kafka.transactions.CleanUp()
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, common.ERROR_STATUS, fields["status"])
+		assert.Equal(t, []string{noResponse}, fields["notes"])
+	}
+}
+
+func TestKafka_largeResponse(t *testing.T) {
+	var store eventStore
+	kafka := newKafka(&store, nil)
+	tcptuple := common.TCPTupleFromIPPort(&forward, 1)
+
+	request := requestHeader(apiFetch, 0, 1).
+		int32(-1).int32(500).int32(1).
+		int32(1).string("orders").int32(1).
+		int32(0).int64(0).int32(1 << 30).
+		message()
+	records := make([]byte, maxBufferedSize)
+	response := (&encoder{}).int32(1).
+		int32(1).string("orders").int32(1).
+		int32(0).int16(0).int64(0).bytes(records).
+		message()
+
+	ts := time.Now()
+	var private protos.ProtocolData
+	private = kafka.Parse(newPacket(forward, ts, request), &tcptuple, 0, private)
+	private = kafka.Parse(newPacket(reverse, ts, response[:maxBufferedSize]), &tcptuple, 1, private)
+	private, drop := kafka.GapInStream(&tcptuple, 1, 10, private)
+	assert.False(t, drop)
+	private = kafka.Parse(newPacket(reverse, ts, response[maxBufferedSize+10:]), &tcptuple, 1, private)
+	private = kafka.Parse(newPacket(reverse, ts, produceResponse(2, 0)), &tcptuple, 1, private)
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, common.OK_STATUS, fields["status"])
+		assert.Equal(t, uint64(len(response)), fields["bytes_out"])
+		assert.Equal(t, []string{truncated}, fields["notes"])
+	}
+
+	// The stream is still in sync, the next response is parsed but does not
+	// match any request.
+	assert.Equal(t, 0, len(private.(*connection).streams[1].data))
+}
+
+func TestKafka_invalidStream(t *testing.T) {
+	var store eventStore
+	kafka := newKafka(&store, nil)
+	tcptuple := common.TCPTupleFromIPPort(&forward, 1)
+
+	private := kafka.Parse(newPacket(forward, time.Now(), []byte("GET / HTTP/1.1\r\n\r\n")), &tcptuple, 0, nil)
+	assert.Nil(t, private.(*connection).streams[0])
+	assert.Empty(t, store.events)
+}
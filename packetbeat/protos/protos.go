@@ -75,7 +75,7 @@ func validatePorts(ports []int) error {
 }
 
 type Protocols interface {
-	BpfFilter(withVlans bool, withICMP bool) string
+	BpfFilter(withVlans bool, withICMP bool, extra ...string) string
 	GetTCP(proto Protocol) TCPPlugin
 	GetUDP(proto Protocol) UDPPlugin
 
@@ -219,10 +219,10 @@ func (s ProtocolsStruct) GetAllUDP() map[Protocol]UDPPlugin {
 }
 
 // BpfFilter returns a Berkeley Packer Filter (BFP) expression that
-// will match against packets for the registered protocols. If with_vlans is
-// true the filter will match against both IEEE 802.1Q VLAN encapsulated
-// and unencapsulated packets
-func (s ProtocolsStruct) BpfFilter(withVlans bool, withICMP bool) string {
+// will match against packets for the registered protocols and the extra
+// expressions. If with_vlans is true the filter will match against both
+// IEEE 802.1Q VLAN encapsulated and unencapsulated packets
+func (s ProtocolsStruct) BpfFilter(withVlans bool, withICMP bool, extra ...string) string {
 	// Sort the protocol IDs so that the return value is consistent.
 	var protos []int
 	for proto := range s.all {
@@ -262,9 +262,21 @@ func (s ProtocolsStruct) BpfFilter(withVlans bool, withICMP bool) string {
 		expressions = append(expressions, "icmp", "icmp6")
 	}
 
+	expressions = append(expressions, extra...)
+
 	filter := strings.Join(expressions, " or ")
 	if withVlans {
-		filter = fmt.Sprintf("%s or (vlan and (%s))", filter, filter)
+		// mpls changes the decoding offsets of the VLAN expressions
+		// following it, the untagged MPLS packets are matched by their
+		// Ethernet type instead.
+		untagged := make([]string, len(expressions))
+		for i, expr := range expressions {
+			if expr == "mpls" {
+				expr = "ether proto 0x8847"
+			}
+			untagged[i] = expr
+		}
+		filter = fmt.Sprintf("%s or (vlan and (%s))", strings.Join(untagged, " or "), filter)
 	}
 	return filter
 }
@@ -161,6 +161,13 @@ func TestBpfFilterWithVlanWithIcmp(t *testing.T) {
 		"(vlan and (tcp port 80 or udp port 5060 or port 53 or icmp or icmp6))", filter)
 }
 
+func TestBpfFilterWithVlanWithExtra(t *testing.T) {
+	p := newProtocols()
+	filter := p.BpfFilter(true, false, "ip proto 47", "mpls")
+	assert.Equal(t, "tcp port 80 or udp port 5060 or port 53 or ip proto 47 or ether proto 0x8847 or "+
+		"(vlan and (tcp port 80 or udp port 5060 or port 53 or ip proto 47 or mpls))", filter)
+}
+
 func TestGetAllTCP(t *testing.T) {
 	p := newProtocols()
 	tcp := p.GetAllTCP()
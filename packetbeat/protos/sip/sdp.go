@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sip
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const contentTypeSDP = "application/sdp"
+
+// sdpMedia is a media description from an SDP body.
+type sdpMedia struct {
+	mediaType  string
+	port       int
+	protocol   string
+	formats    []string
+	codecs     []string
+	connection string
+	direction  string
+}
+
+// sdpSession contains the details of an SDP body (RFC 4566) that are
+// relevant to monitor the media streams of a call.
+type sdpSession struct {
+	sessionName string
+	connection  string
+	media       []*sdpMedia
+}
+
+// parseSDP parses an SDP body. Unknown lines are ignored.
+func parseSDP(body []byte) *sdpSession {
+	session := &sdpSession{}
+	var media *sdpMedia
+	rtpmap := map[string]string{}
+
+	addCodecs := func() {
+		if media == nil {
+			return
+		}
+		for _, format := range media.formats {
+			if codec, found := rtpmap[format]; found {
+				media.codecs = append(media.codecs, codec)
+			}
+		}
+	}
+
+	for _, line := range strings.Split(string(body), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if len(line) < 2 || line[1] != '=' {
+			continue
+		}
+		value := line[2:]
+
+		switch line[0] {
+		case 's':
+			session.sessionName = value
+		case 'c':
+			// c=<nettype> <addrtype> <connection-address>
+			fields := strings.Fields(value)
+			if len(fields) != 3 {
+				continue
+			}
+			address := strings.SplitN(fields[2], "/", 2)[0]
+			if media != nil {
+				media.connection = address
+			} else {
+				session.connection = address
+			}
+		case 'm':
+			// m=<media> <port>[/<number of ports>] <proto> <fmt> ...
+			fields := strings.Fields(value)
+			if len(fields) < 3 {
+				continue
+			}
+			addCodecs()
+			port, _ := strconv.Atoi(strings.SplitN(fields[1], "/", 2)[0])
+			media = &sdpMedia{
+				mediaType:  fields[0],
+				port:       port,
+				protocol:   fields[2],
+				formats:    fields[3:],
+				connection: session.connection,
+			}
+			rtpmap = map[string]string{}
+			session.media = append(session.media, media)
+		case 'a':
+			attr := strings.SplitN(value, ":", 2)
+			switch attr[0] {
+			case "rtpmap":
+				// a=rtpmap:<payload type> <encoding name>/<clock rate>
+				if len(attr) == 2 {
+					fields := strings.Fields(attr[1])
+					if len(fields) == 2 {
+						rtpmap[fields[0]] = fields[1]
+					}
+				}
+			case "sendrecv", "sendonly", "recvonly", "inactive":
+				if media != nil {
+					media.direction = attr[0]
+				}
+			}
+		}
+	}
+	addCodecs()
+
+	return session
+}
+
+func (s *sdpSession) toMapStr() common.MapStr {
+	m := common.MapStr{}
+	if s.sessionName != "" {
+		m["session_name"] = s.sessionName
+	}
+	if s.connection != "" {
+		m["connection"] = s.connection
+	}
+
+	media := make([]common.MapStr, 0, len(s.media))
+	for _, desc := range s.media {
+		d := common.MapStr{
+			"type":     desc.mediaType,
+			"port":     desc.port,
+			"protocol": desc.protocol,
+			"formats":  desc.formats,
+		}
+		if len(desc.codecs) > 0 {
+			d["codecs"] = desc.codecs
+		}
+		if desc.connection != "" {
+			d["connection"] = desc.connection
+		}
+		if desc.direction != "" {
+			d["direction"] = desc.direction
+		}
+		media = append(media, d)
+	}
+	m["media"] = media
+	return m
+}
@@ -0,0 +1,422 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package sip provides support for parsing SIP (RFC 3261) messages sent over
+// UDP and TCP and reporting them as transactions. Requests and responses are
+// correlated using the Call-ID, the CSeq and the branch of the topmost Via
+// header.
+package sip
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+type sipPlugin struct {
+	// Configuration data.
+	ports        []int
+	sendRequest  bool
+	sendResponse bool
+	parseSDP     bool
+
+	// Cache of active SIP transactions, keyed by transactionKey.
+	transactions       *common.Cache
+	transactionTimeout time.Duration
+
+	// Keys of the transactions whose final response was reported, so
+	// retransmissions are not reported as orphaned responses.
+	completed *common.Cache
+
+	results protos.Reporter // Channel where results are pushed.
+}
+
+var (
+	debugf = logp.MakeDebug("sip")
+)
+
+var (
+	unmatchedRequests  = monitoring.NewInt(nil, "sip.unmatched_requests")
+	unmatchedResponses = monitoring.NewInt(nil, "sip.unmatched_responses")
+)
+
+// Transport protocol.
+type transport uint8
+
+const (
+	transportTCP = iota
+	transportUDP
+)
+
+var transportNames = []string{
+	"tcp",
+	"udp",
+}
+
+func (t transport) String() string {
+	if int(t) >= len(transportNames) {
+		return "impossible"
+	}
+	return transportNames[t]
+}
+
+// Notes added to transactions.
+const (
+	noResponse       = "No final response to this request was received"
+	orphanedResponse = "Response received without an associated request"
+)
+
+// transactionKey identifies a SIP transaction. Responses copy these values
+// from the request.
+type transactionKey struct {
+	callID     string
+	cseqNumber uint32
+	cseqMethod string
+	branch     string
+}
+
+func (k transactionKey) String() string {
+	return fmt.Sprintf("call_id[%s] cseq[%d %s] branch[%s]",
+		k.callID, k.cseqNumber, k.cseqMethod, k.branch)
+}
+
+func keyOf(m *message) transactionKey {
+	return transactionKey{
+		callID:     m.callID,
+		cseqNumber: m.cseqNumber,
+		cseqMethod: m.cseqMethod,
+		branch:     m.branch,
+	}
+}
+
+type transaction struct {
+	ts        time.Time // Time when the request was received.
+	key       transactionKey
+	transport transport
+	src       common.Endpoint
+	dst       common.Endpoint
+	notes     []string
+
+	request     *message
+	response    *message  // Final response.
+	provisional []int     // Status codes of the provisional responses.
+	ringingTs   time.Time // Time of the first 180 or 183 response.
+}
+
+func init() {
+	protos.Register("sip", New)
+}
+
+// New creates and initializes a new SIP protocol analyzer instance.
+func New(
+	testMode bool,
+	results protos.Reporter,
+	cfg *common.Config,
+) (protos.Plugin, error) {
+	p := &sipPlugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (sip *sipPlugin) init(results protos.Reporter, config *sipConfig) error {
+	sip.setFromConfig(config)
+	sip.transactions = common.NewCacheWithRemovalListener(
+		sip.transactionTimeout,
+		protos.DefaultTransactionHashSize,
+		func(k common.Key, v common.Value) {
+			trans, ok := v.(*transaction)
+			if !ok {
+				logp.Err("Expired value is not a *transaction.")
+				return
+			}
+			sip.expireTransaction(trans)
+		})
+	sip.transactions.StartJanitor(sip.transactionTimeout)
+	sip.completed = common.NewCache(sip.transactionTimeout, protos.DefaultTransactionHashSize)
+	sip.completed.StartJanitor(sip.transactionTimeout)
+
+	sip.results = results
+
+	return nil
+}
+
+func (sip *sipPlugin) setFromConfig(config *sipConfig) {
+	sip.ports = config.Ports
+	sip.sendRequest = config.SendRequest
+	sip.sendResponse = config.SendResponse
+	sip.parseSDP = config.ParseSDP
+	sip.transactionTimeout = config.TransactionTimeout
+}
+
+func (sip *sipPlugin) GetPorts() []int {
+	return sip.ports
+}
+
+func (sip *sipPlugin) ConnectionTimeout() time.Duration {
+	return sip.transactionTimeout
+}
+
+func (sip *sipPlugin) getTransaction(k transactionKey) *transaction {
+	v := sip.transactions.Get(k)
+	if v != nil {
+		return v.(*transaction)
+	}
+	return nil
+}
+
+func newTransaction(trans transport, msg *message, tuple common.BaseTuple, cmd common.CmdlineTuple) *transaction {
+	t := &transaction{
+		ts:        msg.ts,
+		key:       keyOf(msg),
+		transport: trans,
+	}
+	t.src, t.dst = common.MakeEndpointPair(tuple, &cmd)
+	return t
+}
+
+func (sip *sipPlugin) handleMessage(trans transport, msg *message) {
+	if msg.isRequest {
+		sip.receivedRequest(trans, msg)
+	} else {
+		sip.receivedResponse(trans, msg)
+	}
+}
+
+func (sip *sipPlugin) receivedRequest(trans transport, msg *message) {
+	key := keyOf(msg)
+	debugf("Processing %s request. %s", msg.method, key)
+
+	if msg.method == "ACK" {
+		// ACK requests are not answered, they only confirm the final
+		// response to an INVITE.
+		return
+	}
+
+	if sip.getTransaction(key) != nil || sip.completed.Get(key) != nil {
+		debugf("Ignoring retransmitted request. %s", key)
+		return
+	}
+
+	t := newTransaction(trans, msg, msg.tuple.BaseTuple, *msg.cmdlineTuple)
+	t.request = msg
+	sip.transactions.Put(key, t)
+}
+
+func (sip *sipPlugin) receivedResponse(trans transport, msg *message) {
+	key := keyOf(msg)
+	debugf("Processing %d response. %s", msg.statusCode, key)
+
+	// The transaction is removed from the cache while it is updated, so it
+	// is never accessed by the cache janitor at the same time.
+	v := sip.transactions.Delete(key)
+	if v == nil {
+		if sip.completed.Get(key) != nil {
+			debugf("Ignoring retransmitted response. %s", key)
+			return
+		}
+		if !msg.isFinal() {
+			return
+		}
+		tuple := msg.tuple.BaseTuple
+		tuple.SrcIP, tuple.DstIP = tuple.DstIP, tuple.SrcIP
+		tuple.SrcPort, tuple.DstPort = tuple.DstPort, tuple.SrcPort
+		t := newTransaction(trans, msg, tuple, msg.cmdlineTuple.Reverse())
+		t.notes = append(t.notes, orphanedResponse)
+		debugf("%s %s", orphanedResponse, key)
+		unmatchedResponses.Add(1)
+		t.response = msg
+		sip.publishTransaction(t)
+		return
+	}
+	t := v.(*transaction)
+	if !msg.isFinal() {
+		t.provisional = append(t.provisional, msg.statusCode)
+		if t.ringingTs.IsZero() && (msg.statusCode == 180 || msg.statusCode == 183) {
+			t.ringingTs = msg.ts
+		}
+		// Provisional responses keep the transaction alive, an INVITE
+		// can be ringing for longer than the transaction timeout.
+		sip.transactions.Put(key, t)
+		return
+	}
+
+	t.response = msg
+	sip.completed.Put(key, true)
+	sip.publishTransaction(t)
+}
+
+func (sip *sipPlugin) expireTransaction(t *transaction) {
+	t.notes = append(t.notes, noResponse)
+	debugf("%s %s", noResponse, t.key)
+	sip.publishTransaction(t)
+	unmatchedRequests.Add(1)
+}
+
+func (sip *sipPlugin) publishTransaction(t *transaction) {
+	if sip.results == nil {
+		return
+	}
+
+	debugf("Publishing transaction. %s", t.key)
+
+	fields := common.MapStr{}
+	fields["type"] = "sip"
+	fields["transport"] = t.transport.String()
+	fields["src"] = &t.src
+	fields["dst"] = &t.dst
+	fields["status"] = common.ERROR_STATUS
+	if len(t.notes) == 1 {
+		fields["notes"] = t.notes[0]
+	} else if len(t.notes) > 1 {
+		fields["notes"] = strings.Join(t.notes, " ")
+	}
+
+	sipEvent := common.MapStr{
+		"call_id": t.key.callID,
+		"cseq": common.MapStr{
+			"number": t.key.cseqNumber,
+			"method": t.key.cseqMethod,
+		},
+		"branch": t.key.branch,
+	}
+	fields["sip"] = sipEvent
+	fields["method"] = t.key.cseqMethod
+
+	// The request is the authority for the From and To headers, except for
+	// the To tag that is assigned in the response.
+	var first *message
+	if t.request != nil {
+		first = t.request
+	} else {
+		first = t.response
+	}
+	sipEvent["from"] = first.from.toMapStr()
+	to := first.to
+	if t.response != nil && t.response.to.tag != "" {
+		to.tag = t.response.to.tag
+	}
+	sipEvent["to"] = to.toMapStr()
+
+	if requ := t.request; requ != nil {
+		fields["query"] = fmt.Sprintf("%s %s", requ.method, requ.requestURI)
+		fields["bytes_in"] = requ.size
+
+		request := common.MapStr{"uri": requ.requestURI}
+		sip.addBody(request, requ)
+		sipEvent["request"] = request
+
+		if requ.contact != "" {
+			sipEvent["contact"] = requ.contact
+		}
+		if requ.userAgent != "" {
+			sipEvent["user_agent"] = requ.userAgent
+		}
+		if sip.sendRequest {
+			fields["request"] = string(requ.raw)
+		}
+	}
+
+	response := common.MapStr{}
+	if len(t.provisional) > 0 {
+		response["provisional"] = t.provisional
+	}
+	if resp := t.response; resp != nil {
+		fields["bytes_out"] = resp.size
+		fields["status"] = statusOf(resp.statusCode)
+
+		response["code"] = resp.statusCode
+		response["phrase"] = resp.statusPhrase
+		sip.addBody(response, resp)
+
+		if resp.server != "" {
+			sipEvent["server"] = resp.server
+		} else if resp.userAgent != "" {
+			sipEvent["server"] = resp.userAgent
+		}
+		if _, found := sipEvent["contact"]; !found && resp.contact != "" {
+			sipEvent["contact"] = resp.contact
+		}
+		if sip.sendResponse {
+			fields["response"] = string(resp.raw)
+		}
+	}
+	if len(response) > 0 {
+		sipEvent["response"] = response
+	}
+
+	if t.request != nil {
+		if t.response != nil {
+			fields["responsetime"] = int32(t.response.ts.Sub(t.ts).Nanoseconds() / 1e6)
+		}
+		if !t.ringingTs.IsZero() {
+			sipEvent["ringing_time"] = int32(t.ringingTs.Sub(t.ts).Nanoseconds() / 1e6)
+		}
+	}
+
+	sip.results(beat.Event{
+		Timestamp: t.ts,
+		Fields:    fields,
+	})
+}
+
+// addBody adds the SDP details of the message, if any.
+func (sip *sipPlugin) addBody(m common.MapStr, msg *message) {
+	if !sip.parseSDP || len(msg.body) == 0 {
+		return
+	}
+	if strings.HasPrefix(msg.contentType, contentTypeSDP) {
+		m["sdp"] = parseSDP(msg.body).toMapStr()
+	}
+}
+
+func statusOf(code int) string {
+	switch {
+	case code >= 500:
+		return common.SERVER_ERROR_STATUS
+	case code >= 400:
+		return common.CLIENT_ERROR_STATUS
+	default:
+		return common.OK_STATUS
+	}
+}
+
+func (a nameAddr) toMapStr() common.MapStr {
+	m := common.MapStr{"uri": a.uri}
+	if a.displayName != "" {
+		m["display_name"] = a.displayName
+	}
+	if a.tag != "" {
+		m["tag"] = a.tag
+	}
+	return m
+}
@@ -0,0 +1,276 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sip
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+var (
+	errIncompleteMessage = errors.New("incomplete SIP message")
+	errInvalidStartLine  = errors.New("invalid SIP start line")
+	errInvalidHeader     = errors.New("invalid SIP header")
+	errMissingHeader     = errors.New("missing Call-ID, CSeq or Via header")
+)
+
+const sipVersion = "SIP/2.0"
+
+// message contains a single SIP request or response.
+type message struct {
+	ts           time.Time
+	tuple        common.IPPortTuple
+	cmdlineTuple *common.CmdlineTuple
+	size         int
+	raw          []byte
+
+	isRequest bool
+
+	// Request line
+	method     string
+	requestURI string
+
+	// Status line
+	statusCode   int
+	statusPhrase string
+
+	// Headers
+	callID      string
+	cseqNumber  uint32
+	cseqMethod  string
+	branch      string
+	from        nameAddr
+	to          nameAddr
+	contact     string
+	userAgent   string
+	server      string
+	contentType string
+
+	body []byte
+}
+
+// nameAddr holds the parts of the From and To headers.
+type nameAddr struct {
+	displayName string
+	uri         string
+	tag         string
+}
+
+// Compact header names as defined in RFC 3261 section 7.3.3.
+var compactHeaders = map[string]string{
+	"i": "call-id",
+	"f": "from",
+	"t": "to",
+	"m": "contact",
+	"v": "via",
+	"l": "content-length",
+	"c": "content-type",
+}
+
+// isFinal returns true for responses that terminate a SIP transaction.
+func (m *message) isFinal() bool {
+	return !m.isRequest && m.statusCode >= 200
+}
+
+// parseMessage parses the SIP message at the start of data. It returns the
+// message and the number of bytes it used. If the message is not complete
+// yet errIncompleteMessage is returned. When lengthRequired is false the
+// body extends to the end of data if there is no Content-Length header, as
+// it is allowed for messages sent over UDP.
+func parseMessage(data []byte, lengthRequired bool) (*message, int, error) {
+	headerEnd := bytes.Index(data, []byte("\r\n\r\n"))
+	if headerEnd < 0 {
+		return nil, 0, errIncompleteMessage
+	}
+
+	lines := splitHeaderLines(data[:headerEnd])
+	m := &message{}
+	if err := m.parseStartLine(lines[0]); err != nil {
+		return nil, 0, err
+	}
+
+	contentLength := -1
+	for _, line := range lines[1:] {
+		colon := strings.IndexByte(line, ':')
+		if colon <= 0 {
+			return nil, 0, errInvalidHeader
+		}
+		name := strings.ToLower(strings.TrimSpace(line[:colon]))
+		if long, found := compactHeaders[name]; found {
+			name = long
+		}
+		value := strings.TrimSpace(line[colon+1:])
+
+		switch name {
+		case "call-id":
+			m.callID = value
+		case "cseq":
+			fields := strings.Fields(value)
+			if len(fields) != 2 {
+				return nil, 0, errInvalidHeader
+			}
+			number, err := strconv.ParseUint(fields[0], 10, 32)
+			if err != nil {
+				return nil, 0, errInvalidHeader
+			}
+			m.cseqNumber = uint32(number)
+			m.cseqMethod = strings.ToUpper(fields[1])
+		case "via":
+			// Only the topmost Via header identifies the transaction.
+			if m.branch == "" {
+				first := strings.SplitN(value, ",", 2)[0]
+				m.branch = headerParam(first, "branch")
+				if m.branch == "" {
+					m.branch = "-"
+				}
+			}
+		case "from":
+			m.from = parseNameAddr(value)
+		case "to":
+			m.to = parseNameAddr(value)
+		case "contact":
+			if m.contact == "" {
+				m.contact = parseNameAddr(strings.SplitN(value, ",", 2)[0]).uri
+			}
+		case "user-agent":
+			m.userAgent = value
+		case "server":
+			m.server = value
+		case "content-type":
+			m.contentType = strings.ToLower(value)
+		case "content-length":
+			length, err := strconv.Atoi(value)
+			if err != nil || length < 0 {
+				return nil, 0, errInvalidHeader
+			}
+			contentLength = length
+		}
+	}
+
+	if m.callID == "" || m.cseqMethod == "" || m.branch == "" {
+		return nil, 0, errMissingHeader
+	}
+
+	bodyStart := headerEnd + 4
+	end := len(data)
+	switch {
+	case contentLength >= 0:
+		end = bodyStart + contentLength
+		if end > len(data) {
+			return nil, 0, errIncompleteMessage
+		}
+	case lengthRequired:
+		end = bodyStart
+	}
+
+	m.body = data[bodyStart:end]
+	m.raw = data[:end]
+	m.size = end
+	return m, end, nil
+}
+
+func (m *message) parseStartLine(line string) error {
+	fields := strings.SplitN(line, " ", 3)
+	if len(fields) < 3 {
+		return errInvalidStartLine
+	}
+
+	if fields[0] == sipVersion {
+		code, err := strconv.Atoi(fields[1])
+		if err != nil || code < 100 || code > 699 {
+			return errInvalidStartLine
+		}
+		m.statusCode = code
+		m.statusPhrase = fields[2]
+		return nil
+	}
+
+	if fields[2] != sipVersion {
+		return errInvalidStartLine
+	}
+	m.isRequest = true
+	m.method = fields[0]
+	m.requestURI = fields[1]
+	return nil
+}
+
+// splitHeaderLines splits the start line and headers into lines, joining
+// folded header lines.
+func splitHeaderLines(data []byte) []string {
+	var lines []string
+	for _, line := range strings.Split(string(data), "\r\n") {
+		if len(lines) > 1 && len(line) > 0 && (line[0] == ' ' || line[0] == '\t') {
+			lines[len(lines)-1] += " " + strings.TrimSpace(line)
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// parseNameAddr parses a From, To or Contact header value in either of the
+// name-addr or addr-spec forms.
+func parseNameAddr(value string) nameAddr {
+	var addr nameAddr
+	params := ""
+	if open := strings.IndexByte(value, '<'); open >= 0 {
+		end := strings.IndexByte(value[open:], '>')
+		if end < 0 {
+			addr.uri = value[open+1:]
+			return addr
+		}
+		addr.displayName = strings.Trim(strings.TrimSpace(value[:open]), `"`)
+		addr.uri = value[open+1 : open+end]
+		params = value[open+end+1:]
+	} else {
+		parts := strings.SplitN(value, ";", 2)
+		addr.uri = strings.TrimSpace(parts[0])
+		if len(parts) > 1 {
+			params = ";" + parts[1]
+		}
+	}
+	addr.tag = headerParam(params, "tag")
+	return addr
+}
+
+// headerParam returns the value of the named ';'-separated parameter.
+func headerParam(value, name string) string {
+	params := strings.Split(value, ";")
+	for _, param := range params[1:] {
+		kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+		if len(kv) == 2 && strings.EqualFold(kv[0], name) {
+			return strings.TrimSpace(kv[1])
+		}
+	}
+	return ""
+}
+
+// skipKeepAlives returns the number of CRLF bytes at the start of data,
+// which are sent as keep-alives between SIP messages.
+func skipKeepAlives(data []byte) int {
+	n := 0
+	for n < len(data) && (data[n] == '\r' || data[n] == '\n') {
+		n++
+	}
+	return n
+}
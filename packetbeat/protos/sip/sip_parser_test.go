@@ -0,0 +1,226 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package sip
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const offerSDP = "v=0\r\n" +
+	"o=alice 2890844526 2890844526 IN IP4 client.atlanta.example.com\r\n" +
+	"s=-\r\n" +
+	"c=IN IP4 192.0.2.101\r\n" +
+	"t=0 0\r\n" +
+	"m=audio 49172 RTP/AVP 0 8 101\r\n" +
+	"a=rtpmap:0 PCMU/8000\r\n" +
+	"a=rtpmap:8 PCMA/8000\r\n" +
+	"a=rtpmap:101 telephone-event/8000\r\n" +
+	"a=sendrecv\r\n"
+
+const answerSDP = "v=0\r\n" +
+	"o=bob 2890844527 2890844527 IN IP4 client.biloxi.example.com\r\n" +
+	"s=call\r\n" +
+	"c=IN IP4 192.0.2.201\r\n" +
+	"t=0 0\r\n" +
+	"m=audio 3456 RTP/AVP 0\r\n" +
+	"a=rtpmap:0 PCMU/8000\r\n" +
+	"m=video 0 RTP/AVP 31\r\n" +
+	"c=IN IP4 192.0.2.202\r\n" +
+	"a=inactive\r\n"
+
+// sipMessage builds a SIP message, adding the Content-Length header.
+func sipMessage(startLine string, headers []string, body string) string {
+	return startLine + "\r\n" +
+		strings.Join(headers, "\r\n") + "\r\n" +
+		fmt.Sprintf("Content-Length: %d\r\n", len(body)) +
+		"\r\n" + body
+}
+
+var inviteHeaders = []string{
+	"Via: SIP/2.0/UDP client.atlanta.example.com:5060;branch=z9hG4bK74bf9",
+	"Max-Forwards: 70",
+	`From: "Alice" <sip:alice@atlanta.example.com>;tag=9fxced76sl`,
+	"To: Bob <sip:bob@biloxi.example.com>",
+	"Call-ID: 3848276298220188511@atlanta.example.com",
+	"CSeq: 1 INVITE",
+	"Contact: <sip:alice@client.atlanta.example.com;transport=udp>",
+	"User-Agent: softphone/1.0",
+	"Content-Type: application/sdp",
+}
+
+func responseHeaders(extra ...string) []string {
+	return append([]string{
+		"Via: SIP/2.0/UDP client.atlanta.example.com:5060;branch=z9hG4bK74bf9;received=192.0.2.101",
+		`From: "Alice" <sip:alice@atlanta.example.com>;tag=9fxced76sl`,
+		"To: Bob <sip:bob@biloxi.example.com>;tag=8321234356",
+		"Call-ID: 3848276298220188511@atlanta.example.com",
+		"CSeq: 1 INVITE",
+	}, extra...)
+}
+
+var (
+	invite   = sipMessage("INVITE sip:bob@biloxi.example.com SIP/2.0", inviteHeaders, offerSDP)
+	trying   = sipMessage("SIP/2.0 100 Trying", responseHeaders(), "")
+	ringing  = sipMessage("SIP/2.0 180 Ringing", responseHeaders(), "")
+	inviteOK = sipMessage("SIP/2.0 200 OK", responseHeaders(
+		"Contact: <sip:bob@client.biloxi.example.com;transport=udp>",
+		"Server: pbx/2.1",
+		"Content-Type: application/sdp",
+	), answerSDP)
+	ack = sipMessage("ACK sip:bob@client.biloxi.example.com SIP/2.0", []string{
+		"Via: SIP/2.0/UDP client.atlanta.example.com:5060;branch=z9hG4bK74bd5",
+		`From: "Alice" <sip:alice@atlanta.example.com>;tag=9fxced76sl`,
+		"To: Bob <sip:bob@biloxi.example.com>;tag=8321234356",
+		"Call-ID: 3848276298220188511@atlanta.example.com",
+		"CSeq: 1 ACK",
+	}, "")
+)
+
+func TestParseMessage_request(t *testing.T) {
+	msg, n, err := parseMessage([]byte(invite), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, len(invite), n)
+	assert.True(t, msg.isRequest)
+	assert.Equal(t, "INVITE", msg.method)
+	assert.Equal(t, "sip:bob@biloxi.example.com", msg.requestURI)
+	assert.Equal(t, "3848276298220188511@atlanta.example.com", msg.callID)
+	assert.Equal(t, uint32(1), msg.cseqNumber)
+	assert.Equal(t, "INVITE", msg.cseqMethod)
+	assert.Equal(t, "z9hG4bK74bf9", msg.branch)
+	assert.Equal(t, nameAddr{displayName: "Alice", uri: "sip:alice@atlanta.example.com", tag: "9fxced76sl"}, msg.from)
+	assert.Equal(t, nameAddr{displayName: "Bob", uri: "sip:bob@biloxi.example.com"}, msg.to)
+	assert.Equal(t, "sip:alice@client.atlanta.example.com;transport=udp", msg.contact)
+	assert.Equal(t, "softphone/1.0", msg.userAgent)
+	assert.Equal(t, "application/sdp", msg.contentType)
+	assert.Equal(t, offerSDP, string(msg.body))
+}
+
+func TestParseMessage_response(t *testing.T) {
+	msg, _, err := parseMessage([]byte(ringing), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.False(t, msg.isRequest)
+	assert.False(t, msg.isFinal())
+	assert.Equal(t, 180, msg.statusCode)
+	assert.Equal(t, "Ringing", msg.statusPhrase)
+	assert.Equal(t, "z9hG4bK74bf9", msg.branch)
+	assert.Equal(t, "8321234356", msg.to.tag)
+}
+
+func TestParseMessage_compactHeaders(t *testing.T) {
+	data := "BYE sip:alice@client.atlanta.example.com SIP/2.0\r\n" +
+		"v: SIP/2.0/TCP client.biloxi.example.com:5060\r\n" +
+		" ;branch=z9hG4bKnashds7\r\n" +
+		"f: sip:bob@biloxi.example.com;tag=8321234356\r\n" +
+		"t: <sip:alice@atlanta.example.com>;tag=9fxced76sl\r\n" +
+		"i: 3848276298220188511@atlanta.example.com\r\n" +
+		"CSeq: 1 BYE\r\n" +
+		"l: 0\r\n" +
+		"\r\n"
+
+	msg, n, err := parseMessage([]byte(data), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, len(data), n)
+	assert.Equal(t, "BYE", msg.method)
+	assert.Equal(t, "z9hG4bKnashds7", msg.branch)
+	assert.Equal(t, nameAddr{uri: "sip:bob@biloxi.example.com", tag: "8321234356"}, msg.from)
+	assert.Equal(t, "9fxced76sl", msg.to.tag)
+	assert.Equal(t, "3848276298220188511@atlanta.example.com", msg.callID)
+}
+
+func TestParseMessage_incomplete(t *testing.T) {
+	for _, data := range []string{
+		invite[:20],
+		invite[:len(invite)-10],
+	} {
+		_, _, err := parseMessage([]byte(data), true)
+		assert.Equal(t, errIncompleteMessage, err)
+	}
+}
+
+func TestParseMessage_withoutContentLength(t *testing.T) {
+	data := "SIP/2.0 200 OK\r\n" +
+		"Via: SIP/2.0/UDP host;branch=z9hG4bK1\r\n" +
+		"Call-ID: a@b\r\n" +
+		"CSeq: 2 OPTIONS\r\n" +
+		"\r\n" +
+		"body"
+
+	msg, _, err := parseMessage([]byte(data), false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "body", string(msg.body))
+	}
+
+	msg, n, err := parseMessage([]byte(data), true)
+	if assert.NoError(t, err) {
+		assert.Empty(t, msg.body)
+		assert.Equal(t, len(data)-len("body"), n)
+	}
+}
+
+func TestParseMessage_invalid(t *testing.T) {
+	for _, data := range []string{
+		"GET / HTTP/1.1\r\n\r\n",
+		"SIP/2.0 abc OK\r\n\r\n",
+		"OPTIONS sip:a@b SIP/2.0\r\nno colon\r\n\r\n",
+		"OPTIONS sip:a@b SIP/2.0\r\nCall-ID: a@b\r\n\r\n",
+		"OPTIONS sip:a@b SIP/2.0\r\nCall-ID: a@b\r\nCSeq: x OPTIONS\r\n\r\n",
+	} {
+		_, _, err := parseMessage([]byte(data), true)
+		assert.Error(t, err, data)
+		assert.NotEqual(t, errIncompleteMessage, err, data)
+	}
+}
+
+func TestParseSDP(t *testing.T) {
+	sdp := parseSDP([]byte(answerSDP))
+
+	assert.Equal(t, "call", sdp.sessionName)
+	assert.Equal(t, "192.0.2.201", sdp.connection)
+	if assert.Len(t, sdp.media, 2) {
+		assert.Equal(t, &sdpMedia{
+			mediaType:  "audio",
+			port:       3456,
+			protocol:   "RTP/AVP",
+			formats:    []string{"0"},
+			codecs:     []string{"PCMU/8000"},
+			connection: "192.0.2.201",
+		}, sdp.media[0])
+		assert.Equal(t, &sdpMedia{
+			mediaType:  "video",
+			port:       0,
+			protocol:   "RTP/AVP",
+			formats:    []string{"31"},
+			connection: "192.0.2.202",
+			direction:  "inactive",
+		}, sdp.media[1])
+	}
+}
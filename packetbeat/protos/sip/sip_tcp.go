@@ -0,0 +1,136 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sip
+
+import (
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+	"github.com/elastic/beats/packetbeat/protos/tcp"
+)
+
+// stream contains the SIP data from one side of a TCP connection.
+type stream struct {
+	data []byte
+}
+
+// connection contains the streams of a TCP connection, indexed by
+// direction.
+type connection struct {
+	streams [2]*stream
+}
+
+func (sip *sipPlugin) Parse(
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	defer logp.Recover("SIP ParseTCP")
+
+	debugf("Parsing packet addressed with %s of length %d.",
+		pkt.Tuple.String(), len(pkt.Payload))
+
+	conn := ensureConnection(private)
+	st := conn.streams[dir]
+	if st == nil {
+		st = &stream{}
+		conn.streams[dir] = st
+	}
+
+	st.data = append(st.data, pkt.Payload...)
+	if len(st.data) > tcp.TCPMaxDataInStream {
+		debugf("Stream data too large, dropping SIP stream")
+		conn.streams[dir] = nil
+		return conn
+	}
+
+	for len(st.data) > 0 {
+		st.data = st.data[skipKeepAlives(st.data):]
+		if len(st.data) == 0 {
+			break
+		}
+
+		msg, n, err := parseMessage(st.data, true)
+		if err == errIncompleteMessage {
+			debugf("Waiting for more data")
+			break
+		}
+		if err != nil {
+			debugf("%v, dropping SIP stream %s", err, tcptuple)
+			conn.streams[dir] = nil
+			break
+		}
+
+		// The message refers to the stream buffer, which is reused for
+		// the next segments.
+		msg.raw = append([]byte(nil), msg.raw...)
+		msg.body = msg.raw[len(msg.raw)-len(msg.body):]
+		msg.ts = pkt.Ts
+		msg.tuple = pkt.Tuple
+		msg.cmdlineTuple = procs.ProcWatcher.FindProcessesTuple(tcptuple.IPPort())
+		st.data = st.data[n:]
+
+		sip.handleMessage(transportTCP, msg)
+	}
+
+	return conn
+}
+
+func ensureConnection(private protos.ProtocolData) *connection {
+	if private == nil {
+		return &connection{}
+	}
+
+	conn, ok := private.(*connection)
+	if !ok {
+		logp.Warn("SIP connection data type error, create new one")
+		return &connection{}
+	}
+	if conn == nil {
+		logp.Warn("Unexpected: SIP connection data not set, create new one")
+		return &connection{}
+	}
+
+	return conn
+}
+
+// ReceivedFin is a no-op, SIP messages over TCP are delimited by their
+// Content-Length header.
+func (sip *sipPlugin) ReceivedFin(
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	return private
+}
+
+// GapInStream drops the stream, as the message boundaries are lost.
+func (sip *sipPlugin) GapInStream(
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	nbytes int,
+	private protos.ProtocolData,
+) (priv protos.ProtocolData, drop bool) {
+	if conn, ok := private.(*connection); ok && conn != nil {
+		conn.streams[dir] = nil
+	}
+	return private, true
+}
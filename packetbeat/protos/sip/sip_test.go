@@ -0,0 +1,277 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package sip
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+var (
+	clientIP = net.ParseIP("192.0.2.101")
+	serverIP = net.ParseIP("192.0.2.201")
+
+	forward = common.NewIPPortTuple(4, clientIP, 5060, serverIP, 5060)
+	reverse = common.NewIPPortTuple(4, serverIP, 5060, clientIP, 5060)
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	e.events = append(e.events, event)
+}
+
+func newSIP(store *eventStore, settings map[string]interface{}) *sipPlugin {
+	cfg, _ := common.NewConfigFrom(settings)
+	sip, err := New(false, store.publish, cfg)
+	if err != nil {
+		panic(err)
+	}
+	return sip.(*sipPlugin)
+}
+
+func newPacket(t common.IPPortTuple, ts time.Time, payload string) *protos.Packet {
+	return &protos.Packet{
+		Ts:      ts,
+		Tuple:   t,
+		Payload: []byte(payload),
+	}
+}
+
+func TestSIP_udpCall(t *testing.T) {
+	var store eventStore
+	sip := newSIP(&store, map[string]interface{}{"send_request": true})
+
+	ts := time.Now()
+	sip.ParseUDP(newPacket(forward, ts, invite))
+	sip.ParseUDP(newPacket(forward, ts.Add(500*time.Millisecond), invite))
+	sip.ParseUDP(newPacket(reverse, ts.Add(10*time.Millisecond), trying))
+	sip.ParseUDP(newPacket(reverse, ts.Add(1200*time.Millisecond), ringing))
+	sip.ParseUDP(newPacket(reverse, ts.Add(4500*time.Millisecond), inviteOK))
+	sip.ParseUDP(newPacket(reverse, ts.Add(5000*time.Millisecond), inviteOK))
+	sip.ParseUDP(newPacket(forward, ts.Add(4510*time.Millisecond), ack))
+	sip.ParseUDP(newPacket(forward, ts.Add(4520*time.Millisecond), "\r\n\r\n"))
+
+	if !assert.Len(t, store.events, 1) {
+		return
+	}
+	event := store.events[0]
+	assert.Equal(t, ts, event.Timestamp)
+
+	fields := event.Fields
+	assert.Equal(t, "sip", fields["type"])
+	assert.Equal(t, "udp", fields["transport"])
+	assert.Equal(t, common.OK_STATUS, fields["status"])
+	assert.Equal(t, "INVITE", fields["method"])
+	assert.Equal(t, "INVITE sip:bob@biloxi.example.com", fields["query"])
+	assert.Equal(t, int32(4500), fields["responsetime"])
+	assert.Equal(t, len(invite), fields["bytes_in"])
+	assert.Equal(t, len(inviteOK), fields["bytes_out"])
+	assert.Equal(t, invite, fields["request"])
+	assert.NotContains(t, fields, "response")
+	assert.NotContains(t, fields, "notes")
+	assert.Equal(t, "192.0.2.101", fields["src"].(*common.Endpoint).IP)
+
+	assert.Equal(t, common.MapStr{
+		"call_id": "3848276298220188511@atlanta.example.com",
+		"cseq": common.MapStr{
+			"number": uint32(1),
+			"method": "INVITE",
+		},
+		"branch": "z9hG4bK74bf9",
+		"from": common.MapStr{
+			"uri":          "sip:alice@atlanta.example.com",
+			"display_name": "Alice",
+			"tag":          "9fxced76sl",
+		},
+		"to": common.MapStr{
+			"uri":          "sip:bob@biloxi.example.com",
+			"display_name": "Bob",
+			"tag":          "8321234356",
+		},
+		"contact":      "sip:alice@client.atlanta.example.com;transport=udp",
+		"user_agent":   "softphone/1.0",
+		"server":       "pbx/2.1",
+		"ringing_time": int32(1200),
+		"request": common.MapStr{
+			"uri": "sip:bob@biloxi.example.com",
+			"sdp": common.MapStr{
+				"connection":   "192.0.2.101",
+				"session_name": "-",
+				"media": []common.MapStr{
+					{
+						"type":       "audio",
+						"port":       49172,
+						"protocol":   "RTP/AVP",
+						"formats":    []string{"0", "8", "101"},
+						"codecs":     []string{"PCMU/8000", "PCMA/8000", "telephone-event/8000"},
+						"connection": "192.0.2.101",
+						"direction":  "sendrecv",
+					},
+				},
+			},
+		},
+		"response": common.MapStr{
+			"code":        200,
+			"phrase":      "OK",
+			"provisional": []int{100, 180},
+			"sdp": common.MapStr{
+				"connection":   "192.0.2.201",
+				"session_name": "call",
+				"media": []common.MapStr{
+					{
+						"type":       "audio",
+						"port":       3456,
+						"protocol":   "RTP/AVP",
+						"formats":    []string{"0"},
+						"codecs":     []string{"PCMU/8000"},
+						"connection": "192.0.2.201",
+					},
+					{
+						"type":       "video",
+						"port":       0,
+						"protocol":   "RTP/AVP",
+						"formats":    []string{"31"},
+						"connection": "192.0.2.202",
+						"direction":  "inactive",
+					},
+				},
+			},
+		},
+	}, fields["sip"])
+}
+
+func TestSIP_parseSDPDisabled(t *testing.T) {
+	var store eventStore
+	sip := newSIP(&store, map[string]interface{}{"parse_sdp": false})
+
+	ts := time.Now()
+	sip.ParseUDP(newPacket(forward, ts, invite))
+	sip.ParseUDP(newPacket(reverse, ts, inviteOK))
+
+	if assert.Len(t, store.events, 1) {
+		request, _ := store.events[0].Fields.GetValue("sip.request")
+		assert.Equal(t, common.MapStr{"uri": "sip:bob@biloxi.example.com"}, request)
+	}
+}
+
+func TestSIP_errorResponse(t *testing.T) {
+	var store eventStore
+	sip := newSIP(&store, nil)
+
+	busy := sipMessage("SIP/2.0 486 Busy Here", responseHeaders(), "")
+	ts := time.Now()
+	sip.ParseUDP(newPacket(forward, ts, invite))
+	sip.ParseUDP(newPacket(reverse, ts, busy))
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, common.CLIENT_ERROR_STATUS, fields["status"])
+		code, _ := fields.GetValue("sip.response.code")
+		assert.Equal(t, 486, code)
+	}
+}
+
+func TestSIP_orphanedResponse(t *testing.T) {
+	var store eventStore
+	sip := newSIP(&store, nil)
+
+	ts := time.Now()
+	sip.ParseUDP(newPacket(reverse, ts, ringing))
+	sip.ParseUDP(newPacket(reverse, ts, inviteOK))
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, orphanedResponse, fields["notes"])
+		assert.Equal(t, "192.0.2.101", fields["src"].(*common.Endpoint).IP)
+		assert.NotContains(t, fields, "responsetime")
+	}
+}
+
+func TestSIP_noResponse(t *testing.T) {
+	var store eventStore
+	sip := newSIP(&store, map[string]interface{}{"transaction_timeout": "10ms"})
+
+	sip.ParseUDP(newPacket(forward, time.Now(), invite))
+	time.Sleep(20 * time.Millisecond)
+	sip.transactions.CleanUp()
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, noResponse, fields["notes"])
+		assert.Equal(t, common.ERROR_STATUS, fields["status"])
+	}
+}
+
+func TestSIP_finalResponseRemovesTransaction(t *testing.T) {
+	var store eventStore
+	sip := newSIP(&store, map[string]interface{}{"transaction_timeout": "10ms"})
+
+	ts := time.Now()
+	sip.ParseUDP(newPacket(forward, ts, invite))
+	sip.ParseUDP(newPacket(reverse, ts, inviteOK))
+	assert.Equal(t, 0, sip.transactions.Size())
+
+	// Retransmissions of the request and final response are ignored.
+	sip.ParseUDP(newPacket(forward, ts, invite))
+	sip.ParseUDP(newPacket(reverse, ts, inviteOK))
+	assert.Equal(t, 0, sip.transactions.Size())
+
+	time.Sleep(20 * time.Millisecond)
+	sip.transactions.CleanUp()
+	assert.Len(t, store.events, 1)
+}
+
+func TestSIP_tcp(t *testing.T) {
+	var store eventStore
+	sip := newSIP(&store, nil)
+
+	tcptuple := common.TCPTupleFromIPPort(&forward, 1)
+	ts := time.Now()
+
+	// The request is split in two segments, and the responses are sent in a
+	// single one after a keep-alive.
+	var private protos.ProtocolData
+	private = sip.Parse(newPacket(forward, ts, invite[:100]), &tcptuple, 0, private)
+	private = sip.Parse(newPacket(forward, ts, invite[100:]), &tcptuple, 0, private)
+	private = sip.Parse(newPacket(reverse, ts.Add(time.Second), "\r\n\r\n"+trying+inviteOK), &tcptuple, 1, private)
+
+	if assert.Len(t, store.events, 1) {
+		fields := store.events[0].Fields
+		assert.Equal(t, "tcp", fields["transport"])
+		assert.Equal(t, int32(1000), fields["responsetime"])
+		provisional, _ := fields.GetValue("sip.response.provisional")
+		assert.Equal(t, []int{100}, provisional)
+	}
+
+	// A gap drops the stream, the next message is parsed again.
+	private, drop := sip.GapInStream(&tcptuple, 0, 10, private)
+	assert.True(t, drop)
+	assert.Nil(t, private.(*connection).streams[0])
+}
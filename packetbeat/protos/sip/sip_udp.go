@@ -0,0 +1,50 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sip
+
+import (
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/elastic/beats/packetbeat/procs"
+	"github.com/elastic/beats/packetbeat/protos"
+)
+
+func (sip *sipPlugin) ParseUDP(pkt *protos.Packet) {
+	defer logp.Recover("SIP ParseUDP")
+
+	debugf("Parsing packet addressed with %s of length %d.",
+		pkt.Tuple.String(), len(pkt.Payload))
+
+	// Each datagram carries a single message, possibly preceded by
+	// keep-alives.
+	payload := pkt.Payload[skipKeepAlives(pkt.Payload):]
+	if len(payload) == 0 {
+		return
+	}
+
+	msg, _, err := parseMessage(payload, false)
+	if err != nil {
+		debugf("%v", err)
+		return
+	}
+	msg.ts = pkt.Ts
+	msg.tuple = pkt.Tuple
+	msg.cmdlineTuple = procs.ProcWatcher.FindProcessesTuple(&pkt.Tuple)
+
+	sip.handleMessage(transportUDP, msg)
+}
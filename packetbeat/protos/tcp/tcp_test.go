@@ -191,13 +191,13 @@ type protocols struct {
 // Verify protocols implements the protos.Protocols interface.
 var _ protos.Protocols = &protocols{}
 
-func (p protocols) BpfFilter(withVlans bool, withICMP bool) string       { return "" }
-func (p protocols) GetTCP(proto protos.Protocol) protos.TCPPlugin        { return p.tcp[proto] }
-func (p protocols) GetUDP(proto protos.Protocol) protos.UDPPlugin        { return nil }
-func (p protocols) GetAll() map[protos.Protocol]protos.Plugin            { return nil }
-func (p protocols) GetAllTCP() map[protos.Protocol]protos.TCPPlugin      { return p.tcp }
-func (p protocols) GetAllUDP() map[protos.Protocol]protos.UDPPlugin      { return nil }
-func (p protocols) Register(proto protos.Protocol, plugin protos.Plugin) { return }
+func (p protocols) BpfFilter(withVlans bool, withICMP bool, extra ...string) string { return "" }
+func (p protocols) GetTCP(proto protos.Protocol) protos.TCPPlugin                   { return p.tcp[proto] }
+func (p protocols) GetUDP(proto protos.Protocol) protos.UDPPlugin                   { return nil }
+func (p protocols) GetAll() map[protos.Protocol]protos.Plugin                       { return nil }
+func (p protocols) GetAllTCP() map[protos.Protocol]protos.TCPPlugin                 { return p.tcp }
+func (p protocols) GetAllUDP() map[protos.Protocol]protos.UDPPlugin                 { return nil }
+func (p protocols) Register(proto protos.Protocol, plugin protos.Plugin)            { return }
 
 func TestTCSeqPayload(t *testing.T) {
 	type segment struct {
@@ -52,7 +52,7 @@ type TestProtocols struct {
 	udp map[protos.Protocol]protos.UDPPlugin
 }
 
-func (p TestProtocols) BpfFilter(withVlans bool, withICMP bool) string {
+func (p TestProtocols) BpfFilter(withVlans bool, withICMP bool, extra ...string) string {
 	return "mock bpf filter"
 }
 
@@ -19,9 +19,11 @@ package publish
 
 import (
 	"errors"
+	"net"
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/flowhash"
 	"github.com/elastic/beats/libbeat/logp"
 	"github.com/elastic/beats/libbeat/processors"
 )
@@ -31,12 +33,14 @@ type TransactionPublisher struct {
 	pipeline  beat.Pipeline
 	canDrop   bool
 	processor transProcessor
+	observer  func(*beat.Event)
 }
 
 type transProcessor struct {
 	ignoreOutgoing bool
 	localIPs       []string
 	name           string
+	communityID    *flowhash.CommunityID
 }
 
 var debugf = logp.MakeDebug("publish")
@@ -46,6 +50,7 @@ func NewTransactionPublisher(
 	pipeline beat.Pipeline,
 	ignoreOutgoing bool,
 	canDrop bool,
+	communityID *flowhash.CommunityID,
 ) (*TransactionPublisher, error) {
 	localIPs, err := common.LocalIPAddrsAsStrings(false)
 	if err != nil {
@@ -60,6 +65,7 @@ func NewTransactionPublisher(
 			localIPs:       localIPs,
 			name:           name,
 			ignoreOutgoing: ignoreOutgoing,
+			communityID:    communityID,
 		},
 	}
 	return p, nil
@@ -69,6 +75,13 @@ func (p *TransactionPublisher) Stop() {
 	close(p.done)
 }
 
+// SetObserver registers a function called with every transaction event
+// after normalization and before it is published. It must be set before
+// any reporter is created.
+func (p *TransactionPublisher) SetObserver(observer func(*beat.Event)) {
+	p.observer = observer
+}
+
 func (p *TransactionPublisher) CreateReporter(
 	config *common.Config,
 ) (func(beat.Event), error) {
@@ -121,6 +134,9 @@ func (p *TransactionPublisher) worker(ch chan beat.Event, client beat.Client) {
 		case event := <-ch:
 			pub, _ := p.processor.Run(&event)
 			if pub != nil {
+				if p.observer != nil {
+					p.observer(pub)
+				}
 				client.Publish(*pub)
 			}
 		}
@@ -137,6 +153,12 @@ func (p *transProcessor) Run(event *beat.Event) (*beat.Event, error) {
 		return nil, nil
 	}
 
+	if p.communityID != nil {
+		if flow, ok := communityIDFlow(event.Fields); ok {
+			event.Fields["community_id"] = p.communityID.Hash(flow)
+		}
+	}
+
 	return event, nil
 }
 
@@ -196,6 +218,12 @@ func (p *transProcessor) normalizeTransAddr(event common.MapStr) bool {
 		if _, exists := event["client_server"]; !exists {
 			event["client_server"] = p.GetServerName(src.IP)
 		}
+		if tunnel := src.Tunnel.Fields(); tunnel != nil {
+			event["tunnel"] = tunnel
+		}
+		if src.Interface != "" {
+			event["interface"] = common.MapStr{"name": src.Interface}
+		}
 		delete(event, "src")
 	}
 
@@ -224,6 +252,70 @@ func (p *transProcessor) normalizeTransAddr(event common.MapStr) bool {
 	return true
 }
 
+// communityIDFlow returns the addresses and transport of a normalized
+// transaction event, as needed to compute its Community ID.
+func communityIDFlow(event common.MapStr) (flowhash.Flow, bool) {
+	var flow flowhash.Flow
+
+	client, server := toIP(event["client_ip"]), toIP(event["ip"])
+	if client == nil || server == nil {
+		return flow, false
+	}
+
+	if icmp, ok := event["icmp"].(common.MapStr); ok && event["type"] == "icmp" {
+		flow.Protocol = flowhash.ICMP
+		if version, _ := icmp["version"].(uint8); version == 6 {
+			flow.Protocol = flowhash.ICMPv6
+		}
+
+		// responses without request are sent by the server
+		msg, ok := icmp["request"].(common.MapStr)
+		flow.SourceIP, flow.DestinationIP = client, server
+		if !ok {
+			if msg, ok = icmp["response"].(common.MapStr); !ok {
+				return flow, false
+			}
+			flow.SourceIP, flow.DestinationIP = server, client
+		}
+		flow.ICMP.Type, _ = msg["type"].(uint8)
+		flow.ICMP.Code, _ = msg["code"].(uint8)
+		return flow, true
+	}
+
+	transport := "tcp"
+	if s, ok := event["transport"].(string); ok {
+		transport = s
+	}
+	proto, found := flowhash.TransportNumber(transport)
+	if !found {
+		return flow, false
+	}
+
+	clientPort, ok := event["client_port"].(uint16)
+	if !ok {
+		return flow, false
+	}
+	serverPort, ok := event["port"].(uint16)
+	if !ok {
+		return flow, false
+	}
+
+	flow.SourceIP, flow.DestinationIP = client, server
+	flow.SourcePort, flow.DestinationPort = clientPort, serverPort
+	flow.Protocol = proto
+	return flow, true
+}
+
+func toIP(v interface{}) net.IP {
+	switch ip := v.(type) {
+	case net.IP:
+		return ip
+	case string:
+		return net.ParseIP(ip)
+	}
+	return nil
+}
+
 func (p *transProcessor) IsPublisherIP(ip string) bool {
 	for _, myip := range p.localIPs {
 		if myip == ip {
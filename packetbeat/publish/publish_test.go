@@ -20,6 +20,7 @@
 package publish
 
 import (
+	"net"
 	"testing"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/flowhash"
 )
 
 func testEvent() beat.Event {
@@ -193,3 +195,103 @@ func TestNoDirection(t *testing.T) {
 	_, ok := event.Fields["direction"]
 	assert.False(t, ok)
 }
+
+func TestTunnel(t *testing.T) {
+	processor := transProcessor{
+		localIPs:       []string{"192.145.2.6"},
+		ignoreOutgoing: false,
+		name:           "test",
+	}
+
+	tunnel := common.Tunnel{Type: common.TunnelVXLAN, ID: 100, HasID: true}
+	event := beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"type": "test",
+			"src": &common.Endpoint{
+				IP:     "192.145.2.4",
+				Port:   3267,
+				Tunnel: tunnel,
+			},
+			"dst": &common.Endpoint{
+				IP:     "192.145.2.5",
+				Port:   32232,
+				Tunnel: tunnel,
+			},
+		},
+	}
+
+	if res, _ := processor.Run(&event); res == nil {
+		t.Fatalf("event has been filtered out")
+	}
+	assert.Equal(t, common.MapStr{"type": "vxlan", "id": uint32(100)}, event.Fields["tunnel"])
+}
+
+func TestInterface(t *testing.T) {
+	processor := transProcessor{
+		localIPs:       []string{"192.145.2.6"},
+		ignoreOutgoing: false,
+		name:           "test",
+	}
+
+	event := beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"type": "test",
+			"src": &common.Endpoint{
+				IP:        "192.145.2.4",
+				Port:      3267,
+				Interface: "eth1",
+			},
+			"dst": &common.Endpoint{
+				IP:        "192.145.2.5",
+				Port:      32232,
+				Interface: "eth1",
+			},
+		},
+	}
+
+	if res, _ := processor.Run(&event); res == nil {
+		t.Fatalf("event has been filtered out")
+	}
+	assert.Equal(t, common.MapStr{"name": "eth1"}, event.Fields["interface"])
+}
+
+func TestCommunityID(t *testing.T) {
+	processor := transProcessor{
+		localIPs:    []string{"192.145.2.6"},
+		name:        "test",
+		communityID: flowhash.NewCommunityID(0),
+	}
+
+	event := beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"type":      "dns",
+			"transport": "udp",
+			"src":       &common.Endpoint{IP: "192.168.1.52", Port: 54585},
+			"dst":       &common.Endpoint{IP: "8.8.8.8", Port: 53},
+		},
+	}
+	if res, _ := processor.Run(&event); res == nil {
+		t.Fatalf("event has been filtered out")
+	}
+	assert.Equal(t, "1:d/FP5EW3wiY1vCndhwleRRKHowQ=", event.Fields["community_id"])
+
+	event = beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"type":      "icmp",
+			"client_ip": net.ParseIP("192.168.0.89"),
+			"ip":        net.ParseIP("192.168.0.1"),
+			"icmp": common.MapStr{
+				"version":  uint8(4),
+				"response": common.MapStr{"type": uint8(0), "code": uint8(0)},
+			},
+		},
+	}
+	if res, _ := processor.Run(&event); res == nil {
+		t.Fatalf("event has been filtered out")
+	}
+	assert.Equal(t, "1:X0snYXpgwiv9TZtqg64sgzUn6Dk=", event.Fields["community_id"])
+}
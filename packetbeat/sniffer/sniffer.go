@@ -50,7 +50,8 @@ type Sniffer struct {
 }
 
 // WorkerFactory constructs a new worker instance for use with a Sniffer.
-type WorkerFactory func(layers.LinkType) (Worker, error)
+// The device name is empty if packets are read from a file.
+type WorkerFactory func(device string, linkType layers.LinkType) (Worker, error)
 
 // Worker defines the callback interfaces a Sniffer instance will use
 // to forward packets.
@@ -161,7 +162,7 @@ func (s *Sniffer) Run() error {
 		defer dumper.Close()
 	}
 
-	worker, err := s.factory(handle.LinkType())
+	worker, err := s.factory(s.config.Device, handle.LinkType())
 	if err != nil {
 		return err
 	}
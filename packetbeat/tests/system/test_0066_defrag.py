@@ -0,0 +1,49 @@
+from packetbeat import BaseTest
+
+"""
+Tests for the reassembly of fragmented IP datagrams.
+"""
+
+
+class Test(BaseTest):
+
+    def test_ipv4_fragmented_dns_response(self):
+        """
+        Should reassemble a DNS response fragmented over IPv4, with the
+        fragments received in reverse order.
+        """
+        self.render_config_template(
+            dns_ports=[53],
+        )
+        self.run_packetbeat(pcap="dns_udp_fragmented.pcap")
+
+        objs = self.read_output()
+        assert len(objs) == 1
+        o = objs[0]
+
+        assert o["type"] == "dns"
+        assert o["transport"] == "udp"
+        assert o["status"] == "OK"
+        assert o["query"] == "class IN, type TXT, txt.example.com."
+        assert o["dns.answers_count"] == 12
+        assert o["bytes_out"] == 3260
+
+    def test_ipv6_fragmented_dns_response(self):
+        """
+        Should reassemble a DNS response fragmented over IPv6.
+        """
+        self.render_config_template(
+            dns_ports=[53],
+        )
+        self.run_packetbeat(pcap="dns_udp_fragmented_ipv6.pcap")
+
+        objs = self.read_output()
+        assert len(objs) == 1
+        o = objs[0]
+
+        assert o["type"] == "dns"
+        assert o["transport"] == "udp"
+        assert o["status"] == "OK"
+        assert o["ip"] == "2001:db8::1"
+        assert o["dns.answers_count"] == 12
+        assert o["bytes_out"] == 3260
@@ -0,0 +1,29 @@
+from packetbeat import BaseTest
+
+"""
+Tests for the decapsulation of tunneled traffic.
+"""
+
+
+class Test(BaseTest):
+
+    def test_vxlan_dns(self):
+        """
+        Should decode a DNS transaction encapsulated in VXLAN and report the
+        VNI of the tunnel.
+        """
+        self.render_config_template(
+            dns_ports=[53],
+        )
+        self.run_packetbeat(pcap="dns_vxlan.pcap")
+
+        objs = self.read_output()
+        assert len(objs) == 1
+        o = objs[0]
+
+        assert o["type"] == "dns"
+        assert o["status"] == "OK"
+        assert o["client_ip"] == "192.168.1.10"
+        assert o["ip"] == "192.168.1.1"
+        assert o["tunnel.type"] == "vxlan"
+        assert o["tunnel.id"] == 5001
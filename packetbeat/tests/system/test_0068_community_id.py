@@ -0,0 +1,40 @@
+import base64
+import hashlib
+import socket
+import struct
+
+from packetbeat import BaseTest
+
+"""
+Tests for the Community ID flow hash.
+"""
+
+
+def community_id(src, dst, proto, sport, dport, seed=0):
+    family = socket.AF_INET6 if ":" in src else socket.AF_INET
+    saddr, daddr = socket.inet_pton(family, src), socket.inet_pton(family, dst)
+    if (saddr, sport) > (daddr, dport):
+        saddr, daddr, sport, dport = daddr, saddr, dport, sport
+    data = struct.pack("!H", seed) + saddr + daddr + \
+        struct.pack("!BBHH", proto, 0, sport, dport)
+    return "1:" + base64.b64encode(hashlib.sha1(data).digest()).decode("ascii")
+
+
+class Test(BaseTest):
+
+    def test_dns_transaction(self):
+        """
+        Should add the Community ID to transactions.
+        """
+        self.render_config_template(
+            dns_ports=[53],
+        )
+        self.run_packetbeat(pcap="dns_google_com.pcap")
+
+        objs = self.read_output()
+        assert len(objs) == 1
+        o = objs[0]
+
+        assert o["type"] == "dns"
+        assert o["community_id"] == community_id(
+            o["client_ip"], o["ip"], 17, o["client_port"], o["port"])